@@ -6,8 +6,6 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
-	"regexp"
-	"strconv"
 
 	"github.com/bots-house/share-file-bot/bot/state"
 	"github.com/bots-house/share-file-bot/core"
@@ -30,14 +28,18 @@ type Bot struct {
 	client *tgbotapi.BotAPI
 	state  state.Store
 
-	authSrv  *service.Auth
-	fileSrv  *service.File
-	adminSrv *service.Admin
-	chatSrv  *service.Chat
+	authSrv      *service.Auth
+	fileSrv      *service.File
+	bundleSrv    *service.Bundle
+	adminSrv     *service.Admin
+	chatSrv      *service.Chat
+	reportSrv    *service.Report
+	broadcastSrv *service.Broadcast
 
 	textHelp string
 
 	handler tg.Handler
+	router  *tg.Router
 }
 
 func (bot *Bot) Self() tgbotapi.User {
@@ -50,8 +52,11 @@ func New(
 	state state.Store,
 	authSrv *service.Auth,
 	docSrv *service.File,
+	bundleSrv *service.Bundle,
 	adminSrv *service.Admin,
 	chatSrv *service.Chat,
+	reportSrv *service.Report,
+	broadcastSrv *service.Broadcast,
 	textHelp string,
 ) (*Bot, error) {
 
@@ -60,10 +65,13 @@ func New(
 		client:    client,
 		state:     state,
 
-		authSrv:  authSrv,
-		fileSrv:  docSrv,
-		adminSrv: adminSrv,
-		chatSrv:  chatSrv,
+		authSrv:      authSrv,
+		fileSrv:      docSrv,
+		bundleSrv:    bundleSrv,
+		adminSrv:     adminSrv,
+		chatSrv:      chatSrv,
+		reportSrv:    reportSrv,
+		broadcastSrv: broadcastSrv,
 
 		textHelp: textHelp,
 	}
@@ -99,32 +107,47 @@ func (bot *Bot) SetWebhookIfNeed(ctx context.Context, u string) error {
 	return nil
 }
 
+func (bot *Bot) RemoveWebhookIfNeed(ctx context.Context) error {
+	webhook, err := bot.client.GetWebhookInfo()
+	if err != nil {
+		return errors.Wrap(err, "get webhook info")
+	}
+
+	if webhook.IsSet() {
+		log.Info(ctx, "remove bot webhook", "old", webhook.URL)
+		if _, err := bot.client.RemoveWebhook(); err != nil {
+			return errors.Wrap(err, "remove webhook")
+		}
+	}
+
+	return nil
+}
+
+// Poll receives updates using long polling and handle them until ctx is done.
+// Webhook should be removed before.
+func (bot *Bot) Poll(ctx context.Context) error {
+	handler := tg.HandlerFunc(func(ctx context.Context, update *tg.Update) error {
+		hub := sentry.CurrentHub().Clone()
+		ctx = sentry.SetHubOnContext(ctx, hub)
+
+		return bot.handler.HandleUpdate(ctx, update)
+	})
+
+	poller := tg.NewPoller(bot.client, handler)
+	poller.OnError = bot.onError
+
+	return poller.Run(ctx)
+}
+
 func (bot *Bot) initHandler() {
 	authMiddleware := newAuthMiddleware(bot.authSrv)
 
 	handler := authMiddleware(tg.HandlerFunc(bot.onUpdate))
 
+	bot.router = bot.newRouter()
 	bot.handler = handler
 }
 
-var (
-	cbqFileRefresh               = regexp.MustCompile(`^file:(\d+):refresh$`)
-	cbqFileDelete                = regexp.MustCompile(`^file:(\d+):delete$`)
-	cbqFileDeleteConfirm         = regexp.MustCompile(`^file:(\d+):delete:confirm$`)
-	cbqFileRestrictions          = regexp.MustCompile(`^file:(\d+):restrictions$`)
-	cbqFileRestrictionsChat      = regexp.MustCompile(`file:(\d+):restrictions:chat-subscription:(\d+):toggl`)
-	cbqFileRestrictionsChatCheck = regexp.MustCompile(`^file:(\d+):restrictions:chat:check$`)
-
-	cbqSettings              = regexp.MustCompile(`^` + callbackSettings + `$`)
-	cbqSettingsToggleLongIDs = regexp.MustCompile(`^` + callbackSettingsLongIDs + `$`)
-
-	cbqSettingsChannelsAndChats              = regexp.MustCompile(`^` + callbackSettingsChannelsAndChats + `$`)
-	cbqSettingsChannelsAndChatsConnect       = regexp.MustCompile(`^` + callbackSettingsChannelsAndChatsConnect + `$`)
-	cbqSettingsChannelsAndChatsDetails       = regexp.MustCompile(`^settings:channels-and-chats:(\d+)$`)
-	cbqSettingsChannelsAndChatsDelete        = regexp.MustCompile(`^settings:channels-and-chats:(\d+):delete$`)
-	cbqSettingsChannelsAndChatsDeleteConfirm = regexp.MustCompile(`^settings:channels-and-chats:(\d+):delete:confirm$`)
-)
-
 func parseURLsFromChannelPost(post *tgbotapi.Message) []string {
 	urls := []string{}
 
@@ -143,9 +166,7 @@ func parseURLsFromChannelPost(post *tgbotapi.Message) []string {
 	return urls
 }
 
-// i known, we should rewrite it
-// nolint:gocyclo
-func (bot *Bot) onUpdate(ctx context.Context, update *tgbotapi.Update) error {
+func (bot *Bot) onUpdate(ctx context.Context, update *tg.Update) error {
 	// handle channel post
 	if post := update.ChannelPost; post != nil {
 		if post.NewChatTitle != "" {
@@ -164,17 +185,19 @@ func (bot *Bot) onUpdate(ctx context.Context, update *tgbotapi.Update) error {
 			return nil
 		}
 
-		if msg.Text == textButtonAbout {
-			answer := bot.newAnswerMsg(msg, bot.getTextStart())
+		if isButtonAbout(msg.Text) {
+			answer := bot.newAnswerMsg(msg, bot.getTextStart(getTextsCtx(ctx)))
 			answer.ParseMode = mdv2
 			return bot.send(ctx, answer)
 		}
 
-		userState, err := bot.state.Get(ctx, user.ID)
+		userState, userStateData, err := bot.state.GetData(ctx, user.ID)
 		if err != nil {
 			return errors.Wrap(err, "get state")
 		}
 
+		ctx = withStateData(ctx, userStateData)
+
 		withSentryHub(ctx, func(hub *sentry.Hub) {
 			hub.AddBreadcrumb(&sentry.Breadcrumb{
 				Category: "bot",
@@ -188,23 +211,13 @@ func (bot *Bot) onUpdate(ctx context.Context, update *tgbotapi.Update) error {
 		})
 
 		// handle command
-		switch msg.Command() {
-		case cmdStart:
-			return bot.onStart(ctx, msg)
-		case "help":
-			return bot.onHelp(ctx, msg)
-		case "admin":
-			return bot.onAdmin(ctx, msg)
-		case "settings":
-			return bot.onSettings(ctx, msg)
-		case "version":
-			return bot.onVersion(ctx, msg)
+		if ok, err := bot.router.HandleCommand(ctx, msg); ok {
+			return err
 		}
 
-		//nolint:gocritic
-		switch userState {
-		case state.SettingsChannelsAndChatsConnect:
-			return bot.onSettingsChannelsAndChatsConnectState(ctx, msg)
+		// handle state
+		if ok, err := bot.router.HandleState(ctx, userState, msg); ok {
+			return err
 		}
 
 		// handle other
@@ -216,134 +229,19 @@ func (bot *Bot) onUpdate(ctx context.Context, update *tgbotapi.Update) error {
 	}
 
 	// handle callback queries
-	//nolint:nestif
 	if cbq := update.CallbackQuery; cbq != nil {
-		data := cbq.Data
-		switch {
-
-		// file check chat subscription
-		case len(cbqFileRestrictionsChatCheck.FindStringIndex(data)) > 0:
-			result := cbqFileRestrictionsChatCheck.FindStringSubmatch(data)
-
-			id, err := strconv.Atoi(result[1])
-			if err != nil {
-				return errors.Wrap(err, "parse cbq data")
-			}
-
-			return bot.onFileRestrictionsChatCheck(ctx, cbq, core.FileID(id))
-
-		// file menu
-		case len(cbqFileRefresh.FindStringIndex(data)) > 0:
-			result := cbqFileRefresh.FindStringSubmatch(data)
-
-			id, err := strconv.Atoi(result[1])
-			if err != nil {
-				return errors.Wrap(err, "parse cbq data")
-			}
-
-			return bot.onFileRefreshCBQ(ctx, cbq, id)
-
-		// file menu / delete
-		case len(cbqFileDelete.FindStringIndex(data)) > 0:
-			result := cbqFileDelete.FindStringSubmatch(data)
-
-			id, err := strconv.Atoi(result[1])
-			if err != nil {
-				return errors.Wrap(err, "parse cbq data")
-			}
-
-			return bot.onFileDeleteCBQ(ctx, cbq, id)
-		// file menu / delete
-		case len(cbqFileDeleteConfirm.FindStringIndex(data)) > 0:
-			result := cbqFileDeleteConfirm.FindStringSubmatch(data)
-
-			id, err := strconv.Atoi(result[1])
-			if err != nil {
-				return errors.Wrap(err, "parse cbq data")
-			}
-
-			return bot.onFileDeleteConfirmCBQ(ctx, cbq, id)
-		// file menu / restrictions
-		case len(cbqFileRestrictions.FindStringIndex(data)) > 0:
-			result := cbqFileRestrictions.FindStringSubmatch(data)
-
-			id, err := strconv.Atoi(result[1])
-			if err != nil {
-				return errors.Wrap(err, "parse cbq data")
-			}
-
-			return bot.onFileRestrictionsCBQ(ctx, cbq, id)
-		// file menu / restrictions / set chat
-		case len(cbqFileRestrictionsChat.FindStringIndex(data)) > 0:
-			result := cbqFileRestrictionsChat.FindStringSubmatch(data)
-
-			fileID, err := strconv.Atoi(result[1])
-			if err != nil {
-				return errors.Wrap(err, "parse cbq data (file_id)")
-			}
-
-			chatID, err := strconv.Atoi(result[2])
-			if err != nil {
-				return errors.Wrap(err, "parse cbq data (chat_id)")
-			}
-
-			return bot.onFileRestrictionsSetChatCBQ(ctx, cbq,
-				core.FileID(fileID),
-				core.ChatID(chatID),
-			)
-
-		// settings
-		case len(cbqSettings.FindStringIndex(data)) > 0:
-			return bot.onSettingsCallbackQuery(ctx, cbq)
-
-		// settings / long ids
-		case len(cbqSettingsToggleLongIDs.FindStringIndex(data)) > 0:
-			return bot.onSettingsToggleLongIDsCBQ(ctx, cbq)
-
-		// settings / channels and chats
-		case len(cbqSettingsChannelsAndChats.FindStringIndex(data)) > 0:
-			return bot.onSettingsChannelsAndChats(ctx, cbq)
-
-		// settings / channels and chats / connect
-		case len(cbqSettingsChannelsAndChatsConnect.FindStringIndex(data)) > 0:
-			return bot.onSettingsChannelsAndChatsConnect(ctx, cbq)
-
-		// settings / channels and chats / details
-		case len(cbqSettingsChannelsAndChatsDetails.FindStringIndex(data)) > 0:
-			result := cbqSettingsChannelsAndChatsDetails.FindStringSubmatch(data)
-
-			id, err := strconv.Atoi(result[1])
-			if err != nil {
-				return errors.Wrap(err, "parse cbq data")
-			}
-
-			return bot.onSettingsChannelsAndChatsDetails(ctx, user, cbq, core.ChatID(id))
-		// settings / channels and chats / delete
-		case len(cbqSettingsChannelsAndChatsDelete.FindStringIndex(data)) > 0:
-			result := cbqSettingsChannelsAndChatsDelete.FindStringSubmatch(data)
-
-			id, err := strconv.Atoi(result[1])
-			if err != nil {
-				return errors.Wrap(err, "parse cbq data")
-			}
-
-			return bot.onSettingsChannelsAndChatsDelete(ctx, user, cbq, core.ChatID(id))
-		// settings / channels and chats / delete / confirm
-		case len(cbqSettingsChannelsAndChatsDeleteConfirm.FindStringIndex(data)) > 0:
-			result := cbqSettingsChannelsAndChatsDeleteConfirm.FindStringSubmatch(data)
-
-			id, err := strconv.Atoi(result[1])
-			if err != nil {
-				return errors.Wrap(err, "parse cbq data")
-			}
-
-			return bot.onSettingsChannelsAndChatsDeleteConfirm(ctx, user, cbq, core.ChatID(id))
-		case data == cmdStart:
-			return bot.onPublicFileHelp(ctx, cbq)
-		default:
-			// spew.Dump(cbq)
-		}
+		_, err := bot.router.HandleCallback(ctx, cbq)
+		return err
+	}
 
+	// handle inline queries
+	if query := update.InlineQuery; query != nil {
+		return bot.onInlineQuery(ctx, query)
+	}
+
+	// handle chosen inline results
+	if result := update.ChosenInlineResult; result != nil {
+		return bot.onChosenInlineResult(ctx, result)
 	}
 
 	return nil
@@ -359,7 +257,7 @@ func (bot *Bot) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
-	update := &tgbotapi.Update{}
+	update := &tg.Update{}
 
 	// parse update
 	if err := json.NewDecoder(r.Body).Decode(update); err != nil {
@@ -374,8 +272,12 @@ func (bot *Bot) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func (bot *Bot) onError(ctx context.Context, update *tgbotapi.Update, er error) {
-	log.Error(ctx, "handle update failed", "update_id", update.UpdateID, "err", er)
+func (bot *Bot) onError(ctx context.Context, update *tg.Update, er error) {
+	if update != nil {
+		log.Error(ctx, "handle update failed", "update_id", update.UpdateID, "err", er)
+	} else {
+		log.Error(ctx, "receive updates failed", "err", er)
+	}
 
 	withSentryHub(ctx, func(hub *sentry.Hub) {
 		hub.CaptureException(er)
@@ -0,0 +1,136 @@
+package bot
+
+import (
+	"context"
+
+	"github.com/bots-house/share-file-bot/bot/state"
+	"github.com/bots-house/share-file-bot/pkg/log"
+	tgbotapi "github.com/bots-house/telegram-bot-api"
+	"github.com/friendsofgo/errors"
+)
+
+const (
+	cmdCancel      = "cancel"
+	callbackCancel = "cancel"
+)
+
+// startConversation sets state of user with data collected by conversation.
+// State and data are reset after TTL of state.
+func (bot *Bot) startConversation(ctx context.Context, st state.State, data *state.Data) error {
+	user := getUserCtx(ctx)
+
+	if err := bot.state.SetData(ctx, user.ID, st, data); err != nil {
+		return errors.Wrap(err, "set state")
+	}
+
+	return nil
+}
+
+// endConversation resets state of user and removes keyboard of prompt message, if conversation has it.
+// Prompt message is deleted instead, if deletePrompt is true.
+// Returns state of user before reset.
+func (bot *Bot) endConversation(ctx context.Context, deletePrompt bool) (state.State, error) {
+	user := getUserCtx(ctx)
+
+	userState, data, err := bot.state.GetData(ctx, user.ID)
+	if err != nil {
+		return state.Empty, errors.Wrap(err, "get state")
+	}
+
+	if userState == state.Empty {
+		return userState, nil
+	}
+
+	if err := bot.state.Del(ctx, user.ID); err != nil {
+		return state.Empty, errors.Wrap(err, "delete state")
+	}
+
+	if err := bot.releaseConversation(ctx, userState); err != nil {
+		return state.Empty, errors.Wrap(err, "release conversation")
+	}
+
+	if data != nil && data.MessageID != 0 {
+		var cleanup tgbotapi.Chattable = tgbotapi.NewEditMessageReplyMarkup(
+			int64(user.ID),
+			data.MessageID,
+			tgbotapi.InlineKeyboardMarkup{InlineKeyboard: [][]tgbotapi.InlineKeyboardButton{}},
+		)
+
+		if deletePrompt {
+			cleanup = tgbotapi.NewDeleteMessage(int64(user.ID), data.MessageID)
+		}
+
+		go func() {
+			if err := bot.send(ctx, cleanup); err != nil {
+				log.Warn(ctx, "can't cleanup prompt", "msg_id", data.MessageID, "err", err)
+			}
+		}()
+	}
+
+	return userState, nil
+}
+
+// releaseConversation clears input awaited by service for conversation in state.
+func (bot *Bot) releaseConversation(ctx context.Context, userState state.State) error {
+	user := getUserCtx(ctx)
+
+	switch userState {
+	case state.FilePasswordEnter, state.FilePasswordSet:
+		if err := bot.fileSrv.CancelFilePassword(ctx, user); err != nil {
+			return errors.Wrap(err, "cancel file password")
+		}
+	case state.BundleCollect:
+		if err := bot.bundleSrv.CollectCancel(ctx, user); err != nil {
+			return errors.Wrap(err, "cancel bundle collect")
+		}
+	case state.ReportReason, state.ReportAppeal:
+		if err := bot.reportSrv.CancelAwait(ctx, user); err != nil {
+			return errors.Wrap(err, "cancel report await")
+		}
+	case state.BroadcastButtons, state.BroadcastSegment:
+		if err := bot.broadcastSrv.CancelEdit(ctx, user); err != nil {
+			return errors.Wrap(err, "cancel broadcast edit")
+		}
+	}
+
+	return nil
+}
+
+func (bot *Bot) onCancel(ctx context.Context, msg *tgbotapi.Message) error {
+	user := getUserCtx(ctx)
+	texts := getTextsCtx(ctx)
+
+	userState, err := bot.endConversation(ctx, false)
+	if err != nil {
+		return errors.Wrap(err, "end conversation")
+	}
+
+	if userState == state.Empty {
+		return bot.sendText(ctx, user.ID, texts.CancelNothing)
+	}
+
+	out := tgbotapi.NewMessage(int64(user.ID), texts.CancelDone)
+
+	// bundle collect has reply keyboard with own buttons
+	if userState == state.BundleCollect {
+		out.ReplyMarkup = tgbotapi.NewRemoveKeyboard(false)
+	}
+
+	return bot.send(ctx, out)
+}
+
+func (bot *Bot) onCancelCBQ(ctx context.Context, cbq *tgbotapi.CallbackQuery) error {
+	userState, err := bot.endConversation(ctx, true)
+	if err != nil {
+		return errors.Wrap(err, "end conversation")
+	}
+
+	// prompt of expired conversation
+	if userState == state.Empty {
+		go func() {
+			_ = bot.deleteMessage(ctx, cbq.Message)
+		}()
+	}
+
+	return bot.answerCallbackQuery(ctx, cbq, getTextsCtx(ctx).CancelDone)
+}
@@ -3,6 +3,8 @@ package bot
 import (
 	"context"
 
+	"github.com/bots-house/share-file-bot/bot/i18n"
+	"github.com/bots-house/share-file-bot/bot/state"
 	"github.com/bots-house/share-file-bot/core"
 )
 
@@ -10,6 +12,8 @@ type contextKey int
 
 const (
 	userCtxKey contextKey = iota
+	textsCtxKey
+	stateDataCtxKey
 )
 
 func withUser(ctx context.Context, user *core.User) context.Context {
@@ -19,3 +23,34 @@ func withUser(ctx context.Context, user *core.User) context.Context {
 func getUserCtx(ctx context.Context) *core.User {
 	return ctx.Value(userCtxKey).(*core.User)
 }
+
+func withTexts(ctx context.Context, texts *i18n.Texts) context.Context {
+	return context.WithValue(ctx, textsCtxKey, texts)
+}
+
+// getTextsCtx returns texts in language of user, or texts of default language if user is unknown.
+func getTextsCtx(ctx context.Context) *i18n.Texts {
+	if texts, ok := ctx.Value(textsCtxKey).(*i18n.Texts); ok {
+		return texts
+	}
+
+	return i18n.Get(i18n.LangDefault)
+}
+
+// getUserTexts returns texts in language of user.
+func getUserTexts(user *core.User) *i18n.Texts {
+	return i18n.Get(i18n.Detect(user.Settings.Language, user.LanguageCode))
+}
+
+func withStateData(ctx context.Context, data *state.Data) context.Context {
+	return context.WithValue(ctx, stateDataCtxKey, data)
+}
+
+// getStateDataCtx returns data of current state of user, or empty data if state has no data.
+func getStateDataCtx(ctx context.Context) *state.Data {
+	if data, ok := ctx.Value(stateDataCtxKey).(*state.Data); ok && data != nil {
+		return data
+	}
+
+	return &state.Data{}
+}
@@ -3,32 +3,58 @@ package bot
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
+	"github.com/bots-house/share-file-bot/bot/i18n"
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/pkg/tg"
 	"github.com/bots-house/share-file-bot/service"
 	tgbotapi "github.com/bots-house/telegram-bot-api"
 	"github.com/friendsofgo/errors"
 )
 
-func (bot *Bot) onAdmin(ctx context.Context, msg *tgbotapi.Message) error {
+const (
+	// file id, action
+	callbackAdminFileAction = "admin:file:%d:%s"
+
+	adminFileActionBlock   = "block"
+	adminFileActionUnblock = "unblock"
+	adminFileActionBan     = "ban"
+	adminFileActionUnban   = "unban"
+
+	adminAuditLimit = 20
+)
+
+// renderAdminSummary returns summary stats text and markup with admin sections.
+func (bot *Bot) renderAdminSummary(ctx context.Context) (string, tgbotapi.InlineKeyboardMarkup, error) {
 	user := getUserCtx(ctx)
 
 	stats, err := bot.adminSrv.SummaryStats(ctx, user)
-	if errors.Cause(err) == service.ErrUserIsNotAdmin {
-		return nil
-	} else if err != nil {
-		return errors.Wrap(err, "summary stats")
+	if err != nil {
+		return "", tgbotapi.InlineKeyboardMarkup{}, errors.Wrap(err, "summary stats")
 	}
 
+	reports, err := bot.reportSrv.OpenReportsCount(ctx, user)
+	if err != nil {
+		return "", tgbotapi.InlineKeyboardMarkup{}, errors.Wrap(err, "open reports count")
+	}
+
+	texts := getTextsCtx(ctx)
+
 	lines := []string{
-		"*__Общая__*",
+		texts.AdminSummary,
 		"",
-		fmt.Sprintf("*Пользователи*: `%d`", stats.Users),
-		fmt.Sprintf("*Файлы*: `%d`", stats.Files),
-		fmt.Sprintf("*Загрузки*: `%d`", stats.Downloads),
-		fmt.Sprintf("*Чаты*: `%d`", stats.Chats),
+		fmt.Sprintf(texts.AdminUsers, stats.Users),
+		fmt.Sprintf(texts.AdminActiveUsers, stats.ActiveUsers),
+		fmt.Sprintf(texts.AdminBlockedUsers, stats.BlockedUsers),
+		fmt.Sprintf(texts.AdminUsersActivity, stats.DAU, stats.WAU, stats.MAU),
+		fmt.Sprintf(texts.AdminFiles, stats.Files),
+		fmt.Sprintf(texts.AdminDownloads, stats.Downloads),
+		fmt.Sprintf(texts.AdminChats, stats.Chats),
+		fmt.Sprintf(texts.AdminReports, reports),
 		"",
-		"*__Источники__*",
+		texts.AdminRefs,
 		"",
 	}
 
@@ -46,10 +72,344 @@ func (bot *Bot) onAdmin(ctx context.Context, msg *tgbotapi.Message) error {
 		)
 	}
 
-	text := strings.Join(lines, "\n")
+	markup := tgbotapi.NewInlineKeyboardMarkup(
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(
+				texts.AdminReportsButton,
+				callbackAdminReports,
+			),
+		),
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(
+				texts.AdminBroadcastsButton,
+				callbackAdminBroadcasts,
+			),
+		),
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(
+				texts.AdminCampaignsButton,
+				callbackAdminCampaigns,
+			),
+		),
+	)
+
+	return strings.Join(lines, "\n"), markup, nil
+}
+
+func (bot *Bot) onAdmin(ctx context.Context, msg *tgbotapi.Message) error {
+	args := strings.Fields(msg.CommandArguments())
+
+	switch {
+	case len(args) == 0:
+		return bot.onAdminSummary(ctx, msg)
+	case len(args) == 2 && args[0] == "file":
+		return bot.onAdminFile(ctx, msg, args[1])
+	case len(args) == 2 && (args[0] == "ban" || args[0] == "unban"):
+		id, err := strconv.Atoi(args[1])
+		if err == nil {
+			return bot.onAdminBan(ctx, msg, core.UserID(id), args[0] == "ban")
+		}
+	case len(args) == 1 && args[0] == "audit":
+		return bot.onAdminAudit(ctx, msg)
+	case len(args) == 1 && args[0] == "broadcast":
+		return bot.onAdminBroadcastNew(ctx, msg)
+	case len(args) == 1 && args[0] == "broadcasts":
+		return bot.onAdminBroadcasts(ctx, msg)
+	case len(args) <= 3 && args[0] == "campaigns":
+		return bot.onAdminCampaigns(ctx, msg, args[1:])
+	case args[0] == "campaign":
+		return bot.onAdminCampaign(ctx, msg, args[1:])
+	case len(args) == 1 && args[0] == "reserved":
+		return bot.onAdminReserved(ctx, msg)
+	case len(args) == 2 && (args[0] == "reserve" || args[0] == "unreserve"):
+		return bot.onAdminReserve(ctx, msg, args[1], args[0] == "reserve")
+	}
+
+	if !getUserCtx(ctx).IsAdmin {
+		return nil
+	}
+
+	return bot.send(ctx, bot.newAnswerMsg(msg, getTextsCtx(ctx).AdminUsage))
+}
+
+func (bot *Bot) onAdminSummary(ctx context.Context, msg *tgbotapi.Message) error {
+	text, markup, err := bot.renderAdminSummary(ctx)
+	if errors.Cause(err) == service.ErrUserIsNotAdmin {
+		return nil
+	} else if err != nil {
+		return errors.Wrap(err, "render summary")
+	}
 
 	out := tgbotapi.NewMessage(msg.Chat.ID, text)
 	out.ParseMode = mdv2
+	out.ReplyMarkup = markup
 
 	return bot.send(ctx, out)
 }
+
+func (bot *Bot) editAdminSummary(ctx context.Context, cbq *tgbotapi.CallbackQuery) error {
+	text, markup, err := bot.renderAdminSummary(ctx)
+	if errors.Cause(err) == service.ErrUserIsNotAdmin {
+		return nil
+	} else if err != nil {
+		return errors.Wrap(err, "render summary")
+	}
+
+	edit := tgbotapi.NewEditMessageText(cbq.Message.Chat.ID, cbq.Message.MessageID, text)
+	edit.ParseMode = mdv2
+	edit.ReplyMarkup = &markup
+
+	return bot.send(ctx, edit)
+}
+
+func (bot *Bot) onAdminCBQ(ctx context.Context, cbq *tgbotapi.CallbackQuery) error {
+	go func() {
+		_ = bot.answerCallbackQuery(ctx, cbq, "")
+	}()
+
+	return bot.editAdminSummary(ctx, cbq)
+}
+
+func getAdminFileStatus(texts *i18n.Texts, file *service.AdminFile) string {
+	statuses := []string{}
+
+	if file.IsBlocked {
+		statuses = append(statuses, texts.AdminFileStatusBlocked)
+	}
+
+	if file.IsViolatesCopyright.Bool {
+		statuses = append(statuses, texts.AdminFileStatusCopyright)
+	}
+
+	if file.Owner.IsBanned {
+		statuses = append(statuses, texts.AdminFileStatusOwnerBan)
+	}
+
+	if len(statuses) == 0 {
+		return texts.AdminFileStatusAvailable
+	}
+
+	return strings.Join(statuses, ", ")
+}
+
+func (bot *Bot) renderAdminFile(texts *i18n.Texts, file *service.AdminFile) (string, tgbotapi.InlineKeyboardMarkup) {
+	rows := []string{
+		fmt.Sprintf(texts.AdminFileTitle, file.PublicID),
+		"",
+		fmt.Sprintf(texts.AdminFileName, tg.EscapeMD(getFileLibraryTitle(texts, file.File))),
+		fmt.Sprintf(texts.AdminFileCreatedAt, formatTime(file.CreatedAt)),
+		fmt.Sprintf(texts.AdminFileOwner, renderUserMention(file.Owner), file.Owner.ID),
+		fmt.Sprintf(texts.AdminFileStatus, tg.EscapeMD(getAdminFileStatus(texts, file))),
+		"",
+		texts.FileCaptionStats,
+		"",
+		fmt.Sprintf(texts.StatsDownloads, file.Stats.Total),
+		fmt.Sprintf(texts.StatsUniqueDownloads, file.Stats.Unique),
+		"",
+	}
+
+	if file.Restriction.HasChats() {
+		rows = append(rows,
+			fmt.Sprintf(texts.AdminFileChats, len(file.Restriction.ChatIDs)),
+			"",
+		)
+	}
+
+	rows = append(rows, bot.renderFileLimitsCaption(texts, file.OwnedFile)...)
+
+	blockTitle, blockAction := texts.AdminFileButtonBlock, adminFileActionBlock
+	if file.IsBlocked {
+		blockTitle, blockAction = texts.AdminFileButtonUnblock, adminFileActionUnblock
+	}
+
+	buttons := [][]tgbotapi.InlineKeyboardButton{
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(
+				blockTitle,
+				fmt.Sprintf(callbackAdminFileAction, file.ID, blockAction),
+			),
+		),
+	}
+
+	if !file.Owner.IsAdmin {
+		banTitle, banAction := texts.AdminFileButtonBanOwner, adminFileActionBan
+		if file.Owner.IsBanned {
+			banTitle, banAction = texts.AdminFileButtonUnbanOwner, adminFileActionUnban
+		}
+
+		buttons = append(buttons, tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(
+				banTitle,
+				fmt.Sprintf(callbackAdminFileAction, file.ID, banAction),
+			),
+		))
+	}
+
+	return strings.Join(rows, "\n"), tgbotapi.NewInlineKeyboardMarkup(buttons...)
+}
+
+func (bot *Bot) onAdminFile(ctx context.Context, msg *tgbotapi.Message, publicID string) error {
+	user := getUserCtx(ctx)
+	texts := getTextsCtx(ctx)
+
+	file, err := bot.adminSrv.GetFileByPublicID(ctx, user, publicID)
+	switch {
+	case errors.Is(err, service.ErrUserIsNotAdmin):
+		return nil
+	case errors.Is(err, core.ErrFileNotFound):
+		return bot.send(ctx, bot.newAnswerMsg(msg, tg.EscapeMD(texts.AdminFileNotFound)))
+	case err != nil:
+		return errors.Wrap(err, "get file")
+	}
+
+	text, markup := bot.renderAdminFile(texts, file)
+
+	out := bot.newAnswerMsg(msg, text)
+	out.ReplyMarkup = markup
+	out.DisableWebPagePreview = true
+
+	return bot.send(ctx, out)
+}
+
+// applyAdminFileAction blocks or unblocks file or bans or unbans its owner.
+func (bot *Bot) applyAdminFileAction(ctx context.Context, id core.FileID, action string) (*service.AdminFile, string, error) {
+	user := getUserCtx(ctx)
+	texts := getTextsCtx(ctx)
+
+	switch action {
+	case adminFileActionBlock, adminFileActionUnblock:
+		blocked := action == adminFileActionBlock
+
+		file, err := bot.adminSrv.SetFileBlocked(ctx, user, id, blocked)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if blocked {
+			return file, texts.AdminFileBlocked, nil
+		}
+
+		return file, texts.AdminFileUnblocked, nil
+	default:
+		banned := action == adminFileActionBan
+
+		file, err := bot.adminSrv.GetFileByID(ctx, user, id)
+		if err != nil {
+			return nil, "", err
+		}
+
+		owner, err := bot.adminSrv.SetUserBanned(ctx, user, file.OwnerID, banned)
+		if err != nil {
+			return nil, "", err
+		}
+
+		file.Owner = owner
+
+		if banned {
+			return file, texts.AdminUserBanned, nil
+		}
+
+		return file, texts.AdminUserUnbanned, nil
+	}
+}
+
+func (bot *Bot) onAdminFileActionCBQ(ctx context.Context, cbq *tgbotapi.CallbackQuery, id core.FileID, action string) error {
+	texts := getTextsCtx(ctx)
+
+	file, answer, err := bot.applyAdminFileAction(ctx, id, action)
+	switch {
+	case errors.Is(err, service.ErrUserIsNotAdmin):
+		return nil
+	case errors.Is(err, service.ErrNothingChanged):
+		return bot.answerCallbackQuery(ctx, cbq, texts.CommonNothingChanged)
+	case errors.Is(err, service.ErrCantBanAdmin):
+		return bot.answerCallbackQueryAlert(ctx, cbq, texts.AdminCantBanAdmin)
+	case errors.Is(err, core.ErrFileNotFound):
+		return bot.answerCallbackQueryAlert(ctx, cbq, texts.AdminFileNotFound)
+	case err != nil:
+		return errors.Wrap(err, "apply admin file action")
+	}
+
+	go func() {
+		_ = bot.answerCallbackQuery(ctx, cbq, answer)
+	}()
+
+	text, markup := bot.renderAdminFile(texts, file)
+
+	edit := tgbotapi.NewEditMessageText(cbq.Message.Chat.ID, cbq.Message.MessageID, text)
+	edit.ParseMode = mdv2
+	edit.ReplyMarkup = &markup
+	edit.DisableWebPagePreview = true
+
+	return bot.send(ctx, edit)
+}
+
+func (bot *Bot) onAdminBan(ctx context.Context, msg *tgbotapi.Message, id core.UserID, banned bool) error {
+	user := getUserCtx(ctx)
+	texts := getTextsCtx(ctx)
+
+	var text string
+
+	_, err := bot.adminSrv.SetUserBanned(ctx, user, id, banned)
+	switch {
+	case errors.Is(err, service.ErrUserIsNotAdmin):
+		return nil
+	case errors.Is(err, core.ErrUserNotFound):
+		text = texts.AdminUserNotFound
+	case errors.Is(err, service.ErrCantBanAdmin):
+		text = texts.AdminCantBanAdmin
+	case errors.Is(err, service.ErrNothingChanged):
+		text = texts.CommonNothingChanged
+	case err != nil:
+		return errors.Wrap(err, "set user banned")
+	case banned:
+		text = texts.AdminUserBanned
+	default:
+		text = texts.AdminUserUnbanned
+	}
+
+	return bot.send(ctx, bot.newAnswerMsg(msg, tg.EscapeMD(text)))
+}
+
+func getAuditActionText(texts *i18n.Texts, entry *core.AuditEntry) string {
+	switch entry.Action {
+	case core.AuditActionFileBlock:
+		return fmt.Sprintf(texts.AdminAuditFileBlock, entry.FileID)
+	case core.AuditActionFileUnblock:
+		return fmt.Sprintf(texts.AdminAuditFileUnblock, entry.FileID)
+	case core.AuditActionUserBan:
+		return fmt.Sprintf(texts.AdminAuditUserBan, entry.UserID)
+	case core.AuditActionUserUnban:
+		return fmt.Sprintf(texts.AdminAuditUserUnban, entry.UserID)
+	default:
+		return tg.EscapeMD(entry.Action.String())
+	}
+}
+
+func (bot *Bot) onAdminAudit(ctx context.Context, msg *tgbotapi.Message) error {
+	user := getUserCtx(ctx)
+	texts := getTextsCtx(ctx)
+
+	entries, err := bot.adminSrv.AuditLog(ctx, user, adminAuditLimit)
+	if errors.Is(err, service.ErrUserIsNotAdmin) {
+		return nil
+	} else if err != nil {
+		return errors.Wrap(err, "get audit log")
+	}
+
+	lines := []string{texts.AdminAuditTitle, ""}
+
+	if len(entries) == 0 {
+		lines = append(lines, texts.AdminAuditEmpty)
+	}
+
+	for _, entry := range entries {
+		lines = append(lines, fmt.Sprintf("`%s` `%d` %s",
+			formatTime(entry.CreatedAt),
+			entry.AdminID,
+			getAuditActionText(texts, entry),
+		))
+	}
+
+	return bot.send(ctx, bot.newAnswerMsg(msg, strings.Join(lines, "\n")))
+}
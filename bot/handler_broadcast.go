@@ -0,0 +1,490 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bots-house/share-file-bot/bot/i18n"
+	"github.com/bots-house/share-file-bot/bot/state"
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/pkg/log"
+	"github.com/bots-house/share-file-bot/pkg/tg"
+	"github.com/bots-house/share-file-bot/service"
+	tgbotapi "github.com/bots-house/telegram-bot-api"
+	"github.com/friendsofgo/errors"
+)
+
+const (
+	callbackAdminBroadcasts           = "admin:broadcasts"
+	callbackAdminBroadcastInputCancel = "admin:broadcast:input:cancel"
+
+	// broadcast id, action
+	callbackAdminBroadcast = "admin:broadcast:%d:%s"
+
+	broadcastActionRefresh       = "refresh"
+	broadcastActionPreview       = "preview"
+	broadcastActionButtons       = "buttons"
+	broadcastActionSegment       = "segment"
+	broadcastActionStart         = "start"
+	broadcastActionStartConfirm  = "start-confirm"
+	broadcastActionCancel        = "cancel"
+	broadcastActionCancelConfirm = "cancel-confirm"
+
+	broadcastDateFormat = "2006-01-02"
+)
+
+// getBroadcastErrorText returns text for admin of errors caused by broadcast actions.
+func getBroadcastErrorText(texts *i18n.Texts, err error) (string, bool) {
+	switch {
+	case errors.Is(err, core.ErrBroadcastNotFound):
+		return texts.BroadcastNotFound, true
+	case errors.Is(err, core.ErrBroadcastNotDraft):
+		return texts.BroadcastAlreadyStarted, true
+	case errors.Is(err, core.ErrBroadcastFinished):
+		return texts.BroadcastAlreadyFinished, true
+	case errors.Is(err, service.ErrBroadcastEmptyAudience):
+		return texts.BroadcastEmptyAudience, true
+	default:
+		return "", false
+	}
+}
+
+func getBroadcastStatusText(texts *i18n.Texts, status core.BroadcastStatus) string {
+	switch status {
+	case core.BroadcastStatusDraft:
+		return texts.BroadcastStatusDraft
+	case core.BroadcastStatusRunning:
+		return texts.BroadcastStatusRunning
+	case core.BroadcastStatusDone:
+		return texts.BroadcastStatusDone
+	case core.BroadcastStatusCanceled:
+		return texts.BroadcastStatusCanceled
+	default:
+		return status.String()
+	}
+}
+
+func renderBroadcastSegment(texts *i18n.Texts, segment core.BroadcastSegment) string {
+	if segment.IsAll() {
+		return tg.EscapeMD(texts.BroadcastSegmentAll)
+	}
+
+	conds := []string{}
+
+	if segment.Ref.Valid {
+		conds = append(conds, fmt.Sprintf(texts.BroadcastSegmentRef, tg.EscapeMD(segment.Ref.String)))
+	}
+
+	if segment.Language.Valid {
+		conds = append(conds, fmt.Sprintf(texts.BroadcastSegmentLanguage, tg.EscapeMD(segment.Language.String)))
+	}
+
+	if segment.JoinedAfter.Valid {
+		conds = append(conds, fmt.Sprintf(texts.BroadcastSegmentJoinedAfter,
+			segment.JoinedAfter.Time.UTC().Format(broadcastDateFormat),
+		))
+	}
+
+	return strings.Join(conds, ", ")
+}
+
+func newBroadcastActionButton(title string, id core.BroadcastID, action string) tgbotapi.InlineKeyboardButton {
+	return tgbotapi.NewInlineKeyboardButtonData(title, fmt.Sprintf(callbackAdminBroadcast, id, action))
+}
+
+// renderBroadcast returns broadcast card, confirm is action waiting for confirmation.
+func (bot *Bot) renderBroadcast(
+	texts *i18n.Texts,
+	info *service.BroadcastInfo,
+	confirm string,
+) (string, tgbotapi.InlineKeyboardMarkup) {
+	broadcast := info.Broadcast
+
+	rows := []string{
+		fmt.Sprintf(texts.BroadcastTitle, broadcast.ID),
+		"",
+		fmt.Sprintf(texts.BroadcastStatus, tg.EscapeMD(getBroadcastStatusText(texts, broadcast.Status))),
+		fmt.Sprintf(texts.BroadcastSegment, renderBroadcastSegment(texts, broadcast.Segment)),
+		fmt.Sprintf(texts.BroadcastButtons, len(broadcast.Buttons)),
+	}
+
+	if broadcast.Status == core.BroadcastStatusDraft {
+		rows = append(rows, fmt.Sprintf(texts.BroadcastAudience, info.Audience))
+	} else {
+		rows = append(rows,
+			"",
+			fmt.Sprintf(texts.BroadcastProgress, broadcast.Processed(), broadcast.Total),
+			fmt.Sprintf(texts.BroadcastDelivered, broadcast.Delivered),
+			fmt.Sprintf(texts.BroadcastBlocked, broadcast.Blocked),
+			fmt.Sprintf(texts.BroadcastFailed, broadcast.Failed),
+		)
+	}
+
+	if broadcast.StartedAt.Valid {
+		rows = append(rows, "", fmt.Sprintf(texts.BroadcastStartedAt, formatTime(broadcast.StartedAt.Time)))
+	}
+
+	if broadcast.FinishedAt.Valid {
+		rows = append(rows, fmt.Sprintf(texts.BroadcastFinishedAt, formatTime(broadcast.FinishedAt.Time)))
+	}
+
+	id := broadcast.ID
+
+	back := tgbotapi.NewInlineKeyboardRow(
+		tgbotapi.NewInlineKeyboardButtonData(texts.CommonBack, callbackAdminBroadcasts),
+	)
+
+	var buttons [][]tgbotapi.InlineKeyboardButton
+
+	switch {
+	case confirm == broadcastActionStart:
+		buttons = [][]tgbotapi.InlineKeyboardButton{
+			tgbotapi.NewInlineKeyboardRow(newBroadcastActionButton(texts.BroadcastButtonStartConfirm, id, broadcastActionStartConfirm)),
+			tgbotapi.NewInlineKeyboardRow(newBroadcastActionButton(texts.CommonBack, id, broadcastActionRefresh)),
+		}
+	case confirm == broadcastActionCancel:
+		buttons = [][]tgbotapi.InlineKeyboardButton{
+			tgbotapi.NewInlineKeyboardRow(newBroadcastActionButton(texts.BroadcastButtonCancelConfirm, id, broadcastActionCancelConfirm)),
+			tgbotapi.NewInlineKeyboardRow(newBroadcastActionButton(texts.CommonBack, id, broadcastActionRefresh)),
+		}
+	case broadcast.Status == core.BroadcastStatusDraft:
+		buttons = [][]tgbotapi.InlineKeyboardButton{
+			tgbotapi.NewInlineKeyboardRow(newBroadcastActionButton(texts.BroadcastButtonPreview, id, broadcastActionPreview)),
+			tgbotapi.NewInlineKeyboardRow(
+				newBroadcastActionButton(texts.BroadcastButtonButtons, id, broadcastActionButtons),
+				newBroadcastActionButton(texts.BroadcastButtonSegment, id, broadcastActionSegment),
+			),
+			tgbotapi.NewInlineKeyboardRow(newBroadcastActionButton(texts.BroadcastButtonStart, id, broadcastActionStart)),
+			tgbotapi.NewInlineKeyboardRow(newBroadcastActionButton(texts.BroadcastButtonCancel, id, broadcastActionCancel)),
+			back,
+		}
+	case broadcast.Status == core.BroadcastStatusRunning:
+		buttons = [][]tgbotapi.InlineKeyboardButton{
+			tgbotapi.NewInlineKeyboardRow(newBroadcastActionButton(texts.CommonRefresh, id, broadcastActionRefresh)),
+			tgbotapi.NewInlineKeyboardRow(newBroadcastActionButton(texts.BroadcastButtonCancel, id, broadcastActionCancel)),
+			back,
+		}
+	default:
+		buttons = [][]tgbotapi.InlineKeyboardButton{
+			tgbotapi.NewInlineKeyboardRow(newBroadcastActionButton(texts.BroadcastButtonPreview, id, broadcastActionPreview)),
+			back,
+		}
+	}
+
+	return strings.Join(rows, "\n"), tgbotapi.NewInlineKeyboardMarkup(buttons...)
+}
+
+func (bot *Bot) sendBroadcast(ctx context.Context, info *service.BroadcastInfo) error {
+	user := getUserCtx(ctx)
+
+	text, markup := bot.renderBroadcast(getTextsCtx(ctx), info, "")
+
+	out := tgbotapi.NewMessage(int64(user.ID), text)
+	out.ParseMode = mdv2
+	out.ReplyMarkup = markup
+
+	return bot.send(ctx, out)
+}
+
+func (bot *Bot) editBroadcast(ctx context.Context, cbq *tgbotapi.CallbackQuery, info *service.BroadcastInfo, confirm string) error {
+	text, markup := bot.renderBroadcast(getTextsCtx(ctx), info, confirm)
+
+	edit := tgbotapi.NewEditMessageText(cbq.Message.Chat.ID, cbq.Message.MessageID, text)
+	edit.ParseMode = mdv2
+	edit.ReplyMarkup = &markup
+
+	return bot.send(ctx, edit)
+}
+
+func (bot *Bot) newBroadcastInputCancelReplyMarkup(texts *i18n.Texts) tgbotapi.InlineKeyboardMarkup {
+	return tgbotapi.NewInlineKeyboardMarkup(
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(
+				texts.CommonCancel,
+				callbackAdminBroadcastInputCancel,
+			),
+		),
+	)
+}
+
+func (bot *Bot) onAdminBroadcastNew(ctx context.Context, msg *tgbotapi.Message) error {
+	user := getUserCtx(ctx)
+	texts := getTextsCtx(ctx)
+
+	if !user.IsAdmin {
+		return nil
+	}
+
+	if err := bot.state.Set(ctx, user.ID, state.BroadcastMessage); err != nil {
+		return errors.Wrap(err, "update state")
+	}
+
+	out := tgbotapi.NewMessage(msg.Chat.ID, texts.BroadcastRequest)
+	out.ReplyMarkup = bot.newBroadcastInputCancelReplyMarkup(texts)
+
+	return bot.send(ctx, out)
+}
+
+func (bot *Bot) onBroadcastMessageState(ctx context.Context, msg *tgbotapi.Message) error {
+	user := getUserCtx(ctx)
+
+	info, err := bot.broadcastSrv.Create(ctx, user, msg.Chat.ID, msg.MessageID)
+	if err != nil && !errors.Is(err, service.ErrUserIsNotAdmin) {
+		return errors.Wrap(err, "create broadcast")
+	}
+
+	if err := bot.state.Set(ctx, user.ID, state.Empty); err != nil {
+		return errors.Wrap(err, "update state")
+	}
+
+	if info == nil {
+		return nil
+	}
+
+	return bot.sendBroadcast(ctx, info)
+}
+
+func (bot *Bot) onBroadcastInputCancelCBQ(ctx context.Context, cbq *tgbotapi.CallbackQuery) error {
+	user := getUserCtx(ctx)
+
+	if err := bot.broadcastSrv.CancelEdit(ctx, user); err != nil {
+		return errors.Wrap(err, "cancel edit")
+	}
+
+	if err := bot.state.Set(ctx, user.ID, state.Empty); err != nil {
+		return errors.Wrap(err, "update state")
+	}
+
+	go func() {
+		_ = bot.deleteMessage(ctx, cbq.Message)
+	}()
+
+	return bot.answerCallbackQuery(ctx, cbq, getTextsCtx(ctx).BroadcastInputCanceled)
+}
+
+// onBroadcastEditResult handles result of buttons or segment update.
+func (bot *Bot) onBroadcastEditResult(ctx context.Context, info *service.BroadcastInfo, err error, invalidText string) error {
+	user := getUserCtx(ctx)
+	texts := getTextsCtx(ctx)
+
+	switch {
+	case errors.Is(err, service.ErrBroadcastInvalidButtons),
+		errors.Is(err, service.ErrBroadcastInvalidSegment):
+		return bot.sendText(ctx, user.ID, invalidText)
+	case errors.Is(err, service.ErrUserIsNotAdmin):
+		return bot.state.Set(ctx, user.ID, state.Empty)
+	case errors.Is(err, service.ErrBroadcastNotAwaited),
+		errors.Is(err, core.ErrBroadcastNotDraft),
+		errors.Is(err, core.ErrBroadcastNotFound):
+		if err := bot.state.Set(ctx, user.ID, state.Empty); err != nil {
+			return errors.Wrap(err, "update state")
+		}
+		return bot.sendText(ctx, user.ID, texts.BroadcastNotAwait)
+	case err != nil:
+		return errors.Wrap(err, "update broadcast")
+	}
+
+	if err := bot.state.Set(ctx, user.ID, state.Empty); err != nil {
+		return errors.Wrap(err, "update state")
+	}
+
+	return bot.sendBroadcast(ctx, info)
+}
+
+func (bot *Bot) onBroadcastButtonsState(ctx context.Context, msg *tgbotapi.Message) error {
+	texts := getTextsCtx(ctx)
+
+	info, err := bot.broadcastSrv.SetButtons(ctx, getUserCtx(ctx), msg.Text)
+
+	return bot.onBroadcastEditResult(ctx, info, err, texts.BroadcastInvalidButtons)
+}
+
+func (bot *Bot) onBroadcastSegmentState(ctx context.Context, msg *tgbotapi.Message) error {
+	texts := getTextsCtx(ctx)
+
+	info, err := bot.broadcastSrv.SetSegment(ctx, getUserCtx(ctx), msg.Text)
+
+	return bot.onBroadcastEditResult(ctx, info, err, texts.BroadcastInvalidSegment)
+}
+
+// requestBroadcastEdit asks admin for buttons or segment of draft.
+func (bot *Bot) requestBroadcastEdit(
+	ctx context.Context,
+	cbq *tgbotapi.CallbackQuery,
+	id core.BroadcastID,
+	edit service.BroadcastEdit,
+) error {
+	user := getUserCtx(ctx)
+	texts := getTextsCtx(ctx)
+
+	if err := bot.broadcastSrv.RequestEdit(ctx, user, id, edit); err != nil {
+		return err
+	}
+
+	userState, text := state.BroadcastButtons, fmt.Sprintf(texts.BroadcastButtonsRequest, service.BroadcastMaxButtons)
+	if edit == service.BroadcastEditSegment {
+		userState, text = state.BroadcastSegment, texts.BroadcastSegmentRequest
+	}
+
+	if err := bot.state.Set(ctx, user.ID, userState); err != nil {
+		return errors.Wrap(err, "update state")
+	}
+
+	go func() {
+		_ = bot.answerCallbackQuery(ctx, cbq, "")
+	}()
+
+	out := tgbotapi.NewMessage(cbq.Message.Chat.ID, text)
+	out.ReplyMarkup = bot.newBroadcastInputCancelReplyMarkup(texts)
+
+	return bot.send(ctx, out)
+}
+
+//nolint:gocyclo
+func (bot *Bot) onAdminBroadcastCBQ(ctx context.Context, cbq *tgbotapi.CallbackQuery, id core.BroadcastID, action string) error {
+	user := getUserCtx(ctx)
+	texts := getTextsCtx(ctx)
+
+	var (
+		info    *service.BroadcastInfo
+		answer  string
+		confirm string
+		err     error
+	)
+
+	switch action {
+	case broadcastActionPreview:
+		err = bot.broadcastSrv.Preview(ctx, user, id)
+		switch {
+		case err == nil:
+			return bot.answerCallbackQuery(ctx, cbq, texts.BroadcastPreviewSent)
+		case errors.Is(err, service.ErrUserIsNotAdmin), errors.Is(err, core.ErrBroadcastNotFound):
+			// handled below
+		default:
+			log.Warn(ctx, "can't send broadcast preview", "broadcast_id", id, "err", err)
+			return bot.answerCallbackQueryAlert(ctx, cbq, texts.BroadcastPreviewFailed)
+		}
+	case broadcastActionButtons:
+		err = bot.requestBroadcastEdit(ctx, cbq, id, service.BroadcastEditButtons)
+		if err == nil {
+			return nil
+		}
+	case broadcastActionSegment:
+		err = bot.requestBroadcastEdit(ctx, cbq, id, service.BroadcastEditSegment)
+		if err == nil {
+			return nil
+		}
+	case broadcastActionStart:
+		info, err = bot.broadcastSrv.Get(ctx, user, id)
+		if err == nil && info.Status != core.BroadcastStatusDraft {
+			err = core.ErrBroadcastNotDraft
+		}
+		if err == nil && info.Audience == 0 {
+			err = service.ErrBroadcastEmptyAudience
+		}
+		if err == nil {
+			confirm, answer = broadcastActionStart, fmt.Sprintf(texts.BroadcastStartConfirm, info.Audience)
+		}
+	case broadcastActionStartConfirm:
+		info, err = bot.broadcastSrv.Start(ctx, user, id)
+		answer = texts.BroadcastStarted
+	case broadcastActionCancel:
+		info, err = bot.broadcastSrv.Get(ctx, user, id)
+		confirm, answer = broadcastActionCancel, texts.BroadcastCancelConfirm
+	case broadcastActionCancelConfirm:
+		info, err = bot.broadcastSrv.Cancel(ctx, user, id)
+		answer = texts.BroadcastCanceled
+	default:
+		info, err = bot.broadcastSrv.Get(ctx, user, id)
+	}
+
+	if errors.Is(err, service.ErrUserIsNotAdmin) {
+		return nil
+	} else if text, ok := getBroadcastErrorText(texts, err); ok {
+		return bot.answerCallbackQueryAlert(ctx, cbq, text)
+	} else if err != nil {
+		return errors.Wrapf(err, "broadcast action %s", action)
+	}
+
+	go func() {
+		_ = bot.answerCallbackQuery(ctx, cbq, answer)
+	}()
+
+	return bot.editBroadcast(ctx, cbq, info, confirm)
+}
+
+// renderBroadcasts returns list of recent broadcasts.
+func (bot *Bot) renderBroadcasts(ctx context.Context) (string, tgbotapi.InlineKeyboardMarkup, error) {
+	texts := getTextsCtx(ctx)
+
+	broadcasts, err := bot.broadcastSrv.List(ctx, getUserCtx(ctx))
+	if err != nil {
+		return "", tgbotapi.InlineKeyboardMarkup{}, err
+	}
+
+	lines := []string{texts.BroadcastListTitle, ""}
+
+	if len(broadcasts) == 0 {
+		lines = append(lines, texts.BroadcastListEmpty)
+	}
+
+	buttons := make([][]tgbotapi.InlineKeyboardButton, 0, len(broadcasts)+1)
+
+	for _, broadcast := range broadcasts {
+		lines = append(lines, fmt.Sprintf("`#%d` `%s` %s `%d/%d`",
+			broadcast.ID,
+			formatTime(broadcast.CreatedAt),
+			tg.EscapeMD(getBroadcastStatusText(texts, broadcast.Status)),
+			broadcast.Processed(),
+			broadcast.Total,
+		))
+
+		buttons = append(buttons, tgbotapi.NewInlineKeyboardRow(
+			newBroadcastActionButton(
+				fmt.Sprintf("#%d %s", broadcast.ID, getBroadcastStatusText(texts, broadcast.Status)),
+				broadcast.ID,
+				broadcastActionRefresh,
+			),
+		))
+	}
+
+	buttons = append(buttons, tgbotapi.NewInlineKeyboardRow(
+		tgbotapi.NewInlineKeyboardButtonData(texts.CommonBack, callbackAdmin),
+	))
+
+	return strings.Join(lines, "\n"), tgbotapi.NewInlineKeyboardMarkup(buttons...), nil
+}
+
+func (bot *Bot) onAdminBroadcasts(ctx context.Context, msg *tgbotapi.Message) error {
+	text, markup, err := bot.renderBroadcasts(ctx)
+	if errors.Is(err, service.ErrUserIsNotAdmin) {
+		return nil
+	} else if err != nil {
+		return errors.Wrap(err, "render broadcasts")
+	}
+
+	out := bot.newAnswerMsg(msg, text)
+	out.ReplyMarkup = markup
+
+	return bot.send(ctx, out)
+}
+
+func (bot *Bot) onAdminBroadcastsCBQ(ctx context.Context, cbq *tgbotapi.CallbackQuery) error {
+	text, markup, err := bot.renderBroadcasts(ctx)
+	if errors.Is(err, service.ErrUserIsNotAdmin) {
+		return nil
+	} else if err != nil {
+		return errors.Wrap(err, "render broadcasts")
+	}
+
+	go func() {
+		_ = bot.answerCallbackQuery(ctx, cbq, "")
+	}()
+
+	edit := tgbotapi.NewEditMessageText(cbq.Message.Chat.ID, cbq.Message.MessageID, text)
+	edit.ParseMode = mdv2
+	edit.ReplyMarkup = &markup
+
+	return bot.send(ctx, edit)
+}
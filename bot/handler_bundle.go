@@ -0,0 +1,657 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bots-house/share-file-bot/bot/i18n"
+	"github.com/bots-house/share-file-bot/bot/state"
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/pkg/log"
+	"github.com/bots-house/share-file-bot/pkg/tg"
+	"github.com/bots-house/share-file-bot/service"
+	tgbotapi "github.com/bots-house/telegram-bot-api"
+	"github.com/friendsofgo/errors"
+)
+
+const (
+	cmdBundle = "bundle"
+
+	callbackBundleRefresh                = "bundle:%d:refresh"
+	callbackBundleDelete                 = "bundle:%d:delete"
+	callbackBundleDeleteConfirm          = "bundle:%d:delete:confirm"
+	callbackBundleRestrictions           = "bundle:%d:restrictions"
+	callbackBundleRestrictionsChat       = "bundle:%d:restrictions:chat-sub:%d:toggl"
+	callbackBundleRestrictionsChatPolicy = "bundle:%d:restrictions:chat-policy:%d"
+	callbackBundleRestrictionsChatCheck  = "bundle:%d:restrictions:chat:check"
+
+	// Telegram limit of items in media group.
+	bundleMediaGroupMaxSize = 10
+)
+
+func (bot *Bot) newBundleCollectReplyMarkup(texts *i18n.Texts) tgbotapi.ReplyKeyboardMarkup {
+	kb := tgbotapi.NewReplyKeyboard(
+		tgbotapi.NewKeyboardButtonRow(
+			tgbotapi.NewKeyboardButton(texts.BundleButtonDone),
+			tgbotapi.NewKeyboardButton(texts.BundleButtonCancel),
+		),
+	)
+
+	kb.ResizeKeyboard = true
+
+	return kb
+}
+
+func (bot *Bot) onBundle(ctx context.Context, msg *tgbotapi.Message) error {
+	user := getUserCtx(ctx)
+	texts := getTextsCtx(ctx)
+
+	err := bot.bundleSrv.CollectStart(ctx, user)
+	switch {
+	case errors.Is(err, service.ErrUsersCantUploadFiles):
+		return bot.sendText(ctx, user.ID, texts.UploadOnlyAdmins)
+	case err != nil:
+		return errors.Wrap(err, "start bundle collect")
+	}
+
+	if err := bot.state.Set(ctx, user.ID, state.BundleCollect); err != nil {
+		return errors.Wrap(err, "update state")
+	}
+
+	answer := bot.newAnswerMsg(msg, texts.BundleCollect)
+	answer.ReplyMarkup = bot.newBundleCollectReplyMarkup(texts)
+
+	return bot.send(ctx, answer)
+}
+
+func (bot *Bot) onBundleCollectState(ctx context.Context, msg *tgbotapi.Message) error {
+	user := getUserCtx(ctx)
+	texts := getTextsCtx(ctx)
+
+	switch {
+	case msg.Text == texts.BundleButtonCancel:
+		if err := bot.bundleSrv.CollectCancel(ctx, user); err != nil {
+			return errors.Wrap(err, "cancel bundle collect")
+		}
+
+		if err := bot.state.Set(ctx, user.ID, state.Empty); err != nil {
+			return errors.Wrap(err, "update state")
+		}
+
+		out := tgbotapi.NewMessage(msg.Chat.ID, texts.BundleCollectCanceled)
+		out.ReplyMarkup = tgbotapi.NewRemoveKeyboard(false)
+
+		return bot.send(ctx, out)
+	case msg.Text == texts.BundleButtonDone:
+		return bot.onBundleCollectDone(ctx, msg)
+	case msg.Text != "":
+		if err := bot.bundleSrv.CollectCaption(ctx, user, msg.Text); err != nil {
+			return errors.Wrap(err, "set bundle caption")
+		}
+
+		return bot.sendText(ctx, user.ID, texts.BundleCollectCaption)
+	}
+
+	inputFile := bot.extractInputFileFromMessage(msg)
+	if inputFile == nil {
+		return bot.sendText(ctx, user.ID, texts.BundleCollectUnsupportedFileKind)
+	}
+
+	count, err := bot.bundleSrv.CollectAdd(ctx, user, inputFile)
+	switch {
+	case errors.Is(err, service.ErrBundleFull):
+		return bot.sendText(ctx, user.ID, fmt.Sprintf(texts.BundleCollectFull, service.BundleMaxFiles))
+	case err != nil:
+		return errors.Wrap(err, "add file to bundle")
+	}
+
+	out := tgbotapi.NewMessage(msg.Chat.ID, fmt.Sprintf(texts.BundleCollectAdded, count))
+	out.ReplyToMessageID = msg.MessageID
+
+	return bot.send(ctx, out)
+}
+
+func (bot *Bot) onBundleCollectDone(ctx context.Context, msg *tgbotapi.Message) error {
+	user := getUserCtx(ctx)
+	texts := getTextsCtx(ctx)
+
+	bundle, err := bot.bundleSrv.CollectDone(ctx, user)
+	switch {
+	case errors.Is(err, service.ErrBundleEmpty):
+		return bot.sendText(ctx, user.ID, texts.BundleCollectEmpty)
+	case err != nil:
+		_ = bot.sendText(ctx, user.ID, texts.BundleCreateFailed)
+
+		return errors.Wrap(err, "create bundle")
+	}
+
+	if err := bot.state.Set(ctx, user.ID, state.Empty); err != nil {
+		return errors.Wrap(err, "update state")
+	}
+
+	done := tgbotapi.NewMessage(msg.Chat.ID, texts.BundleCreated)
+	done.ReplyMarkup = tgbotapi.NewRemoveKeyboard(false)
+
+	if err := bot.send(ctx, done); err != nil {
+		return errors.Wrap(err, "send done message")
+	}
+
+	return bot.send(ctx, bot.renderOwnedBundle(texts, msg.Chat.ID, bundle))
+}
+
+func (bot *Bot) renderOwnedBundleText(texts *i18n.Texts, bundle *service.OwnedBundle) string {
+	rows := []string{
+		texts.Plural(texts.BundleTitle, len(bundle.Files)),
+		"",
+	}
+
+	if bundle.Caption.String != "" {
+		rows = append(rows,
+			texts.FileCaptionDescription,
+			"",
+			tg.EscapeMD(bundle.Caption.String),
+			"",
+		)
+	}
+
+	rows = append(rows,
+		texts.FileCaptionPublicLink,
+		"",
+		fmt.Sprintf("https://%s/%s?start\\=%s",
+			tg.EscapeMD(tgDomain),
+			tg.EscapeMD(bot.client.Self.UserName),
+			tg.EscapeMD(bundle.PublicID),
+		),
+		"",
+		texts.FileCaptionStats,
+		"",
+		fmt.Sprintf(texts.StatsDownloads, bundle.Stats.Total),
+		fmt.Sprintf(texts.StatsUniqueDownloads, bundle.Stats.Unique),
+		"",
+	)
+
+	if bundle.Restriction.HasChats() {
+		rows = append(rows,
+			fmt.Sprintf(texts.StatsWithSubscription, bundle.Stats.WithSubscription),
+			fmt.Sprintf(texts.StatsNewSubscription, bundle.Stats.NewSubscription),
+			"",
+		)
+	}
+
+	return strings.Join(rows, "\n")
+}
+
+func (bot *Bot) renderOwnedBundleReplyMarkup(texts *i18n.Texts, bundle *service.OwnedBundle) tgbotapi.InlineKeyboardMarkup {
+	return tgbotapi.NewInlineKeyboardMarkup(
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(
+				texts.CommonRefresh,
+				fmt.Sprintf(callbackBundleRefresh, bundle.ID),
+			),
+			tgbotapi.NewInlineKeyboardButtonData(
+				texts.CommonDelete,
+				fmt.Sprintf(callbackBundleDelete, bundle.ID),
+			),
+		),
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(
+				addHasLockEmoji(bundle.Restriction.HasChats(), texts.CommonRestrictions),
+				fmt.Sprintf(callbackBundleRestrictions, bundle.ID),
+			),
+		),
+	)
+}
+
+func (bot *Bot) renderOwnedBundle(texts *i18n.Texts, chatID int64, bundle *service.OwnedBundle) tgbotapi.MessageConfig {
+	out := tgbotapi.NewMessage(chatID, bot.renderOwnedBundleText(texts, bundle))
+	out.ParseMode = mdv2
+	out.DisableWebPagePreview = true
+	out.ReplyMarkup = bot.renderOwnedBundleReplyMarkup(texts, bundle)
+	return out
+}
+
+// getBundleMediaGroup returns type of media group which file can be sent in.
+// Empty string means file can't be grouped.
+func getBundleMediaGroup(kind core.Kind) string {
+	switch kind {
+	case core.KindPhoto, core.KindVideo:
+		return "visual"
+	case core.KindAudio:
+		return "audio"
+	default:
+		return ""
+	}
+}
+
+// groupBundleFiles splits files to chunks in order of delivery.
+// Consecutive photos and videos, as well as consecutive audios,
+// are grouped to media groups of up to 10 items, other files are delivered one by one.
+// Telegram doesn't allow to mix audios with other kinds in one media group.
+func groupBundleFiles(files []*core.File) [][]*core.File {
+	result := [][]*core.File{}
+
+	for _, file := range files {
+		group := getBundleMediaGroup(file.Kind)
+
+		if n := len(result); n > 0 && group != "" {
+			last := result[n-1]
+			if getBundleMediaGroup(last[0].Kind) == group && len(last) < bundleMediaGroupMaxSize {
+				result[n-1] = append(last, file)
+				continue
+			}
+		}
+
+		result = append(result, []*core.File{file})
+	}
+
+	return result
+}
+
+func (bot *Bot) renderBundleMediaGroup(chatID int64, files []*core.File) tgbotapi.MediaGroupConfig {
+	media := make([]interface{}, len(files))
+
+	for i, file := range files {
+		switch file.Kind {
+		case core.KindPhoto:
+			item := tgbotapi.NewInputMediaPhoto(file.TelegramID)
+			item.Caption = renderFileCaption(file)
+			item.ParseMode = mdv2
+			media[i] = item
+		case core.KindAudio:
+			item := tg.NewInputMediaAudio(file.TelegramID)
+			item.Caption = renderFileCaption(file)
+			item.ParseMode = mdv2
+			if audio := file.Metadata.Audio; audio != nil {
+				item.Title = audio.Title
+				item.Performer = audio.Performer
+			}
+			media[i] = item
+		default:
+			item := tgbotapi.NewInputMediaVideo(file.TelegramID)
+			item.Caption = renderFileCaption(file)
+			item.ParseMode = mdv2
+			media[i] = item
+		}
+	}
+
+	return tgbotapi.NewMediaGroup(chatID, media)
+}
+
+func (bot *Bot) sendNotOwnedBundle(ctx context.Context, chatID int64, result *service.BundleDownloadResult) error {
+	texts := getTextsCtx(ctx)
+
+	for _, chunk := range groupBundleFiles(result.Files) {
+		var out tgbotapi.Chattable
+
+		if len(chunk) > 1 {
+			out = bot.renderBundleMediaGroup(chatID, chunk)
+		} else {
+			file := chunk[0]
+			out = bot.renderGenericFile(
+				chatID,
+				file,
+				renderFileCaption(file),
+				mdv2,
+				nil,
+			)
+		}
+
+		if err := bot.send(ctx, out); err != nil {
+			return errors.Wrap(err, "send bundle files")
+		}
+	}
+
+	text := fmt.Sprintf(texts.BundleSent, len(result.Files))
+	if result.Bundle.Caption.String != "" {
+		text = tg.EscapeMD(result.Bundle.Caption.String)
+	}
+
+	kb := tgbotapi.NewReplyKeyboard(
+		tgbotapi.NewKeyboardButtonRow(
+			tgbotapi.NewKeyboardButton(texts.ButtonAbout),
+		),
+	)
+
+	kb.OneTimeKeyboard = true
+	kb.ResizeKeyboard = true
+
+	out := tgbotapi.NewMessage(chatID, text)
+	out.ParseMode = mdv2
+	out.ReplyMarkup = kb
+
+	return bot.send(ctx, out)
+}
+
+func (bot *Bot) onStartBundle(ctx context.Context, msg *tgbotapi.Message, publicID string) error {
+	user := getUserCtx(ctx)
+	texts := getTextsCtx(ctx)
+
+	result, err := bot.bundleSrv.GetBundleByPublicID(ctx, user, publicID)
+
+	switch {
+	case errors.Is(err, core.ErrBundleNotFound):
+		answer := bot.newAnswerMsg(msg, tg.EscapeMD(texts.BundleNotFound))
+		return bot.send(ctx, answer)
+	case errors.Is(err, service.ErrBundleEmpty):
+		answer := bot.newAnswerMsg(msg, tg.EscapeMD(texts.BundleEmpty))
+		return bot.send(ctx, answer)
+	case errors.Is(err, service.ErrOwnerIsBanned):
+		answer := bot.newAnswerMsg(msg, tg.EscapeMD(texts.BlockedByAdmin))
+		return bot.send(ctx, answer)
+	case errors.Is(err, service.ErrCantCheckMembership):
+		answer := bot.newAnswerMsg(msg, tg.EscapeMD(texts.BundleCantCheckMembership))
+		return bot.send(ctx, answer)
+	case err != nil:
+		return errors.Wrap(err, "download bundle")
+	}
+
+	switch {
+	case result.OwnedBundle != nil:
+		return bot.send(ctx, bot.renderOwnedBundle(texts, msg.Chat.ID, result.OwnedBundle))
+	case result.Files != nil:
+		return bot.sendNotOwnedBundle(ctx, msg.Chat.ID, result)
+	case result.ChatSubRequest != nil:
+		return bot.send(ctx, bot.renderSubRequest(texts, msg, result.ChatSubRequest))
+	default:
+		log.Error(ctx, "bad result")
+	}
+
+	return nil
+}
+
+func (bot *Bot) getBundleForOwner(ctx context.Context, cbq *tgbotapi.CallbackQuery, id core.BundleID) (*service.OwnedBundle, error) {
+	user := getUserCtx(ctx)
+
+	bundle, err := bot.bundleSrv.GetBundleByID(ctx, user, id)
+	if errors.Is(err, core.ErrBundleNotFound) {
+		_ = bot.deleteMessage(ctx, cbq.Message)
+		_ = bot.answerCallbackQueryAlert(ctx, cbq, getTextsCtx(ctx).BundleDeletedBefore)
+		return nil, err
+	} else if err != nil {
+		return nil, errors.Wrap(err, "get bundle by id")
+	}
+
+	return bundle, nil
+}
+
+func (bot *Bot) onBundleRefreshCBQ(ctx context.Context, cbq *tgbotapi.CallbackQuery, id core.BundleID) error {
+	bundle, err := bot.getBundleForOwner(ctx, cbq, id)
+	if errors.Is(err, core.ErrBundleNotFound) {
+		return nil
+	} else if err != nil {
+		return errors.Wrap(err, "get bundle for owner")
+	}
+
+	texts := getTextsCtx(ctx)
+
+	edit := tgbotapi.NewEditMessageText(
+		cbq.Message.Chat.ID,
+		cbq.Message.MessageID,
+		bot.renderOwnedBundleText(texts, bundle),
+	)
+
+	edit.ParseMode = mdv2
+	edit.DisableWebPagePreview = true
+	replyMarkup := bot.renderOwnedBundleReplyMarkup(texts, bundle)
+	edit.ReplyMarkup = &replyMarkup
+
+	if err := bot.send(ctx, edit); err != nil {
+		var tgErr *tgbotapi.Error
+
+		if errors.As(err, &tgErr) {
+			if strings.Contains(tgErr.Message, "message is not modified:") {
+				return bot.answerCallbackQuery(ctx, cbq, texts.CommonNothingChanged)
+			}
+		}
+		return errors.Wrap(err, "edit message error")
+	}
+
+	return bot.answerCallbackQuery(ctx, cbq, "")
+}
+
+func (bot *Bot) onBundleDeleteCBQ(ctx context.Context, cbq *tgbotapi.CallbackQuery, id core.BundleID) error {
+	bundle, err := bot.getBundleForOwner(ctx, cbq, id)
+	if errors.Is(err, core.ErrBundleNotFound) {
+		return nil
+	} else if err != nil {
+		return errors.Wrap(err, "get bundle for owner")
+	}
+
+	go func() {
+		_ = bot.answerCallbackQuery(ctx, cbq, "")
+	}()
+
+	texts := getTextsCtx(ctx)
+
+	edit := tgbotapi.NewEditMessageText(
+		cbq.Message.Chat.ID,
+		cbq.Message.MessageID,
+		texts.BundleDeleteConfirm,
+	)
+	edit.ParseMode = tgbotapi.ModeMarkdown
+
+	markup := tgbotapi.NewInlineKeyboardMarkup(
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(
+				texts.CommonYes,
+				fmt.Sprintf(callbackBundleDeleteConfirm, bundle.ID),
+			),
+			tgbotapi.NewInlineKeyboardButtonData(
+				texts.CommonNo,
+				fmt.Sprintf(callbackBundleRefresh, bundle.ID),
+			),
+		),
+	)
+
+	edit.ReplyMarkup = &markup
+
+	return bot.send(ctx, edit)
+}
+
+func (bot *Bot) onBundleDeleteConfirmCBQ(ctx context.Context, cbq *tgbotapi.CallbackQuery, id core.BundleID) error {
+	user := getUserCtx(ctx)
+
+	bundle, err := bot.getBundleForOwner(ctx, cbq, id)
+	if errors.Is(err, core.ErrBundleNotFound) {
+		return nil
+	} else if err != nil {
+		return errors.Wrap(err, "get bundle for owner")
+	}
+
+	if err := bot.bundleSrv.DeleteBundle(ctx, user, bundle.ID); err != nil {
+		return errors.Wrap(err, "delete bundle")
+	}
+
+	go func() {
+		_ = bot.deleteMessage(ctx, cbq.Message)
+	}()
+
+	return bot.answerCallbackQuery(ctx, cbq, getTextsCtx(ctx).BundleDeleted)
+}
+
+func (bot *Bot) newBundleRestrictionsReplyMarkup(
+	texts *i18n.Texts,
+	bundle *core.Bundle,
+	chats []*core.Chat,
+) *tgbotapi.InlineKeyboardMarkup {
+	keyboard := make([][]tgbotapi.InlineKeyboardButton, 0, len(chats)+2)
+
+	if len(bundle.Restriction.ChatIDs) > 1 {
+		keyboard = append(keyboard, renderChatPolicyRow(
+			texts,
+			&bundle.Restriction,
+			callbackBundleRestrictionsChatPolicy,
+			int(bundle.ID),
+		))
+	}
+
+	for _, chat := range chats {
+		keyboard = append(keyboard, tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(
+				addIsEnabledEmoji(
+					bundle.Restriction.HasChat(chat.ID),
+					chat.Title,
+				),
+				fmt.Sprintf(
+					callbackBundleRestrictionsChat,
+					bundle.ID,
+					chat.ID,
+				),
+			),
+		))
+	}
+
+	keyboard = append(keyboard, tgbotapi.NewInlineKeyboardRow(
+		tgbotapi.NewInlineKeyboardButtonData(
+			texts.CommonBack,
+			fmt.Sprintf(callbackBundleRefresh, bundle.ID),
+		),
+	))
+
+	markup := tgbotapi.NewInlineKeyboardMarkup(keyboard...)
+
+	return &markup
+}
+
+func (bot *Bot) onBundleRestrictionsCBQ(ctx context.Context, cbq *tgbotapi.CallbackQuery, id core.BundleID) error {
+	bundle, err := bot.getBundleForOwner(ctx, cbq, id)
+	if errors.Is(err, core.ErrBundleNotFound) {
+		return nil
+	} else if err != nil {
+		return errors.Wrap(err, "get bundle for owner")
+	}
+
+	go func() {
+		_ = bot.answerCallbackQuery(ctx, cbq, "")
+	}()
+
+	user := getUserCtx(ctx)
+
+	chats, err := bot.chatSrv.GetChats(ctx, user)
+	if err != nil {
+		return errors.Wrap(err, "service get chats")
+	}
+
+	texts := getTextsCtx(ctx)
+
+	edit := tgbotapi.NewEditMessageText(
+		cbq.Message.Chat.ID,
+		cbq.Message.MessageID,
+		texts.FileRestrictions,
+	)
+	edit.ParseMode = mdv2
+	edit.ReplyMarkup = bot.newBundleRestrictionsReplyMarkup(texts, bundle.Bundle, chats)
+
+	return bot.send(ctx, edit)
+}
+
+func (bot *Bot) onBundleRestrictionsSetChatCBQ(
+	ctx context.Context,
+	cbq *tgbotapi.CallbackQuery,
+	bundleID core.BundleID,
+	chatID core.ChatID,
+) error {
+	user := getUserCtx(ctx)
+
+	result, err := bot.bundleSrv.SetChatRestriction(ctx, user, bundleID, chatID)
+	if err != nil {
+		return errors.Wrap(err, "service set chat restriction")
+	}
+
+	chats, err := bot.chatSrv.GetChats(ctx, user)
+	if err != nil {
+		return errors.Wrap(err, "service query chats")
+	}
+
+	texts := getTextsCtx(ctx)
+
+	replyMarkup := bot.newBundleRestrictionsReplyMarkup(texts, result.Bundle, chats)
+
+	go func() {
+		if result.Disable {
+			_ = bot.answerCallbackQuery(ctx, cbq, texts.RestrictionDisabled)
+		} else {
+			_ = bot.answerCallbackQuery(ctx, cbq, texts.RestrictionSet)
+		}
+	}()
+
+	return bot.send(ctx, tgbotapi.NewEditMessageReplyMarkup(
+		cbq.Message.Chat.ID,
+		cbq.Message.MessageID,
+		*replyMarkup,
+	))
+}
+
+func (bot *Bot) onBundleRestrictionsChatPolicyCBQ(
+	ctx context.Context,
+	cbq *tgbotapi.CallbackQuery,
+	bundleID core.BundleID,
+	policy core.ChatPolicy,
+) error {
+	user := getUserCtx(ctx)
+
+	bundle, err := bot.bundleSrv.SetChatPolicy(ctx, user, bundleID, policy)
+	if err != nil {
+		return errors.Wrap(err, "service set chat policy")
+	}
+
+	chats, err := bot.chatSrv.GetChats(ctx, user)
+	if err != nil {
+		return errors.Wrap(err, "service query chats")
+	}
+
+	texts := getTextsCtx(ctx)
+
+	go func() {
+		_ = bot.answerCallbackQuery(ctx, cbq, getChatPolicyTitle(texts, policy))
+	}()
+
+	return bot.send(ctx, tgbotapi.NewEditMessageReplyMarkup(
+		cbq.Message.Chat.ID,
+		cbq.Message.MessageID,
+		*bot.newBundleRestrictionsReplyMarkup(texts, bundle, chats),
+	))
+}
+
+func (bot *Bot) onBundleRestrictionsChatCheck(ctx context.Context, cbq *tgbotapi.CallbackQuery, id core.BundleID) error {
+	user := getUserCtx(ctx)
+	texts := getTextsCtx(ctx)
+
+	status, err := bot.bundleSrv.CheckBundleRestrictionsChat(ctx, user, id)
+	switch {
+	case errors.Is(err, service.ErrCantCheckMembership):
+		return bot.answerCallbackQueryAlert(ctx, cbq, texts.BundleCantCheckMembership)
+	case errors.Is(err, core.ErrBundleNotFound):
+		return bot.answerCallbackQueryAlert(ctx, cbq, texts.BundleDeletedByOwner)
+	case errors.Is(err, service.ErrOwnerIsBanned):
+		return bot.answerCallbackQueryAlert(ctx, cbq, texts.BlockedByAdmin)
+	case err != nil:
+		return errors.Wrap(err, "check bundle restrictions chat")
+	}
+
+	if !status.Ok {
+		go func() {
+			_ = bot.send(ctx, bot.renderSubRequestEdit(texts, cbq.Message, status.Sub))
+		}()
+
+		return bot.answerCallbackQueryAlert(ctx, cbq, texts.BundleNotSubscribed)
+	}
+
+	result, err := bot.bundleSrv.RegisterDownload(ctx, user, status.Bundle)
+	switch {
+	case errors.Is(err, service.ErrBundleEmpty):
+		return bot.answerCallbackQueryAlert(ctx, cbq, texts.BundleEmpty)
+	case errors.Is(err, service.ErrOwnerIsBanned):
+		return bot.answerCallbackQueryAlert(ctx, cbq, texts.BlockedByAdmin)
+	case err != nil:
+		return errors.Wrap(err, "register bundle download")
+	}
+
+	go func() {
+		_ = bot.deleteMessage(ctx, cbq.Message)
+		_ = bot.answerCallbackQuery(ctx, cbq, texts.BundleAccessGranted)
+	}()
+
+	return bot.sendNotOwnedBundle(ctx, cbq.Message.Chat.ID, result)
+}
@@ -0,0 +1,92 @@
+package bot
+
+import (
+	"testing"
+
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupBundleFiles(t *testing.T) {
+	newFiles := func(kinds ...core.Kind) []*core.File {
+		result := make([]*core.File, len(kinds))
+		for i, kind := range kinds {
+			result[i] = &core.File{ID: core.FileID(i + 1), Kind: kind}
+		}
+		return result
+	}
+
+	chunkSizes := func(chunks [][]*core.File) []int {
+		result := make([]int, len(chunks))
+		for i, chunk := range chunks {
+			result[i] = len(chunk)
+		}
+		return result
+	}
+
+	manyPhotos := make([]core.Kind, 12)
+	for i := range manyPhotos {
+		manyPhotos[i] = core.KindPhoto
+	}
+
+	for _, test := range []struct {
+		Name  string
+		Kinds []core.Kind
+		Sizes []int
+	}{
+		{
+			Name:  "Empty",
+			Kinds: nil,
+			Sizes: []int{},
+		},
+		{
+			Name:  "Documents",
+			Kinds: []core.Kind{core.KindDocument, core.KindDocument},
+			Sizes: []int{1, 1},
+		},
+		{
+			Name:  "PhotoAndVideo",
+			Kinds: []core.Kind{core.KindPhoto, core.KindVideo, core.KindPhoto},
+			Sizes: []int{3},
+		},
+		{
+			Name:  "MediaSplitByDocument",
+			Kinds: []core.Kind{core.KindPhoto, core.KindDocument, core.KindPhoto, core.KindVideo},
+			Sizes: []int{1, 1, 2},
+		},
+		{
+			Name:  "Audios",
+			Kinds: []core.Kind{core.KindAudio, core.KindAudio, core.KindAudio},
+			Sizes: []int{3},
+		},
+		{
+			Name:  "AudiosNotMixedWithMedia",
+			Kinds: []core.Kind{core.KindPhoto, core.KindAudio, core.KindAudio, core.KindVideo, core.KindPhoto, core.KindAudio},
+			Sizes: []int{1, 2, 2, 1},
+		},
+		{
+			Name:  "MediaGroupLimit",
+			Kinds: manyPhotos,
+			Sizes: []int{10, 2},
+		},
+	} {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			files := newFiles(test.Kinds...)
+
+			chunks := groupBundleFiles(files)
+
+			assert.Equal(t, test.Sizes, chunkSizes(chunks))
+
+			// order of files should be kept
+			flat := []*core.File{}
+			for _, chunk := range chunks {
+				flat = append(flat, chunk...)
+			}
+			assert.Equal(t, len(files), len(flat))
+			for i := range files {
+				assert.Equal(t, files[i].ID, flat[i].ID)
+			}
+		})
+	}
+}
@@ -0,0 +1,214 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/pkg/tg"
+	"github.com/bots-house/share-file-bot/service"
+	tgbotapi "github.com/bots-house/telegram-bot-api"
+	"github.com/friendsofgo/errors"
+)
+
+const (
+	callbackAdminCampaigns = "admin:campaigns"
+
+	campaignDateFormat = "2006-01-02"
+)
+
+func (bot *Bot) getCampaignDeepLink(campaign *core.Campaign) string {
+	return fmt.Sprintf("https://%s/%s?start=%s%s",
+		tgDomain,
+		bot.client.Self.UserName,
+		refDeepLinkPrefix,
+		campaign.Ref,
+	)
+}
+
+// formatCents formats amount in cents like 150.00.
+func formatCents(v float64) string {
+	return fmt.Sprintf("%.2f", v/100)
+}
+
+// parseCampaignsPeriod parses optional dates of period [from, to].
+// Returned to is exclusive, so whole day of to is included.
+func parseCampaignsPeriod(args []string) (from, to time.Time, err error) {
+	if len(args) > 0 {
+		from, err = time.Parse(campaignDateFormat, args[0])
+		if err != nil {
+			return time.Time{}, time.Time{}, errors.Wrap(err, "parse from")
+		}
+	}
+
+	if len(args) > 1 {
+		to, err = time.Parse(campaignDateFormat, args[1])
+		if err != nil {
+			return time.Time{}, time.Time{}, errors.Wrap(err, "parse to")
+		}
+
+		to = to.AddDate(0, 0, 1)
+	}
+
+	return from, to, nil
+}
+
+func (bot *Bot) renderCampaigns(ctx context.Context, from, to time.Time) (string, error) {
+	texts := getTextsCtx(ctx)
+
+	campaigns, err := bot.adminSrv.CampaignsStats(ctx, getUserCtx(ctx), from, to)
+	if err != nil {
+		return "", err
+	}
+
+	period := texts.AdminCampaignsAllTime
+	if !from.IsZero() || !to.IsZero() {
+		var fromText, toText string
+
+		if !from.IsZero() {
+			fromText = from.Format(campaignDateFormat)
+		}
+
+		if !to.IsZero() {
+			toText = to.AddDate(0, 0, -1).Format(campaignDateFormat)
+		}
+
+		period = fmt.Sprintf("%s — %s", fromText, toText)
+	}
+
+	lines := []string{
+		texts.AdminCampaignsTitle,
+		"",
+		fmt.Sprintf(texts.AdminCampaignsPeriod, tg.EscapeMD(period)),
+		"",
+	}
+
+	if len(campaigns) == 0 {
+		lines = append(lines, texts.AdminCampaignsEmpty)
+	}
+
+	for _, campaign := range campaigns {
+		lines = append(lines,
+			fmt.Sprintf(texts.AdminCampaignName, tg.EscapeMD(campaign.Name), campaign.Ref),
+			fmt.Sprintf(texts.AdminCampaignUsers, campaign.Users, campaign.Downloads),
+			fmt.Sprintf(texts.AdminCampaignCost,
+				formatCents(float64(campaign.Cost)),
+				formatCents(campaign.CostPerUser()),
+			),
+			fmt.Sprintf(texts.AdminCampaignLink, tg.EscapeMD(bot.getCampaignDeepLink(campaign.Campaign))),
+			"",
+		)
+	}
+
+	return strings.Join(lines, "\n"), nil
+}
+
+func (bot *Bot) onAdminCampaigns(ctx context.Context, msg *tgbotapi.Message, args []string) error {
+	if !getUserCtx(ctx).IsAdmin {
+		return nil
+	}
+
+	texts := getTextsCtx(ctx)
+
+	from, to, err := parseCampaignsPeriod(args)
+	if err != nil {
+		return bot.send(ctx, bot.newAnswerMsg(msg, tg.EscapeMD(texts.AdminCampaignInvalidPeriod)))
+	}
+
+	text, err := bot.renderCampaigns(ctx, from, to)
+	if errors.Is(err, service.ErrUserIsNotAdmin) {
+		return nil
+	} else if err != nil {
+		return errors.Wrap(err, "render campaigns")
+	}
+
+	out := bot.newAnswerMsg(msg, text)
+	out.DisableWebPagePreview = true
+
+	return bot.send(ctx, out)
+}
+
+func (bot *Bot) onAdminCampaignsCBQ(ctx context.Context, cbq *tgbotapi.CallbackQuery) error {
+	text, err := bot.renderCampaigns(ctx, time.Time{}, time.Time{})
+	if errors.Is(err, service.ErrUserIsNotAdmin) {
+		return nil
+	} else if err != nil {
+		return errors.Wrap(err, "render campaigns")
+	}
+
+	go func() {
+		_ = bot.answerCallbackQuery(ctx, cbq, "")
+	}()
+
+	markup := tgbotapi.NewInlineKeyboardMarkup(
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(getTextsCtx(ctx).CommonBack, callbackAdmin),
+		),
+	)
+
+	edit := tgbotapi.NewEditMessageText(cbq.Message.Chat.ID, cbq.Message.MessageID, text)
+	edit.ParseMode = mdv2
+	edit.ReplyMarkup = &markup
+	edit.DisableWebPagePreview = true
+
+	return bot.send(ctx, edit)
+}
+
+// onAdminCampaign handles /admin campaign add <ref> <cost> <name> and /admin campaign delete <ref>.
+func (bot *Bot) onAdminCampaign(ctx context.Context, msg *tgbotapi.Message, args []string) error {
+	user := getUserCtx(ctx)
+	texts := getTextsCtx(ctx)
+
+	if !user.IsAdmin {
+		return nil
+	}
+
+	var text string
+
+	switch {
+	case len(args) >= 3 && args[0] == "add":
+		cost, err := service.ParseCampaignCost(args[2])
+		if err != nil {
+			text = texts.AdminCampaignInvalidCost
+			break
+		}
+
+		name := args[1]
+		if len(args) > 3 {
+			name = strings.Join(args[3:], " ")
+		}
+
+		campaign, err := bot.adminSrv.CreateCampaign(ctx, user, args[1], name, cost)
+		switch {
+		case errors.Is(err, service.ErrCampaignInvalidRef):
+			text = texts.AdminCampaignInvalidRef
+		case errors.Is(err, service.ErrCampaignInvalidCost):
+			text = texts.AdminCampaignInvalidCost
+		case errors.Is(err, service.ErrCampaignAlreadyExists):
+			text = texts.AdminCampaignExists
+		case err != nil:
+			return errors.Wrap(err, "create campaign")
+		default:
+			text = fmt.Sprintf(texts.AdminCampaignCreated, bot.getCampaignDeepLink(campaign))
+		}
+	case len(args) == 2 && args[0] == "delete":
+		err := bot.adminSrv.DeleteCampaign(ctx, user, args[1])
+		switch {
+		case errors.Is(err, core.ErrCampaignNotFound):
+			text = texts.AdminCampaignNotFound
+		case err != nil:
+			return errors.Wrap(err, "delete campaign")
+		default:
+			text = texts.AdminCampaignDeleted
+		}
+	default:
+		return bot.send(ctx, bot.newAnswerMsg(msg, texts.AdminCampaignsUsage))
+	}
+
+	out := bot.newAnswerMsg(msg, tg.EscapeMD(text))
+	out.DisableWebPagePreview = true
+
+	return bot.send(ctx, out)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/bots-house/share-file-bot/bot/i18n"
 	"github.com/bots-house/share-file-bot/bot/state"
 	"github.com/bots-house/share-file-bot/core"
 	"github.com/bots-house/share-file-bot/pkg/log"
@@ -12,55 +13,44 @@ import (
 	"github.com/bots-house/share-file-bot/service"
 	tgbotapi "github.com/bots-house/telegram-bot-api"
 	"github.com/friendsofgo/errors"
-	"github.com/lithammer/dedent"
 )
 
-const (
-	textUnsupportedFileKind = "К сожалению, я не поддерживаю данный тип файлов\\. На данный момент я умею работать только с документами, видео, фото, аудио и голосовыми\\. Отправь и перешли мне сообщение перечисленного типа, а в ответ я дам тебе ссылку\\."
-	mdv2                    = "MarkdownV2"
-)
-
-var (
-	textHello = "Привет\\! 👋\n"
-
-	textHelp = dedent.Dedent(`
-		Я помогу тебе поделиться любым медиафайлом \(фото, видео, документы, аудио, голосовые\) с подписчиками твоего канала\. 
-		Отправь любой из перечисленных файлов, а я в ответ дам тебе ссылку\. Желательно указать подпись, чтобы человек не забыл кто ему это пошарил\.
-		Так же ты можешь подключить свой канал или чат и установить ограничение на доступ к медиафайлу только своим подписчикам\. 
-
-		/settings \- для более тонкой настройки
-
-		Поддержка: @share\_file\_support
-		Новости и обновления: @share\_file\_news
-	`)
+const mdv2 = "MarkdownV2"
 
-	textStart = textHello + textHelp
-)
+func (bot *Bot) getTextStart(texts *i18n.Texts) string {
+	return texts.Hello + bot.getTextHelp(texts)
+}
 
-func (bot *Bot) getTextStart() string {
+// getTextHelp returns help text, it can be overridden by config for all languages.
+func (bot *Bot) getTextHelp(texts *i18n.Texts) string {
 	if bot.textHelp != "" {
-		return textHello + bot.textHelp
+		return bot.textHelp
 	}
 
-	return textStart
+	return texts.Help
 }
 
-func (bot *Bot) getTextHelp() string {
-	if bot.textHelp != "" {
-		return bot.textHelp
+// isButtonAbout checks if text is title of about button in any language,
+// because keyboard could be sent before user changed language.
+func isButtonAbout(text string) bool {
+	for _, lang := range i18n.Langs {
+		if text == i18n.Get(lang).ButtonAbout {
+			return true
+		}
 	}
 
-	return textHelp
+	return false
 }
 
 func (bot *Bot) onHelp(ctx context.Context, msg *tgbotapi.Message) error {
-	answer := bot.newAnswerMsg(msg, bot.getTextHelp())
+	answer := bot.newAnswerMsg(msg, bot.getTextHelp(getTextsCtx(ctx)))
 	answer.ParseMode = mdv2
 	return bot.send(ctx, answer)
 }
 
 func (bot *Bot) onStart(ctx context.Context, msg *tgbotapi.Message) error {
 	user := getUserCtx(ctx)
+	texts := getTextsCtx(ctx)
 
 	// reset state
 	if err := bot.state.Set(ctx, user.ID, state.Empty); err != nil {
@@ -68,42 +58,36 @@ func (bot *Bot) onStart(ctx context.Context, msg *tgbotapi.Message) error {
 	}
 
 	if args := msg.CommandArguments(); args != "" {
-		log.Debug(ctx, "query file", "public_id", args)
-		result, err := bot.fileSrv.GetFileByPublicID(ctx, user, args)
+		log.Debug(ctx, "query file", "payload", args)
+		result, err := bot.fileSrv.GetFileByDeepLink(ctx, user, args)
+
+		if text, ok := getFileRestrictionsErrorText(texts, err); ok {
+			answer := bot.newAnswerMsg(msg, tg.EscapeMD(text))
+			return bot.send(ctx, answer)
+		}
 
 		switch {
 		case errors.Is(err, core.ErrFileNotFound):
-			answer := bot.newAnswerMsg(msg, "😐 Ничего не знаю о таком файле, проверь ссылку...")
-			return bot.send(ctx, answer)
-		case errors.Is(err, service.ErrFileViolatesCopyright):
-			answer := bot.newAnswerMsg(msg, "😐 К сожалению, на данный файл поступила жалоба от правообладателей и мы были вынужденны его удалить.")
+			return bot.onStartBundle(ctx, msg, args)
+		case errors.Is(err, service.ErrFileLinkRevoked):
+			answer := bot.newAnswerMsg(msg, tg.EscapeMD(texts.FileLinkRevoked))
 			return bot.send(ctx, answer)
 		case errors.Is(err, service.ErrCantCheckMembership):
-			//nolint:stylecheck
-			answer := bot.newAnswerMsg(msg, "🙅‍♂️‍ Я не могу выдать тебе файл, так как больше не являюсь админом канала на который требовалась подписка, свяжись с владельцем файла и передавай от меня привет!")
+			answer := bot.newAnswerMsg(msg, tg.EscapeMD(texts.CantCheckMembership))
 			return bot.send(ctx, answer)
 		case err != nil:
 			return errors.Wrap(err, "download file")
 		}
 
-		switch {
-		case result.OwnedFile != nil:
-			return bot.send(ctx, bot.renderOwnedFile(msg, result.OwnedFile))
-		case result.File != nil:
-			return bot.send(ctx, bot.renderNotOwnedFile(msg, result.File))
-		case result.ChatSubRequest != nil:
-			return bot.send(ctx, bot.renderSubRequest(msg, result.ChatSubRequest))
-		default:
-			log.Error(ctx, "bad result")
-		}
+		return bot.sendDownloadResult(ctx, msg, result)
 	}
 
-	answer := bot.newAnswerMsg(msg, bot.getTextStart())
+	answer := bot.newAnswerMsg(msg, bot.getTextStart(texts))
 	return bot.send(ctx, answer)
 }
 
 func (bot *Bot) onUnsupportedFileKind(ctx context.Context, msg *tgbotapi.Message) error {
-	answer := bot.newReplyMsg(msg, textUnsupportedFileKind)
+	answer := bot.newReplyMsg(msg, tg.EscapeMD(getTextsCtx(ctx).UnsupportedFileKind))
 	return bot.send(ctx, answer)
 }
 
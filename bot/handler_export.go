@@ -0,0 +1,118 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/pkg/log"
+	"github.com/bots-house/share-file-bot/service"
+	tgbotapi "github.com/bots-house/telegram-bot-api"
+	"github.com/friendsofgo/errors"
+)
+
+// sendDownloadsExport writes export to temporary file and sends it as document.
+// Nothing is sent if export is empty.
+func (bot *Bot) sendDownloadsExport(
+	ctx context.Context,
+	chatID int64,
+	name string,
+	export func(w io.Writer) (int, error),
+) (int, error) {
+	tmp, err := ioutil.TempFile("", "share-file-bot-export-*")
+	if err != nil {
+		return 0, errors.Wrap(err, "create temp file")
+	}
+
+	defer func() {
+		_ = tmp.Close()
+
+		if err := os.Remove(tmp.Name()); err != nil {
+			log.Warn(ctx, "can't remove export temp file", "err", err)
+		}
+	}()
+
+	total, err := export(tmp)
+	if err != nil {
+		return 0, errors.Wrap(err, "export")
+	}
+
+	if total == 0 {
+		return 0, nil
+	}
+
+	size, err := tmp.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return 0, errors.Wrap(err, "get size")
+	}
+
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return 0, errors.Wrap(err, "seek to start")
+	}
+
+	doc := tgbotapi.NewDocumentUpload(chatID, tgbotapi.FileReader{
+		Name:   name,
+		Reader: tmp,
+		Size:   size,
+	})
+	doc.Caption = fmt.Sprintf(getTextsCtx(ctx).ExportCaption, total)
+
+	return total, bot.send(ctx, doc)
+}
+
+func (bot *Bot) onFileExportCBQ(
+	ctx context.Context,
+	cbq *tgbotapi.CallbackQuery,
+	id core.FileID,
+	format service.ExportFormat,
+) error {
+	user := getUserCtx(ctx)
+	texts := getTextsCtx(ctx)
+
+	total, err := bot.sendDownloadsExport(ctx,
+		cbq.Message.Chat.ID,
+		fmt.Sprintf("downloads-file-%d.%s", id, format.Ext()),
+		func(w io.Writer) (int, error) {
+			return bot.fileSrv.ExportDownloads(ctx, user, id, format, w)
+		},
+	)
+	if errors.Is(err, core.ErrFileNotFound) {
+		return bot.answerCallbackQueryAlert(ctx, cbq, texts.FileDeletedBefore)
+	} else if err != nil {
+		return errors.Wrap(err, "send export")
+	}
+
+	if total == 0 {
+		return bot.answerCallbackQueryAlert(ctx, cbq, texts.ExportEmpty)
+	}
+
+	return bot.answerCallbackQuery(ctx, cbq, "")
+}
+
+func (bot *Bot) onSettingsChannelsAndChatsExport(
+	ctx context.Context,
+	user *core.User,
+	cbq *tgbotapi.CallbackQuery,
+	id core.ChatID,
+	format service.ExportFormat,
+) error {
+	total, err := bot.sendDownloadsExport(ctx,
+		cbq.Message.Chat.ID,
+		fmt.Sprintf("downloads-chat-%d.%s", id, format.Ext()),
+		func(w io.Writer) (int, error) {
+			return bot.chatSrv.ExportDownloads(ctx, user, id, format, w)
+		},
+	)
+	if err != nil {
+		return errors.Wrap(err, "send export")
+	}
+
+	if total == 0 {
+		return bot.answerCallbackQueryAlert(ctx, cbq, getTextsCtx(ctx).ExportEmpty)
+	}
+
+	return bot.answerCallbackQuery(ctx, cbq, "")
+}
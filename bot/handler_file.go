@@ -5,90 +5,68 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/bots-house/share-file-bot/bot/i18n"
 	"github.com/bots-house/share-file-bot/core"
 	"github.com/bots-house/share-file-bot/pkg/log"
 	"github.com/bots-house/share-file-bot/pkg/tg"
 	"github.com/bots-house/share-file-bot/service"
 	tgbotapi "github.com/bots-house/telegram-bot-api"
 	"github.com/friendsofgo/errors"
-	"github.com/lithammer/dedent"
 )
 
 const tgDomain = "t.me"
 
 const (
-	callbackFileRestrictions          = "file:%d:restrictions"
-	callbackFileRestrictionsChat      = "file:%d:restrictions:chat-subscription:%d:toggl"
-	callbackFileRestrictionsChatCheck = "file:%d:restrictions:chat:check"
-
-	textButtonAbout = "Что это за бот?"
-)
-
-var (
-	textFileRestrictions = dedent.Dedent(`
-		С помощью данного инструмента вы можете ограничить доступ к файлу только подписчикам вашего канала / супергруппы\.
-		Перед каждым скачиванием бот будет проверять наличие подписки и только после этого выдавать доступ к файлу\. 
-
-		_Для подключения каналов перейдите в настройки \(/settings\)\._
-	`)
-
-	textFileSubRequest = dedent.Dedent(`
-		Владелец файла установил ограничение на доступ только с подпиской\. 
-		Подпишись на %s и нажми кнопку *«Я подписался»*
-	`)
+	callbackFileRefresh                = "file:%d:refresh"
+	callbackFileDelete                 = "file:%d:delete"
+	callbackFileDeleteConfirm          = "file:%d:delete:confirm"
+	callbackFileRestrictions           = "file:%d:restrictions"
+	callbackFileRestrictionsChat       = "file:%d:restrictions:chat-subscription:%d:toggl"
+	callbackFileRestrictionsChatPolicy = "file:%d:restrictions:chat-policy:%d"
+	callbackFileRestrictionsChatCheck  = "file:%d:restrictions:chat:check"
 )
 
-func (bot *Bot) renderNotOwnedFile(msg *tgbotapi.Message, file *core.File) tgbotapi.Chattable {
+func (bot *Bot) renderNotOwnedFile(texts *i18n.Texts, msg *tgbotapi.Message, file *core.File) tgbotapi.Chattable {
 
-	var replyMarkup interface{}
+	rows := [][]tgbotapi.InlineKeyboardButton{}
 
 	if file.LinkedPostURI.String != "" {
-		replyMarkup = tgbotapi.NewInlineKeyboardMarkup(
-			tgbotapi.NewInlineKeyboardRow(
-				tgbotapi.NewInlineKeyboardButtonURL("« Пост в канале", file.LinkedPostURI.String),
-			),
-			tgbotapi.NewInlineKeyboardRow(
-				tgbotapi.NewInlineKeyboardButtonData("Что это за бот?", cmdStart),
-			),
-		)
-	} else {
-		kb := tgbotapi.NewReplyKeyboard(
-			tgbotapi.NewKeyboardButtonRow(
-				tgbotapi.NewKeyboardButton("Что это за бот?"),
-			),
-		)
+		rows = append(rows, tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonURL(texts.FilePostButton, file.LinkedPostURI.String),
+		))
+	}
 
-		kb.OneTimeKeyboard = true
-		kb.ResizeKeyboard = true
+	rows = append(rows, tgbotapi.NewInlineKeyboardRow(
+		tgbotapi.NewInlineKeyboardButtonData(texts.ButtonAbout, cmdStart),
+		tgbotapi.NewInlineKeyboardButtonData(texts.ReportButton, fmt.Sprintf(callbackFileReport, file.ID)),
+	))
 
-		replyMarkup = kb
-	}
+	replyMarkup := tgbotapi.NewInlineKeyboardMarkup(rows...)
 
 	return bot.renderGenericFile(
 		msg.Chat.ID,
-		file.Kind,
-		file.TelegramID,
-		tg.EscapeMD(file.Caption.String),
+		file,
+		renderFileCaption(file),
 		mdv2,
 		replyMarkup,
 	)
 }
 
-func (bot *Bot) renderOwnedFileCaption(file *service.OwnedFile) string {
+func (bot *Bot) renderOwnedFileCaption(texts *i18n.Texts, file *service.OwnedFile) string {
 	rows := []string{}
 
 	if file.Caption.String != "" {
 
 		rows = append(rows,
-			"💬 __Описание__",
+			texts.FileCaptionDescription,
 			"",
-			tg.EscapeMD(file.Caption.String),
+			renderFileCaption(file.File),
 			"",
 		)
 	}
 
 	rows = append(rows,
-		"🔗 __Публичная ссылка__",
+		texts.FileCaptionPublicLink,
 		"",
 		fmt.Sprintf("https://%s/%s?start\\=%s",
 			tg.EscapeMD(tgDomain),
@@ -98,11 +76,11 @@ func (bot *Bot) renderOwnedFileCaption(file *service.OwnedFile) string {
 		"",
 	)
 
-	if file.HasLinkedPostURI() && file.Restriction.HasChatID() {
+	if file.HasLinkedPostURI() {
 		path, err := humanizePostURI(file.LinkedPostURI.String)
 		if err == nil {
 			rows = append(rows,
-				fmt.Sprintf("Связанный пост: [%s](%s)",
+				fmt.Sprintf(texts.FileCaptionLinkedPost,
 					tg.EscapeMD(path),
 					file.LinkedPostURI.String,
 				),
@@ -112,132 +90,308 @@ func (bot *Bot) renderOwnedFileCaption(file *service.OwnedFile) string {
 	}
 
 	rows = append(rows,
-		"📈 __Статистика__",
+		texts.FileCaptionStats,
 		"",
 	)
 
 	rows = append(rows,
-		fmt.Sprintf("*Загрузок*: `%d`", file.Stats.Total),
-		fmt.Sprintf("*Уникальных загрузок*: `%d`", file.Stats.Unique),
+		fmt.Sprintf(texts.StatsDownloads, file.Stats.Total),
+		fmt.Sprintf(texts.StatsUniqueDownloads, file.Stats.Unique),
 		"",
 	)
 
-	if file.Restriction.HasChatID() {
+	if file.Restriction.HasChats() {
 		rows = append(rows,
-			fmt.Sprintf("*Загрузок с подпиской*: `%d`", file.Stats.WithSubscription),
-			fmt.Sprintf("*Загрузок с новой подпиской*: `%d`", file.Stats.NewSubscription),
+			fmt.Sprintf(texts.StatsWithSubscription, file.Stats.WithSubscription),
+			fmt.Sprintf(texts.StatsNewSubscription, file.Stats.NewSubscription),
 			"",
 		)
 	}
 
+	rows = append(rows, bot.renderFileLimitsCaption(texts, file)...)
+
 	return strings.Join(rows, "\n")
 }
 
-func (bot *Bot) renderSubRequest(msg *tgbotapi.Message, sub *service.ChatSubRequest) tgbotapi.MessageConfig {
-	var link string
+func renderSubRequestChatLink(chat *service.SubRequestChat) string {
+	if chat.Username != "" {
+		return fmt.Sprintf("[@%s](https://t.me/%s)", tg.EscapeMD(chat.Username), chat.Username)
+	}
+	return fmt.Sprintf("[%s](%s)", tg.EscapeMD(chat.Title), chat.JoinLink)
+}
 
-	if sub.Username != "" {
-		link = fmt.Sprintf("[@%s](https://t.me/%s)", tg.EscapeMD(sub.Username), sub.Username)
-	} else {
-		link = fmt.Sprintf("[%s](%s)", tg.EscapeMD(sub.Title), sub.JoinLink)
+func (bot *Bot) renderSubRequestText(texts *i18n.Texts, sub *service.ChatSubRequest) string {
+	if len(sub.Chats) == 1 {
+		return fmt.Sprintf(texts.SubRequest, renderSubRequestChatLink(sub.Chats[0]))
 	}
 
-	text := fmt.Sprintf(textFileSubRequest, link)
+	links := make([]string, len(sub.Chats))
+	for i, chat := range sub.Chats {
+		links[i] = "• " + renderSubRequestChatLink(chat)
+	}
 
-	out := tgbotapi.NewMessage(msg.Chat.ID, text)
-	out.ParseMode = mdv2
-	out.ReplyMarkup = tgbotapi.NewInlineKeyboardMarkup(
-		tgbotapi.NewInlineKeyboardRow(
-			tgbotapi.NewInlineKeyboardButtonURL("Подписаться", sub.Link()),
-			tgbotapi.NewInlineKeyboardButtonData("Я подписался", fmt.Sprintf(callbackFileRestrictionsChatCheck, sub.FileID)),
-		),
-	)
+	text := texts.SubRequestAll
+	if sub.Policy == core.ChatPolicyAny {
+		text = texts.SubRequestAny
+	}
+
+	return fmt.Sprintf(text, strings.Join(links, "\n"))
+}
+
+func (bot *Bot) renderSubRequestReplyMarkup(texts *i18n.Texts, sub *service.ChatSubRequest) tgbotapi.InlineKeyboardMarkup {
+	check := fmt.Sprintf(callbackFileRestrictionsChatCheck, sub.FileID)
+	if sub.BundleID != core.ZeroBundleID {
+		check = fmt.Sprintf(callbackBundleRestrictionsChatCheck, sub.BundleID)
+	}
+
+	if len(sub.Chats) == 1 {
+		return tgbotapi.NewInlineKeyboardMarkup(
+			tgbotapi.NewInlineKeyboardRow(
+				tgbotapi.NewInlineKeyboardButtonURL(texts.SubRequestButtonSubscribe, sub.Chats[0].Link()),
+				tgbotapi.NewInlineKeyboardButtonData(texts.SubRequestButtonCheck, check),
+			),
+		)
+	}
 
+	rows := make([][]tgbotapi.InlineKeyboardButton, 0, len(sub.Chats)+1)
+
+	for _, chat := range sub.Chats {
+		rows = append(rows, tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonURL(fmt.Sprintf(texts.SubRequestButtonSubscribeTo, chat.Title), chat.Link()),
+		))
+	}
+
+	rows = append(rows, tgbotapi.NewInlineKeyboardRow(
+		tgbotapi.NewInlineKeyboardButtonData(texts.SubRequestButtonCheck, check),
+	))
+
+	return tgbotapi.NewInlineKeyboardMarkup(rows...)
+}
+
+func (bot *Bot) renderSubRequest(
+	texts *i18n.Texts,
+	msg *tgbotapi.Message,
+	sub *service.ChatSubRequest,
+) tgbotapi.MessageConfig {
+	out := tgbotapi.NewMessage(msg.Chat.ID, bot.renderSubRequestText(texts, sub))
+	out.ParseMode = mdv2
+	out.ReplyMarkup = bot.renderSubRequestReplyMarkup(texts, sub)
 	return out
 }
 
-func (bot *Bot) renderOwnedFileReplyMarkup(file *service.OwnedFile) tgbotapi.InlineKeyboardMarkup {
+// renderSubRequestEdit updates list of chats user is not subscribed yet.
+func (bot *Bot) renderSubRequestEdit(
+	texts *i18n.Texts,
+	msg *tgbotapi.Message,
+	sub *service.ChatSubRequest,
+) tgbotapi.EditMessageTextConfig {
+	markup := bot.renderSubRequestReplyMarkup(texts, sub)
+
+	edit := tgbotapi.NewEditMessageText(msg.Chat.ID, msg.MessageID, bot.renderSubRequestText(texts, sub))
+	edit.ParseMode = mdv2
+	edit.ReplyMarkup = &markup
+
+	return edit
+}
+
+func (bot *Bot) renderOwnedFileReplyMarkup(texts *i18n.Texts, file *service.OwnedFile) tgbotapi.InlineKeyboardMarkup {
 	return tgbotapi.NewInlineKeyboardMarkup(
 		tgbotapi.NewInlineKeyboardRow(
 			tgbotapi.NewInlineKeyboardButtonData(
-				"Обновить",
-				fmt.Sprintf("file:%d:refresh", file.ID),
+				texts.CommonRefresh,
+				fmt.Sprintf(callbackFileRefresh, file.ID),
+			),
+			tgbotapi.NewInlineKeyboardButtonData(
+				texts.FileLinkButton,
+				fmt.Sprintf(callbackFileLink, file.ID),
 			),
 			tgbotapi.NewInlineKeyboardButtonData(
-				"Удалить",
-				fmt.Sprintf("file:%d:delete", file.ID),
+				texts.CommonDelete,
+				fmt.Sprintf(callbackFileDelete, file.ID),
 			),
 		),
 		tgbotapi.NewInlineKeyboardRow(
 			tgbotapi.NewInlineKeyboardButtonData(
-				addHasLockEmoji(file.Restriction.Any(), "Ограничения"),
+				addHasLockEmoji(file.Restriction.Any(), texts.CommonRestrictions),
 				fmt.Sprintf(callbackFileRestrictions, file.ID),
 			),
+			tgbotapi.NewInlineKeyboardButtonData(
+				texts.StatsButton,
+				fmt.Sprintf(callbackFileStats, file.ID),
+			),
+		),
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(
+				texts.PlacementsButton,
+				fmt.Sprintf(callbackFilePlacements, file.ID),
+			),
+			tgbotapi.NewInlineKeyboardButtonData(
+				texts.FileEditButton,
+				fmt.Sprintf(callbackFileEdit, file.ID),
+			),
+			tgbotapi.NewInlineKeyboardButtonData(
+				texts.FileVersionsButton,
+				fmt.Sprintf(callbackFileVersions, file.ID),
+			),
 		),
 	)
 }
 
 func (bot *Bot) renderGenericFile(
 	chatID int64,
-	fileKind core.Kind,
-	fileID string,
+	file *core.File,
 	caption string,
 	parseMode string,
 	replyMarkup interface{},
 ) tgbotapi.Chattable {
-	switch fileKind {
+	switch file.Kind {
 	case core.KindDocument:
-		share := tgbotapi.NewDocumentShare(chatID, fileID)
+		share := tgbotapi.NewDocumentShare(chatID, file.TelegramID)
 		share.Caption = caption
 		share.ReplyMarkup = replyMarkup
 		share.ParseMode = parseMode
 		return share
 	case core.KindAnimation:
-		share := tgbotapi.NewAnimationShare(chatID, fileID)
+		share := tgbotapi.NewAnimationShare(chatID, file.TelegramID)
 		share.Caption = caption
 		share.ReplyMarkup = replyMarkup
 		share.ParseMode = parseMode
 		return share
 	case core.KindAudio:
-		share := tgbotapi.NewAudioShare(chatID, fileID)
+		share := tgbotapi.NewAudioShare(chatID, file.TelegramID)
 		share.Caption = caption
 		share.ReplyMarkup = replyMarkup
 		share.ParseMode = parseMode
 		return share
 	case core.KindPhoto:
-		share := tgbotapi.NewPhotoShare(chatID, fileID)
+		share := tgbotapi.NewPhotoShare(chatID, file.TelegramID)
 		share.Caption = caption
 		share.ReplyMarkup = replyMarkup
 		share.ParseMode = parseMode
 		return share
 	case core.KindVideo:
-		share := tgbotapi.NewVideoShare(chatID, fileID)
+		share := tgbotapi.NewVideoShare(chatID, file.TelegramID)
 		share.Caption = caption
 		share.ReplyMarkup = replyMarkup
 		share.ParseMode = parseMode
 		return share
 	case core.KindVoice:
-		share := tgbotapi.NewVoiceShare(chatID, fileID)
+		share := tgbotapi.NewVoiceShare(chatID, file.TelegramID)
 		share.Caption = caption
 		share.ReplyMarkup = replyMarkup
 		share.ParseMode = parseMode
 		return share
+	case core.KindSticker:
+		share := tgbotapi.NewStickerShare(chatID, file.TelegramID)
+		share.ReplyMarkup = replyMarkup
+		return share
+	case core.KindVideoNote:
+		var length int
+		if file.Metadata.VideoNote != nil {
+			length = file.Metadata.VideoNote.Length
+		}
+
+		share := tgbotapi.NewVideoNoteShare(chatID, length, file.TelegramID)
+		share.ReplyMarkup = replyMarkup
+		return share
+	case core.KindContact:
+		contact := file.Metadata.Contact
+		if contact == nil {
+			return nil
+		}
+
+		share := tgbotapi.NewContact(chatID, contact.PhoneNumber, contact.FirstName)
+		share.LastName = contact.LastName
+		share.ReplyMarkup = replyMarkup
+		return share
+	case core.KindLocation:
+		location := file.Metadata.Location
+		if location == nil {
+			return nil
+		}
+
+		share := tgbotapi.NewLocation(chatID, location.Latitude, location.Longitude)
+		share.ReplyMarkup = replyMarkup
+		return share
 	default:
 		return nil
 	}
 }
 
-func (bot *Bot) renderOwnedFile(msg *tgbotapi.Message, file *service.OwnedFile) tgbotapi.Chattable {
+func (bot *Bot) renderOwnedFile(texts *i18n.Texts, msg *tgbotapi.Message, file *service.OwnedFile) tgbotapi.Chattable {
 	return bot.renderGenericFile(
 		msg.Chat.ID,
-		file.Kind,
-		file.TelegramID,
-		bot.renderOwnedFileCaption(file),
+		file.File,
+		bot.renderOwnedFileCaption(texts, file),
 		mdv2,
-		bot.renderOwnedFileReplyMarkup(file),
+		bot.renderOwnedFileReplyMarkup(texts, file),
 	)
 }
 
+// sendOwnedFile sends file with controls to owner.
+// Files of kinds without caption are sent as is, controls are sent in reply to them.
+func (bot *Bot) sendOwnedFile(ctx context.Context, msg *tgbotapi.Message, file *service.OwnedFile) error {
+	texts := getTextsCtx(ctx)
+
+	if file.Kind.HasCaption() {
+		return bot.send(ctx, bot.renderOwnedFile(texts, msg, file))
+	}
+
+	sent, err := bot.client.Send(bot.renderGenericFile(msg.Chat.ID, file.File, "", "", nil))
+	if err != nil {
+		return errors.Wrap(err, "send file")
+	}
+
+	card := tgbotapi.NewMessage(msg.Chat.ID, bot.renderOwnedFileCaption(texts, file))
+	card.ParseMode = mdv2
+	card.DisableWebPagePreview = true
+	card.ReplyToMessageID = sent.MessageID
+	card.ReplyMarkup = bot.renderOwnedFileReplyMarkup(texts, file)
+
+	return bot.send(ctx, card)
+}
+
+// newEditFileCard returns edit of message with controls of file.
+// Controls of files without caption are placed in separate text message.
+func newEditFileCard(
+	msg *tgbotapi.Message,
+	text string,
+	parseMode string,
+	markup tgbotapi.InlineKeyboardMarkup,
+) tgbotapi.Chattable {
+	if msg.Text != "" {
+		edit := tgbotapi.NewEditMessageText(msg.Chat.ID, msg.MessageID, text)
+		edit.ParseMode = parseMode
+		edit.DisableWebPagePreview = true
+		edit.ReplyMarkup = &markup
+		return edit
+	}
+
+	edit := tgbotapi.NewEditMessageCaption(msg.Chat.ID, msg.MessageID, text)
+	edit.ParseMode = parseMode
+	edit.ReplyMarkup = &markup
+	return edit
+}
+
+func (bot *Bot) sendDownloadResult(ctx context.Context, msg *tgbotapi.Message, result *service.DownloadResult) error {
+	texts := getTextsCtx(ctx)
+
+	switch {
+	case result.OwnedFile != nil:
+		return bot.sendOwnedFile(ctx, msg, result.OwnedFile)
+	case result.File != nil:
+		return bot.send(ctx, bot.renderNotOwnedFile(texts, msg, result.File))
+	case result.ChatSubRequest != nil:
+		return bot.send(ctx, bot.renderSubRequest(texts, msg, result.ChatSubRequest))
+	case result.PasswordRequest != nil:
+		return bot.onFilePasswordRequest(ctx, msg)
+	default:
+		log.Error(ctx, "bad result")
+	}
+
+	return nil
+}
+
 func (bot *Bot) deleteMessage(ctx context.Context, msg *tgbotapi.Message) error {
 	if err := bot.send(ctx, tgbotapi.NewDeleteMessage(
 		msg.Chat.ID,
@@ -250,25 +404,30 @@ func (bot *Bot) deleteMessage(ctx context.Context, msg *tgbotapi.Message) error
 	return nil
 }
 
+// deleteFileCard deletes message with controls of file
+// and message with file itself, if controls were sent separately.
+func (bot *Bot) deleteFileCard(ctx context.Context, msg *tgbotapi.Message) {
+	if msg.Text != "" && msg.ReplyToMessage != nil {
+		_ = bot.deleteMessage(ctx, msg.ReplyToMessage)
+	}
+
+	_ = bot.deleteMessage(ctx, msg)
+}
+
 func (bot *Bot) onFile(ctx context.Context, msg *tgbotapi.Message) error {
 	user := getUserCtx(ctx)
+	texts := getTextsCtx(ctx)
 
 	inputFile := bot.extractInputFileFromMessage(msg)
 
 	if inputFile == nil {
-		_ = bot.sendText(ctx,
-			user.ID,
-			"⚠️ Упс, я не могу добавить этот файл, так как не поддерживаю его",
-		)
+		_ = bot.sendText(ctx, user.ID, texts.FileNotSupported)
 
 		return core.ErrInvalidKind
 	}
 
 	if inputFile.Kind == core.KindAudio {
-		_ = bot.sendText(ctx,
-			user.ID,
-			"⚠️ Упс, я не могу добавить этот файл, так как поддержка аудио временно отключена",
-		)
+		_ = bot.sendText(ctx, user.ID, texts.FileAudioDisabled)
 
 		return nil
 	}
@@ -282,24 +441,16 @@ func (bot *Bot) onFile(ctx context.Context, msg *tgbotapi.Message) error {
 
 	switch {
 	case errors.Is(err, service.ErrUsersCantUploadFiles):
-		_ = bot.sendText(ctx,
-			user.ID,
-			"✋ Загрузка файлов доступна только администраторам ботам",
-		)
+		_ = bot.sendText(ctx, user.ID, texts.UploadOnlyAdmins)
 
 		return nil
 	case err != nil:
-		_ = bot.sendText(ctx,
-			user.ID,
-			"⚠️ Что-то пошло не так при добавлении файла",
-		)
+		_ = bot.sendText(ctx, user.ID, texts.FileAddFailed)
 
 		return errors.Wrap(err, "service add file")
 	}
 
-	result := bot.renderOwnedFile(msg, file)
-
-	return bot.send(ctx, result)
+	return bot.sendOwnedFile(ctx, msg, file)
 }
 
 func (bot *Bot) getFileForOwner(ctx context.Context, cbq *tgbotapi.CallbackQuery, id int) (*service.OwnedFile, error) {
@@ -308,7 +459,7 @@ func (bot *Bot) getFileForOwner(ctx context.Context, cbq *tgbotapi.CallbackQuery
 	file, err := bot.fileSrv.GetFileByID(ctx, user, core.FileID(id))
 	if errors.Is(err, core.ErrFileNotFound) {
 		_ = bot.deleteMessage(ctx, cbq.Message)
-		_ = bot.answerCallbackQueryAlert(ctx, cbq, "Файл был удален ранее")
+		_ = bot.answerCallbackQueryAlert(ctx, cbq, getTextsCtx(ctx).FileDeletedBefore)
 		return nil, err
 	} else if err != nil {
 		return nil, errors.Wrap(err, "get file by id")
@@ -333,25 +484,22 @@ func (bot *Bot) onFileRefreshCBQ(ctx context.Context, cbq *tgbotapi.CallbackQuer
 		return errors.Wrap(err, "get file for owner")
 	}
 
-	caption := bot.renderOwnedFileCaption(file)
+	texts := getTextsCtx(ctx)
 
-	edit := tgbotapi.NewEditMessageCaption(
-		cbq.Message.Chat.ID,
-		cbq.Message.MessageID,
-		caption,
+	edit := newEditFileCard(
+		cbq.Message,
+		bot.renderOwnedFileCaption(texts, file),
+		mdv2,
+		bot.renderOwnedFileReplyMarkup(texts, file),
 	)
 
-	edit.ParseMode = mdv2
-	replyMarkup := bot.renderOwnedFileReplyMarkup(file)
-	edit.ReplyMarkup = &replyMarkup
-
 	if err := bot.send(ctx, edit); err != nil {
 		var tgErr *tgbotapi.Error
 
 		if errors.As(err, &tgErr) {
 			// TODO: use tg.IsErr...
 			if strings.Contains(tgErr.Message, "message is not modified:") {
-				return bot.answerCallbackQuery(ctx, cbq, "🤷 Ничего не изменилось")
+				return bot.answerCallbackQuery(ctx, cbq, texts.CommonNothingChanged)
 			}
 		}
 		return errors.Wrap(err, "edit message error")
@@ -376,34 +524,22 @@ func (bot *Bot) onFileDeleteCBQ(
 		_ = bot.answerCallbackQuery(ctx, cbq, "")
 	}()
 
-	edit := tgbotapi.NewEditMessageCaption(
-		cbq.Message.Chat.ID,
-		cbq.Message.MessageID,
-		strings.Join([]string{
-			"Уверены что хотите *удалить* этот файл?",
-			"",
-			"Пользователи больше не смогут получить доступ к документу перейдя по ссылке.",
-			"Но у пользователей уже скачавших документ, он сохранится в истории диалога с ботом.",
-		}, "\n"),
-	)
-	edit.ParseMode = tgbotapi.ModeMarkdown
+	texts := getTextsCtx(ctx)
 
 	markup := tgbotapi.NewInlineKeyboardMarkup(
 		tgbotapi.NewInlineKeyboardRow(
 			tgbotapi.NewInlineKeyboardButtonData(
-				"Да, уверен",
-				fmt.Sprintf("file:%d:delete:confirm", file.ID),
+				texts.CommonYes,
+				fmt.Sprintf(callbackFileDeleteConfirm, file.ID),
 			),
 			tgbotapi.NewInlineKeyboardButtonData(
-				"Нет",
-				fmt.Sprintf("file:%d:refresh", file.ID),
+				texts.CommonNo,
+				fmt.Sprintf(callbackFileRefresh, file.ID),
 			),
 		),
 	)
 
-	edit.ReplyMarkup = &markup
-
-	return bot.send(ctx, edit)
+	return bot.send(ctx, newEditFileCard(cbq.Message, texts.FileDeleteConfirm, tgbotapi.ModeMarkdown, markup))
 }
 
 func (bot *Bot) onFileDeleteConfirmCBQ(
@@ -424,11 +560,9 @@ func (bot *Bot) onFileDeleteConfirmCBQ(
 		return errors.Wrap(err, "delete file")
 	}
 
-	go func() {
-		_ = bot.deleteMessage(ctx, cbq.Message)
-	}()
+	go bot.deleteFileCard(ctx, cbq.Message)
 
-	return bot.answerCallbackQuery(ctx, cbq, "✅ Документ удален")
+	return bot.answerCallbackQuery(ctx, cbq, getTextsCtx(ctx).FileDeleted)
 }
 
 func (bot *Bot) onFileRestrictionsCBQ(
@@ -454,29 +588,76 @@ func (bot *Bot) onFileRestrictionsCBQ(
 		return errors.Wrap(err, "service get chats")
 	}
 
-	markup := bot.newFileRestrictionsReplyMarkup(file.File, chats)
+	return bot.send(ctx, bot.newFileRestrictionsEdit(getTextsCtx(ctx), cbq, file.File, chats))
+}
+
+func (bot *Bot) newFileRestrictionsEdit(
+	texts *i18n.Texts,
+	cbq *tgbotapi.CallbackQuery,
+	file *core.File,
+	chats []*core.Chat,
+) tgbotapi.Chattable {
+	return newEditFileCard(
+		cbq.Message,
+		texts.FileRestrictions,
+		mdv2,
+		*bot.newFileRestrictionsReplyMarkup(texts, file, chats),
+	)
+}
 
-	edit := tgbotapi.EditMessageCaptionConfig{
-		BaseEdit: tgbotapi.BaseEdit{
-			ChatID:      cbq.Message.Chat.ID,
-			MessageID:   cbq.Message.MessageID,
-			ReplyMarkup: markup,
-		},
-		ParseMode: mdv2,
-		Caption:   textFileRestrictions,
+func getChatPolicyTitle(texts *i18n.Texts, policy core.ChatPolicy) string {
+	if policy == core.ChatPolicyAny {
+		return texts.ChatPolicyAny
 	}
+	return texts.ChatPolicyAll
+}
 
-	return bot.send(ctx, edit)
+// renderChatPolicyRow returns button which switches policy of chats check.
+func renderChatPolicyRow(
+	texts *i18n.Texts,
+	restriction *core.ChatRestrictions,
+	callback string,
+	id int,
+) []tgbotapi.InlineKeyboardButton {
+	next := core.ChatPolicyAny
+	if restriction.ChatPolicy == core.ChatPolicyAny {
+		next = core.ChatPolicyAll
+	}
+
+	return tgbotapi.NewInlineKeyboardRow(
+		tgbotapi.NewInlineKeyboardButtonData(
+			getChatPolicyTitle(texts, restriction.ChatPolicy),
+			fmt.Sprintf(callback, id, next),
+		),
+	)
 }
 
-func (bot *Bot) newFileRestrictionsReplyMarkup(file *core.File, chats []*core.Chat) *tgbotapi.InlineKeyboardMarkup {
-	keyboard := make([][]tgbotapi.InlineKeyboardButton, 0, len(chats)+1)
+func (bot *Bot) newFileRestrictionsReplyMarkup(
+	texts *i18n.Texts,
+	file *core.File,
+	chats []*core.Chat,
+) *tgbotapi.InlineKeyboardMarkup {
+	limits := bot.renderFileLimitsRows(texts, file)
+
+	keyboard := make([][]tgbotapi.InlineKeyboardButton, 0, len(chats)+len(limits)+3)
+
+	keyboard = append(keyboard, limits...)
+	keyboard = append(keyboard, bot.renderFilePasswordRow(texts, file))
+
+	if len(file.Restriction.ChatIDs) > 1 {
+		keyboard = append(keyboard, renderChatPolicyRow(
+			texts,
+			&file.Restriction.ChatRestrictions,
+			callbackFileRestrictionsChatPolicy,
+			int(file.ID),
+		))
+	}
 
 	for _, chat := range chats {
 		keyboard = append(keyboard, tgbotapi.NewInlineKeyboardRow(
 			tgbotapi.NewInlineKeyboardButtonData(
 				addIsEnabledEmoji(
-					chat.ID == file.Restriction.ChatID,
+					file.Restriction.HasChat(chat.ID),
 					chat.Title,
 				),
 				fmt.Sprintf(
@@ -490,8 +671,8 @@ func (bot *Bot) newFileRestrictionsReplyMarkup(file *core.File, chats []*core.Ch
 
 	keyboard = append(keyboard, tgbotapi.NewInlineKeyboardRow(
 		tgbotapi.NewInlineKeyboardButtonData(
-			textCommonBack,
-			fmt.Sprintf("file:%d:refresh", file.ID),
+			texts.CommonBack,
+			fmt.Sprintf(callbackFileRefresh, file.ID),
 		),
 	))
 
@@ -523,16 +704,19 @@ func (bot *Bot) onFileRestrictionsSetChatCBQ(
 		return errors.Wrap(err, "service query chats")
 	}
 
+	texts := getTextsCtx(ctx)
+
 	replyMarkup := bot.newFileRestrictionsReplyMarkup(
+		texts,
 		result.File,
 		chats,
 	)
 
 	go func() {
 		if result.Disable {
-			_ = bot.answerCallbackQuery(ctx, cbq, "Ограничение на загрузку отключено")
+			_ = bot.answerCallbackQuery(ctx, cbq, texts.RestrictionDisabled)
 		} else {
-			_ = bot.answerCallbackQuery(ctx, cbq, "Ограничение установлено")
+			_ = bot.answerCallbackQuery(ctx, cbq, texts.RestrictionSet)
 		}
 	}()
 
@@ -549,38 +733,80 @@ func (bot *Bot) onFileRestrictionsChatCheck(
 	fileID core.FileID,
 ) error {
 	user := getUserCtx(ctx)
+	texts := getTextsCtx(ctx)
 
 	status, err := bot.fileSrv.CheckFileRestrictionsChat(ctx, user, fileID)
+	if text, ok := getFileRestrictionsErrorText(texts, err); ok {
+		return bot.answerCallbackQueryAlert(ctx, cbq, text)
+	}
+
 	switch {
 	case errors.Is(err, service.ErrCantCheckMembership):
-		//nolint:stylecheck
-		return bot.answerCallbackQueryAlert(ctx, cbq, "🙅‍♂️ Я не могу выдать тебе файл, так как больше не являюсь админом канала на который требовалась подписка, свяжись с владельцем файла и передавай от меня привет!")
+		return bot.answerCallbackQueryAlert(ctx, cbq, texts.CantCheckMembership)
 	case errors.Is(err, core.ErrFileNotFound):
-		//nolint:stylecheck
-		return bot.answerCallbackQueryAlert(ctx, cbq, "🙅‍♂️ Файл был удален владельцем")
+		return bot.answerCallbackQueryAlert(ctx, cbq, texts.FileDeletedByOwner)
+	case errors.Is(err, service.ErrFilePasswordRequired):
+		return bot.answerCallbackQueryAlert(ctx, cbq, texts.FilePasswordExpired)
 	case err != nil:
 		return errors.Wrap(err, "check file restrictions chat")
 	}
 
 	if !status.Ok {
-		return bot.answerCallbackQueryAlert(ctx, cbq, "Я не наблюдаю тебя в подписчиках, подпишись чтобы получить доступ к файлу")
-	}
+		go func() {
+			_ = bot.send(ctx, bot.renderSubRequestEdit(texts, cbq.Message, status.Sub))
+		}()
 
-	go func() {
-		_ = bot.deleteMessage(ctx, cbq.Message)
-		_ = bot.answerCallbackQuery(ctx, cbq, "🔓 Доступ к файлу получен")
-	}()
+		return bot.answerCallbackQueryAlert(ctx, cbq, texts.FileNotSubscribed)
+	}
 
 	result, err := bot.fileSrv.RegisterDownload(ctx, user, status.File)
-	if err != nil {
+	if text, ok := getFileRestrictionsErrorText(texts, err); ok {
+		return bot.answerCallbackQueryAlert(ctx, cbq, text)
+	} else if err != nil {
 		return errors.Wrap(err, "register file download")
 	}
 
-	return bot.send(ctx, bot.renderNotOwnedFile(cbq.Message, result.File))
+	go func() {
+		_ = bot.deleteMessage(ctx, cbq.Message)
+		_ = bot.answerCallbackQuery(ctx, cbq, texts.FileAccessGranted)
+	}()
+
+	return bot.send(ctx, bot.renderNotOwnedFile(texts, cbq.Message, result.File))
 }
 
 func (bot *Bot) onPublicFileHelp(ctx context.Context, cbq *tgbotapi.CallbackQuery) error {
-	answer := tgbotapi.NewMessage(cbq.Message.Chat.ID, bot.getTextStart())
+	answer := tgbotapi.NewMessage(cbq.Message.Chat.ID, bot.getTextStart(getTextsCtx(ctx)))
 	answer.ParseMode = mdv2
 	return bot.send(ctx, answer)
 }
+
+func (bot *Bot) onFileRestrictionsChatPolicyCBQ(
+	ctx context.Context,
+	cbq *tgbotapi.CallbackQuery,
+	fileID core.FileID,
+	policy core.ChatPolicy,
+) error {
+	user := getUserCtx(ctx)
+
+	file, err := bot.fileSrv.SetChatPolicy(ctx, user, fileID, policy)
+	if err != nil {
+		return errors.Wrap(err, "service set chat policy")
+	}
+
+	chats, err := bot.chatSrv.GetChats(ctx, user)
+	if err != nil {
+		return errors.Wrap(err, "service query chats")
+	}
+
+	texts := getTextsCtx(ctx)
+
+	go func() {
+		_ = bot.answerCallbackQuery(ctx, cbq, getChatPolicyTitle(texts, policy))
+	}()
+
+	return bot.send(ctx, tgbotapi.NewEditMessageReplyMarkup(
+		cbq.Message.Chat.ID,
+		cbq.Message.MessageID,
+		*bot.newFileRestrictionsReplyMarkup(texts, file, chats),
+	))
+}
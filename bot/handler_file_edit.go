@@ -0,0 +1,299 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bots-house/share-file-bot/bot/i18n"
+	"github.com/bots-house/share-file-bot/bot/state"
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/service"
+	tgbotapi "github.com/bots-house/telegram-bot-api"
+	"github.com/friendsofgo/errors"
+)
+
+const (
+	callbackFileEdit             = "file:%d:edit"
+	callbackFileEditCaption      = "file:%d:edit:caption"
+	callbackFileEditCaptionClear = "file:%d:edit:caption:clear"
+	callbackFileEditName         = "file:%d:edit:name"
+	callbackFileEditPost         = "file:%d:edit:post"
+	callbackFileEditPostClear    = "file:%d:edit:post:clear"
+)
+
+func (bot *Bot) renderFileEditReplyMarkup(texts *i18n.Texts, file *service.OwnedFile) tgbotapi.InlineKeyboardMarkup {
+	first := tgbotapi.NewInlineKeyboardRow()
+
+	// caption of sticker, contact, etc. is not shown to users
+	if file.Kind.HasCaption() {
+		first = append(first, tgbotapi.NewInlineKeyboardButtonData(
+			texts.FileEditButtonCaption,
+			fmt.Sprintf(callbackFileEditCaption, file.ID),
+		))
+	}
+
+	first = append(first, tgbotapi.NewInlineKeyboardButtonData(
+		texts.FileEditButtonName,
+		fmt.Sprintf(callbackFileEditName, file.ID),
+	))
+
+	rows := [][]tgbotapi.InlineKeyboardButton{
+		first,
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(
+				texts.FileEditButtonPost,
+				fmt.Sprintf(callbackFileEditPost, file.ID),
+			),
+		),
+	}
+
+	drop := []tgbotapi.InlineKeyboardButton{}
+
+	if file.Caption.String != "" {
+		drop = append(drop, tgbotapi.NewInlineKeyboardButtonData(
+			texts.FileEditButtonCaptionDrop,
+			fmt.Sprintf(callbackFileEditCaptionClear, file.ID),
+		))
+	}
+
+	if file.HasLinkedPostURI() {
+		drop = append(drop, tgbotapi.NewInlineKeyboardButtonData(
+			texts.FileEditButtonPostDrop,
+			fmt.Sprintf(callbackFileEditPostClear, file.ID),
+		))
+	}
+
+	if len(drop) > 0 {
+		rows = append(rows, drop)
+	}
+
+	rows = append(rows, tgbotapi.NewInlineKeyboardRow(
+		tgbotapi.NewInlineKeyboardButtonData(
+			texts.CommonBack,
+			fmt.Sprintf(callbackFileRefresh, file.ID),
+		),
+	))
+
+	return tgbotapi.NewInlineKeyboardMarkup(rows...)
+}
+
+func (bot *Bot) newFileEditEdit(
+	texts *i18n.Texts,
+	cbq *tgbotapi.CallbackQuery,
+	file *service.OwnedFile,
+) tgbotapi.Chattable {
+	return newEditFileCard(
+		cbq.Message,
+		texts.FileEdit,
+		mdv2,
+		bot.renderFileEditReplyMarkup(texts, file),
+	)
+}
+
+func (bot *Bot) onFileEditCBQ(ctx context.Context, cbq *tgbotapi.CallbackQuery, id core.FileID) error {
+	file, err := bot.getFileForOwner(ctx, cbq, int(id))
+	if errors.Is(err, core.ErrFileNotFound) {
+		return nil
+	} else if err != nil {
+		return errors.Wrap(err, "get file for owner")
+	}
+
+	go func() {
+		_ = bot.answerCallbackQuery(ctx, cbq, "")
+	}()
+
+	return bot.send(ctx, bot.newFileEditEdit(getTextsCtx(ctx), cbq, file))
+}
+
+// onFileEditInputCBQ asks user to send new value of file field.
+func (bot *Bot) onFileEditInputCBQ(
+	ctx context.Context,
+	cbq *tgbotapi.CallbackQuery,
+	id core.FileID,
+	st state.State,
+	prompt string,
+) error {
+	if _, err := bot.getFileForOwner(ctx, cbq, int(id)); errors.Is(err, core.ErrFileNotFound) {
+		return nil
+	} else if err != nil {
+		return errors.Wrap(err, "get file for owner")
+	}
+
+	texts := getTextsCtx(ctx)
+
+	out := tgbotapi.NewMessage(cbq.Message.Chat.ID, prompt)
+	out.DisableWebPagePreview = true
+	out.ReplyMarkup = tgbotapi.NewInlineKeyboardMarkup(
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(
+				texts.CommonCancel,
+				callbackCancel,
+			),
+		),
+	)
+
+	sent, err := bot.client.Send(out)
+	if err != nil {
+		return errors.Wrap(err, "send prompt")
+	}
+
+	if err := bot.startConversation(ctx, st, &state.Data{
+		FileID:    id,
+		MessageID: sent.MessageID,
+	}); err != nil {
+		return errors.Wrap(err, "start conversation")
+	}
+
+	return bot.answerCallbackQuery(ctx, cbq, "")
+}
+
+func (bot *Bot) onFileEditCaptionCBQ(ctx context.Context, cbq *tgbotapi.CallbackQuery, id core.FileID) error {
+	return bot.onFileEditInputCBQ(ctx, cbq, id, state.FileEditCaption, getTextsCtx(ctx).FileEditCaptionPrompt)
+}
+
+func (bot *Bot) onFileEditNameCBQ(ctx context.Context, cbq *tgbotapi.CallbackQuery, id core.FileID) error {
+	return bot.onFileEditInputCBQ(ctx, cbq, id, state.FileEditName, getTextsCtx(ctx).FileEditNamePrompt)
+}
+
+func (bot *Bot) onFileEditPostCBQ(ctx context.Context, cbq *tgbotapi.CallbackQuery, id core.FileID) error {
+	return bot.onFileEditInputCBQ(ctx, cbq, id, state.FileEditPost, getTextsCtx(ctx).FileEditPostPrompt)
+}
+
+func (bot *Bot) onFileEditCaptionClearCBQ(ctx context.Context, cbq *tgbotapi.CallbackQuery, id core.FileID) error {
+	user := getUserCtx(ctx)
+	texts := getTextsCtx(ctx)
+
+	file, err := bot.fileSrv.SetCaption(ctx, user, id, "", nil)
+	if errors.Is(err, core.ErrFileNotFound) {
+		return bot.answerCallbackQueryAlert(ctx, cbq, texts.FileDeletedBefore)
+	} else if err != nil {
+		return errors.Wrap(err, "clear caption")
+	}
+
+	go func() {
+		_ = bot.answerCallbackQuery(ctx, cbq, texts.FileEditCaptionDropped)
+	}()
+
+	return bot.send(ctx, bot.newFileEditEdit(texts, cbq, file))
+}
+
+func (bot *Bot) onFileEditPostClearCBQ(ctx context.Context, cbq *tgbotapi.CallbackQuery, id core.FileID) error {
+	user := getUserCtx(ctx)
+	texts := getTextsCtx(ctx)
+
+	file, err := bot.fileSrv.SetLinkedPost(ctx, user, id, nil)
+	if errors.Is(err, core.ErrFileNotFound) {
+		return bot.answerCallbackQueryAlert(ctx, cbq, texts.FileDeletedBefore)
+	} else if err != nil {
+		return errors.Wrap(err, "clear linked post")
+	}
+
+	go func() {
+		_ = bot.answerCallbackQuery(ctx, cbq, texts.FileEditPostDropped)
+	}()
+
+	return bot.send(ctx, bot.newFileEditEdit(texts, cbq, file))
+}
+
+// onFileEdited ends conversation and sends updated file.
+func (bot *Bot) onFileEdited(ctx context.Context, msg *tgbotapi.Message, file *service.OwnedFile) error {
+	if _, err := bot.endConversation(ctx, true); err != nil {
+		return errors.Wrap(err, "end conversation")
+	}
+
+	return bot.sendOwnedFile(ctx, msg, file)
+}
+
+// onFileEditDeleted ends conversation when file was deleted before it is edited.
+func (bot *Bot) onFileEditDeleted(ctx context.Context) error {
+	user := getUserCtx(ctx)
+
+	if _, err := bot.endConversation(ctx, true); err != nil {
+		return errors.Wrap(err, "end conversation")
+	}
+
+	return bot.sendText(ctx, user.ID, getTextsCtx(ctx).FileDeletedBefore)
+}
+
+func (bot *Bot) onFileEditCaptionState(ctx context.Context, msg *tgbotapi.Message) error {
+	user := getUserCtx(ctx)
+	texts := getTextsCtx(ctx)
+
+	if msg.Text == "" {
+		return bot.sendText(ctx, user.ID, texts.FileEditOnlyText)
+	}
+
+	file, err := bot.fileSrv.SetCaption(ctx, user, getStateDataCtx(ctx).FileID, msg.Text, getTextEntities(msg.Entities))
+	switch {
+	case errors.Is(err, service.ErrFileCaptionTooLong):
+		return bot.sendText(ctx, user.ID, fmt.Sprintf(texts.FileEditCaptionTooLong, service.FileCaptionMaxLength))
+	case errors.Is(err, core.ErrFileNotFound):
+		return bot.onFileEditDeleted(ctx)
+	case err != nil:
+		return errors.Wrap(err, "set caption")
+	}
+
+	return bot.onFileEdited(ctx, msg, file)
+}
+
+func (bot *Bot) onFileEditNameState(ctx context.Context, msg *tgbotapi.Message) error {
+	user := getUserCtx(ctx)
+	texts := getTextsCtx(ctx)
+
+	if msg.Text == "" {
+		return bot.sendText(ctx, user.ID, texts.FileEditOnlyText)
+	}
+
+	file, err := bot.fileSrv.RenameFile(ctx, user, getStateDataCtx(ctx).FileID, msg.Text)
+	switch {
+	case errors.Is(err, service.ErrFileNameInvalid):
+		return bot.sendText(ctx, user.ID, fmt.Sprintf(texts.FileEditNameInvalid, service.FileNameMaxLength))
+	case errors.Is(err, core.ErrFileNotFound):
+		return bot.onFileEditDeleted(ctx)
+	case err != nil:
+		return errors.Wrap(err, "rename file")
+	}
+
+	return bot.onFileEdited(ctx, msg, file)
+}
+
+// getForwardedPostInfo returns info about channel post forwarded by user, if any.
+func getForwardedPostInfo(msg *tgbotapi.Message) *service.ChannelPostInfo {
+	if msg.ForwardFromChat == nil || !msg.ForwardFromChat.IsChannel() || msg.ForwardFromMessageID == 0 {
+		return nil
+	}
+
+	return &service.ChannelPostInfo{
+		ChatID:       msg.ForwardFromChat.ID,
+		ChatUsername: msg.ForwardFromChat.UserName,
+		PostID:       msg.ForwardFromMessageID,
+	}
+}
+
+func (bot *Bot) onFileEditPostState(ctx context.Context, msg *tgbotapi.Message) error {
+	user := getUserCtx(ctx)
+	texts := getTextsCtx(ctx)
+
+	post := getForwardedPostInfo(msg)
+
+	if post == nil {
+		var err error
+
+		post, err = service.ParsePostLink(msg.Text)
+		if errors.Is(err, service.ErrInvalidPostLink) {
+			return bot.sendText(ctx, user.ID, texts.FileEditPostInvalid)
+		} else if err != nil {
+			return errors.Wrap(err, "parse post link")
+		}
+	}
+
+	file, err := bot.fileSrv.SetLinkedPost(ctx, user, getStateDataCtx(ctx).FileID, post)
+	switch {
+	case errors.Is(err, core.ErrFileNotFound):
+		return bot.onFileEditDeleted(ctx)
+	case err != nil:
+		return errors.Wrap(err, "set linked post")
+	}
+
+	return bot.onFileEdited(ctx, msg, file)
+}
@@ -0,0 +1,316 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bots-house/share-file-bot/bot/i18n"
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/pkg/tg"
+	"github.com/bots-house/share-file-bot/service"
+	tgbotapi "github.com/bots-house/telegram-bot-api"
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+)
+
+const (
+	callbackFileRestrictionsLimit    = "file:%d:restrictions:%s"
+	callbackFileRestrictionsLimitSet = "file:%d:restrictions:%s:%d"
+
+	fileLimitMaxDownloads        = "max-downloads"
+	fileLimitMaxDownloadsPerUser = "user-max-downloads"
+	fileLimitExpiresAt           = "expires-at"
+	fileLimitAvailableFrom       = "available-from"
+
+	timeFormat = "02.01.2006 15:04 UTC"
+)
+
+var (
+	// presets of downloads count
+	fileLimitCountPresets = []int{10, 50, 100, 500, 1000}
+
+	// presets of downloads count per user
+	fileLimitUserCountPresets = []int{1, 2, 3, 5, 10}
+
+	// presets of duration from now in hours
+	fileLimitDurationPresets = []int{1, 24, 24 * 3, 24 * 7, 24 * 30}
+)
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(timeFormat)
+}
+
+// getFileLimitDurationTitle returns title of duration preset in hours.
+func getFileLimitDurationTitle(texts *i18n.Texts, hours int) string {
+	if hours < 24 {
+		return texts.Plural(texts.LimitHours, hours)
+	}
+
+	return texts.Plural(texts.LimitDays, hours/24)
+}
+
+// getFileLimitTitle returns button title of limit with current value.
+func getFileLimitTitle(texts *i18n.Texts, restriction *core.DownloadRestrictions, limit string) string {
+	value := texts.LimitNone
+
+	switch limit {
+	case fileLimitMaxDownloads:
+		if restriction.HasMaxDownloads() {
+			value = fmt.Sprint(restriction.MaxDownloads)
+		}
+		return fmt.Sprintf(texts.LimitMaxDownloadsButton, value)
+	case fileLimitMaxDownloadsPerUser:
+		if restriction.HasMaxDownloadsPerUser() {
+			value = fmt.Sprint(restriction.MaxDownloadsPerUser)
+		}
+		return fmt.Sprintf(texts.LimitMaxDownloadsPerUserButton, value)
+	case fileLimitExpiresAt:
+		if restriction.ExpiresAt.Valid {
+			value = formatTime(restriction.ExpiresAt.Time)
+		}
+		return fmt.Sprintf(texts.LimitExpiresAtButton, value)
+	case fileLimitAvailableFrom:
+		if restriction.AvailableFrom.Valid {
+			value = formatTime(restriction.AvailableFrom.Time)
+		}
+		return fmt.Sprintf(texts.LimitAvailableFromButton, value)
+	default:
+		return limit
+	}
+}
+
+func (bot *Bot) renderFileLimitsRows(texts *i18n.Texts, file *core.File) [][]tgbotapi.InlineKeyboardButton {
+	limits := []string{
+		fileLimitMaxDownloads,
+		fileLimitMaxDownloadsPerUser,
+		fileLimitExpiresAt,
+		fileLimitAvailableFrom,
+	}
+
+	rows := make([][]tgbotapi.InlineKeyboardButton, len(limits))
+
+	for i, limit := range limits {
+		rows[i] = tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(
+				getFileLimitTitle(texts, &file.Restriction, limit),
+				fmt.Sprintf(callbackFileRestrictionsLimit, file.ID, limit),
+			),
+		)
+	}
+
+	return rows
+}
+
+// renderFileLimitsCaption returns rows of owned file caption with state of limits.
+func (bot *Bot) renderFileLimitsCaption(texts *i18n.Texts, file *service.OwnedFile) []string {
+	restriction := &file.Restriction
+
+	rows := []string{}
+
+	if restriction.HasMaxDownloads() {
+		left := restriction.MaxDownloads - file.Stats.Total
+		if left < 0 {
+			left = 0
+		}
+
+		rows = append(rows, fmt.Sprintf(texts.LimitsCaptionDownloadsLeft, left, restriction.MaxDownloads))
+	}
+
+	if restriction.HasMaxDownloadsPerUser() {
+		rows = append(rows, fmt.Sprintf(texts.LimitsCaptionPerUser, restriction.MaxDownloadsPerUser))
+	}
+
+	if restriction.AvailableFrom.Valid {
+		rows = append(rows, fmt.Sprintf(texts.LimitsCaptionAvailableFrom, formatTime(restriction.AvailableFrom.Time)))
+	}
+
+	if restriction.ExpiresAt.Valid {
+		if restriction.IsExpired(time.Now()) {
+			rows = append(rows, fmt.Sprintf(texts.LimitsCaptionExpired, formatTime(restriction.ExpiresAt.Time)))
+		} else {
+			rows = append(rows, fmt.Sprintf(texts.LimitsCaptionExpiresAt, formatTime(restriction.ExpiresAt.Time)))
+		}
+	}
+
+	if restriction.HasPassword() {
+		rows = append(rows, texts.LimitsCaptionPassword)
+	}
+
+	if len(rows) == 0 {
+		return rows
+	}
+
+	return append(append([]string{texts.LimitsCaption, ""}, rows...), "")
+}
+
+func (bot *Bot) newFileLimitReplyMarkup(texts *i18n.Texts, file *core.File, limit string) *tgbotapi.InlineKeyboardMarkup {
+	var (
+		presets []int
+		current int
+	)
+
+	isDuration := false
+
+	switch limit {
+	case fileLimitMaxDownloads:
+		presets, current = fileLimitCountPresets, file.Restriction.MaxDownloads
+	case fileLimitMaxDownloadsPerUser:
+		presets, current = fileLimitUserCountPresets, file.Restriction.MaxDownloadsPerUser
+	default:
+		presets, isDuration = fileLimitDurationPresets, true
+	}
+
+	keyboard := make([][]tgbotapi.InlineKeyboardButton, 0, len(presets)+2)
+
+	for _, preset := range presets {
+		title := fmt.Sprint(preset)
+		if isDuration {
+			title = getFileLimitDurationTitle(texts, preset)
+		}
+
+		keyboard = append(keyboard, tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(
+				addIsEnabledEmoji(current == preset, title),
+				fmt.Sprintf(callbackFileRestrictionsLimitSet, file.ID, limit, preset),
+			),
+		))
+	}
+
+	keyboard = append(keyboard,
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(
+				texts.LimitDisable,
+				fmt.Sprintf(callbackFileRestrictionsLimitSet, file.ID, limit, 0),
+			),
+		),
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(
+				texts.CommonBack,
+				fmt.Sprintf(callbackFileRestrictions, file.ID),
+			),
+		),
+	)
+
+	markup := tgbotapi.NewInlineKeyboardMarkup(keyboard...)
+
+	return &markup
+}
+
+func (bot *Bot) getFileLimitText(texts *i18n.Texts, file *core.File, limit string) string {
+	var text string
+
+	switch limit {
+	case fileLimitMaxDownloads:
+		text = texts.LimitMaxDownloads
+	case fileLimitMaxDownloadsPerUser:
+		text = texts.LimitMaxDownloadsPerUser
+	case fileLimitExpiresAt:
+		text = texts.LimitExpiresAt
+	case fileLimitAvailableFrom:
+		text = texts.LimitAvailableFrom
+	}
+
+	return text + "\n" + tg.EscapeMD(getFileLimitTitle(texts, &file.Restriction, limit))
+}
+
+func (bot *Bot) onFileRestrictionsLimitCBQ(
+	ctx context.Context,
+	cbq *tgbotapi.CallbackQuery,
+	fileID int,
+	limit string,
+) error {
+	file, err := bot.getFileForOwner(ctx, cbq, fileID)
+	if errors.Is(err, core.ErrFileNotFound) {
+		return nil
+	} else if err != nil {
+		return errors.Wrap(err, "get file for owner")
+	}
+
+	go func() {
+		_ = bot.answerCallbackQuery(ctx, cbq, "")
+	}()
+
+	texts := getTextsCtx(ctx)
+
+	return bot.send(ctx, newEditFileCard(
+		cbq.Message,
+		bot.getFileLimitText(texts, file.File, limit),
+		mdv2,
+		*bot.newFileLimitReplyMarkup(texts, file.File, limit),
+	))
+}
+
+func (bot *Bot) onFileRestrictionsLimitSetCBQ(
+	ctx context.Context,
+	cbq *tgbotapi.CallbackQuery,
+	fileID core.FileID,
+	limit string,
+	value int,
+) error {
+	user := getUserCtx(ctx)
+
+	var (
+		file *core.File
+		err  error
+	)
+
+	// duration presets are counted from now
+	at := null.NewTime(time.Now().Add(time.Duration(value)*time.Hour), value != 0)
+
+	switch limit {
+	case fileLimitMaxDownloads:
+		file, err = bot.fileSrv.SetDownloadsLimit(ctx, user, fileID, value)
+	case fileLimitMaxDownloadsPerUser:
+		file, err = bot.fileSrv.SetUserDownloadsLimit(ctx, user, fileID, value)
+	case fileLimitExpiresAt:
+		file, err = bot.fileSrv.SetExpiresAt(ctx, user, fileID, at)
+	case fileLimitAvailableFrom:
+		file, err = bot.fileSrv.SetAvailableFrom(ctx, user, fileID, at)
+	default:
+		return errors.Errorf("unknown limit '%s'", limit)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "service set limit")
+	}
+
+	texts := getTextsCtx(ctx)
+
+	go func() {
+		if value == 0 {
+			_ = bot.answerCallbackQuery(ctx, cbq, texts.LimitDisabled)
+		} else {
+			_ = bot.answerCallbackQuery(ctx, cbq, texts.RestrictionSet)
+		}
+	}()
+
+	chats, err := bot.chatSrv.GetChats(ctx, user)
+	if err != nil {
+		return errors.Wrap(err, "service get chats")
+	}
+
+	return bot.send(ctx, bot.newFileRestrictionsEdit(texts, cbq, file, chats))
+}
+
+// getFileRestrictionsErrorText returns text for user, if file is not available by restrictions or moderation.
+func getFileRestrictionsErrorText(texts *i18n.Texts, err error) (string, bool) {
+	var notAvailableYet *service.FileNotAvailableYetError
+
+	switch {
+	case errors.As(err, &notAvailableYet):
+		return fmt.Sprintf(texts.FileNotAvailableYet, formatTime(notAvailableYet.From)), true
+	case errors.Is(err, service.ErrFileExpired):
+		return texts.FileExpired, true
+	case errors.Is(err, service.ErrFileDownloadsLimitReached):
+		return texts.FileLimitReached, true
+	case errors.Is(err, service.ErrFileUserDownloadsLimitReached):
+		return texts.FileUserLimitReached, true
+	case errors.Is(err, service.ErrFileViolatesCopyright):
+		return texts.FileViolatesCopyright, true
+	case errors.Is(err, service.ErrFileBlocked), errors.Is(err, service.ErrOwnerIsBanned):
+		return texts.BlockedByAdmin, true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,159 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bots-house/share-file-bot/bot/i18n"
+	"github.com/bots-house/share-file-bot/bot/state"
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/service"
+	tgbotapi "github.com/bots-house/telegram-bot-api"
+	"github.com/friendsofgo/errors"
+)
+
+const (
+	callbackFileLink       = "file:%d:link"
+	callbackFileLinkRegen  = "file:%d:link:regen:%d"
+	callbackFileLinkCustom = "file:%d:link:custom"
+
+	// grace period of previous link, when custom link is set
+	fileLinkCustomGraceDays = 7
+)
+
+// presets of grace period of previous link in hours
+var fileLinkGracePresets = []int{0, 1, 24, 24 * 7}
+
+func (bot *Bot) renderFileLinkReplyMarkup(texts *i18n.Texts, file *service.OwnedFile) tgbotapi.InlineKeyboardMarkup {
+	rows := [][]tgbotapi.InlineKeyboardButton{}
+
+	for _, hours := range fileLinkGracePresets {
+		title := texts.FileLinkGraceNow
+		if hours > 0 {
+			title = fmt.Sprintf(texts.FileLinkGraceButton, getFileLimitDurationTitle(texts, hours))
+		}
+
+		rows = append(rows, tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(
+				title,
+				fmt.Sprintf(callbackFileLinkRegen, file.ID, hours),
+			),
+		))
+	}
+
+	rows = append(rows, tgbotapi.NewInlineKeyboardRow(
+		tgbotapi.NewInlineKeyboardButtonData(
+			texts.FileLinkCustomButton,
+			fmt.Sprintf(callbackFileLinkCustom, file.ID),
+		),
+	))
+
+	rows = append(rows, tgbotapi.NewInlineKeyboardRow(
+		tgbotapi.NewInlineKeyboardButtonData(
+			texts.CommonBack,
+			fmt.Sprintf(callbackFileRefresh, file.ID),
+		),
+	))
+
+	return tgbotapi.NewInlineKeyboardMarkup(rows...)
+}
+
+func (bot *Bot) onFileLinkCBQ(ctx context.Context, cbq *tgbotapi.CallbackQuery, id core.FileID) error {
+	file, err := bot.getFileForOwner(ctx, cbq, int(id))
+	if errors.Is(err, core.ErrFileNotFound) {
+		return nil
+	} else if err != nil {
+		return errors.Wrap(err, "get file for owner")
+	}
+
+	go func() {
+		_ = bot.answerCallbackQuery(ctx, cbq, "")
+	}()
+
+	texts := getTextsCtx(ctx)
+
+	return bot.send(ctx, newEditFileCard(
+		cbq.Message,
+		texts.FileLink,
+		mdv2,
+		bot.renderFileLinkReplyMarkup(texts, file),
+	))
+}
+
+func (bot *Bot) onFileLinkRegenCBQ(ctx context.Context, cbq *tgbotapi.CallbackQuery, id core.FileID, hours int) error {
+	user := getUserCtx(ctx)
+	texts := getTextsCtx(ctx)
+
+	result, err := bot.fileSrv.RegenerateLink(ctx, user, id, time.Duration(hours)*time.Hour)
+	switch {
+	case errors.Is(err, core.ErrFileNotFound):
+		return bot.answerCallbackQueryAlert(ctx, cbq, texts.FileDeletedBefore)
+	case errors.Is(err, service.ErrFileLinkGraceInvalid):
+		return bot.answerCallbackQuery(ctx, cbq, "bad body, what you do?")
+	case err != nil:
+		return errors.Wrap(err, "regenerate link")
+	}
+
+	answer := texts.FileLinkRegenerated
+	if hours > 0 {
+		answer = fmt.Sprintf(texts.FileLinkRegeneratedGrace, formatTime(result.Revoked.ActiveUntil))
+	}
+
+	go func() {
+		_ = bot.answerCallbackQueryAlert(ctx, cbq, answer)
+	}()
+
+	file := result.File
+
+	return bot.send(ctx, newEditFileCard(
+		cbq.Message,
+		bot.renderOwnedFileCaption(texts, file),
+		mdv2,
+		bot.renderOwnedFileReplyMarkup(texts, file),
+	))
+}
+
+func (bot *Bot) onFileLinkCustomCBQ(ctx context.Context, cbq *tgbotapi.CallbackQuery, id core.FileID) error {
+	texts := getTextsCtx(ctx)
+
+	prompt := fmt.Sprintf(texts.FileLinkCustomPrompt,
+		service.FilePublicIDMinLength,
+		service.FilePublicIDMaxLength,
+		texts.Plural(texts.LimitDays, fileLinkCustomGraceDays),
+	)
+
+	return bot.onFileEditInputCBQ(ctx, cbq, id, state.FileLinkCustom, prompt)
+}
+
+func (bot *Bot) onFileLinkCustomState(ctx context.Context, msg *tgbotapi.Message) error {
+	user := getUserCtx(ctx)
+	texts := getTextsCtx(ctx)
+
+	if msg.Text == "" {
+		return bot.sendText(ctx, user.ID, texts.FileEditOnlyText)
+	}
+
+	grace := fileLinkCustomGraceDays * 24 * time.Hour
+
+	result, err := bot.fileSrv.SetPublicID(ctx, user, getStateDataCtx(ctx).FileID, msg.Text, grace)
+	switch {
+	case errors.Is(err, service.ErrFilePublicIDInvalid):
+		return bot.sendText(ctx, user.ID, fmt.Sprintf(texts.FileLinkCustomInvalid,
+			service.FilePublicIDMinLength,
+			service.FilePublicIDMaxLength,
+		))
+	case errors.Is(err, service.ErrFilePublicIDTaken):
+		return bot.sendText(ctx, user.ID, texts.FileLinkCustomTaken)
+	case errors.Is(err, service.ErrFilePublicIDReserved):
+		return bot.sendText(ctx, user.ID, texts.FileLinkCustomReserved)
+	case errors.Is(err, service.ErrNothingChanged):
+		return bot.sendText(ctx, user.ID, texts.FileLinkCustomSame)
+	case errors.Is(err, core.ErrFileNotFound):
+		return bot.onFileEditDeleted(ctx)
+	case err != nil:
+		return errors.Wrap(err, "set public id")
+	}
+
+	return bot.onFileEdited(ctx, msg, result.File)
+}
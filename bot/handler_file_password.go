@@ -0,0 +1,254 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bots-house/share-file-bot/bot/i18n"
+	"github.com/bots-house/share-file-bot/bot/state"
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/service"
+	tgbotapi "github.com/bots-house/telegram-bot-api"
+	"github.com/friendsofgo/errors"
+)
+
+const (
+	callbackFilePasswordCancel       = "file:password:cancel"
+	callbackFileRestrictionsPassword = "file:%d:restrictions:password"
+	callbackFilePasswordSet          = "file:%d:restrictions:password:set"
+	callbackFilePasswordDisable      = "file:%d:restrictions:password:disable"
+)
+
+func (bot *Bot) newFilePasswordCancelReplyMarkup(texts *i18n.Texts) tgbotapi.InlineKeyboardMarkup {
+	return tgbotapi.NewInlineKeyboardMarkup(
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(
+				texts.CommonCancel,
+				callbackFilePasswordCancel,
+			),
+		),
+	)
+}
+
+func (bot *Bot) renderFilePasswordRow(texts *i18n.Texts, file *core.File) []tgbotapi.InlineKeyboardButton {
+	value := texts.LimitNone
+	if file.Restriction.HasPassword() {
+		value = texts.PasswordValueSet
+	}
+
+	return tgbotapi.NewInlineKeyboardRow(
+		tgbotapi.NewInlineKeyboardButtonData(
+			fmt.Sprintf(texts.PasswordButton, value),
+			fmt.Sprintf(callbackFileRestrictionsPassword, file.ID),
+		),
+	)
+}
+
+func (bot *Bot) onFilePasswordRequest(ctx context.Context, msg *tgbotapi.Message) error {
+	user := getUserCtx(ctx)
+
+	if err := bot.state.Set(ctx, user.ID, state.FilePasswordEnter); err != nil {
+		return errors.Wrap(err, "update state")
+	}
+
+	texts := getTextsCtx(ctx)
+
+	answer := bot.newAnswerMsg(msg, texts.PasswordRequest)
+	answer.ReplyMarkup = bot.newFilePasswordCancelReplyMarkup(texts)
+
+	return bot.send(ctx, answer)
+}
+
+func (bot *Bot) onFilePasswordCancelCBQ(ctx context.Context, cbq *tgbotapi.CallbackQuery) error {
+	user := getUserCtx(ctx)
+
+	if err := bot.fileSrv.CancelFilePassword(ctx, user); err != nil {
+		return errors.Wrap(err, "cancel file password")
+	}
+
+	if err := bot.state.Set(ctx, user.ID, state.Empty); err != nil {
+		return errors.Wrap(err, "update state")
+	}
+
+	go func() {
+		_ = bot.deleteMessage(ctx, cbq.Message)
+	}()
+
+	return bot.answerCallbackQuery(ctx, cbq, getTextsCtx(ctx).PasswordCanceled)
+}
+
+func (bot *Bot) onFilePasswordEnterState(ctx context.Context, msg *tgbotapi.Message) error {
+	user := getUserCtx(ctx)
+	texts := getTextsCtx(ctx)
+
+	if msg.Text == "" {
+		return bot.sendText(ctx, user.ID, texts.PasswordOnlyText)
+	}
+
+	// delete password for avoid leak in history
+	go func() {
+		_ = bot.deleteMessage(ctx, msg)
+	}()
+
+	result, err := bot.fileSrv.CheckFilePassword(ctx, user, msg.Text)
+
+	if text, ok := getFileRestrictionsErrorText(texts, err); ok {
+		_ = bot.state.Set(ctx, user.ID, state.Empty)
+		return bot.sendText(ctx, user.ID, text)
+	}
+
+	var invalidErr *service.FilePasswordInvalidError
+
+	switch {
+	case errors.As(err, &invalidErr):
+		return bot.sendText(ctx, user.ID, fmt.Sprintf(texts.PasswordInvalid, invalidErr.AttemptsLeft))
+	case errors.Is(err, service.ErrFilePasswordAttemptsExceeded):
+		if err := bot.state.Set(ctx, user.ID, state.Empty); err != nil {
+			return errors.Wrap(err, "update state")
+		}
+		return bot.sendText(ctx, user.ID, texts.PasswordExceeded)
+	case errors.Is(err, service.ErrFilePasswordNotAwaited), errors.Is(err, core.ErrFileNotFound):
+		if err := bot.state.Set(ctx, user.ID, state.Empty); err != nil {
+			return errors.Wrap(err, "update state")
+		}
+		return bot.sendText(ctx, user.ID, texts.PasswordNotAwait)
+	case errors.Is(err, service.ErrCantCheckMembership):
+		return bot.sendText(ctx, user.ID, texts.CantCheckMembership)
+	case err != nil:
+		return errors.Wrap(err, "check file password")
+	}
+
+	if err := bot.state.Set(ctx, user.ID, state.Empty); err != nil {
+		return errors.Wrap(err, "update state")
+	}
+
+	return bot.sendDownloadResult(ctx, msg, result)
+}
+
+func (bot *Bot) onFileRestrictionsPasswordCBQ(ctx context.Context, cbq *tgbotapi.CallbackQuery, id int) error {
+	file, err := bot.getFileForOwner(ctx, cbq, id)
+	if errors.Is(err, core.ErrFileNotFound) {
+		return nil
+	} else if err != nil {
+		return errors.Wrap(err, "get file for owner")
+	}
+
+	go func() {
+		_ = bot.answerCallbackQuery(ctx, cbq, "")
+	}()
+
+	texts := getTextsCtx(ctx)
+
+	setTitle := texts.PasswordButtonSet
+	rows := [][]tgbotapi.InlineKeyboardButton{}
+
+	if file.Restriction.HasPassword() {
+		setTitle = texts.PasswordButtonChange
+	}
+
+	rows = append(rows, tgbotapi.NewInlineKeyboardRow(
+		tgbotapi.NewInlineKeyboardButtonData(
+			setTitle,
+			fmt.Sprintf(callbackFilePasswordSet, file.ID),
+		),
+	))
+
+	if file.Restriction.HasPassword() {
+		rows = append(rows, tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(
+				texts.PasswordButtonDisable,
+				fmt.Sprintf(callbackFilePasswordDisable, file.ID),
+			),
+		))
+	}
+
+	rows = append(rows, tgbotapi.NewInlineKeyboardRow(
+		tgbotapi.NewInlineKeyboardButtonData(
+			texts.CommonBack,
+			fmt.Sprintf(callbackFileRestrictions, file.ID),
+		),
+	))
+
+	markup := tgbotapi.NewInlineKeyboardMarkup(rows...)
+
+	return bot.send(ctx, newEditFileCard(cbq.Message, texts.PasswordRestrictions, mdv2, markup))
+}
+
+func (bot *Bot) onFilePasswordSetCBQ(ctx context.Context, cbq *tgbotapi.CallbackQuery, id core.FileID) error {
+	user := getUserCtx(ctx)
+
+	if _, err := bot.fileSrv.StartSetPassword(ctx, user, id); err != nil {
+		return errors.Wrap(err, "start set password")
+	}
+
+	if err := bot.state.Set(ctx, user.ID, state.FilePasswordSet); err != nil {
+		return errors.Wrap(err, "update state")
+	}
+
+	go func() {
+		_ = bot.answerCallbackQuery(ctx, cbq, "")
+	}()
+
+	texts := getTextsCtx(ctx)
+
+	out := tgbotapi.NewMessage(cbq.Message.Chat.ID, texts.PasswordSet)
+	out.ParseMode = mdv2
+	out.ReplyMarkup = bot.newFilePasswordCancelReplyMarkup(texts)
+
+	return bot.send(ctx, out)
+}
+
+func (bot *Bot) onFilePasswordSetState(ctx context.Context, msg *tgbotapi.Message) error {
+	user := getUserCtx(ctx)
+	texts := getTextsCtx(ctx)
+
+	if msg.Text == "" {
+		return bot.sendText(ctx, user.ID, texts.PasswordOnlyText)
+	}
+
+	// delete password for avoid leak in history
+	go func() {
+		_ = bot.deleteMessage(ctx, msg)
+	}()
+
+	_, err := bot.fileSrv.SetPassword(ctx, user, msg.Text)
+	switch {
+	case errors.Is(err, service.ErrFilePasswordInvalidLength):
+		return bot.sendText(ctx, user.ID, fmt.Sprintf(texts.PasswordBadLength, service.FilePasswordMaxLength))
+	case errors.Is(err, service.ErrFilePasswordNotAwaited), errors.Is(err, core.ErrFileNotFound):
+		if err := bot.state.Set(ctx, user.ID, state.Empty); err != nil {
+			return errors.Wrap(err, "update state")
+		}
+		return bot.sendText(ctx, user.ID, texts.PasswordFileNotFound)
+	case err != nil:
+		return errors.Wrap(err, "set password")
+	}
+
+	if err := bot.state.Set(ctx, user.ID, state.Empty); err != nil {
+		return errors.Wrap(err, "update state")
+	}
+
+	return bot.sendText(ctx, user.ID, texts.PasswordSetDone)
+}
+
+func (bot *Bot) onFilePasswordDisableCBQ(ctx context.Context, cbq *tgbotapi.CallbackQuery, id core.FileID) error {
+	user := getUserCtx(ctx)
+
+	file, err := bot.fileSrv.DisablePassword(ctx, user, id)
+	if err != nil {
+		return errors.Wrap(err, "disable password")
+	}
+
+	texts := getTextsCtx(ctx)
+
+	go func() {
+		_ = bot.answerCallbackQuery(ctx, cbq, texts.PasswordDisabled)
+	}()
+
+	chats, err := bot.chatSrv.GetChats(ctx, user)
+	if err != nil {
+		return errors.Wrap(err, "service get chats")
+	}
+
+	return bot.send(ctx, bot.newFileRestrictionsEdit(texts, cbq, file, chats))
+}
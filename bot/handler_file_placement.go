@@ -0,0 +1,300 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bots-house/share-file-bot/bot/i18n"
+	"github.com/bots-house/share-file-bot/bot/state"
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/pkg/tg"
+	"github.com/bots-house/share-file-bot/service"
+	tgbotapi "github.com/bots-house/telegram-bot-api"
+	"github.com/friendsofgo/errors"
+)
+
+const (
+	callbackFilePlacements       = "file:%d:placements"
+	callbackFilePlacementsAdd    = "file:%d:placements:add"
+	callbackFilePlacement        = "file:%d:placement:%d"
+	callbackFilePlacementDelete  = "file:%d:placement:%d:delete"
+	callbackFilePlacementsCancel = "file:placements:cancel"
+)
+
+// getFilePlacementDeepLink returns link to file attributed to placement.
+func (bot *Bot) getFilePlacementDeepLink(file *core.File, placement *core.Placement) string {
+	return fmt.Sprintf("https://%s/%s?start=%s",
+		tgDomain,
+		bot.client.Self.UserName,
+		service.DeepLinkPayload(file, placement),
+	)
+}
+
+func renderPlacementStats(texts *i18n.Texts, item *service.PlacementStats) string {
+	name := texts.PlacementsDirectName
+	if item.Placement != nil {
+		name = item.Name
+	}
+
+	return fmt.Sprintf(texts.PlacementsItem, tg.EscapeMD(name), item.Total, item.Unique)
+}
+
+func (bot *Bot) renderFilePlacementsCaption(texts *i18n.Texts, placements *service.FilePlacements) string {
+	rows := []string{
+		texts.PlacementsTitle,
+		"",
+		renderPlacementStats(texts, placements.Direct),
+	}
+
+	if len(placements.Items) == 0 {
+		rows = append(rows, "", texts.PlacementsEmpty)
+	}
+
+	for _, item := range placements.Items {
+		rows = append(rows, renderPlacementStats(texts, item))
+	}
+
+	return strings.Join(rows, "\n")
+}
+
+func (bot *Bot) renderFilePlacementsReplyMarkup(texts *i18n.Texts, placements *service.FilePlacements) tgbotapi.InlineKeyboardMarkup {
+	file := placements.File
+	rows := make([][]tgbotapi.InlineKeyboardButton, 0, len(placements.Items)+2)
+
+	for _, item := range placements.Items {
+		rows = append(rows, tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(
+				item.Name,
+				fmt.Sprintf(callbackFilePlacement, file.ID, item.ID),
+			),
+		))
+	}
+
+	if len(placements.Items) < service.FilePlacementsLimit {
+		rows = append(rows, tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(
+				texts.PlacementsAddButton,
+				fmt.Sprintf(callbackFilePlacementsAdd, file.ID),
+			),
+		))
+	}
+
+	rows = append(rows, tgbotapi.NewInlineKeyboardRow(
+		tgbotapi.NewInlineKeyboardButtonData(
+			texts.CommonBack,
+			fmt.Sprintf(callbackFileRefresh, file.ID),
+		),
+	))
+
+	return tgbotapi.NewInlineKeyboardMarkup(rows...)
+}
+
+func (bot *Bot) newFilePlacementsEdit(
+	texts *i18n.Texts,
+	cbq *tgbotapi.CallbackQuery,
+	placements *service.FilePlacements,
+) tgbotapi.Chattable {
+	return newEditFileCard(
+		cbq.Message,
+		bot.renderFilePlacementsCaption(texts, placements),
+		mdv2,
+		bot.renderFilePlacementsReplyMarkup(texts, placements),
+	)
+}
+
+func (bot *Bot) onFilePlacementsCBQ(ctx context.Context, cbq *tgbotapi.CallbackQuery, id core.FileID) error {
+	user := getUserCtx(ctx)
+	texts := getTextsCtx(ctx)
+
+	placements, err := bot.fileSrv.GetPlacements(ctx, user, id)
+	if errors.Is(err, core.ErrFileNotFound) {
+		return bot.answerCallbackQueryAlert(ctx, cbq, texts.FileDeletedBefore)
+	} else if err != nil {
+		return errors.Wrap(err, "get placements")
+	}
+
+	go func() {
+		_ = bot.answerCallbackQuery(ctx, cbq, "")
+	}()
+
+	return bot.send(ctx, bot.newFilePlacementsEdit(texts, cbq, placements))
+}
+
+func (bot *Bot) onFilePlacementCBQ(
+	ctx context.Context,
+	cbq *tgbotapi.CallbackQuery,
+	fileID core.FileID,
+	id core.PlacementID,
+) error {
+	user := getUserCtx(ctx)
+	texts := getTextsCtx(ctx)
+
+	placements, err := bot.fileSrv.GetPlacements(ctx, user, fileID)
+	if errors.Is(err, core.ErrFileNotFound) {
+		return bot.answerCallbackQueryAlert(ctx, cbq, texts.FileDeletedBefore)
+	} else if err != nil {
+		return errors.Wrap(err, "get placements")
+	}
+
+	var item *service.PlacementStats
+
+	for _, v := range placements.Items {
+		if v.ID == id {
+			item = v
+			break
+		}
+	}
+
+	if item == nil {
+		return bot.answerCallbackQuery(ctx, cbq, texts.PlacementNotFound)
+	}
+
+	go func() {
+		_ = bot.answerCallbackQuery(ctx, cbq, "")
+	}()
+
+	caption := strings.Join([]string{
+		fmt.Sprintf(texts.PlacementTitle, tg.EscapeMD(item.Name)),
+		"",
+		fmt.Sprintf(texts.PlacementLink, tg.EscapeMD(bot.getFilePlacementDeepLink(placements.File, item.Placement))),
+		fmt.Sprintf(texts.PlacementDownloads, item.Total, item.Unique),
+	}, "\n")
+
+	markup := tgbotapi.NewInlineKeyboardMarkup(
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(
+				texts.CommonDelete,
+				fmt.Sprintf(callbackFilePlacementDelete, fileID, id),
+			),
+		),
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(
+				texts.CommonBack,
+				fmt.Sprintf(callbackFilePlacements, fileID),
+			),
+		),
+	)
+
+	return bot.send(ctx, newEditFileCard(cbq.Message, caption, mdv2, markup))
+}
+
+func (bot *Bot) onFilePlacementDeleteCBQ(
+	ctx context.Context,
+	cbq *tgbotapi.CallbackQuery,
+	fileID core.FileID,
+	id core.PlacementID,
+) error {
+	user := getUserCtx(ctx)
+	texts := getTextsCtx(ctx)
+
+	err := bot.fileSrv.DeletePlacement(ctx, user, fileID, id)
+	switch {
+	case errors.Is(err, core.ErrFileNotFound):
+		return bot.answerCallbackQueryAlert(ctx, cbq, texts.FileDeletedBefore)
+	case errors.Is(err, core.ErrPlacementNotFound):
+		return bot.answerCallbackQuery(ctx, cbq, texts.PlacementNotFound)
+	case err != nil:
+		return errors.Wrap(err, "delete placement")
+	}
+
+	placements, err := bot.fileSrv.GetPlacements(ctx, user, fileID)
+	if err != nil {
+		return errors.Wrap(err, "get placements")
+	}
+
+	go func() {
+		_ = bot.answerCallbackQuery(ctx, cbq, texts.PlacementDeleted)
+	}()
+
+	return bot.send(ctx, bot.newFilePlacementsEdit(texts, cbq, placements))
+}
+
+func (bot *Bot) onFilePlacementsAddCBQ(ctx context.Context, cbq *tgbotapi.CallbackQuery, id core.FileID) error {
+	user := getUserCtx(ctx)
+	texts := getTextsCtx(ctx)
+
+	_, err := bot.fileSrv.StartAddPlacement(ctx, user, id)
+	switch {
+	case errors.Is(err, core.ErrFileNotFound):
+		return bot.answerCallbackQueryAlert(ctx, cbq, texts.FileDeletedBefore)
+	case errors.Is(err, service.ErrPlacementsLimitReached):
+		return bot.answerCallbackQueryAlert(ctx, cbq, fmt.Sprintf(texts.PlacementsLimit, service.FilePlacementsLimit))
+	case err != nil:
+		return errors.Wrap(err, "start add placement")
+	}
+
+	if err := bot.state.Set(ctx, user.ID, state.FilePlacementName); err != nil {
+		return errors.Wrap(err, "update state")
+	}
+
+	go func() {
+		_ = bot.answerCallbackQuery(ctx, cbq, "")
+	}()
+
+	out := tgbotapi.NewMessage(cbq.Message.Chat.ID, texts.PlacementAdd)
+	out.ParseMode = mdv2
+	out.ReplyMarkup = tgbotapi.NewInlineKeyboardMarkup(
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(
+				texts.CommonCancel,
+				callbackFilePlacementsCancel,
+			),
+		),
+	)
+
+	return bot.send(ctx, out)
+}
+
+func (bot *Bot) onFilePlacementsCancelCBQ(ctx context.Context, cbq *tgbotapi.CallbackQuery) error {
+	user := getUserCtx(ctx)
+
+	if err := bot.fileSrv.CancelAddPlacement(ctx, user); err != nil {
+		return errors.Wrap(err, "cancel add placement")
+	}
+
+	if err := bot.state.Set(ctx, user.ID, state.Empty); err != nil {
+		return errors.Wrap(err, "update state")
+	}
+
+	go func() {
+		_ = bot.deleteMessage(ctx, cbq.Message)
+	}()
+
+	return bot.answerCallbackQuery(ctx, cbq, getTextsCtx(ctx).PlacementCanceled)
+}
+
+func (bot *Bot) onFilePlacementNameState(ctx context.Context, msg *tgbotapi.Message) error {
+	user := getUserCtx(ctx)
+	texts := getTextsCtx(ctx)
+
+	if msg.Text == "" {
+		return bot.sendText(ctx, user.ID, texts.PlacementOnlyText)
+	}
+
+	file, placement, err := bot.fileSrv.AddAwaitedPlacement(ctx, user, msg.Text)
+	switch {
+	case errors.Is(err, service.ErrPlacementInvalidName):
+		return bot.sendText(ctx, user.ID, fmt.Sprintf(texts.PlacementBadName, service.FilePlacementNameMaxLength))
+	case errors.Is(err, service.ErrPlacementsLimitReached):
+		_ = bot.state.Set(ctx, user.ID, state.Empty)
+		return bot.sendText(ctx, user.ID, fmt.Sprintf(texts.PlacementsLimit, service.FilePlacementsLimit))
+	case errors.Is(err, service.ErrPlacementNotAwaited):
+		_ = bot.state.Set(ctx, user.ID, state.Empty)
+		return bot.sendText(ctx, user.ID, texts.PlacementNotAwait)
+	case errors.Is(err, core.ErrFileNotFound):
+		_ = bot.state.Set(ctx, user.ID, state.Empty)
+		return bot.sendText(ctx, user.ID, texts.FileDeletedBefore)
+	case err != nil:
+		return errors.Wrap(err, "add placement")
+	}
+
+	if err := bot.state.Set(ctx, user.ID, state.Empty); err != nil {
+		return errors.Wrap(err, "update state")
+	}
+
+	return bot.send(ctx, bot.newAnswerMsg(msg, fmt.Sprintf(texts.PlacementAdded,
+		tg.EscapeMD(placement.Name),
+		tg.EscapeMD(bot.getFilePlacementDeepLink(file, placement)),
+	)))
+}
@@ -0,0 +1,196 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/bots-house/share-file-bot/bot/i18n"
+	"github.com/bots-house/share-file-bot/bot/state"
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/pkg/tg"
+	"github.com/bots-house/share-file-bot/service"
+	tgbotapi "github.com/bots-house/telegram-bot-api"
+	"github.com/friendsofgo/errors"
+)
+
+const (
+	callbackFileVersions       = "file:%d:versions"
+	callbackFileVersionsUpload = "file:%d:versions:upload"
+	callbackFileVersionRestore = "file:%d:version:%d:restore"
+)
+
+func getFileVersionTitle(version *core.FileVersion) string {
+	title := getKindEmoji(version.Kind)
+
+	if name := version.Name; name != "" {
+		if utf8.RuneCountInString(name) > fileLibraryNameMaxLength {
+			name = string([]rune(name)[:fileLibraryNameMaxLength-1]) + "…"
+		}
+
+		title += " " + name
+	}
+
+	return title
+}
+
+func (bot *Bot) renderFileVersionsCaption(texts *i18n.Texts, versions *service.FileVersions) string {
+	rows := []string{
+		texts.FileVersions,
+		fmt.Sprintf(texts.FileVersionsCurrent,
+			versions.Current.Version,
+			versions.Current.Total,
+			versions.Current.Unique,
+		),
+		"",
+	}
+
+	if len(versions.Items) == 0 {
+		rows = append(rows, texts.FileVersionsEmpty)
+	}
+
+	for _, item := range versions.Items {
+		rows = append(rows, fmt.Sprintf(texts.FileVersionsItem,
+			item.Version,
+			tg.EscapeMD(getFileVersionTitle(item.FileVersion)),
+			tg.EscapeMD(formatTime(item.ReplacedAt)),
+			item.Total,
+			item.Unique,
+		))
+	}
+
+	if more := versions.Count - len(versions.Items); more > 0 {
+		rows = append(rows, fmt.Sprintf(texts.FileVersionsMore, more))
+	}
+
+	return strings.Join(rows, "\n")
+}
+
+func (bot *Bot) renderFileVersionsReplyMarkup(texts *i18n.Texts, versions *service.FileVersions) tgbotapi.InlineKeyboardMarkup {
+	file := versions.File
+
+	rows := [][]tgbotapi.InlineKeyboardButton{
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(
+				texts.FileVersionsUploadButton,
+				fmt.Sprintf(callbackFileVersionsUpload, file.ID),
+			),
+		),
+	}
+
+	row := []tgbotapi.InlineKeyboardButton{}
+
+	for _, item := range versions.Items {
+		row = append(row, tgbotapi.NewInlineKeyboardButtonData(
+			fmt.Sprintf(texts.FileVersionsRestoreButton, item.Version),
+			fmt.Sprintf(callbackFileVersionRestore, file.ID, item.ID),
+		))
+
+		if len(row) == 2 {
+			rows = append(rows, row)
+			row = []tgbotapi.InlineKeyboardButton{}
+		}
+	}
+
+	if len(row) > 0 {
+		rows = append(rows, row)
+	}
+
+	rows = append(rows, tgbotapi.NewInlineKeyboardRow(
+		tgbotapi.NewInlineKeyboardButtonData(
+			texts.CommonBack,
+			fmt.Sprintf(callbackFileRefresh, file.ID),
+		),
+	))
+
+	return tgbotapi.NewInlineKeyboardMarkup(rows...)
+}
+
+func (bot *Bot) onFileVersionsCBQ(ctx context.Context, cbq *tgbotapi.CallbackQuery, id core.FileID) error {
+	user := getUserCtx(ctx)
+	texts := getTextsCtx(ctx)
+
+	versions, err := bot.fileSrv.GetFileVersions(ctx, user, id)
+	if errors.Is(err, core.ErrFileNotFound) {
+		return bot.answerCallbackQueryAlert(ctx, cbq, texts.FileDeletedBefore)
+	} else if err != nil {
+		return errors.Wrap(err, "get file versions")
+	}
+
+	go func() {
+		_ = bot.answerCallbackQuery(ctx, cbq, "")
+	}()
+
+	return bot.send(ctx, newEditFileCard(
+		cbq.Message,
+		bot.renderFileVersionsCaption(texts, versions),
+		mdv2,
+		bot.renderFileVersionsReplyMarkup(texts, versions),
+	))
+}
+
+func (bot *Bot) onFileVersionsUploadCBQ(ctx context.Context, cbq *tgbotapi.CallbackQuery, id core.FileID) error {
+	return bot.onFileEditInputCBQ(ctx, cbq, id, state.FileReplace, getTextsCtx(ctx).FileReplacePrompt)
+}
+
+// sendReplacedFile sends file with new content and removes message with previous one.
+func (bot *Bot) sendReplacedFile(ctx context.Context, msg *tgbotapi.Message, file *service.OwnedFile) error {
+	go bot.deleteFileCard(ctx, msg)
+
+	return bot.sendOwnedFile(ctx, msg, file)
+}
+
+func (bot *Bot) onFileVersionRestoreCBQ(
+	ctx context.Context,
+	cbq *tgbotapi.CallbackQuery,
+	fileID core.FileID,
+	id core.FileVersionID,
+) error {
+	user := getUserCtx(ctx)
+	texts := getTextsCtx(ctx)
+
+	file, err := bot.fileSrv.RestoreFileVersion(ctx, user, fileID, id)
+	switch {
+	case errors.Is(err, core.ErrFileNotFound):
+		return bot.answerCallbackQueryAlert(ctx, cbq, texts.FileDeletedBefore)
+	case errors.Is(err, core.ErrFileVersionNotFound):
+		return bot.answerCallbackQuery(ctx, cbq, texts.FileVersionNotFound)
+	case err != nil:
+		return errors.Wrap(err, "restore file version")
+	}
+
+	go func() {
+		_ = bot.answerCallbackQuery(ctx, cbq, fmt.Sprintf(texts.FileVersionRestored, file.Version))
+	}()
+
+	return bot.sendReplacedFile(ctx, cbq.Message, file)
+}
+
+func (bot *Bot) onFileReplaceState(ctx context.Context, msg *tgbotapi.Message) error {
+	user := getUserCtx(ctx)
+	texts := getTextsCtx(ctx)
+
+	inputFile := bot.extractInputFileFromMessage(msg)
+	if inputFile == nil {
+		return bot.sendText(ctx, user.ID, texts.FileReplaceOnlyFile)
+	}
+
+	if inputFile.Kind == core.KindAudio {
+		return bot.sendText(ctx, user.ID, texts.FileAudioDisabled)
+	}
+
+	file, err := bot.fileSrv.ReplaceFile(ctx, user, getStateDataCtx(ctx).FileID, inputFile)
+	switch {
+	case errors.Is(err, core.ErrFileNotFound):
+		return bot.onFileEditDeleted(ctx)
+	case err != nil:
+		return errors.Wrap(err, "replace file")
+	}
+
+	if _, err := bot.endConversation(ctx, true); err != nil {
+		return errors.Wrap(err, "end conversation")
+	}
+
+	return bot.sendReplacedFile(ctx, msg, file)
+}
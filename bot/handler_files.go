@@ -0,0 +1,376 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/bots-house/share-file-bot/bot/i18n"
+	"github.com/bots-house/share-file-bot/bot/state"
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/pkg/tg"
+	"github.com/bots-house/share-file-bot/service"
+	tgbotapi "github.com/bots-house/telegram-bot-api"
+	"github.com/friendsofgo/errors"
+)
+
+const (
+	cmdFiles = "files"
+
+	// page, order, kind, is search enabled
+	callbackFiles            = "files:%d:%d:%d:%d"
+	callbackFilesSearch      = "files:search"
+	callbackFilesSearchReset = "files:search:reset"
+	callbackFileOpen         = "file:%d:open"
+
+	fileLibraryNameMaxLength = 40
+)
+
+// order of kinds in filter
+var fileLibraryKinds = []core.Kind{
+	core.KindUnknown,
+	core.KindDocument,
+	core.KindPhoto,
+	core.KindVideo,
+	core.KindAnimation,
+	core.KindAudio,
+	core.KindVoice,
+	core.KindVideoNote,
+	core.KindSticker,
+	core.KindContact,
+	core.KindLocation,
+}
+
+func getKindEmoji(kind core.Kind) string {
+	switch kind {
+	case core.KindPhoto:
+		return "🖼"
+	case core.KindVideo:
+		return "🎬"
+	case core.KindAnimation:
+		return "🎞"
+	case core.KindAudio:
+		return "🎵"
+	case core.KindVoice:
+		return "🎤"
+	case core.KindVideoNote:
+		return "📹"
+	case core.KindSticker:
+		return "🏷"
+	case core.KindContact:
+		return "👤"
+	case core.KindLocation:
+		return "📍"
+	default:
+		return "📄"
+	}
+}
+
+func getKindFilterTitle(texts *i18n.Texts, kind core.Kind) string {
+	switch kind {
+	case core.KindDocument:
+		return texts.KindDocument
+	case core.KindPhoto:
+		return texts.KindPhoto
+	case core.KindVideo:
+		return texts.KindVideo
+	case core.KindAnimation:
+		return texts.KindAnimation
+	case core.KindAudio:
+		return texts.KindAudio
+	case core.KindVoice:
+		return texts.KindVoice
+	case core.KindVideoNote:
+		return texts.KindVideoNote
+	case core.KindSticker:
+		return texts.KindSticker
+	case core.KindContact:
+		return texts.KindContact
+	case core.KindLocation:
+		return texts.KindLocation
+	default:
+		return texts.KindAll
+	}
+}
+
+func getNextKindFilter(kind core.Kind) core.Kind {
+	for i, v := range fileLibraryKinds {
+		if v == kind {
+			return fileLibraryKinds[(i+1)%len(fileLibraryKinds)]
+		}
+	}
+	return core.KindUnknown
+}
+
+func getFileLibraryTitle(texts *i18n.Texts, file *core.File) string {
+	title := file.Name
+	if title == "" {
+		title = file.Caption.String
+	}
+	if title == "" {
+		title = fmt.Sprintf(texts.FileUntitled, file.ID)
+	}
+
+	if utf8.RuneCountInString(title) > fileLibraryNameMaxLength {
+		title = string([]rune(title)[:fileLibraryNameMaxLength-1]) + "…"
+	}
+
+	return getKindEmoji(file.Kind) + " " + title
+}
+
+func newFilesCallback(filter *service.FileLibraryFilter, page int) string {
+	search := 0
+	if filter.Search != "" {
+		search = 1
+	}
+
+	return fmt.Sprintf(callbackFiles, page, filter.Order, filter.Kind, search)
+}
+
+func (bot *Bot) renderFileLibraryText(
+	texts *i18n.Texts,
+	filter *service.FileLibraryFilter,
+	page *service.FileLibraryPage,
+) string {
+	isFiltered := filter.Search != "" || filter.Kind != core.KindUnknown
+
+	if page.Total == 0 && !isFiltered {
+		return texts.FilesEmpty
+	}
+
+	rows := []string{
+		texts.FilesTitle,
+		"",
+		fmt.Sprintf(texts.FilesFound, page.Total),
+	}
+
+	if filter.Search != "" {
+		rows = append(rows, fmt.Sprintf(texts.FilesQuery, tg.EscapeMD(filter.Search)))
+	}
+
+	if page.Total == 0 {
+		rows = append(rows, "", tg.EscapeMD(texts.FilesNotFound))
+	}
+
+	return strings.Join(rows, "\n")
+}
+
+func (bot *Bot) renderFileLibraryReplyMarkup(
+	texts *i18n.Texts,
+	filter *service.FileLibraryFilter,
+	page *service.FileLibraryPage,
+) tgbotapi.InlineKeyboardMarkup {
+	keyboard := make([][]tgbotapi.InlineKeyboardButton, 0, len(page.Files)+3)
+
+	for _, file := range page.Files {
+		keyboard = append(keyboard, tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(
+				getFileLibraryTitle(texts, file),
+				fmt.Sprintf(callbackFileOpen, file.ID),
+			),
+		))
+	}
+
+	if page.Pages > 1 {
+		nav := tgbotapi.NewInlineKeyboardRow()
+
+		if page.HasPrev() {
+			nav = append(nav, tgbotapi.NewInlineKeyboardButtonData("◀️", newFilesCallback(filter, page.Page-1)))
+		}
+
+		nav = append(nav, tgbotapi.NewInlineKeyboardButtonData(
+			fmt.Sprintf("%d / %d", page.Page+1, page.Pages),
+			newFilesCallback(filter, page.Page),
+		))
+
+		if page.HasNext() {
+			nav = append(nav, tgbotapi.NewInlineKeyboardButtonData("▶️", newFilesCallback(filter, page.Page+1)))
+		}
+
+		keyboard = append(keyboard, nav)
+	}
+
+	// filters reset page
+	orderTitle := texts.FilesOrderCreatedAt
+	nextOrder := *filter
+	nextOrder.Order = core.FileOrderDownloads
+
+	if filter.Order == core.FileOrderDownloads {
+		orderTitle = texts.FilesOrderDownloads
+		nextOrder.Order = core.FileOrderCreatedAt
+	}
+
+	nextKind := *filter
+	nextKind.Kind = getNextKindFilter(filter.Kind)
+
+	keyboard = append(keyboard, tgbotapi.NewInlineKeyboardRow(
+		tgbotapi.NewInlineKeyboardButtonData(orderTitle, newFilesCallback(&nextOrder, 0)),
+		tgbotapi.NewInlineKeyboardButtonData(
+			fmt.Sprintf(texts.FilesKindFilter, getKindFilterTitle(texts, filter.Kind)),
+			newFilesCallback(&nextKind, 0),
+		),
+	))
+
+	search := tgbotapi.NewInlineKeyboardRow(
+		tgbotapi.NewInlineKeyboardButtonData(texts.FilesSearchButton, callbackFilesSearch),
+	)
+
+	if filter.Search != "" {
+		search = append(search, tgbotapi.NewInlineKeyboardButtonData(texts.FilesSearchResetButton, callbackFilesSearchReset))
+	}
+
+	keyboard = append(keyboard, search)
+
+	return tgbotapi.NewInlineKeyboardMarkup(keyboard...)
+}
+
+func (bot *Bot) sendFileLibrary(ctx context.Context, chatID int64, filter *service.FileLibraryFilter) error {
+	user := getUserCtx(ctx)
+
+	page, err := bot.fileSrv.GetLibraryPage(ctx, user, filter)
+	if err != nil {
+		return errors.Wrap(err, "get library page")
+	}
+
+	texts := getTextsCtx(ctx)
+
+	out := tgbotapi.NewMessage(chatID, bot.renderFileLibraryText(texts, filter, page))
+	out.ParseMode = mdv2
+	out.ReplyMarkup = bot.renderFileLibraryReplyMarkup(texts, filter, page)
+
+	return bot.send(ctx, out)
+}
+
+func (bot *Bot) onFiles(ctx context.Context, msg *tgbotapi.Message) error {
+	user := getUserCtx(ctx)
+
+	if err := bot.state.Set(ctx, user.ID, state.Empty); err != nil {
+		return errors.Wrap(err, "reset state")
+	}
+
+	// arguments of command is search query, without them search is reset
+	search, err := bot.fileSrv.SetLibrarySearch(ctx, user, msg.CommandArguments())
+	if errors.Is(err, service.ErrFileLibrarySearchInvalidLength) {
+		return bot.sendText(ctx, user.ID, fmt.Sprintf(getTextsCtx(ctx).FilesSearchLength, service.FileLibrarySearchMaxLength))
+	} else if err != nil {
+		return errors.Wrap(err, "set library search")
+	}
+
+	return bot.sendFileLibrary(ctx, msg.Chat.ID, &service.FileLibraryFilter{
+		Search: search,
+	})
+}
+
+func (bot *Bot) onFilesCBQ(
+	ctx context.Context,
+	cbq *tgbotapi.CallbackQuery,
+	filter *service.FileLibraryFilter,
+	isSearch bool,
+) error {
+	user := getUserCtx(ctx)
+
+	if isSearch {
+		search, err := bot.fileSrv.GetLibrarySearch(ctx, user)
+		if err != nil {
+			return errors.Wrap(err, "get library search")
+		}
+		filter.Search = search
+	}
+
+	page, err := bot.fileSrv.GetLibraryPage(ctx, user, filter)
+	if err != nil {
+		return errors.Wrap(err, "get library page")
+	}
+
+	texts := getTextsCtx(ctx)
+
+	markup := bot.renderFileLibraryReplyMarkup(texts, filter, page)
+
+	edit := tgbotapi.NewEditMessageText(
+		cbq.Message.Chat.ID,
+		cbq.Message.MessageID,
+		bot.renderFileLibraryText(texts, filter, page),
+	)
+	edit.ParseMode = mdv2
+	edit.ReplyMarkup = &markup
+
+	if err := bot.send(ctx, edit); err != nil {
+		var tgErr *tgbotapi.Error
+
+		if !(errors.As(err, &tgErr) && strings.Contains(tgErr.Message, "message is not modified")) {
+			return errors.Wrap(err, "edit message")
+		}
+	}
+
+	return bot.answerCallbackQuery(ctx, cbq, "")
+}
+
+func (bot *Bot) onFilesSearchCBQ(ctx context.Context, cbq *tgbotapi.CallbackQuery) error {
+	user := getUserCtx(ctx)
+
+	if err := bot.state.Set(ctx, user.ID, state.FilesSearch); err != nil {
+		return errors.Wrap(err, "update state")
+	}
+
+	go func() {
+		_ = bot.answerCallbackQuery(ctx, cbq, "")
+	}()
+
+	return bot.sendText(ctx, user.ID, getTextsCtx(ctx).FilesSearchRequest)
+}
+
+func (bot *Bot) onFilesSearchResetCBQ(ctx context.Context, cbq *tgbotapi.CallbackQuery) error {
+	user := getUserCtx(ctx)
+
+	if _, err := bot.fileSrv.SetLibrarySearch(ctx, user, ""); err != nil {
+		return errors.Wrap(err, "reset library search")
+	}
+
+	return bot.onFilesCBQ(ctx, cbq, &service.FileLibraryFilter{}, false)
+}
+
+func (bot *Bot) onFilesSearchState(ctx context.Context, msg *tgbotapi.Message) error {
+	user := getUserCtx(ctx)
+
+	if msg.Text == "" {
+		return bot.sendText(ctx, user.ID, getTextsCtx(ctx).FilesSearchRequest)
+	}
+
+	search, err := bot.fileSrv.SetLibrarySearch(ctx, user, msg.Text)
+	if errors.Is(err, service.ErrFileLibrarySearchInvalidLength) {
+		return bot.sendText(ctx, user.ID, fmt.Sprintf(getTextsCtx(ctx).FilesSearchLength, service.FileLibrarySearchMaxLength))
+	} else if err != nil {
+		return errors.Wrap(err, "set library search")
+	}
+
+	if err := bot.state.Set(ctx, user.ID, state.Empty); err != nil {
+		return errors.Wrap(err, "update state")
+	}
+
+	return bot.sendFileLibrary(ctx, msg.Chat.ID, &service.FileLibraryFilter{
+		Search: search,
+	})
+}
+
+func (bot *Bot) onFileOpenCBQ(ctx context.Context, cbq *tgbotapi.CallbackQuery, id core.FileID) error {
+	user := getUserCtx(ctx)
+	texts := getTextsCtx(ctx)
+
+	result, err := bot.fileSrv.GetFileByID(ctx, user, id)
+	if errors.Is(err, core.ErrFileNotFound) {
+		return bot.answerCallbackQueryAlert(ctx, cbq, texts.FileDeletedBefore)
+	} else if err != nil {
+		return errors.Wrap(err, "get file by id")
+	}
+
+	if result.OwnedFile == nil {
+		return bot.answerCallbackQuery(ctx, cbq, "bad body, what you do?")
+	}
+
+	go func() {
+		_ = bot.answerCallbackQuery(ctx, cbq, "")
+	}()
+
+	return bot.sendOwnedFile(ctx, cbq.Message, result.OwnedFile)
+}
@@ -0,0 +1,186 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/bots-house/share-file-bot/bot/i18n"
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/pkg/log"
+	"github.com/bots-house/share-file-bot/pkg/tg"
+	"github.com/bots-house/share-file-bot/service"
+	tgbotapi "github.com/bots-house/telegram-bot-api"
+	"github.com/friendsofgo/errors"
+)
+
+const (
+	// users came from switch pm button are attributed to ref "inline"
+	inlineSwitchPMParameter = refDeepLinkPrefix + "inline"
+
+	// results are personal, so cache them for a short time
+	inlineCacheTime = 10
+)
+
+func (bot *Bot) getFileDeepLink(file *core.File) string {
+	return fmt.Sprintf("https://%s/%s?start=%s",
+		tgDomain,
+		bot.client.Self.UserName,
+		file.PublicID,
+	)
+}
+
+func (bot *Bot) renderInlineResult(texts *i18n.Texts, file *core.File) interface{} {
+	id := strconv.Itoa(int(file.ID))
+	title := getFileLibraryTitle(texts, file)
+	caption := renderFileCaption(file)
+
+	markup := tgbotapi.NewInlineKeyboardMarkup(
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonURL(texts.InlineButtonDownload, bot.getFileDeepLink(file)),
+		),
+	)
+
+	switch file.Kind {
+	case core.KindDocument:
+		result := tgbotapi.NewInlineQueryResultCachedDocument(id, file.TelegramID, title)
+		result.Description = file.Caption.String
+		result.Caption = caption
+		result.ParseMode = mdv2
+		result.ReplyMarkup = &markup
+		return result
+	case core.KindAnimation:
+		result := tgbotapi.NewInlineQueryResultCachedMPEG4GIF(id, file.TelegramID)
+		result.Title = title
+		result.Caption = caption
+		result.ParseMode = mdv2
+		result.ReplyMarkup = &markup
+		return result
+	case core.KindAudio:
+		result := tgbotapi.NewInlineQueryResultCachedAudio(id, file.TelegramID)
+		result.Caption = caption
+		result.ParseMode = mdv2
+		result.ReplyMarkup = &markup
+		return result
+	case core.KindPhoto:
+		result := tgbotapi.NewInlineQueryResultCachedPhoto(id, file.TelegramID)
+		result.Title = title
+		result.Description = file.Caption.String
+		result.Caption = caption
+		result.ParseMode = mdv2
+		result.ReplyMarkup = &markup
+		return result
+	case core.KindVideo:
+		result := tgbotapi.NewInlineQueryResultCachedVideo(id, file.TelegramID, title)
+		result.Description = file.Caption.String
+		result.Caption = caption
+		result.ParseMode = mdv2
+		result.ReplyMarkup = &markup
+		return result
+	case core.KindVoice:
+		result := tgbotapi.NewInlineQueryResultCachedVoice(id, file.TelegramID, title)
+		result.Caption = caption
+		result.ParseMode = mdv2
+		result.ReplyMarkup = &markup
+		return result
+	case core.KindSticker:
+		result := tg.NewInlineQueryResultCachedSticker(id, file.TelegramID)
+		result.ReplyMarkup = &markup
+		return result
+	case core.KindVideoNote:
+		// telegram has no inline result for video note, so it's sent as video
+		result := tgbotapi.NewInlineQueryResultCachedVideo(id, file.TelegramID, title)
+		result.ReplyMarkup = &markup
+		return result
+	case core.KindContact:
+		contact := file.Metadata.Contact
+		if contact == nil {
+			return nil
+		}
+
+		result := tg.NewInlineQueryResultContact(id, contact.PhoneNumber, contact.FirstName)
+		result.LastName = contact.LastName
+		result.ReplyMarkup = &markup
+		return result
+	case core.KindLocation:
+		location := file.Metadata.Location
+		if location == nil {
+			return nil
+		}
+
+		result := tgbotapi.NewInlineQueryResultLocation(id, title, location.Latitude, location.Longitude)
+		result.ReplyMarkup = &markup
+		return result
+	default:
+		return nil
+	}
+}
+
+func (bot *Bot) onInlineQuery(ctx context.Context, query *tgbotapi.InlineQuery) error {
+	user := getUserCtx(ctx)
+	texts := getTextsCtx(ctx)
+
+	answer := tgbotapi.InlineConfig{
+		InlineQueryID: query.ID,
+		Results:       []interface{}{},
+		CacheTime:     inlineCacheTime,
+		IsPersonal:    true,
+	}
+
+	// invalid offset means first page
+	offset, _ := strconv.Atoi(query.Offset)
+
+	page, err := bot.fileSrv.SearchInline(ctx, user, query.Query, offset)
+	if errors.Is(err, service.ErrFileLibrarySearchInvalidLength) {
+		page = &service.FileInlinePage{}
+	} else if err != nil {
+		return errors.Wrap(err, "search inline")
+	}
+
+	for _, file := range page.Files {
+		if result := bot.renderInlineResult(texts, file); result != nil {
+			answer.Results = append(answer.Results, result)
+		}
+	}
+
+	if page.NextOffset > 0 {
+		answer.NextOffset = strconv.Itoa(page.NextOffset)
+	}
+
+	if offset == 0 && len(answer.Results) == 0 {
+		answer.SwitchPMText = texts.InlineSwitchPM
+		answer.SwitchPMParameter = inlineSwitchPMParameter
+	}
+
+	if _, err := bot.client.AnswerInlineQuery(answer); err != nil {
+		return errors.Wrap(err, "answer inline query")
+	}
+
+	return nil
+}
+
+// onChosenInlineResult is called only if inline feedback is enabled in @BotFather.
+func (bot *Bot) onChosenInlineResult(ctx context.Context, result *tgbotapi.ChosenInlineResult) error {
+	user := getUserCtx(ctx)
+
+	id, err := strconv.Atoi(result.ResultID)
+	if err != nil {
+		return errors.Wrap(err, "parse result id")
+	}
+
+	err = bot.fileSrv.RegisterInlineDownload(ctx, user, core.FileID(id))
+	switch {
+	case errors.Is(err, core.ErrFileNotFound):
+		log.Warn(ctx, "chosen inline result file not found", "file_id", id)
+		return nil
+	case errors.Is(err, service.ErrFileBlocked),
+		errors.Is(err, service.ErrFileViolatesCopyright),
+		errors.Is(err, service.ErrOwnerIsBanned):
+		log.Warn(ctx, "chosen inline result file is moderated", "file_id", id, "err", err)
+		return nil
+	case err != nil:
+		return errors.Wrap(err, "register inline download")
+	}
+
+	return nil
+}
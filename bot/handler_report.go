@@ -0,0 +1,398 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bots-house/share-file-bot/bot/i18n"
+	"github.com/bots-house/share-file-bot/bot/state"
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/pkg/log"
+	"github.com/bots-house/share-file-bot/pkg/tg"
+	"github.com/bots-house/share-file-bot/service"
+	tgbotapi "github.com/bots-house/telegram-bot-api"
+	"github.com/friendsofgo/errors"
+)
+
+const (
+	callbackFileReport   = "file:%d:report"
+	callbackReportCancel = "report:cancel"
+	callbackReportAppeal = "report:%d:appeal"
+
+	callbackAdmin              = "admin"
+	callbackAdminReports       = "admin:reports"
+	callbackAdminReportFile    = "admin:report:%d:file"
+	callbackAdminReportApprove = "admin:report:%d:approve"
+	callbackAdminReportReject  = "admin:report:%d:reject"
+)
+
+func (bot *Bot) newReportCancelReplyMarkup(texts *i18n.Texts) tgbotapi.InlineKeyboardMarkup {
+	return tgbotapi.NewInlineKeyboardMarkup(
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(
+				texts.CommonCancel,
+				callbackReportCancel,
+			),
+		),
+	)
+}
+
+// getReportErrorText returns text for user of errors caused by report or appeal request.
+func getReportErrorText(texts *i18n.Texts, err error) (string, bool) {
+	switch {
+	case errors.Is(err, service.ErrReportOwnFile):
+		return texts.ReportOwnFile, true
+	case errors.Is(err, service.ErrReportAlreadyExists):
+		return texts.ReportAlreadyExists, true
+	case errors.Is(err, service.ErrReportFileAlreadyBlocked):
+		return texts.ReportFileBlocked, true
+	case errors.Is(err, core.ErrFileNotFound):
+		return texts.PasswordFileNotFound, true
+	case errors.Is(err, service.ErrReportAppealNotFileOwner),
+		errors.Is(err, core.ErrReportCantBeAppealed),
+		errors.Is(err, core.ErrReportNotFound):
+		return texts.ReportAppealNotAllowed, true
+	default:
+		return "", false
+	}
+}
+
+func (bot *Bot) onFileReportCBQ(ctx context.Context, cbq *tgbotapi.CallbackQuery, id core.FileID) error {
+	user := getUserCtx(ctx)
+	texts := getTextsCtx(ctx)
+
+	err := bot.reportSrv.RequestReport(ctx, user, id)
+	if text, ok := getReportErrorText(texts, err); ok {
+		return bot.answerCallbackQueryAlert(ctx, cbq, text)
+	} else if err != nil {
+		return errors.Wrap(err, "request report")
+	}
+
+	if err := bot.state.Set(ctx, user.ID, state.ReportReason); err != nil {
+		return errors.Wrap(err, "update state")
+	}
+
+	go func() {
+		_ = bot.answerCallbackQuery(ctx, cbq, "")
+	}()
+
+	out := tgbotapi.NewMessage(cbq.Message.Chat.ID, fmt.Sprintf(texts.ReportRequest, service.ReportReasonMaxLength))
+	out.ReplyMarkup = bot.newReportCancelReplyMarkup(texts)
+
+	return bot.send(ctx, out)
+}
+
+func (bot *Bot) onReportCancelCBQ(ctx context.Context, cbq *tgbotapi.CallbackQuery) error {
+	user := getUserCtx(ctx)
+
+	if err := bot.reportSrv.CancelAwait(ctx, user); err != nil {
+		return errors.Wrap(err, "cancel report")
+	}
+
+	if err := bot.state.Set(ctx, user.ID, state.Empty); err != nil {
+		return errors.Wrap(err, "update state")
+	}
+
+	go func() {
+		_ = bot.deleteMessage(ctx, cbq.Message)
+	}()
+
+	return bot.answerCallbackQuery(ctx, cbq, getTextsCtx(ctx).ReportCanceled)
+}
+
+func (bot *Bot) onReportReasonState(ctx context.Context, msg *tgbotapi.Message) error {
+	user := getUserCtx(ctx)
+	texts := getTextsCtx(ctx)
+
+	if msg.Text == "" {
+		return bot.sendText(ctx, user.ID, texts.ReportOnlyText)
+	}
+
+	_, err := bot.reportSrv.CreateReport(ctx, user, msg.Text)
+	switch {
+	case errors.Is(err, service.ErrReportTextInvalidLength):
+		return bot.sendText(ctx, user.ID, fmt.Sprintf(texts.ReportBadLength, service.ReportReasonMaxLength))
+	case errors.Is(err, service.ErrReportNotAwaited):
+		if err := bot.state.Set(ctx, user.ID, state.Empty); err != nil {
+			return errors.Wrap(err, "update state")
+		}
+		return bot.sendText(ctx, user.ID, texts.ReportNotAwait)
+	case err != nil:
+		return errors.Wrap(err, "create report")
+	}
+
+	if err := bot.state.Set(ctx, user.ID, state.Empty); err != nil {
+		return errors.Wrap(err, "update state")
+	}
+
+	return bot.sendText(ctx, user.ID, texts.ReportSent)
+}
+
+func (bot *Bot) onReportAppealCBQ(ctx context.Context, cbq *tgbotapi.CallbackQuery, id core.ReportID) error {
+	user := getUserCtx(ctx)
+	texts := getTextsCtx(ctx)
+
+	err := bot.reportSrv.RequestAppeal(ctx, user, id)
+	if text, ok := getReportErrorText(texts, err); ok {
+		return bot.answerCallbackQueryAlert(ctx, cbq, text)
+	} else if err != nil {
+		return errors.Wrap(err, "request appeal")
+	}
+
+	if err := bot.state.Set(ctx, user.ID, state.ReportAppeal); err != nil {
+		return errors.Wrap(err, "update state")
+	}
+
+	go func() {
+		_ = bot.answerCallbackQuery(ctx, cbq, "")
+	}()
+
+	out := tgbotapi.NewMessage(cbq.Message.Chat.ID, fmt.Sprintf(texts.ReportAppealRequest, service.ReportAppealMaxLength))
+	out.ReplyMarkup = bot.newReportCancelReplyMarkup(texts)
+
+	return bot.send(ctx, out)
+}
+
+func (bot *Bot) onReportAppealState(ctx context.Context, msg *tgbotapi.Message) error {
+	user := getUserCtx(ctx)
+	texts := getTextsCtx(ctx)
+
+	if msg.Text == "" {
+		return bot.sendText(ctx, user.ID, texts.ReportOnlyText)
+	}
+
+	_, err := bot.reportSrv.CreateAppeal(ctx, user, msg.Text)
+	switch {
+	case errors.Is(err, service.ErrReportTextInvalidLength):
+		return bot.sendText(ctx, user.ID, fmt.Sprintf(texts.ReportBadLength, service.ReportAppealMaxLength))
+	case errors.Is(err, service.ErrReportAppealNotAwaited),
+		errors.Is(err, core.ErrReportCantBeAppealed),
+		errors.Is(err, core.ErrReportNotFound):
+		if err := bot.state.Set(ctx, user.ID, state.Empty); err != nil {
+			return errors.Wrap(err, "update state")
+		}
+		return bot.sendText(ctx, user.ID, texts.ReportNotAwait)
+	case err != nil:
+		return errors.Wrap(err, "create appeal")
+	}
+
+	if err := bot.state.Set(ctx, user.ID, state.Empty); err != nil {
+		return errors.Wrap(err, "update state")
+	}
+
+	return bot.sendText(ctx, user.ID, texts.ReportAppealSent)
+}
+
+// renderUserMention returns MarkdownV2 link to user profile.
+func renderUserMention(user *core.User) string {
+	name := user.FirstName
+	if user.LastName.Valid {
+		name += " " + user.LastName.String
+	}
+
+	return fmt.Sprintf("[%s](tg://user?id=%d)", tg.EscapeMD(name), user.ID)
+}
+
+func (bot *Bot) renderAdminReport(texts *i18n.Texts, queue *service.ReportQueue) (string, tgbotapi.InlineKeyboardMarkup) {
+	report := queue.Report
+	isAppeal := report.Status == core.ReportStatusAppealed
+
+	title := texts.AdminReportTitle
+	if isAppeal {
+		title = texts.AdminAppealTitle
+	}
+
+	reporter := texts.AdminReportNoReporter
+	if report.Reporter != nil {
+		reporter = renderUserMention(report.Reporter)
+	}
+
+	lines := []string{
+		fmt.Sprintf(title, report.ID),
+		fmt.Sprintf(texts.AdminReportQueue, queue.Total),
+		"",
+		fmt.Sprintf(texts.AdminReportFile, tg.EscapeMD(report.File.Name)),
+		fmt.Sprintf(texts.AdminReportOwner, renderUserMention(report.Owner)),
+		fmt.Sprintf(texts.AdminReportReporter, reporter),
+		fmt.Sprintf(texts.AdminReportCreatedAt, formatTime(report.CreatedAt)),
+		fmt.Sprintf(texts.AdminReportReason, tg.EscapeMD(report.Reason)),
+	}
+
+	approve, reject := texts.AdminReportButtonApprove, texts.AdminReportButtonReject
+
+	if isAppeal {
+		lines = append(lines,
+			"",
+			fmt.Sprintf(texts.AdminReportResolvedAt, formatTime(report.ResolvedAt.Time)),
+			fmt.Sprintf(texts.AdminReportAppealedAt, formatTime(report.AppealedAt.Time)),
+			fmt.Sprintf(texts.AdminReportAppeal, tg.EscapeMD(report.Appeal.String)),
+		)
+
+		approve, reject = texts.AdminAppealButtonApprove, texts.AdminAppealButtonReject
+	}
+
+	markup := tgbotapi.NewInlineKeyboardMarkup(
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(
+				approve,
+				fmt.Sprintf(callbackAdminReportApprove, report.ID),
+			),
+			tgbotapi.NewInlineKeyboardButtonData(
+				reject,
+				fmt.Sprintf(callbackAdminReportReject, report.ID),
+			),
+		),
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(
+				texts.AdminReportButtonFile,
+				fmt.Sprintf(callbackAdminReportFile, report.ID),
+			),
+		),
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(
+				texts.CommonBack,
+				callbackAdmin,
+			),
+		),
+	)
+
+	return strings.Join(lines, "\n"), markup
+}
+
+// editAdminReports shows first report of queue or summary if queue is empty.
+func (bot *Bot) editAdminReports(ctx context.Context, cbq *tgbotapi.CallbackQuery) error {
+	user := getUserCtx(ctx)
+	texts := getTextsCtx(ctx)
+
+	queue, err := bot.reportSrv.GetQueue(ctx, user)
+	if errors.Is(err, service.ErrReportQueueEmpty) {
+		return bot.editAdminSummary(ctx, cbq)
+	} else if err != nil {
+		return errors.Wrap(err, "get report queue")
+	}
+
+	text, markup := bot.renderAdminReport(texts, queue)
+
+	edit := tgbotapi.NewEditMessageText(cbq.Message.Chat.ID, cbq.Message.MessageID, text)
+	edit.ParseMode = mdv2
+	edit.ReplyMarkup = &markup
+	edit.DisableWebPagePreview = true
+
+	return bot.send(ctx, edit)
+}
+
+func (bot *Bot) onAdminReportsCBQ(ctx context.Context, cbq *tgbotapi.CallbackQuery) error {
+	user := getUserCtx(ctx)
+
+	total, err := bot.reportSrv.OpenReportsCount(ctx, user)
+	if errors.Is(err, service.ErrUserIsNotAdmin) {
+		return nil
+	} else if err != nil {
+		return errors.Wrap(err, "open reports count")
+	}
+
+	if total == 0 {
+		return bot.answerCallbackQuery(ctx, cbq, getTextsCtx(ctx).AdminReportQueueEmpty)
+	}
+
+	go func() {
+		_ = bot.answerCallbackQuery(ctx, cbq, "")
+	}()
+
+	return bot.editAdminReports(ctx, cbq)
+}
+
+func (bot *Bot) onAdminReportFileCBQ(ctx context.Context, cbq *tgbotapi.CallbackQuery, id core.ReportID) error {
+	user := getUserCtx(ctx)
+
+	report, err := bot.reportSrv.GetReport(ctx, user, id)
+	if errors.Is(err, service.ErrUserIsNotAdmin) {
+		return nil
+	} else if err != nil {
+		return errors.Wrap(err, "get report")
+	}
+
+	go func() {
+		_ = bot.answerCallbackQuery(ctx, cbq, "")
+	}()
+
+	return bot.send(ctx, bot.renderGenericFile(
+		cbq.Message.Chat.ID,
+		report.File,
+		tg.EscapeMD(report.File.Caption.String),
+		mdv2,
+		nil,
+	))
+}
+
+func (bot *Bot) onAdminReportResolveCBQ(ctx context.Context, cbq *tgbotapi.CallbackQuery, id core.ReportID, approve bool) error {
+	user := getUserCtx(ctx)
+	texts := getTextsCtx(ctx)
+
+	report, err := bot.reportSrv.Resolve(ctx, user, id, approve)
+	switch {
+	case errors.Is(err, service.ErrUserIsNotAdmin):
+		return nil
+	case errors.Is(err, core.ErrReportAlreadyResolved):
+		go func() {
+			_ = bot.answerCallbackQuery(ctx, cbq, texts.AdminReportResolvedEarly)
+		}()
+		return bot.editAdminReports(ctx, cbq)
+	case err != nil:
+		return errors.Wrap(err, "resolve report")
+	}
+
+	var answer string
+
+	switch report.Status {
+	case core.ReportStatusApproved:
+		answer = texts.AdminReportApproved
+	case core.ReportStatusRejected:
+		answer = texts.AdminReportRejected
+	case core.ReportStatusAppealApproved:
+		answer = texts.AdminAppealApproved
+	case core.ReportStatusAppealRejected:
+		answer = texts.AdminAppealRejected
+	}
+
+	go func() {
+		_ = bot.answerCallbackQuery(ctx, cbq, answer)
+	}()
+
+	if err := bot.notifyReportOwner(ctx, report); err != nil {
+		log.Warn(ctx, "can't notify owner about report decision", "report_id", report.ID, "err", err)
+	}
+
+	return bot.editAdminReports(ctx, cbq)
+}
+
+// notifyReportOwner sends decision on report to owner of file.
+// Owner is not notified about rejected reports, because they don't affect file.
+func (bot *Bot) notifyReportOwner(ctx context.Context, report *service.FullReport) error {
+	texts := getUserTexts(report.Owner)
+	chatID := int64(report.Owner.ID)
+
+	var out tgbotapi.MessageConfig
+
+	switch report.Status {
+	case core.ReportStatusApproved:
+		out = tgbotapi.NewMessage(chatID, fmt.Sprintf(texts.ReportOwnerBlocked, report.File.Name, report.Reason))
+		out.ReplyMarkup = tgbotapi.NewInlineKeyboardMarkup(
+			tgbotapi.NewInlineKeyboardRow(
+				tgbotapi.NewInlineKeyboardButtonData(
+					texts.ReportOwnerAppealButton,
+					fmt.Sprintf(callbackReportAppeal, report.ID),
+				),
+			),
+		)
+	case core.ReportStatusAppealApproved:
+		out = tgbotapi.NewMessage(chatID, fmt.Sprintf(texts.ReportOwnerAppealGranted, report.File.Name))
+	case core.ReportStatusAppealRejected:
+		out = tgbotapi.NewMessage(chatID, fmt.Sprintf(texts.ReportOwnerAppealDenied, report.File.Name))
+	default:
+		return nil
+	}
+
+	return bot.send(ctx, out)
+}
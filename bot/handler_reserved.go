@@ -0,0 +1,76 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/pkg/tg"
+	"github.com/bots-house/share-file-bot/service"
+	tgbotapi "github.com/bots-house/telegram-bot-api"
+	"github.com/friendsofgo/errors"
+)
+
+// onAdminReserved handles /admin reserved, which lists names reserved for custom links.
+func (bot *Bot) onAdminReserved(ctx context.Context, msg *tgbotapi.Message) error {
+	user := getUserCtx(ctx)
+	texts := getTextsCtx(ctx)
+
+	items, err := bot.adminSrv.ReservedPublicIDs(ctx, user)
+	if errors.Is(err, service.ErrUserIsNotAdmin) {
+		return nil
+	} else if err != nil {
+		return errors.Wrap(err, "get reserved public ids")
+	}
+
+	rows := []string{texts.AdminReservedTitle, ""}
+
+	if len(items) == 0 {
+		rows = append(rows, texts.AdminReservedEmpty)
+	}
+
+	for _, item := range items {
+		rows = append(rows, fmt.Sprintf("• `%s`", tg.EscapeMD(item.Name)))
+	}
+
+	return bot.send(ctx, bot.newAnswerMsg(msg, strings.Join(rows, "\n")))
+}
+
+// onAdminReserve handles /admin reserve <name> and /admin unreserve <name>.
+func (bot *Bot) onAdminReserve(ctx context.Context, msg *tgbotapi.Message, name string, reserve bool) error {
+	user := getUserCtx(ctx)
+	texts := getTextsCtx(ctx)
+
+	if !user.IsAdmin {
+		return nil
+	}
+
+	var text string
+
+	if reserve {
+		_, err := bot.adminSrv.ReservePublicID(ctx, user, name)
+		switch {
+		case errors.Is(err, service.ErrFilePublicIDInvalid):
+			text = fmt.Sprintf(texts.AdminReservedInvalid, service.FilePublicIDMaxLength)
+		case errors.Is(err, service.ErrPublicIDAlreadyReserved):
+			text = texts.AdminReservedExists
+		case err != nil:
+			return errors.Wrap(err, "reserve public id")
+		default:
+			text = fmt.Sprintf(texts.AdminReservedAdded, name)
+		}
+	} else {
+		err := bot.adminSrv.UnreservePublicID(ctx, user, name)
+		switch {
+		case errors.Is(err, core.ErrReservedPublicIDNotFound):
+			text = texts.AdminReservedNotFound
+		case err != nil:
+			return errors.Wrap(err, "unreserve public id")
+		default:
+			text = fmt.Sprintf(texts.AdminReservedDeleted, name)
+		}
+	}
+
+	return bot.send(ctx, bot.newAnswerMsg(msg, tg.EscapeMD(text)))
+}
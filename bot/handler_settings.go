@@ -2,36 +2,20 @@ package bot
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/friendsofgo/errors"
 
+	"github.com/bots-house/share-file-bot/bot/i18n"
 	"github.com/bots-house/share-file-bot/core"
 	"github.com/bots-house/share-file-bot/pkg/log"
 	tgbotapi "github.com/bots-house/telegram-bot-api"
-	"github.com/lithammer/dedent"
 )
 
-var (
-	textSettings = dedent.Dedent(`
-        ⚙️ __*Настройки*__
-
-		• _Длинные ID_ — бот будет генерировать максимально возможные по длине ссылки, идеально для личных файлов\. Длинные ссылки буду генерироватся только для новых документов\.
-
-		• _Каналы и чаты_ — управление каналами и чата подключенными к боту в качестве ограничителя при скачивании ваших файлов\.
-    `)
-
-	textCommonBack       = "« Назад"
-	textCommonDisconnect = "Отключить"
-	textCommonYesIamSure = "Да, я уверен"
-
-	textSettingsButtonLongIDs              = "Длинные ID"
-	textSettingsButtonLongIDsEnabledAlert  = "Генериация длинных ссылок включена"
-	textSettingsButtonLongIDsDisabledAlert = "Генериация длинных ссылок выключена"
-
-	textSettingsButtonChannelsAndChats = "📢 Каналы и чаты"
-
-	callbackSettings        = "settings"
-	callbackSettingsLongIDs = "settings:toggle-long-ids"
+const (
+	callbackSettings         = "settings"
+	callbackSettingsLongIDs  = "settings:toggle-long-ids"
+	callbackSettingsLanguage = "settings:language:%s"
 )
 
 func addIsEnabledEmoji(v bool, text string) string {
@@ -50,35 +34,53 @@ func addHasLockEmoji(v bool, text string) string {
 	return "🔓 " + text
 }
 
-func (bot *Bot) newSettingsMenuMessageReplyMarkup(longIDs bool) tgbotapi.InlineKeyboardMarkup {
+func (bot *Bot) newSettingsMenuMessageReplyMarkup(texts *i18n.Texts, user *core.User) tgbotapi.InlineKeyboardMarkup {
+	langs := make([]tgbotapi.InlineKeyboardButton, len(i18n.Langs))
+
+	for i, lang := range i18n.Langs {
+		langs[i] = tgbotapi.NewInlineKeyboardButtonData(
+			addIsEnabledEmoji(lang == texts.Lang, i18n.Get(lang).LangName),
+			fmt.Sprintf(callbackSettingsLanguage, lang),
+		)
+	}
+
 	return tgbotapi.NewInlineKeyboardMarkup(
 		tgbotapi.NewInlineKeyboardRow(
 			tgbotapi.NewInlineKeyboardButtonData(
-				addIsEnabledEmoji(longIDs, textSettingsButtonLongIDs),
+				addIsEnabledEmoji(user.Settings.LongIDs, texts.SettingsButtonLongIDs),
 				callbackSettingsLongIDs,
 			),
 		),
 		tgbotapi.NewInlineKeyboardRow(
 			tgbotapi.NewInlineKeyboardButtonData(
-				textSettingsButtonChannelsAndChats,
+				texts.SettingsButtonChannelsAndChats,
 				callbackSettingsChannelsAndChats,
 			),
 		),
+		langs,
 	)
 }
 
-func (bot *Bot) newSettingsMenuMessage(msg *tgbotapi.Message, user *core.User) *tgbotapi.MessageConfig {
-	answ := bot.newAnswerMsg(msg, textSettings)
-	answ.ReplyMarkup = bot.newSettingsMenuMessageReplyMarkup(user.Settings.LongIDs)
+func (bot *Bot) newSettingsMenuMessage(
+	texts *i18n.Texts,
+	msg *tgbotapi.Message,
+	user *core.User,
+) *tgbotapi.MessageConfig {
+	answ := bot.newAnswerMsg(msg, texts.Settings)
+	answ.ReplyMarkup = bot.newSettingsMenuMessageReplyMarkup(texts, user)
 	answ.ParseMode = mdv2
 
 	return answ
 }
 
-func (bot *Bot) newSettingsMenuMessageEdit(msg *tgbotapi.Message, user *core.User) tgbotapi.EditMessageTextConfig {
-	answ := tgbotapi.NewEditMessageText(msg.Chat.ID, msg.MessageID, textSettings)
+func (bot *Bot) newSettingsMenuMessageEdit(
+	texts *i18n.Texts,
+	msg *tgbotapi.Message,
+	user *core.User,
+) tgbotapi.EditMessageTextConfig {
+	answ := tgbotapi.NewEditMessageText(msg.Chat.ID, msg.MessageID, texts.Settings)
 
-	markup := bot.newSettingsMenuMessageReplyMarkup(user.Settings.LongIDs)
+	markup := bot.newSettingsMenuMessageReplyMarkup(texts, user)
 
 	answ.ReplyMarkup = &markup
 	answ.ParseMode = mdv2
@@ -88,6 +90,7 @@ func (bot *Bot) newSettingsMenuMessageEdit(msg *tgbotapi.Message, user *core.Use
 
 func (bot *Bot) onSettingsToggleLongIDsCBQ(ctx context.Context, cbq *tgbotapi.CallbackQuery) error {
 	user := getUserCtx(ctx)
+	texts := getTextsCtx(ctx)
 
 	isEnabled, err := bot.authSrv.SettingsToggleLongIDs(ctx, user)
 	if err != nil {
@@ -97,9 +100,9 @@ func (bot *Bot) onSettingsToggleLongIDsCBQ(ctx context.Context, cbq *tgbotapi.Ca
 	var answer string
 
 	if isEnabled {
-		answer = textSettingsButtonLongIDsEnabledAlert
+		answer = texts.SettingsLongIDsEnabled
 	} else {
-		answer = textSettingsButtonLongIDsDisabledAlert
+		answer = texts.SettingsLongIDsDisabled
 	}
 
 	go func() {
@@ -108,14 +111,38 @@ func (bot *Bot) onSettingsToggleLongIDsCBQ(ctx context.Context, cbq *tgbotapi.Ca
 		}
 	}()
 
-	answ := bot.newSettingsMenuMessageEdit(cbq.Message, user)
+	answ := bot.newSettingsMenuMessageEdit(texts, cbq.Message, user)
+	return bot.send(ctx, answ)
+}
+
+func (bot *Bot) onSettingsLanguageCBQ(ctx context.Context, cbq *tgbotapi.CallbackQuery, code string) error {
+	user := getUserCtx(ctx)
+
+	lang, ok := i18n.ParseLang(code)
+	if !ok {
+		return bot.answerCallbackQuery(ctx, cbq, "bad body, what you do?")
+	}
+
+	if err := bot.authSrv.SettingsSetLanguage(ctx, user, string(lang)); err != nil {
+		return errors.Wrap(err, "set settings language")
+	}
+
+	texts := i18n.Get(lang)
+
+	go func() {
+		if err := bot.answerCallbackQuery(ctx, cbq, texts.SettingsLanguageChanged); err != nil {
+			log.Warn(ctx, "cant answer inline query in onSettingsLanguageCBQ", "err", err)
+		}
+	}()
+
+	answ := bot.newSettingsMenuMessageEdit(texts, cbq.Message, user)
 	return bot.send(ctx, answ)
 }
 
 func (bot *Bot) onSettings(ctx context.Context, msg *tgbotapi.Message) error {
 	user := getUserCtx(ctx)
 
-	answ := bot.newSettingsMenuMessage(msg, user)
+	answ := bot.newSettingsMenuMessage(getTextsCtx(ctx), msg, user)
 
 	return bot.send(ctx, answ)
 }
@@ -123,6 +150,6 @@ func (bot *Bot) onSettings(ctx context.Context, msg *tgbotapi.Message) error {
 func (bot *Bot) onSettingsCallbackQuery(ctx context.Context, cbq *tgbotapi.CallbackQuery) error {
 
 	user := getUserCtx(ctx)
-	answ := bot.newSettingsMenuMessageEdit(cbq.Message, user)
+	answ := bot.newSettingsMenuMessageEdit(getTextsCtx(ctx), cbq.Message, user)
 	return bot.send(ctx, answ)
 }
@@ -5,68 +5,16 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/bots-house/share-file-bot/bot/i18n"
 	"github.com/bots-house/share-file-bot/bot/state"
 	"github.com/bots-house/share-file-bot/core"
 	"github.com/bots-house/share-file-bot/pkg/tg"
 	"github.com/bots-house/share-file-bot/service"
 	tgbotapi "github.com/bots-house/telegram-bot-api"
 	"github.com/friendsofgo/errors"
-	"github.com/lithammer/dedent"
 )
 
-func join(vs ...string) string {
-	return strings.Join(vs, "\n")
-}
-
-var (
-	textSettingsChannelsAndChats = dedent.Dedent(`
-		⚙️ __*Настройки*__ / 📢 __*Каналы и чаты*__
-		
-		В данном разделе вы можете управлять подключенными чатами и каналами\. 
-		Это эффективный инструмент для увелечения конверсии, так как позволяет ограничить скачивание файла только подписчиками вашего канала или чата\.
-	`)
-
-	textSettingsChannelsAndChatsConnect = dedent.Dedent(`
-		⚙️ __*Настройки*__ / 📢 __*Каналы и чаты*__ / __*Подключить*__
-
-		Чтобы добавить канал или чат, тебе нужно выполнить следующие действия:
-
-		1\. Добавьте @%s в администратраторы канала или чата с правами «Добавление подписчиков» \(Add User\)\.
-		2\. Отправь мне @username или приватную ссылку на канал или чат, так же ты можешь переслать любое сообщение из канала\.
-	`)
-
-	textSettingsChannelsAndChatsDetails = join(
-		"⚙️ __*Настройки*__ / 📢 __*Каналы и чаты*__ / __*%s*__",
-		"",
-		"*ID:* `%d`",
-		"*Тип:* `%s`",
-		"",
-		"📈 __Статистика__",
-		"",
-		"*Файлов:* `%d`",
-		"*Загрузок с подпиской:* `%d`",
-		"*Загрузок с новой подпиской*: `%d`",
-	)
-
-	textSettingsChannelsAndChatsDelete = dedent.Dedent(`
-		⚙️ __*Настройки*__ / 📢 __*Каналы и чаты*__ / __*%s*__
-
-		Уверены что хотите отвзять этот канал/группу? 
-		Файл для скачивания которых требовалась подписка на этот канал/группу, станут доступны без нее\.
-		Это действие нельзя будет отменить\. 
-	`)
-
-	textSettingsChannelsAndChatsConnectNotValid             = "⚠️ Для подключения канала или чата отправь мне его @username, приватную ссылку или перешли мне любое сообщение из канала"
-	textSettingsChannelsAndChatsConnectIsPrivate            = "⚠️ Нужно отправить @username или приватную ссылку на канал или чат, ты скинул пользователя :)"
-	textSettingsChannelsAndChatsConnectNotFound             = "⚠️ Чат не найден или бот не является админом, добавь бота в администраторы и повтори запрос"
-	textSettingsChannelsAndChatsConnectBotIsNotAdmin        = "⚠️ Бот не установлен администратором чата или канала, добавьте его в администраторы с правами «Добавление подписчиков» (Add User)"
-	textSettingsChannelsAndChatsConnectUserIsNotAdmin       = "⚠️ Ты не являешся администратором данного чата / канала"
-	textSettingsChannelsAndChatsConnectBotIsNotEnoughRights = "⚠️ Бот установлен администратором чата / канала, но ему не хватает прав «Добавление подписчиков» (Add User)"
-	textSettingsChannelsAndChatsConnectChatAlreadyConnected = "👌 Канал / чат уже подключен"
-
-	textSettingsChannelsAndChatsConnectNotValidButtonCancel = "Я передумал"
-	textSettingsChannelsAndChatsButtonConnect               = "+ Подключить"
-
+const (
 	callbackSettingsChannelsAndChats              = "settings:channels-and-chats"
 	callbackSettingsChannelsAndChatsConnect       = "settings:channels-and-chats:connect"
 	callbackSettingsChannelsAndChatsDetails       = "settings:channels-and-chats:%d"
@@ -75,11 +23,12 @@ var (
 )
 
 func (bot *Bot) newSettingsChannelsAndChatsMessageEdit(
+	texts *i18n.Texts,
 	chatID int64,
 	msgID int,
 	chats []*core.Chat,
 ) tgbotapi.EditMessageTextConfig {
-	answ := tgbotapi.NewEditMessageText(chatID, msgID, textSettingsChannelsAndChats)
+	answ := tgbotapi.NewEditMessageText(chatID, msgID, texts.Chats)
 
 	chatRows := make([][]tgbotapi.InlineKeyboardButton, len(chats))
 
@@ -92,11 +41,11 @@ func (bot *Bot) newSettingsChannelsAndChatsMessageEdit(
 
 	chatRows = append(chatRows, tgbotapi.NewInlineKeyboardRow(
 		tgbotapi.NewInlineKeyboardButtonData(
-			textCommonBack,
+			texts.CommonBack,
 			callbackSettings,
 		),
 		tgbotapi.NewInlineKeyboardButtonData(
-			textSettingsChannelsAndChatsButtonConnect,
+			texts.ChatsButtonConnect,
 			callbackSettingsChannelsAndChatsConnect,
 		),
 	))
@@ -127,18 +76,22 @@ func (bot *Bot) onSettingsChannelsAndChats(ctx context.Context, cbq *tgbotapi.Ca
 		return errors.Wrap(err, "get chats")
 	}
 
-	edit := bot.newSettingsChannelsAndChatsMessageEdit(cbq.Message.Chat.ID, cbq.Message.MessageID, chats)
+	edit := bot.newSettingsChannelsAndChatsMessageEdit(getTextsCtx(ctx), cbq.Message.Chat.ID, cbq.Message.MessageID, chats)
 	return bot.send(ctx, edit)
 }
 
-func (bot *Bot) newSettingsChannelsAndChatsConnectEdit(cid int64, mid int) tgbotapi.EditMessageTextConfig {
-	text := fmt.Sprintf(textSettingsChannelsAndChatsConnect, tg.EscapeMD(bot.client.Self.UserName))
+func (bot *Bot) newSettingsChannelsAndChatsConnectEdit(
+	texts *i18n.Texts,
+	cid int64,
+	mid int,
+) tgbotapi.EditMessageTextConfig {
+	text := fmt.Sprintf(texts.ChatsConnect, tg.EscapeMD(bot.client.Self.UserName))
 	answ := tgbotapi.NewEditMessageText(cid, mid, text)
 
 	markup := tgbotapi.NewInlineKeyboardMarkup(
 		tgbotapi.NewInlineKeyboardRow(
 			tgbotapi.NewInlineKeyboardButtonData(
-				textCommonBack,
+				texts.CommonBack,
 				callbackSettingsChannelsAndChats,
 			),
 		),
@@ -155,40 +108,41 @@ func (bot *Bot) onSettingsChannelsAndChatsConnect(ctx context.Context, cbq *tgbo
 		_ = bot.answerCallbackQuery(ctx, cbq, "")
 	}()
 
-	user := getUserCtx(ctx)
-
-	if err := bot.state.Set(ctx, user.ID, state.SettingsChannelsAndChatsConnect); err != nil {
-		return errors.Wrap(err, "can't set state of user")
+	if err := bot.startConversation(ctx, state.SettingsChannelsAndChatsConnect, &state.Data{
+		MessageID: cbq.Message.MessageID,
+	}); err != nil {
+		return errors.Wrap(err, "start conversation")
 	}
 
-	edit := bot.newSettingsChannelsAndChatsConnectEdit(cbq.Message.Chat.ID, cbq.Message.MessageID)
+	edit := bot.newSettingsChannelsAndChatsConnectEdit(getTextsCtx(ctx), cbq.Message.Chat.ID, cbq.Message.MessageID)
 	return bot.send(ctx, edit)
 }
 
-func getChatTypeRussian(typ core.ChatType) string {
+func getChatTypeTitle(texts *i18n.Texts, typ core.ChatType) string {
 	switch typ {
 	case core.ChatTypeChannel:
-		return "канал"
+		return texts.ChatTypeChannel
 	case core.ChatTypeSuperGroup:
-		return "супергруппа"
+		return texts.ChatTypeSuperGroup
 	case core.ChatTypeGroup:
-		return "группа"
+		return texts.ChatTypeGroup
 	default:
-		return "неизвестно"
+		return texts.ChatTypeUnknown
 	}
 }
 
 func (bot *Bot) newSettingsChannelsAndChatsDetailsEdit(
+	texts *i18n.Texts,
 	cid int64, mid int,
 	chat *service.FullChat,
 ) *tgbotapi.EditMessageTextConfig {
 	stats := chat.GetStats()
 
 	text := fmt.Sprintf(
-		textSettingsChannelsAndChatsDetails,
+		texts.ChatsDetails,
 		tg.EscapeMD(chat.Title),
 		chat.TelegramID,
-		getChatTypeRussian(chat.Type),
+		getChatTypeTitle(texts, chat.Type),
 		chat.Files,
 		stats.WithSubscription,
 		stats.NewSubscription,
@@ -199,14 +153,20 @@ func (bot *Bot) newSettingsChannelsAndChatsDetailsEdit(
 	markup := tgbotapi.NewInlineKeyboardMarkup(
 		tgbotapi.NewInlineKeyboardRow(
 			tgbotapi.NewInlineKeyboardButtonData(
-				textCommonBack,
+				texts.CommonBack,
 				callbackSettingsChannelsAndChats,
 			),
 			tgbotapi.NewInlineKeyboardButtonData(
-				textCommonDisconnect,
+				texts.CommonDisconnect,
 				fmt.Sprintf(callbackSettingsChannelsAndChatsDelete, chat.ID),
 			),
 		),
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(
+				texts.StatsButton,
+				fmt.Sprintf(callbackSettingsChannelsAndChatsStats, chat.ID),
+			),
+		),
 	)
 
 	answ.ReplyMarkup = &markup
@@ -230,8 +190,10 @@ func (bot *Bot) onSettingsChannelsAndChatsDeleteConfirm(
 		return errors.Wrap(err, "service disconnect chat")
 	}
 
+	texts := getTextsCtx(ctx)
+
 	go func() {
-		_ = bot.answerCallbackQuery(ctx, cbq, "Канал/группа отключена")
+		_ = bot.answerCallbackQuery(ctx, cbq, texts.ChatsDisconnected)
 	}()
 
 	chats, err := bot.chatSrv.GetChats(ctx, user)
@@ -239,7 +201,7 @@ func (bot *Bot) onSettingsChannelsAndChatsDeleteConfirm(
 		return errors.Wrap(err, "service get chats")
 	}
 
-	edit := bot.newSettingsChannelsAndChatsMessageEdit(cbq.Message.Chat.ID, cbq.Message.MessageID, chats)
+	edit := bot.newSettingsChannelsAndChatsMessageEdit(texts, cbq.Message.Chat.ID, cbq.Message.MessageID, chats)
 
 	return bot.send(ctx, edit)
 }
@@ -256,6 +218,7 @@ func (bot *Bot) onSettingsChannelsAndChatsDelete(
 	}
 
 	return bot.send(ctx, bot.newSettingsChannelsAndChatsDeleteEdit(
+		getTextsCtx(ctx),
 		cbq.Message.Chat.ID,
 		cbq.Message.MessageID,
 		chat,
@@ -263,11 +226,12 @@ func (bot *Bot) onSettingsChannelsAndChatsDelete(
 }
 
 func (bot *Bot) newSettingsChannelsAndChatsDeleteEdit(
+	texts *i18n.Texts,
 	cid int64,
 	mid int,
 	chat *service.FullChat,
 ) tgbotapi.EditMessageTextConfig {
-	text := fmt.Sprintf(textSettingsChannelsAndChatsDelete, tg.EscapeMD(chat.Title))
+	text := fmt.Sprintf(texts.ChatsDelete, tg.EscapeMD(chat.Title))
 	answ := tgbotapi.NewEditMessageText(
 		cid,
 		mid,
@@ -277,11 +241,11 @@ func (bot *Bot) newSettingsChannelsAndChatsDeleteEdit(
 	replyMarkup := tgbotapi.NewInlineKeyboardMarkup(
 		tgbotapi.NewInlineKeyboardRow(
 			tgbotapi.NewInlineKeyboardButtonData(
-				textCommonBack,
+				texts.CommonBack,
 				fmt.Sprintf(callbackSettingsChannelsAndChatsDetails, chat.ID),
 			),
 			tgbotapi.NewInlineKeyboardButtonData(
-				textCommonYesIamSure,
+				texts.CommonYesIamSure,
 				fmt.Sprintf(callbackSettingsChannelsAndChatsDeleteConfirm, chat.ID),
 			),
 		),
@@ -306,6 +270,7 @@ func (bot *Bot) onSettingsChannelsAndChatsDetails(
 	}
 
 	edit := bot.newSettingsChannelsAndChatsDetailsEdit(
+		getTextsCtx(ctx),
 		cbq.Message.Chat.ID,
 		cbq.Message.MessageID,
 		chat,
@@ -318,17 +283,13 @@ func (bot *Bot) onSettingsChannelsAndChatsConnectState(ctx context.Context, msg
 	var identity service.ChatIdentity
 
 	user := getUserCtx(ctx)
+	texts := getTextsCtx(ctx)
 
 	switch {
 	// handle forward
 	case msg.ForwardDate != 0:
 		if msg.ForwardFromChat == nil {
-			return bot.sendText(
-				ctx,
-				user.ID,
-				"⚠️ Пересланное сообщение не является сообщением из канала, для подключения группы или супергруппы отправь мне ее @username или приватную ссылку",
-			)
-
+			return bot.sendText(ctx, user.ID, texts.ChatsConnectNotChannelForward)
 		}
 
 		identity = service.NewChatIdentityFromID(msg.ForwardFromChat.ID)
@@ -344,21 +305,21 @@ func (bot *Bot) onSettingsChannelsAndChatsConnectState(ctx context.Context, msg
 		case tg.ChatInputJoinLink:
 			payload, err := tg.DecodeJoinLinkPayload(val)
 			if err != nil {
-				return bot.sendText(ctx, user.ID, "⚠️ Не могу декодировать приватную ссылку попробуйте переслать сообщение из канала")
+				return bot.sendText(ctx, user.ID, texts.ChatsConnectBadJoinLink)
 			}
 
 			identity = service.NewChatIdentityFromID(payload.BotChatID())
 		default:
-			return bot.sendText(ctx, user.ID, "⚠️ Для подключения канала отправьте ссылку или @username канала / супергруппы, так же вы можете переслать сообщение с канала")
+			return bot.sendText(ctx, user.ID, texts.ChatsConnectBadInput)
 		}
 	// unknown input
 	default:
-		answ := bot.newAnswerMsg(msg, textSettingsChannelsAndChatsConnectNotValid)
+		answ := bot.newAnswerMsg(msg, tg.EscapeMD(texts.ChatsConnectNotValid))
 		answ.ParseMode = mdv2
 		answ.ReplyMarkup = tgbotapi.NewInlineKeyboardMarkup(
 			tgbotapi.NewInlineKeyboardRow(
 				tgbotapi.NewInlineKeyboardButtonData(
-					textSettingsChannelsAndChatsConnectNotValidButtonCancel,
+					texts.ChatsConnectButtonCancel,
 					callbackSettingsChannelsAndChats,
 				),
 			),
@@ -370,38 +331,35 @@ func (bot *Bot) onSettingsChannelsAndChatsConnectState(ctx context.Context, msg
 
 	switch {
 	case err == service.ErrChatIsUser:
-		return bot.sendText(ctx, user.ID, textSettingsChannelsAndChatsConnectIsPrivate)
+		return bot.sendText(ctx, user.ID, texts.ChatsConnectIsPrivate)
 	case err == service.ErrChatNotFoundOrBotIsNotAdmin:
-		return bot.sendText(ctx, user.ID, textSettingsChannelsAndChatsConnectNotFound)
+		return bot.sendText(ctx, user.ID, texts.ChatsConnectNotFound)
 	case err == service.ErrBotIsNotChatAdmin:
-		return bot.sendText(ctx, user.ID, textSettingsChannelsAndChatsConnectBotIsNotAdmin)
+		return bot.sendText(ctx, user.ID, texts.ChatsConnectBotIsNotAdmin)
 	case err == service.ErrBotNotEnoughRights:
-		return bot.sendText(ctx, user.ID, textSettingsChannelsAndChatsConnectBotIsNotEnoughRights)
+		return bot.sendText(ctx, user.ID, texts.ChatsConnectBotNotEnoughRight)
 	case err == service.ErrUserIsNotChatAdmin:
-		return bot.sendText(ctx, user.ID, textSettingsChannelsAndChatsConnectUserIsNotAdmin)
+		return bot.sendText(ctx, user.ID, texts.ChatsConnectUserIsNotAdmin)
 	case err == service.ErrChatAlreadyConnected:
-		if err := bot.state.Set(ctx, user.ID, state.Empty); err != nil {
-			return errors.Wrap(err, "update state")
+		if _, err := bot.endConversation(ctx, false); err != nil {
+			return errors.Wrap(err, "end conversation")
 		}
 
-		return bot.sendText(ctx, user.ID, textSettingsChannelsAndChatsConnectChatAlreadyConnected)
+		return bot.sendText(ctx, user.ID, texts.ChatsConnectAlreadyConnected)
 	case err != nil:
 		return errors.Wrap(err, "add chat")
 	}
 
-	out := tgbotapi.NewMessage(
-		msg.Chat.ID,
-		fmt.Sprintln("Канал / супергруппа подключена! Теперь вы можете установить ограничение на скачивание для всех существующих и новых файлов."),
-	)
+	out := tgbotapi.NewMessage(msg.Chat.ID, texts.ChatsConnected)
 
 	out.ReplyMarkup = tgbotapi.NewInlineKeyboardMarkup(
 		tgbotapi.NewInlineKeyboardRow(
-			tgbotapi.NewInlineKeyboardButtonData(textCommonBack, callbackSettingsChannelsAndChats),
+			tgbotapi.NewInlineKeyboardButtonData(texts.CommonBack, callbackSettingsChannelsAndChats),
 		),
 	)
 
-	if err := bot.state.Set(ctx, user.ID, state.Empty); err != nil {
-		return errors.Wrap(err, "update state")
+	if _, err := bot.endConversation(ctx, false); err != nil {
+		return errors.Wrap(err, "end conversation")
 	}
 
 	return bot.send(ctx, out)
@@ -0,0 +1,218 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/bots-house/share-file-bot/bot/i18n"
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/pkg/tg"
+	"github.com/bots-house/share-file-bot/service"
+	tgbotapi "github.com/bots-house/telegram-bot-api"
+	"github.com/friendsofgo/errors"
+)
+
+const (
+	callbackFileStats                     = "file:%d:stats"
+	callbackSettingsChannelsAndChatsStats = "settings:channels-and-chats:%d:stats"
+
+	// id, format
+	callbackFileExport                     = "file:%d:export:%d"
+	callbackSettingsChannelsAndChatsExport = "settings:channels-and-chats:%d:export:%d"
+)
+
+var sparklineBars = []rune("▁▂▃▄▅▆▇█")
+
+// statsPlacementsMax is max count of placements on statistics screen.
+const statsPlacementsMax = 5
+
+// renderSparkline renders total downloads of series as one line bar chart.
+func renderSparkline(series []*core.DownloadSeriesPoint) string {
+	max := 0
+	for _, point := range series {
+		if point.Total > max {
+			max = point.Total
+		}
+	}
+
+	result := make([]rune, len(series))
+
+	for i, point := range series {
+		idx := 0
+		if max > 0 {
+			idx = point.Total * (len(sparklineBars) - 1) / max
+		}
+		result[i] = sparklineBars[idx]
+	}
+
+	return string(result)
+}
+
+func renderStatsChange(current, previous int) string {
+	change, ok := service.PercentChange(current, previous)
+	if !ok {
+		return "—"
+	}
+
+	if change > 0 {
+		return fmt.Sprintf("+%d%%", change)
+	}
+
+	return fmt.Sprintf("%d%%", change)
+}
+
+func renderStatsValue(title string, current, previous int) string {
+	return fmt.Sprintf("*%s*: `%d` \\(%s\\)",
+		title,
+		current,
+		tg.EscapeMD(renderStatsChange(current, previous)),
+	)
+}
+
+func renderDownloadStats(texts *i18n.Texts, stats *service.DownloadStats) []string {
+	rows := []string{}
+
+	for _, period := range stats.Periods {
+		rows = append(rows,
+			texts.Plural(texts.StatsPeriod, period.Days),
+			"",
+			renderStatsValue(texts.StatsValueDownloads, period.Current.Total, period.Previous.Total),
+			renderStatsValue(texts.StatsValueUnique, period.Current.Unique, period.Previous.Unique),
+			renderStatsValue(texts.StatsValueNewSubscriptions, period.Current.NewSubscription, period.Previous.NewSubscription),
+			"",
+		)
+	}
+
+	rows = append(rows,
+		texts.StatsNote,
+		"",
+		texts.StatsDaily,
+		"`"+renderSparkline(stats.Daily)+"`",
+		texts.StatsHourly,
+		"`"+renderSparkline(stats.Hourly)+"`",
+	)
+
+	if len(stats.Placements) > 0 {
+		rows = append(rows, "", texts.StatsPlacements)
+
+		// caption is limited, so only top placements are displayed
+		placements := append([]*service.PlacementStats{}, stats.Placements...)
+		sort.SliceStable(placements, func(i, j int) bool {
+			return placements[i].Total > placements[j].Total
+		})
+
+		if len(placements) > statsPlacementsMax {
+			placements = placements[:statsPlacementsMax]
+		}
+
+		for _, item := range placements {
+			rows = append(rows, renderPlacementStats(texts, item))
+		}
+	}
+
+	return rows
+}
+
+func renderDownloadStatsReplyMarkup(
+	texts *i18n.Texts,
+	back string,
+	export string,
+	id int,
+) tgbotapi.InlineKeyboardMarkup {
+	return tgbotapi.NewInlineKeyboardMarkup(
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(
+				"⬇️ CSV",
+				fmt.Sprintf(export, id, service.ExportFormatCSV),
+			),
+			tgbotapi.NewInlineKeyboardButtonData(
+				"⬇️ JSON",
+				fmt.Sprintf(export, id, service.ExportFormatJSON),
+			),
+		),
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(texts.CommonBack, back),
+		),
+	)
+}
+
+func (bot *Bot) onFileStatsCBQ(ctx context.Context, cbq *tgbotapi.CallbackQuery, id core.FileID) error {
+	user := getUserCtx(ctx)
+	texts := getTextsCtx(ctx)
+
+	stats, err := bot.fileSrv.GetDownloadStats(ctx, user, id)
+	if errors.Is(err, core.ErrFileNotFound) {
+		return bot.answerCallbackQueryAlert(ctx, cbq, texts.FileDeletedBefore)
+	} else if err != nil {
+		return errors.Wrap(err, "get download stats")
+	}
+
+	rows := append([]string{
+		texts.StatsFileTitle,
+		"",
+	}, renderDownloadStats(texts, stats)...)
+
+	markup := renderDownloadStatsReplyMarkup(
+		texts,
+		fmt.Sprintf(callbackFileRefresh, id),
+		callbackFileExport,
+		int(id),
+	)
+
+	edit := newEditFileCard(cbq.Message, strings.Join(rows, "\n"), mdv2, markup)
+
+	go func() {
+		_ = bot.answerCallbackQuery(ctx, cbq, "")
+	}()
+
+	return bot.send(ctx, edit)
+}
+
+func (bot *Bot) onSettingsChannelsAndChatsStats(
+	ctx context.Context,
+	user *core.User,
+	cbq *tgbotapi.CallbackQuery,
+	id core.ChatID,
+) error {
+	chat, err := bot.chatSrv.GetChat(ctx, user, id)
+	if err != nil {
+		return errors.Wrap(err, "Chat.GetChat")
+	}
+
+	stats, err := bot.chatSrv.GetDownloadStats(ctx, user, chat.ID)
+	if err != nil {
+		return errors.Wrap(err, "Chat.GetDownloadStats")
+	}
+
+	texts := getTextsCtx(ctx)
+
+	rows := append([]string{
+		fmt.Sprintf(texts.StatsChatTitle, tg.EscapeMD(chat.Title)),
+		"",
+	}, renderDownloadStats(texts, stats)...)
+
+	edit := tgbotapi.NewEditMessageText(
+		cbq.Message.Chat.ID,
+		cbq.Message.MessageID,
+		strings.Join(rows, "\n"),
+	)
+
+	edit.ParseMode = mdv2
+
+	markup := renderDownloadStatsReplyMarkup(
+		texts,
+		fmt.Sprintf(callbackSettingsChannelsAndChatsDetails, chat.ID),
+		callbackSettingsChannelsAndChatsExport,
+		int(chat.ID),
+	)
+
+	edit.ReplyMarkup = &markup
+
+	go func() {
+		_ = bot.answerCallbackQuery(ctx, cbq, "")
+	}()
+
+	return bot.send(ctx, edit)
+}
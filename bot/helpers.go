@@ -3,8 +3,10 @@ package bot
 import (
 	"context"
 	"net/url"
+	"strings"
 
 	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/pkg/tg"
 	"github.com/bots-house/share-file-bot/service"
 	tgbotapi "github.com/bots-house/telegram-bot-api"
 	"github.com/friendsofgo/errors"
@@ -26,6 +28,52 @@ func getURLsFromMessageEntities(entities *[]tgbotapi.MessageEntity) []string {
 	return result
 }
 
+// getTextEntities converts formatting entities of message to stored form.
+func getTextEntities(entities *[]tgbotapi.MessageEntity) []core.TextEntity {
+	if entities == nil {
+		return nil
+	}
+
+	result := make([]core.TextEntity, 0, len(*entities))
+
+	for _, entity := range *entities {
+		item := core.TextEntity{
+			Type:   entity.Type,
+			Offset: entity.Offset,
+			Length: entity.Length,
+			URL:    entity.URL,
+		}
+
+		if entity.User != nil {
+			item.UserID = core.UserID(entity.User.ID)
+		}
+
+		result = append(result, item)
+	}
+
+	return result
+}
+
+// renderFileCaption returns caption of file as MarkdownV2 with original formatting.
+func renderFileCaption(file *core.File) string {
+	entities := make([]tgbotapi.MessageEntity, len(file.CaptionEntities))
+
+	for i, entity := range file.CaptionEntities {
+		entities[i] = tgbotapi.MessageEntity{
+			Type:   entity.Type,
+			Offset: entity.Offset,
+			Length: entity.Length,
+			URL:    entity.URL,
+		}
+
+		if entity.UserID != 0 {
+			entities[i].User = &tgbotapi.User{ID: int(entity.UserID)}
+		}
+	}
+
+	return tg.FormatMD(file.Caption.String, entities)
+}
+
 func getURLsFromMessageReplyMarkup(rm *tgbotapi.InlineKeyboardMarkup) []string {
 	if rm == nil {
 		return []string{}
@@ -106,6 +154,14 @@ func (bot *Bot) detectKind(msg *tgbotapi.Message) core.Kind {
 		return core.KindVoice
 	case msg.Document != nil:
 		return core.KindDocument
+	case msg.Sticker != nil:
+		return core.KindSticker
+	case msg.VideoNote != nil:
+		return core.KindVideoNote
+	case msg.Contact != nil:
+		return core.KindContact
+	case msg.Location != nil:
+		return core.KindLocation
 	default:
 		return core.KindUnknown
 	}
@@ -115,54 +171,89 @@ func (bot *Bot) extractInputFileFromMessage(msg *tgbotapi.Message) *service.Inpu
 	switch kind := bot.detectKind(msg); kind {
 	case core.KindDocument:
 		return &service.InputFile{
-			FileID:   msg.Document.FileID,
-			Caption:  msg.Caption,
-			Kind:     kind,
-			MIMEType: msg.Document.MimeType,
-			Size:     msg.Document.FileSize,
-			Name:     msg.Document.FileName,
+			FileID:          msg.Document.FileID,
+			Caption:         msg.Caption,
+			CaptionEntities: getTextEntities(msg.CaptionEntities),
+			Kind:            kind,
+			MIMEType:        msg.Document.MimeType,
+			Size:            msg.Document.FileSize,
+			Name:            msg.Document.FileName,
 		}
 	case core.KindAnimation:
 		return &service.InputFile{
-			FileID:   msg.Animation.FileID,
-			Caption:  msg.Caption,
-			Kind:     kind,
-			MIMEType: msg.Animation.MimeType,
-			Size:     msg.Animation.FileSize,
+			FileID:          msg.Animation.FileID,
+			Caption:         msg.Caption,
+			CaptionEntities: getTextEntities(msg.CaptionEntities),
+			Kind:            kind,
+			MIMEType:        msg.Animation.MimeType,
+			Size:            msg.Animation.FileSize,
 		}
 	case core.KindAudio:
 		return &service.InputFile{
-			FileID:   msg.Audio.FileID,
-			Caption:  msg.Caption,
-			Kind:     core.KindAudio,
-			Metadata: core.NewMetadataAudio(msg.Audio.Title, msg.Audio.Performer),
-			MIMEType: msg.Audio.MimeType,
-			Size:     msg.Audio.FileSize,
+			FileID:          msg.Audio.FileID,
+			Caption:         msg.Caption,
+			CaptionEntities: getTextEntities(msg.CaptionEntities),
+			Kind:            core.KindAudio,
+			Metadata:        core.NewMetadataAudio(msg.Audio.Title, msg.Audio.Performer),
+			MIMEType:        msg.Audio.MimeType,
+			Size:            msg.Audio.FileSize,
 		}
 	case core.KindPhoto:
 		total := len(*msg.Photo)
 
 		return &service.InputFile{
-			FileID:  (*msg.Photo)[total-1].FileID,
-			Caption: msg.Caption,
-			Kind:    core.KindPhoto,
-			Size:    (*msg.Photo)[total-1].FileSize,
+			FileID:          (*msg.Photo)[total-1].FileID,
+			Caption:         msg.Caption,
+			CaptionEntities: getTextEntities(msg.CaptionEntities),
+			Kind:            core.KindPhoto,
+			Size:            (*msg.Photo)[total-1].FileSize,
 		}
 	case core.KindVideo:
 		return &service.InputFile{
-			FileID:   msg.Video.FileID,
-			Caption:  msg.Caption,
-			Kind:     core.KindVideo,
-			Size:     msg.Video.FileSize,
-			MIMEType: msg.Video.MimeType,
+			FileID:          msg.Video.FileID,
+			Caption:         msg.Caption,
+			CaptionEntities: getTextEntities(msg.CaptionEntities),
+			Kind:            core.KindVideo,
+			Size:            msg.Video.FileSize,
+			MIMEType:        msg.Video.MimeType,
 		}
 	case core.KindVoice:
 		return &service.InputFile{
-			FileID:   msg.Voice.FileID,
-			Caption:  msg.Caption,
-			Kind:     core.KindVoice,
-			Size:     msg.Voice.FileSize,
-			MIMEType: msg.Voice.MimeType,
+			FileID:          msg.Voice.FileID,
+			Caption:         msg.Caption,
+			CaptionEntities: getTextEntities(msg.CaptionEntities),
+			Kind:            core.KindVoice,
+			Size:            msg.Voice.FileSize,
+			MIMEType:        msg.Voice.MimeType,
+		}
+	case core.KindSticker:
+		return &service.InputFile{
+			FileID:   msg.Sticker.FileID,
+			Kind:     core.KindSticker,
+			Metadata: core.NewMetadataSticker(msg.Sticker.SetName, msg.Sticker.Emoji),
+			Size:     msg.Sticker.FileSize,
+		}
+	case core.KindVideoNote:
+		return &service.InputFile{
+			FileID:   msg.VideoNote.FileID,
+			Kind:     core.KindVideoNote,
+			Metadata: core.NewMetadataVideoNote(msg.VideoNote.Length, msg.VideoNote.Duration),
+			Size:     msg.VideoNote.FileSize,
+		}
+	case core.KindContact:
+		return &service.InputFile{
+			Kind: core.KindContact,
+			Metadata: core.NewMetadataContact(
+				msg.Contact.PhoneNumber,
+				msg.Contact.FirstName,
+				msg.Contact.LastName,
+			),
+			Name: strings.TrimSpace(msg.Contact.FirstName + " " + msg.Contact.LastName),
+		}
+	case core.KindLocation:
+		return &service.InputFile{
+			Kind:     core.KindLocation,
+			Metadata: core.NewMetadataLocation(msg.Location.Latitude, msg.Location.Longitude),
 		}
 	default:
 		return nil
@@ -0,0 +1,81 @@
+package bot
+
+import (
+	"testing"
+
+	"github.com/bots-house/share-file-bot/core"
+	tgbotapi "github.com/bots-house/telegram-bot-api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtractInputFileFromMessage(t *testing.T) {
+	bot := &Bot{}
+
+	for _, test := range []struct {
+		Name     string
+		Message  *tgbotapi.Message
+		Kind     core.Kind
+		FileID   string
+		FileName string
+		Metadata core.Metadata
+	}{
+		{
+			Name: "Sticker",
+			Message: &tgbotapi.Message{
+				Sticker: &tgbotapi.Sticker{FileID: "sticker", SetName: "set", Emoji: "😀"},
+			},
+			Kind:     core.KindSticker,
+			FileID:   "sticker",
+			Metadata: core.NewMetadataSticker("set", "😀"),
+		},
+		{
+			Name: "VideoNote",
+			Message: &tgbotapi.Message{
+				VideoNote: &tgbotapi.VideoNote{FileID: "video-note", Length: 240, Duration: 15},
+			},
+			Kind:     core.KindVideoNote,
+			FileID:   "video-note",
+			Metadata: core.NewMetadataVideoNote(240, 15),
+		},
+		{
+			Name: "Contact",
+			Message: &tgbotapi.Message{
+				Contact: &tgbotapi.Contact{PhoneNumber: "+10000000000", FirstName: "John"},
+			},
+			Kind:     core.KindContact,
+			FileName: "John",
+			Metadata: core.NewMetadataContact("+10000000000", "John", ""),
+		},
+		{
+			Name: "Location",
+			Message: &tgbotapi.Message{
+				Location: &tgbotapi.Location{Latitude: 50.4501, Longitude: 30.5234},
+			},
+			Kind:     core.KindLocation,
+			Metadata: core.NewMetadataLocation(50.4501, 30.5234),
+		},
+	} {
+		test := test
+
+		t.Run(test.Name, func(t *testing.T) {
+			in := bot.extractInputFileFromMessage(test.Message)
+			if !assert.NotNil(t, in) {
+				return
+			}
+
+			assert.Equal(t, test.Kind, in.Kind)
+			assert.Equal(t, test.FileID, in.FileID)
+			assert.Equal(t, test.FileName, in.Name)
+			assert.Equal(t, test.Metadata, in.Metadata)
+
+			out := bot.renderGenericFile(1, &core.File{
+				Kind:       in.Kind,
+				TelegramID: in.FileID,
+				Metadata:   in.Metadata,
+			}, "", "", nil)
+			assert.NotNil(t, out)
+		})
+	}
+
+	assert.Nil(t, bot.extractInputFileFromMessage(&tgbotapi.Message{Text: "text"}))
+}
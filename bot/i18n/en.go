@@ -0,0 +1,627 @@
+package i18n
+
+import "github.com/lithammer/dedent"
+
+var en = &Texts{
+	Lang:     LangEnglish,
+	LangName: "🇬🇧 English",
+
+	CommonBack:           "« Back",
+	CommonDisconnect:     "Disconnect",
+	CommonYesIamSure:     "Yes, I'm sure",
+	CommonYes:            "Yes, I'm sure",
+	CommonNo:             "No",
+	CommonRefresh:        "Refresh",
+	CommonDelete:         "Delete",
+	CommonCancel:         "Cancel",
+	CommonRestrictions:   "Restrictions",
+	CommonNothingChanged: "🤷 Nothing changed",
+
+	CancelDone:    "❌ Canceled",
+	CancelNothing: "🤷 Nothing to cancel",
+
+	Hello: "Hi\\! 👋\n",
+	Help: dedent.Dedent(`
+		I will help you share any media file \(photos, videos, documents, audio, voice messages\) with subscribers of your channel\.
+		Send me any of these files and I will reply with a link\. It's better to add a caption, so people remember who shared it\.
+		You can also connect your channel or chat and restrict access to the file to its subscribers only\.
+
+		/files \- list of uploaded files
+		/bundle \- share several files by one link
+		/settings \- fine\-tuning
+		/cancel \- cancel current action
+
+		You can send your files to any chat using inline mode: type my username and part of the file name in the message field\.
+
+		Support: @share\_file\_support
+		News and updates: @share\_file\_news
+	`),
+
+	ButtonAbout: "What is this bot?",
+
+	UnsupportedFileKind:   "Unfortunately, I don't support this type of files. For now I can work only with documents, videos, photos, audio, voice and video messages, stickers, contacts and locations. Send or forward me a message of one of these types and I will reply with a link.",
+	UploadOnlyAdmins:      "✋ Only admins of the bot can upload files",
+	FileViolatesCopyright: "😐 Unfortunately, we received a complaint from the copyright holder about this file and had to delete it.",
+	BlockedByAdmin:        "🚫 Access by this link was blocked by the administration of the bot",
+	CantCheckMembership:   "🙅‍♂️ I can't give you the file, because I'm no longer an admin of the channel required for subscription. Contact the owner of the file and say hi from me!",
+
+	FileCaptionDescription: "💬 __Description__",
+	FileCaptionPublicLink:  "🔗 __Public link__",
+	FileCaptionLinkedPost:  "Linked post: [%s](%s)",
+	FileCaptionStats:       "📈 __Statistics__",
+	StatsDownloads:         "*Downloads*: `%d`",
+	StatsUniqueDownloads:   "*Unique downloads*: `%d`",
+	StatsWithSubscription:  "*Downloads with subscription*: `%d`",
+	StatsNewSubscription:   "*Downloads with new subscription*: `%d`",
+
+	FileDeleteConfirm: join(
+		"Are you sure you want to *delete* this file?",
+		"",
+		"Users will no longer be able to get the document by the link.",
+		"But users who already downloaded the document will keep it in the chat history with the bot.",
+	),
+
+	FileNotSupported:   "⚠️ Oops, I can't add this file, because I don't support it",
+	FileAudioDisabled:  "⚠️ Oops, I can't add this file, because audio support is temporarily disabled",
+	FileAddFailed:      "⚠️ Something went wrong while adding the file",
+	FileDeletedBefore:  "The file was deleted earlier",
+	FileDeleted:        "✅ Document deleted",
+	FileDeletedByOwner: "🙅‍♂️ The file was deleted by its owner",
+	FilePostButton:     "« Channel post",
+
+	FileRestrictions: dedent.Dedent(`
+		With this tool you can restrict access to the file to subscribers of your channel / supergroup only\.
+		Before each download the bot checks the subscription and only then gives access to the file\.
+
+		If several channels are selected, you can require subscription to all of them or to any of them\.
+
+		You can also limit the number of downloads and the time the file is available\.
+
+		_To connect channels go to settings \(/settings\)\._
+	`),
+
+	ChatPolicyAny:       "🔀 Subscription: any channel",
+	ChatPolicyAll:       "🔀 Subscription: all channels",
+	RestrictionDisabled: "Download restriction disabled",
+	RestrictionSet:      "Restriction set",
+
+	SubRequest: dedent.Dedent(`
+		The owner of the file allowed access only with a subscription\.
+		Subscribe to %s and press *«I subscribed»*
+	`),
+	SubRequestAll: dedent.Dedent(`
+		The owner of the file allowed access only with a subscription\.
+		Subscribe to all channels from the list and press *«I subscribed»*
+
+		%s
+	`),
+	SubRequestAny: dedent.Dedent(`
+		The owner of the file allowed access only with a subscription\.
+		Subscribe to any channel from the list and press *«I subscribed»*
+
+		%s
+	`),
+
+	SubRequestButtonSubscribe:   "Subscribe",
+	SubRequestButtonSubscribeTo: "Subscribe to %s",
+	SubRequestButtonCheck:       "I subscribed",
+
+	FileNotSubscribed:    "I don't see you among subscribers, subscribe to get access to the file",
+	FileAccessGranted:    "🔓 Access to the file granted",
+	FilePasswordExpired:  "🔑 The entered password has expired, open the link to the file again",
+	FileNotAvailableYet:  "⏳ The file will be available from %s",
+	FileExpired:          "⌛️ The link to the file has expired",
+	FileLimitReached:     "🚫 Downloads limit of the file is reached",
+	FileUserLimitReached: "🚫 You have already received this file the maximum allowed number of times",
+
+	LimitDisable:  "Disable",
+	LimitDisabled: "Restriction disabled",
+	LimitNone:     "no",
+	LimitHours: Plural{
+		One:  "%d hour",
+		Few:  "%d hours",
+		Many: "%d hours",
+	},
+	LimitDays: Plural{
+		One:  "%d day",
+		Few:  "%d days",
+		Many: "%d days",
+	},
+	LimitMaxDownloadsButton:        "📊 Downloads limit: %s",
+	LimitMaxDownloadsPerUserButton: "👤 Per user: %s",
+	LimitExpiresAtButton:           "⌛️ Available until: %s",
+	LimitAvailableFromButton:       "⏳ Available from: %s",
+
+	LimitMaxDownloads: dedent.Dedent(`
+		📊 *Downloads limit*
+
+		After the specified number of downloads the file becomes unavailable for all users\.
+	`),
+	LimitMaxDownloadsPerUser: dedent.Dedent(`
+		👤 *Downloads limit per user*
+
+		How many times one user can get the file\.
+	`),
+	LimitExpiresAt: dedent.Dedent(`
+		⌛️ *Expiration*
+
+		After the specified time the file becomes unavailable\. Countdown starts when the restriction is set\.
+	`),
+	LimitAvailableFrom: dedent.Dedent(`
+		⏳ *Available from*
+
+		The file is unavailable until the specified time\. Countdown starts when the restriction is set\.
+	`),
+
+	LimitsCaption:              "⏳ __Restrictions__",
+	LimitsCaptionDownloadsLeft: "*Downloads left*: `%d of %d`",
+	LimitsCaptionPerUser:       "*Downloads per user*: `%d`",
+	LimitsCaptionAvailableFrom: "*Available from*: `%s`",
+	LimitsCaptionExpired:       "*Expired*: `%s`",
+	LimitsCaptionExpiresAt:     "*Available until*: `%s`",
+	LimitsCaptionPassword:      "*Password*: `set`",
+
+	PasswordRequest: "🔑 The owner protected the file with a password\\. Send me the password to get the file\\.",
+	PasswordSet: dedent.Dedent(`
+		🔑 Send me a new password for the file\.
+
+		_The message with the password will be deleted from the chat history\._
+	`),
+	PasswordRestrictions: dedent.Dedent(`
+		🔑 *Password*
+
+		The file is given only to users who know the password\.
+		The number of wrong attempts is limited\.
+	`),
+
+	PasswordCanceled:      "Password input canceled",
+	PasswordNotAwait:      "Password is no longer awaited, open the link to the file again",
+	PasswordExceeded:      "🚫 Too many wrong attempts, try again later",
+	PasswordInvalid:       "❌ Wrong password, attempts left: %d",
+	PasswordOnlyText:      "⚠️ Password should be sent as a text message",
+	PasswordSetDone:       "🔑 Password set, now the file can be received only after entering it",
+	PasswordDisabled:      "Password disabled",
+	PasswordBadLength:     "⚠️ Password should contain from 1 to %d characters",
+	PasswordFileNotFound:  "File not found, probably it was deleted",
+	PasswordValueSet:      "set",
+	PasswordButton:        "🔑 Password: %s",
+	PasswordButtonSet:     "Set password",
+	PasswordButtonChange:  "Change password",
+	PasswordButtonDisable: "Disable",
+
+	PlacementsButton: "📍 Placements",
+	PlacementsTitle: dedent.Dedent(`
+		📍 *Placements*
+
+		Separate links to the file for each place where you publish it, for example a channel post or a forum thread\. Downloads are counted for each link\.
+	`),
+	PlacementsEmpty:      "_No placements yet_",
+	PlacementsItem:       "• *%s*: `%d` downloads, `%d` unique",
+	PlacementsDirectName: "Direct link",
+	PlacementsAddButton:  "➕ Add placement",
+	PlacementTitle:       "📍 *%s*",
+	PlacementLink:        "*Link*: %s",
+	PlacementDownloads:   "*Downloads*: `%d`, *unique*: `%d`",
+	PlacementAdd:         "📍 Send me a name of the placement, for example _reddit_ or _channel\\-A_",
+	PlacementAdded:       "📍 Placement *%s* added, link: %s",
+
+	PlacementDeleted:  "Placement deleted, its downloads are counted as direct",
+	PlacementNotFound: "Placement not found",
+	PlacementBadName:  "⚠️ Name should contain from 1 to %d characters",
+	PlacementsLimit:   "⚠️ A file can have at most %d placements",
+	PlacementNotAwait: "Placement name is no longer awaited",
+	PlacementOnlyText: "⚠️ Name should be sent as a text message",
+	PlacementCanceled: "Canceled",
+
+	FileEditButton: "✏️ Edit",
+	FileEdit: dedent.Dedent(`
+		✏️ *Edit*
+
+		Change caption, name of the file in your library or linked channel post\.
+	`),
+	FileEditButtonCaption:     "Caption",
+	FileEditButtonName:        "Name",
+	FileEditButtonPost:        "Linked post",
+	FileEditButtonCaptionDrop: "Remove caption",
+	FileEditButtonPostDrop:    "Unlink post",
+
+	FileEditCaptionPrompt:  "Send me a new caption of the file, formatting is kept",
+	FileEditNamePrompt:     "Send me a new name of the file, it's shown in your library and search",
+	FileEditPostPrompt:     "Send me a link to the channel post, for example https://t.me/channel/10, or forward the post",
+	FileEditOnlyText:       "⚠️ Send it as a text message",
+	FileEditCaptionTooLong: "⚠️ Caption should contain at most %d characters",
+	FileEditNameInvalid:    "⚠️ Name should be a single line from 1 to %d characters",
+	FileEditPostInvalid:    "⚠️ It's not a link to a channel post, send a link like https://t.me/channel/10 or forward the post",
+	FileEditCaptionDropped: "Caption removed",
+	FileEditPostDropped:    "Post unlinked",
+
+	FileVersionsButton: "🗂 Versions",
+	FileVersions: dedent.Dedent(`
+		🗂 *Versions*
+
+		Upload a new version of the file to keep its public link, caption, restrictions and stats\. Previous versions can be restored\.
+	`),
+	FileVersionsCurrent:       "*Current version*: `v%d`, `%d` downloads, `%d` unique",
+	FileVersionsEmpty:         "_No previous versions yet_",
+	FileVersionsItem:          "• *v%d* %s, replaced %s: `%d` downloads, `%d` unique",
+	FileVersionsMore:          "_and %d older_",
+	FileVersionsUploadButton:  "⬆️ Upload new version",
+	FileVersionsRestoreButton: "↩️ Restore v%d",
+
+	FileReplacePrompt:   "⬆️ Send me a new version of the file, public link, caption, restrictions and stats will be kept",
+	FileReplaceOnlyFile: "⚠️ Send a new version as a file",
+	FileVersionRestored: "Restored as v%d",
+	FileVersionNotFound: "Version not found",
+
+	FileLinkButton: "🔗 New link",
+	FileLink: dedent.Dedent(`
+		🔗 *New link*
+
+		The file will get a new public link\. Choose how long the current link keeps working, after that it will say the link was revoked\.
+	`),
+	FileLinkGraceNow:         "Revoke current link now",
+	FileLinkGraceButton:      "Keep current link for %s",
+	FileLinkRegenerated:      "New link is ready, the previous one is revoked",
+	FileLinkRegeneratedGrace: "New link is ready, the previous one works until %s",
+	FileLinkRevoked:          "🔗 This link was revoked by the owner of the file. Ask them for a new one.",
+
+	FileLinkCustomButton:   "✍️ Custom link",
+	FileLinkCustomPrompt:   "✍️ Send me a custom link, for example spring_catalog. It can contain latin letters, digits, _ and - (from %d to %d characters). The current link will keep working for %s",
+	FileLinkCustomInvalid:  "⚠️ Link can contain only latin letters, digits, _ and - (from %d to %d characters), it can't start with ref_ and end with - and digits",
+	FileLinkCustomTaken:    "⚠️ This link is already taken, try another one",
+	FileLinkCustomReserved: "⚠️ This link is reserved, try another one",
+	FileLinkCustomSame:     "⚠️ It's the current link of the file, send another one",
+
+	FilesEmpty: "You have no files yet\\. Send me any file and I will reply with a link\\.",
+	FilesTitle: "📂 *My files*",
+	FilesFound: "*Found*: `%d`",
+	FilesQuery: "*Search*: `%s`",
+
+	FilesNotFound:          "Nothing found, try to change filters",
+	FilesSearchRequest:     "🔍 Send text to search by name and caption of files",
+	FilesSearchLength:      "⚠️ Query should contain at most %d characters",
+	FilesOrderCreatedAt:    "↕️ By date",
+	FilesOrderDownloads:    "↕️ By downloads",
+	FilesKindFilter:        "🗂 Type: %s",
+	FilesSearchButton:      "🔍 Search",
+	FilesSearchResetButton: "✖️ Reset search",
+	FileUntitled:           "File #%d",
+
+	KindAll:       "all",
+	KindDocument:  "documents",
+	KindPhoto:     "photos",
+	KindVideo:     "videos",
+	KindAnimation: "GIF",
+	KindAudio:     "audio",
+	KindVoice:     "voice",
+	KindSticker:   "stickers",
+	KindVideoNote: "video messages",
+	KindContact:   "contacts",
+	KindLocation:  "locations",
+
+	InlineButtonDownload: "📥 Get file",
+	InlineSwitchPM:       "Upload file to the bot",
+
+	StatsPeriod: Plural{
+		One:  "🗓 __Last %d day__",
+		Few:  "🗓 __Last %d days__",
+		Many: "🗓 __Last %d days__",
+	},
+	StatsNote:       "Change relative to the previous period is in brackets\\.",
+	StatsDaily:      "*By days*:",
+	StatsHourly:     "*By hours*:",
+	StatsFileTitle:  "📊 __Downloads statistics__",
+	StatsChatTitle:  "⚙️ __*Settings*__ / 📢 __*Channels and chats*__ / __*%s*__ / __*Statistics*__",
+	StatsPlacements: "*By placements*:",
+
+	StatsButton:                "📊 Statistics",
+	StatsValueDownloads:        "Downloads",
+	StatsValueUnique:           "Unique",
+	StatsValueNewSubscriptions: "With new subscription",
+
+	ExportEmpty:   "No downloads yet, nothing to export",
+	ExportCaption: "Downloads: %d",
+
+	Settings: dedent.Dedent(`
+		⚙️ __*Settings*__
+
+		• _Long IDs_ — the bot will generate links of maximum possible length, ideal for private files\. Long links are generated only for new documents\.
+
+		• _Channels and chats_ — management of channels and chats connected to the bot to restrict downloads of your files\.
+
+		• _Language_ — language of the bot interface\. Language of your Telegram app is used by default\.
+	`),
+
+	SettingsButtonLongIDs:          "Long IDs",
+	SettingsLongIDsEnabled:         "Generation of long links enabled",
+	SettingsLongIDsDisabled:        "Generation of long links disabled",
+	SettingsButtonChannelsAndChats: "📢 Channels and chats",
+	SettingsLanguageChanged:        "Language changed",
+
+	Chats: dedent.Dedent(`
+		⚙️ __*Settings*__ / 📢 __*Channels and chats*__
+
+		Here you can manage connected chats and channels\.
+		It's an effective tool to increase conversion, because it allows to restrict downloads of the file to subscribers of your channel or chat\.
+	`),
+	ChatsConnect: dedent.Dedent(`
+		⚙️ __*Settings*__ / 📢 __*Channels and chats*__ / __*Connect*__
+
+		To add a channel or chat, do the following:
+
+		1\. Add @%s to admins of the channel or chat with «Add Users» permission\.
+		2\. Send me @username or private link of the channel or chat, you can also forward any message from the channel\.
+
+		Send /cancel to stop connecting\.
+	`),
+	ChatsDetails: join(
+		"⚙️ __*Settings*__ / 📢 __*Channels and chats*__ / __*%s*__",
+		"",
+		"*ID:* `%d`",
+		"*Type:* `%s`",
+		"",
+		"📈 __Statistics__",
+		"",
+		"*Files:* `%d`",
+		"*Downloads with subscription:* `%d`",
+		"*Downloads with new subscription*: `%d`",
+	),
+	ChatsDelete: dedent.Dedent(`
+		⚙️ __*Settings*__ / 📢 __*Channels and chats*__ / __*%s*__
+
+		Are you sure you want to disconnect this channel/group?
+		Files which required subscription to this channel/group will become available without it\.
+		This action can't be undone\.
+	`),
+
+	ChatsButtonConnect:            "+ Connect",
+	ChatsConnectButtonCancel:      "Never mind",
+	ChatsConnectNotValid:          "⚠️ To connect a channel or chat send me its @username, private link or forward me any message from the channel",
+	ChatsConnectIsPrivate:         "⚠️ You should send @username or private link of a channel or chat, you sent a user :)",
+	ChatsConnectNotFound:          "⚠️ Chat not found or the bot is not an admin, add the bot to admins and try again",
+	ChatsConnectBotIsNotAdmin:     "⚠️ The bot is not an admin of the chat or channel, add it to admins with «Add Users» permission",
+	ChatsConnectUserIsNotAdmin:    "⚠️ You are not an admin of this chat / channel",
+	ChatsConnectBotNotEnoughRight: "⚠️ The bot is an admin of the chat / channel, but it lacks «Add Users» permission",
+	ChatsConnectAlreadyConnected:  "👌 Channel / chat is already connected",
+	ChatsConnectNotChannelForward: "⚠️ Forwarded message is not from a channel, to connect a group or supergroup send me its @username or private link",
+	ChatsConnectBadJoinLink:       "⚠️ Can't decode private link, try to forward a message from the channel",
+	ChatsConnectBadInput:          "⚠️ To connect a channel send a link or @username of the channel / supergroup, you can also forward a message from the channel",
+	ChatsConnected:                "Channel / supergroup connected! Now you can restrict downloads of all existing and new files.",
+	ChatsDisconnected:             "Channel/group disconnected",
+
+	ChatTypeChannel:    "channel",
+	ChatTypeSuperGroup: "supergroup",
+	ChatTypeGroup:      "group",
+	ChatTypeUnknown:    "unknown",
+
+	BundleCollect: dedent.Dedent(`
+		📦 Send or forward me files to combine into a bundle\.
+		Text message becomes the bundle description\.
+
+		When you are done, press *«Done»* and I will give you one link to all files\.
+	`),
+	BundleTitle: Plural{
+		One:  "📦 __Bundle of %d file__",
+		Few:  "📦 __Bundle of %d files__",
+		Many: "📦 __Bundle of %d files__",
+	},
+	BundleSent: "📦 All files of the bundle are sent \\(%d\\)",
+
+	BundleDeleteConfirm: join(
+		"Are you sure you want to *delete* this bundle?",
+		"",
+		"Users will no longer be able to get files of the bundle by the link.",
+		"But users who already downloaded the bundle will keep files in the chat history with the bot.",
+	),
+
+	BundleButtonDone:                 "✅ Done",
+	BundleButtonCancel:               "❌ Cancel",
+	BundleCollectCanceled:            "Bundle creation canceled",
+	BundleCollectEmpty:               "⚠️ There are no files in the bundle, send me files or press «Cancel»",
+	BundleCollectFull:                "⚠️ Bundle can contain at most %d files, press «Done» to create the bundle",
+	BundleCollectAdded:               "File added, files in the bundle: %d",
+	BundleCollectCaption:             "Bundle description saved",
+	BundleCollectUnsupportedFileKind: "⚠️ Oops, I can't add this file to the bundle, because I don't support it",
+	BundleCreateFailed:               "⚠️ Something went wrong while creating the bundle",
+	BundleCreated:                    "✅ Bundle created",
+	BundleNotFound:                   "😐 I know nothing about this file, check the link...",
+	BundleEmpty:                      "😐 There are no available files left in this bundle",
+	BundleDeletedBefore:              "The bundle was deleted earlier",
+	BundleDeleted:                    "✅ Bundle deleted",
+	BundleDeletedByOwner:             "🙅‍♂️ The bundle was deleted by its owner",
+	BundleNotSubscribed:              "I don't see you among subscribers, subscribe to get access to the files",
+	BundleAccessGranted:              "🔓 Access to the files granted",
+	BundleCantCheckMembership:        "🙅‍♂️ I can't give you the files, because I'm no longer an admin of the channel required for subscription. Contact the owner and say hi from me!",
+
+	ReportButton:             "⚠️ Report",
+	ReportRequest:            "Describe why this file violates copyright (up to %d characters). Admins will review the report.",
+	ReportCanceled:           "Report canceled",
+	ReportOwnFile:            "You can't report your own file",
+	ReportAlreadyExists:      "You have already reported this file, wait for the decision of admins",
+	ReportFileBlocked:        "This file is already blocked",
+	ReportOnlyText:           "⚠️ Send the text as a text message",
+	ReportBadLength:          "⚠️ Text should contain from 1 to %d characters",
+	ReportNotAwait:           "Text is no longer awaited, start again",
+	ReportSent:               "✅ Report sent, admins will review it soon",
+	ReportOwnerBlocked:       "🚫 Your file «%s» was blocked after a copyright complaint.\n\nReason: %s\n\nIf you think it's a mistake, you can appeal the decision.",
+	ReportOwnerAppealButton:  "Appeal",
+	ReportAppealRequest:      "Explain why the file doesn't violate copyright (up to %d characters). Admins will review the appeal.",
+	ReportAppealNotAllowed:   "This decision can't be appealed",
+	ReportAppealSent:         "✅ Appeal sent, admins will review it soon",
+	ReportOwnerAppealGranted: "✅ Your appeal was approved, the file «%s» is available again",
+	ReportOwnerAppealDenied:  "❌ Your appeal was rejected, the file «%s» stays blocked",
+
+	AdminSummary:       "*__Summary__*",
+	AdminUsers:         "*Users*: `%d`",
+	AdminActiveUsers:   "*Active users*: `%d`",
+	AdminBlockedUsers:  "*Blocked bot*: `%d`",
+	AdminUsersActivity: "*DAU / WAU / MAU*: `%d / %d / %d`",
+	AdminFiles:         "*Files*: `%d`",
+	AdminDownloads:     "*Downloads*: `%d`",
+	AdminChats:         "*Chats*: `%d`",
+	AdminRefs:          "*__Sources__*",
+	AdminReports:       "*Open reports*: `%d`",
+
+	AdminReportTitle:      "*__Report \\#%d__*",
+	AdminAppealTitle:      "*__Appeal on report \\#%d__*",
+	AdminReportQueue:      "_Open reports in queue: %d_",
+	AdminReportFile:       "*File*: %s",
+	AdminReportOwner:      "*Owner*: %s",
+	AdminReportReporter:   "*Reporter*: %s",
+	AdminReportReason:     "*Reason*: %s",
+	AdminReportCreatedAt:  "*Reported at*: `%s`",
+	AdminReportResolvedAt: "*Blocked at*: `%s`",
+	AdminReportAppeal:     "*Appeal*: %s",
+	AdminReportAppealedAt: "*Appealed at*: `%s`",
+	AdminReportNoReporter: "_deleted user_",
+
+	AdminReportsButton:       "📥 Reports queue",
+	AdminReportButtonFile:    "📄 Show file",
+	AdminReportButtonApprove: "🚫 Block file",
+	AdminReportButtonReject:  "✅ Reject report",
+	AdminAppealButtonApprove: "✅ Unblock file",
+	AdminAppealButtonReject:  "🚫 Keep blocked",
+	AdminReportQueueEmpty:    "There are no open reports",
+	AdminReportApproved:      "File blocked, owner notified",
+	AdminReportRejected:      "Report rejected",
+	AdminAppealApproved:      "File unblocked, owner notified",
+	AdminAppealRejected:      "Appeal rejected, owner notified",
+	AdminReportResolvedEarly: "Report was already resolved",
+
+	AdminUsage: dedent.Dedent(`
+		*__Admin commands__*
+
+		/admin \- summary
+		/admin file \<public id\> \- inspect file
+		/admin ban \<user id\> \- ban user
+		/admin unban \<user id\> \- unban user
+		/admin broadcast \- new broadcast
+		/admin broadcasts \- recent broadcasts
+		/admin campaigns \- campaigns, see /admin campaign
+		/admin reserved \- names reserved for custom links
+		/admin reserve \<name\> \- reserve name
+		/admin unreserve \<name\> \- unreserve name
+		/admin audit \- last actions of admins
+	`),
+	AdminFileTitle:            "*__File__* `%s`",
+	AdminFileName:             "*Name*: %s",
+	AdminFileCreatedAt:        "*Uploaded at*: `%s`",
+	AdminFileOwner:            "*Owner*: %s \\(`%d`\\)",
+	AdminFileStatus:           "*Status*: %s",
+	AdminFileStatusAvailable:  "available",
+	AdminFileStatusBlocked:    "blocked by admin",
+	AdminFileStatusCopyright:  "blocked by copyright report",
+	AdminFileStatusOwnerBan:   "owner is banned",
+	AdminFileChats:            "*Chats for subscription*: `%d`",
+	AdminAuditTitle:           "*__Audit log__*",
+	AdminAuditEmpty:           "_No actions yet_",
+	AdminAuditFileBlock:       "blocked file `%d`",
+	AdminAuditFileUnblock:     "unblocked file `%d`",
+	AdminAuditUserBan:         "banned user `%d`",
+	AdminAuditUserUnban:       "unbanned user `%d`",
+	AdminFileButtonBlock:      "🚫 Block file",
+	AdminFileButtonUnblock:    "✅ Unblock file",
+	AdminFileButtonBanOwner:   "🚫 Ban owner",
+	AdminFileButtonUnbanOwner: "✅ Unban owner",
+
+	AdminFileNotFound:  "File not found",
+	AdminUserNotFound:  "User not found",
+	AdminFileBlocked:   "File blocked",
+	AdminFileUnblocked: "File unblocked",
+	AdminUserBanned:    "User banned",
+	AdminUserUnbanned:  "User unbanned",
+	AdminCantBanAdmin:  "Admins can't be banned",
+
+	AdminBroadcastsButton:        "📣 Broadcasts",
+	BroadcastTitle:               "*__Broadcast \\#%d__*",
+	BroadcastStatus:              "*Status*: %s",
+	BroadcastStatusDraft:         "draft",
+	BroadcastStatusRunning:       "running",
+	BroadcastStatusDone:          "done",
+	BroadcastStatusCanceled:      "canceled",
+	BroadcastSegment:             "*Segment*: %s",
+	BroadcastSegmentAll:          "all users",
+	BroadcastSegmentRef:          "ref `%s`",
+	BroadcastSegmentLanguage:     "language `%s`",
+	BroadcastSegmentJoinedAfter:  "joined after `%s`",
+	BroadcastAudience:            "*Audience*: `%d`",
+	BroadcastButtons:             "*Buttons*: `%d`",
+	BroadcastProgress:            "*Processed*: `%d` of `%d`",
+	BroadcastDelivered:           "*Delivered*: `%d`",
+	BroadcastBlocked:             "*Blocked the bot*: `%d`",
+	BroadcastFailed:              "*Failed*: `%d`",
+	BroadcastStartedAt:           "*Started at*: `%s`",
+	BroadcastFinishedAt:          "*Finished at*: `%s`",
+	BroadcastListTitle:           "*__Broadcasts__*",
+	BroadcastListEmpty:           "_No broadcasts yet_",
+	BroadcastButtonPreview:       "👁 Preview",
+	BroadcastButtonButtons:       "🔗 Buttons",
+	BroadcastButtonSegment:       "🎯 Segment",
+	BroadcastButtonStart:         "🚀 Start",
+	BroadcastButtonStartConfirm:  "🚀 Yes, start",
+	BroadcastButtonCancel:        "✖️ Cancel broadcast",
+	BroadcastButtonCancelConfirm: "✖️ Yes, cancel",
+
+	BroadcastRequest: "Send the message for broadcast: text, photo, video or any other message. It will be copied to users as is.",
+	BroadcastButtonsRequest: dedent.Dedent(`
+		Send up to %d link buttons, one per line in format:
+
+		Title - https://example.com
+
+		Send «-» to remove buttons.
+	`),
+	BroadcastSegmentRequest: dedent.Dedent(`
+		Send conditions of segment, one per line:
+
+		all — all users
+		ref <ref> — users came by ref link
+		lang <code> — users with language, e.g. lang en
+		joined <YYYY-MM-DD> — users joined after date
+	`),
+	BroadcastInvalidButtons:  "⚠️ Invalid buttons, check format and links",
+	BroadcastInvalidSegment:  "⚠️ Invalid segment, check format of conditions",
+	BroadcastNotAwait:        "Nothing to edit, open the broadcast again",
+	BroadcastInputCanceled:   "Canceled",
+	BroadcastPreviewSent:     "Preview sent",
+	BroadcastPreviewFailed:   "Can't send preview, the message was probably deleted",
+	BroadcastStartConfirm:    "Start delivery to %d users?",
+	BroadcastCancelConfirm:   "Cancel the broadcast?",
+	BroadcastStarted:         "Broadcast started",
+	BroadcastCanceled:        "Broadcast canceled",
+	BroadcastEmptyAudience:   "There are no users in the segment",
+	BroadcastAlreadyStarted:  "Broadcast is already started",
+	BroadcastAlreadyFinished: "Broadcast is already finished",
+	BroadcastNotFound:        "Broadcast not found",
+
+	AdminCampaignsButton:  "📈 Campaigns",
+	AdminCampaignsTitle:   "*__Campaigns__*",
+	AdminCampaignsPeriod:  "*Period*: %s",
+	AdminCampaignsAllTime: "all time",
+	AdminCampaignsEmpty:   "_No campaigns yet_",
+	AdminCampaignsUsage: dedent.Dedent(`
+		*__Campaign commands__*
+
+		/admin campaigns \[from\] \[to\] \- stats of campaigns for period, dates in format YYYY\-MM\-DD
+		/admin campaign add \<ref\> \<cost\> \<name\> \- register campaign
+		/admin campaign delete \<ref\> \- delete campaign
+	`),
+	AdminCampaignName:          "*%s* \\(`%s`\\)",
+	AdminCampaignUsers:         "*Users*: `%d`, *downloads*: `%d`",
+	AdminCampaignCost:          "*Cost*: `%s`, *per user*: `%s`",
+	AdminCampaignLink:          "*Link*: %s",
+	AdminCampaignCreated:       "Campaign created, link: %s",
+	AdminCampaignDeleted:       "Campaign deleted",
+	AdminCampaignNotFound:      "Campaign not found",
+	AdminCampaignExists:        "Campaign with this ref already exists",
+	AdminCampaignInvalidRef:    "Ref can contain only latin letters, digits and _ (up to 32 characters)",
+	AdminCampaignInvalidCost:   "Invalid cost, use number like 150 or 99.90",
+	AdminCampaignInvalidPeriod: "Invalid period, use dates in format YYYY-MM-DD",
+
+	AdminReservedTitle:    "*__Reserved links__*",
+	AdminReservedEmpty:    "_No reserved names yet_",
+	AdminReservedAdded:    "Name %s is reserved, owners can't use it as custom link",
+	AdminReservedDeleted:  "Name %s is not reserved anymore",
+	AdminReservedNotFound: "Name is not reserved",
+	AdminReservedExists:   "Name is already reserved",
+	AdminReservedInvalid:  "Name can contain only latin letters, digits, _ and - (up to %d characters)",
+}
@@ -0,0 +1,109 @@
+// Package i18n contains catalog of bot texts in supported languages.
+package i18n
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Lang is code of supported language.
+type Lang string
+
+const (
+	LangRussian Lang = "ru"
+	LangEnglish Lang = "en"
+
+	// LangDefault is used when language of user is not supported.
+	LangDefault = LangRussian
+)
+
+// Langs contains all supported languages in order of display.
+var Langs = []Lang{
+	LangRussian,
+	LangEnglish,
+}
+
+var catalog = map[Lang]*Texts{
+	LangRussian: ru,
+	LangEnglish: en,
+}
+
+// ParseLang returns supported language by Telegram language code (like "en" or "en-US").
+func ParseLang(code string) (Lang, bool) {
+	code = strings.ToLower(code)
+
+	if i := strings.IndexAny(code, "-_"); i != -1 {
+		code = code[:i]
+	}
+
+	lang := Lang(code)
+
+	_, ok := catalog[lang]
+
+	return lang, ok
+}
+
+// Detect returns language of user.
+// Language selected by user has priority over language of Telegram client.
+func Detect(selected, code string) Lang {
+	if lang, ok := ParseLang(selected); ok {
+		return lang
+	}
+
+	if lang, ok := ParseLang(code); ok {
+		return lang
+	}
+
+	return LangDefault
+}
+
+// Get returns texts of language, or texts of default language if it's not supported.
+func Get(lang Lang) *Texts {
+	if texts, ok := catalog[lang]; ok {
+		return texts
+	}
+
+	return catalog[LangDefault]
+}
+
+// Plural contains forms of text depends on number.
+// Each form is format string with one verb for number.
+type Plural struct {
+	// Form for 1, 21, 31 (ru) or 1 (en).
+	One string
+
+	// Form for 2-4, 22-24 (ru). Not used in English.
+	Few string
+
+	// Form for other numbers.
+	Many string
+}
+
+// Plural formats form of plural suitable for n.
+func (texts *Texts) Plural(p Plural, n int) string {
+	return fmt.Sprintf(texts.pluralForm(p, n), n)
+}
+
+func (texts *Texts) pluralForm(p Plural, n int) string {
+	if texts.Lang != LangRussian {
+		if n == 1 {
+			return p.One
+		}
+		return p.Many
+	}
+
+	n10, n100 := n%10, n%100
+
+	switch {
+	case n10 == 1 && n100 != 11:
+		return p.One
+	case n10 >= 2 && n10 <= 4 && (n100 < 12 || n100 > 14):
+		return p.Few
+	default:
+		return p.Many
+	}
+}
+
+func join(rows ...string) string {
+	return strings.Join(rows, "\n")
+}
@@ -0,0 +1,105 @@
+package i18n
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var formatVerb = regexp.MustCompile(`%[a-z]`)
+
+// getTexts returns all texts of catalog by name of field.
+func getTexts(texts *Texts) map[string]string {
+	result := map[string]string{}
+
+	v := reflect.ValueOf(texts).Elem()
+
+	for i := 0; i < v.NumField(); i++ {
+		name := v.Type().Field(i).Name
+
+		switch field := v.Field(i).Interface().(type) {
+		case string:
+			result[name] = field
+		case Plural:
+			result[name+".One"] = field.One
+			result[name+".Few"] = field.Few
+			result[name+".Many"] = field.Many
+		}
+	}
+
+	return result
+}
+
+func TestCatalog(t *testing.T) {
+	base := getTexts(Get(LangDefault))
+
+	for _, lang := range Langs {
+		texts, ok := catalog[lang]
+		if !assert.True(t, ok, "language %s is not in catalog", lang) {
+			continue
+		}
+
+		assert.Equal(t, lang, texts.Lang)
+
+		for key, text := range getTexts(texts) {
+			assert.NotEmpty(t, text, "key %s is missing in %s", key, lang)
+
+			assert.Equal(t,
+				formatVerb.FindAllString(base[key], -1),
+				formatVerb.FindAllString(text, -1),
+				"format verbs of key %s in %s are differ from %s", key, lang, LangDefault,
+			)
+		}
+	}
+}
+
+func TestParseLang(t *testing.T) {
+	for _, test := range []struct {
+		Code string
+		Lang Lang
+		Ok   bool
+	}{
+		{"ru", LangRussian, true},
+		{"en", LangEnglish, true},
+		{"en-US", LangEnglish, true},
+		{"EN", LangEnglish, true},
+		{"de", Lang("de"), false},
+		{"", Lang(""), false},
+	} {
+		lang, ok := ParseLang(test.Code)
+		assert.Equal(t, test.Lang, lang, test.Code)
+		assert.Equal(t, test.Ok, ok, test.Code)
+	}
+}
+
+func TestDetect(t *testing.T) {
+	assert.Equal(t, LangEnglish, Detect("en", "ru"))
+	assert.Equal(t, LangEnglish, Detect("", "en-GB"))
+	assert.Equal(t, LangDefault, Detect("", "de"))
+	assert.Equal(t, LangRussian, Detect("de", "ru"))
+}
+
+func TestTexts_Plural(t *testing.T) {
+	p := Plural{One: "%d день", Few: "%d дня", Many: "%d дней"}
+
+	for n, expected := range map[int]string{
+		1:   "1 день",
+		2:   "2 дня",
+		5:   "5 дней",
+		11:  "11 дней",
+		12:  "12 дней",
+		21:  "21 день",
+		24:  "24 дня",
+		111: "111 дней",
+	} {
+		assert.Equal(t, expected, ru.Plural(p, n))
+	}
+
+	p = Plural{One: "%d day", Few: "%d days", Many: "%d days"}
+
+	assert.Equal(t, "1 day", en.Plural(p, 1))
+	assert.Equal(t, "2 days", en.Plural(p, 2))
+	assert.Equal(t, "21 days", en.Plural(p, 21))
+}
@@ -0,0 +1,627 @@
+package i18n
+
+import "github.com/lithammer/dedent"
+
+var ru = &Texts{
+	Lang:     LangRussian,
+	LangName: "🇷🇺 Русский",
+
+	CommonBack:           "« Назад",
+	CommonDisconnect:     "Отключить",
+	CommonYesIamSure:     "Да, я уверен",
+	CommonYes:            "Да, уверен",
+	CommonNo:             "Нет",
+	CommonRefresh:        "Обновить",
+	CommonDelete:         "Удалить",
+	CommonCancel:         "Отмена",
+	CommonRestrictions:   "Ограничения",
+	CommonNothingChanged: "🤷 Ничего не изменилось",
+
+	CancelDone:    "❌ Отменено",
+	CancelNothing: "🤷 Нечего отменять",
+
+	Hello: "Привет\\! 👋\n",
+	Help: dedent.Dedent(`
+		Я помогу тебе поделиться любым медиафайлом \(фото, видео, документы, аудио, голосовые\) с подписчиками твоего канала\.
+		Отправь любой из перечисленных файлов, а я в ответ дам тебе ссылку\. Желательно указать подпись, чтобы человек не забыл кто ему это пошарил\.
+		Так же ты можешь подключить свой канал или чат и установить ограничение на доступ к медиафайлу только своим подписчикам\.
+
+		/files \- список загруженных файлов
+		/bundle \- поделиться несколькими файлами по одной ссылке
+		/settings \- для более тонкой настройки
+		/cancel \- отменить текущее действие
+
+		Свои файлы можно отправить в любой чат через inline\-режим: набери в поле ввода мой юзернейм и часть названия файла\.
+
+		Поддержка: @share\_file\_support
+		Новости и обновления: @share\_file\_news
+	`),
+
+	ButtonAbout: "Что это за бот?",
+
+	UnsupportedFileKind:   "К сожалению, я не поддерживаю данный тип файлов. На данный момент я умею работать только с документами, видео, фото, аудио, голосовыми и видеосообщениями, стикерами, контактами и геопозициями. Отправь и перешли мне сообщение перечисленного типа, а в ответ я дам тебе ссылку.",
+	UploadOnlyAdmins:      "✋ Загрузка файлов доступна только администраторам ботам",
+	FileViolatesCopyright: "😐 К сожалению, на данный файл поступила жалоба от правообладателей и мы были вынужденны его удалить.",
+	BlockedByAdmin:        "🚫 Доступ по этой ссылке заблокирован администрацией бота",
+	CantCheckMembership:   "🙅‍♂️ Я не могу выдать тебе файл, так как больше не являюсь админом канала на который требовалась подписка, свяжись с владельцем файла и передавай от меня привет!",
+
+	FileCaptionDescription: "💬 __Описание__",
+	FileCaptionPublicLink:  "🔗 __Публичная ссылка__",
+	FileCaptionLinkedPost:  "Связанный пост: [%s](%s)",
+	FileCaptionStats:       "📈 __Статистика__",
+	StatsDownloads:         "*Загрузок*: `%d`",
+	StatsUniqueDownloads:   "*Уникальных загрузок*: `%d`",
+	StatsWithSubscription:  "*Загрузок с подпиской*: `%d`",
+	StatsNewSubscription:   "*Загрузок с новой подпиской*: `%d`",
+
+	FileDeleteConfirm: join(
+		"Уверены что хотите *удалить* этот файл?",
+		"",
+		"Пользователи больше не смогут получить доступ к документу перейдя по ссылке.",
+		"Но у пользователей уже скачавших документ, он сохранится в истории диалога с ботом.",
+	),
+
+	FileNotSupported:   "⚠️ Упс, я не могу добавить этот файл, так как не поддерживаю его",
+	FileAudioDisabled:  "⚠️ Упс, я не могу добавить этот файл, так как поддержка аудио временно отключена",
+	FileAddFailed:      "⚠️ Что-то пошло не так при добавлении файла",
+	FileDeletedBefore:  "Файл был удален ранее",
+	FileDeleted:        "✅ Документ удален",
+	FileDeletedByOwner: "🙅‍♂️ Файл был удален владельцем",
+	FilePostButton:     "« Пост в канале",
+
+	FileRestrictions: dedent.Dedent(`
+		С помощью данного инструмента вы можете ограничить доступ к файлу только подписчикам вашего канала / супергруппы\.
+		Перед каждым скачиванием бот будет проверять наличие подписки и только после этого выдавать доступ к файлу\.
+
+		Если выбрано несколько каналов, можно требовать подписку на все сразу или на любой из них\.
+
+		Так же можно ограничить количество загрузок и время, в течении которого файл доступен\.
+
+		_Для подключения каналов перейдите в настройки \(/settings\)\._
+	`),
+
+	ChatPolicyAny:       "🔀 Подписка: на любой канал",
+	ChatPolicyAll:       "🔀 Подписка: на все каналы",
+	RestrictionDisabled: "Ограничение на загрузку отключено",
+	RestrictionSet:      "Ограничение установлено",
+
+	SubRequest: dedent.Dedent(`
+		Владелец файла установил ограничение на доступ только с подпиской\.
+		Подпишись на %s и нажми кнопку *«Я подписался»*
+	`),
+	SubRequestAll: dedent.Dedent(`
+		Владелец файла установил ограничение на доступ только с подпиской\.
+		Подпишись на все каналы из списка и нажми кнопку *«Я подписался»*
+
+		%s
+	`),
+	SubRequestAny: dedent.Dedent(`
+		Владелец файла установил ограничение на доступ только с подпиской\.
+		Подпишись на любой из каналов списка и нажми кнопку *«Я подписался»*
+
+		%s
+	`),
+
+	SubRequestButtonSubscribe:   "Подписаться",
+	SubRequestButtonSubscribeTo: "Подписаться на %s",
+	SubRequestButtonCheck:       "Я подписался",
+
+	FileNotSubscribed:    "Я не наблюдаю тебя в подписчиках, подпишись чтобы получить доступ к файлу",
+	FileAccessGranted:    "🔓 Доступ к файлу получен",
+	FilePasswordExpired:  "🔑 Срок действия введенного пароля истек, перейди по ссылке на файл еще раз",
+	FileNotAvailableYet:  "⏳ Файл будет доступен с %s",
+	FileExpired:          "⌛️ Срок действия ссылки на файл истек",
+	FileLimitReached:     "🚫 Лимит загрузок файла исчерпан",
+	FileUserLimitReached: "🚫 Ты уже получил этот файл максимально допустимое количество раз",
+
+	LimitDisable:  "Отключить",
+	LimitDisabled: "Ограничение отключено",
+	LimitNone:     "нет",
+	LimitHours: Plural{
+		One:  "%d час",
+		Few:  "%d часа",
+		Many: "%d часов",
+	},
+	LimitDays: Plural{
+		One:  "%d день",
+		Few:  "%d дня",
+		Many: "%d дней",
+	},
+	LimitMaxDownloadsButton:        "📊 Лимит загрузок: %s",
+	LimitMaxDownloadsPerUserButton: "👤 На пользователя: %s",
+	LimitExpiresAtButton:           "⌛️ Доступен до: %s",
+	LimitAvailableFromButton:       "⏳ Доступен с: %s",
+
+	LimitMaxDownloads: dedent.Dedent(`
+		📊 *Лимит загрузок*
+
+		После указанного количества загрузок файл станет недоступен для всех пользователей\.
+	`),
+	LimitMaxDownloadsPerUser: dedent.Dedent(`
+		👤 *Лимит загрузок на пользователя*
+
+		Сколько раз один пользователь может получить файл\.
+	`),
+	LimitExpiresAt: dedent.Dedent(`
+		⌛️ *Срок действия*
+
+		Через указанное время файл станет недоступен\. Отсчет начинается с момента установки ограничения\.
+	`),
+	LimitAvailableFrom: dedent.Dedent(`
+		⏳ *Доступен с*
+
+		До указанного времени файл будет недоступен\. Отсчет начинается с момента установки ограничения\.
+	`),
+
+	LimitsCaption:              "⏳ __Ограничения__",
+	LimitsCaptionDownloadsLeft: "*Осталось загрузок*: `%d из %d`",
+	LimitsCaptionPerUser:       "*Загрузок на пользователя*: `%d`",
+	LimitsCaptionAvailableFrom: "*Доступен с*: `%s`",
+	LimitsCaptionExpired:       "*Истек*: `%s`",
+	LimitsCaptionExpiresAt:     "*Доступен до*: `%s`",
+	LimitsCaptionPassword:      "*Пароль*: `установлен`",
+
+	PasswordRequest: "🔑 Владелец защитил файл паролем\\. Отправь мне пароль, чтобы получить файл\\.",
+	PasswordSet: dedent.Dedent(`
+		🔑 Отправь мне новый пароль для файла\.
+
+		_Сообщение с паролем будет удалено из истории диалога\._
+	`),
+	PasswordRestrictions: dedent.Dedent(`
+		🔑 *Пароль*
+
+		Файл будет выдан только пользователям, которые знают пароль\.
+		Количество неверных попыток ограничено\.
+	`),
+
+	PasswordCanceled:      "Ввод пароля отменен",
+	PasswordNotAwait:      "Ввод пароля больше не ожидается, перейди по ссылке на файл еще раз",
+	PasswordExceeded:      "🚫 Слишком много неверных попыток, попробуй позже",
+	PasswordInvalid:       "❌ Неверный пароль, осталось попыток: %d",
+	PasswordOnlyText:      "⚠️ Пароль нужно отправить текстовым сообщением",
+	PasswordSetDone:       "🔑 Пароль установлен, теперь файл можно получить только после его ввода",
+	PasswordDisabled:      "Пароль отключен",
+	PasswordBadLength:     "⚠️ Пароль должен содержать от 1 до %d символов",
+	PasswordFileNotFound:  "Файл не найден, возможно он был удален",
+	PasswordValueSet:      "установлен",
+	PasswordButton:        "🔑 Пароль: %s",
+	PasswordButtonSet:     "Установить пароль",
+	PasswordButtonChange:  "Изменить пароль",
+	PasswordButtonDisable: "Отключить",
+
+	PlacementsButton: "📍 Размещения",
+	PlacementsTitle: dedent.Dedent(`
+		📍 *Размещения*
+
+		Отдельные ссылки на файл для каждого места, где ты его публикуешь, например пост в канале или тема на форуме\. Загрузки считаются для каждой ссылки\.
+	`),
+	PlacementsEmpty:      "_Размещений пока нет_",
+	PlacementsItem:       "• *%s*: `%d` загрузок, `%d` уникальных",
+	PlacementsDirectName: "Прямая ссылка",
+	PlacementsAddButton:  "➕ Добавить размещение",
+	PlacementTitle:       "📍 *%s*",
+	PlacementLink:        "*Ссылка*: %s",
+	PlacementDownloads:   "*Загрузок*: `%d`, *уникальных*: `%d`",
+	PlacementAdd:         "📍 Отправь мне название размещения, например _reddit_ или _channel\\-A_",
+	PlacementAdded:       "📍 Размещение *%s* добавлено, ссылка: %s",
+
+	PlacementDeleted:  "Размещение удалено, его загрузки учитываются как прямые",
+	PlacementNotFound: "Размещение не найдено",
+	PlacementBadName:  "⚠️ Название должно содержать от 1 до %d символов",
+	PlacementsLimit:   "⚠️ У файла может быть не больше %d размещений",
+	PlacementNotAwait: "Название размещения больше не ожидается",
+	PlacementOnlyText: "⚠️ Название нужно отправить текстовым сообщением",
+	PlacementCanceled: "Отменено",
+
+	FileEditButton: "✏️ Изменить",
+	FileEdit: dedent.Dedent(`
+		✏️ *Изменение*
+
+		Измени подпись, название файла в библиотеке или связанный пост в канале\.
+	`),
+	FileEditButtonCaption:     "Подпись",
+	FileEditButtonName:        "Название",
+	FileEditButtonPost:        "Связанный пост",
+	FileEditButtonCaptionDrop: "Удалить подпись",
+	FileEditButtonPostDrop:    "Отвязать пост",
+
+	FileEditCaptionPrompt:  "Отправь мне новую подпись файла, форматирование сохранится",
+	FileEditNamePrompt:     "Отправь мне новое название файла, оно отображается в библиотеке и поиске",
+	FileEditPostPrompt:     "Отправь мне ссылку на пост в канале, например https://t.me/channel/10, или перешли сам пост",
+	FileEditOnlyText:       "⚠️ Отправь это текстовым сообщением",
+	FileEditCaptionTooLong: "⚠️ Подпись должна содержать не более %d символов",
+	FileEditNameInvalid:    "⚠️ Название должно быть одной строкой от 1 до %d символов",
+	FileEditPostInvalid:    "⚠️ Это не ссылка на пост в канале, отправь ссылку вида https://t.me/channel/10 или перешли пост",
+	FileEditCaptionDropped: "Подпись удалена",
+	FileEditPostDropped:    "Пост отвязан",
+
+	FileVersionsButton: "🗂 Версии",
+	FileVersions: dedent.Dedent(`
+		🗂 *Версии*
+
+		Загрузи новую версию файла, чтобы сохранить его публичную ссылку, подпись, ограничения и статистику\. Предыдущие версии можно восстановить\.
+	`),
+	FileVersionsCurrent:       "*Текущая версия*: `v%d`, `%d` загрузок, `%d` уникальных",
+	FileVersionsEmpty:         "_Предыдущих версий пока нет_",
+	FileVersionsItem:          "• *v%d* %s, заменена %s: `%d` загрузок, `%d` уникальных",
+	FileVersionsMore:          "_и еще %d старых_",
+	FileVersionsUploadButton:  "⬆️ Загрузить новую версию",
+	FileVersionsRestoreButton: "↩️ Восстановить v%d",
+
+	FileReplacePrompt:   "⬆️ Отправь мне новую версию файла, публичная ссылка, подпись, ограничения и статистика сохранятся",
+	FileReplaceOnlyFile: "⚠️ Отправь новую версию файлом",
+	FileVersionRestored: "Восстановлено как v%d",
+	FileVersionNotFound: "Версия не найдена",
+
+	FileLinkButton: "🔗 Новая ссылка",
+	FileLink: dedent.Dedent(`
+		🔗 *Новая ссылка*
+
+		Файл получит новую публичную ссылку\. Выбери, сколько еще будет работать текущая ссылка, после этого она сообщит, что ссылка отозвана\.
+	`),
+	FileLinkGraceNow:         "Отозвать текущую ссылку сразу",
+	FileLinkGraceButton:      "Оставить текущую ссылку на %s",
+	FileLinkRegenerated:      "Новая ссылка готова, предыдущая отозвана",
+	FileLinkRegeneratedGrace: "Новая ссылка готова, предыдущая работает до %s",
+	FileLinkRevoked:          "🔗 Эта ссылка была отозвана. Попроси новую у владельца файла.",
+
+	FileLinkCustomButton:   "✍️ Своя ссылка",
+	FileLinkCustomPrompt:   "✍️ Отправь мне свою ссылку, например spring_catalog. Она может содержать латинские буквы, цифры, _ и - (от %d до %d символов). Текущая ссылка будет работать еще %s",
+	FileLinkCustomInvalid:  "⚠️ Ссылка может содержать только латинские буквы, цифры, _ и - (от %d до %d символов), не может начинаться с ref_ и заканчиваться на - и цифры",
+	FileLinkCustomTaken:    "⚠️ Эта ссылка уже занята, попробуй другую",
+	FileLinkCustomReserved: "⚠️ Эта ссылка зарезервирована, попробуй другую",
+	FileLinkCustomSame:     "⚠️ Это текущая ссылка файла, отправь другую",
+
+	FilesEmpty: "У тебя пока нет файлов\\. Отправь мне любой файл, а я в ответ дам тебе ссылку\\.",
+	FilesTitle: "📂 *Мои файлы*",
+	FilesFound: "*Найдено*: `%d`",
+	FilesQuery: "*Поиск*: `%s`",
+
+	FilesNotFound:          "Ничего не найдено, попробуй изменить фильтры",
+	FilesSearchRequest:     "🔍 Отправь текст для поиска по названию и подписи файлов",
+	FilesSearchLength:      "⚠️ Запрос должен содержать не более %d символов",
+	FilesOrderCreatedAt:    "↕️ По дате",
+	FilesOrderDownloads:    "↕️ По загрузкам",
+	FilesKindFilter:        "🗂 Тип: %s",
+	FilesSearchButton:      "🔍 Поиск",
+	FilesSearchResetButton: "✖️ Сбросить поиск",
+	FileUntitled:           "Файл #%d",
+
+	KindAll:       "все",
+	KindDocument:  "документы",
+	KindPhoto:     "фото",
+	KindVideo:     "видео",
+	KindAnimation: "GIF",
+	KindAudio:     "аудио",
+	KindVoice:     "голосовые",
+	KindSticker:   "стикеры",
+	KindVideoNote: "видеосообщения",
+	KindContact:   "контакты",
+	KindLocation:  "геопозиции",
+
+	InlineButtonDownload: "📥 Получить файл",
+	InlineSwitchPM:       "Загрузить файл в бота",
+
+	StatsPeriod: Plural{
+		One:  "🗓 __За %d день__",
+		Few:  "🗓 __За %d дня__",
+		Many: "🗓 __За %d дней__",
+	},
+	StatsNote:       "В скобках изменение относительно предыдущего периода\\.",
+	StatsDaily:      "*По дням*:",
+	StatsHourly:     "*По часам*:",
+	StatsFileTitle:  "📊 __Статистика загрузок__",
+	StatsChatTitle:  "⚙️ __*Настройки*__ / 📢 __*Каналы и чаты*__ / __*%s*__ / __*Статистика*__",
+	StatsPlacements: "*По размещениям*:",
+
+	StatsButton:                "📊 Статистика",
+	StatsValueDownloads:        "Загрузок",
+	StatsValueUnique:           "Уникальных",
+	StatsValueNewSubscriptions: "С новой подпиской",
+
+	ExportEmpty:   "Загрузок пока нет, выгружать нечего",
+	ExportCaption: "Загрузок: %d",
+
+	Settings: dedent.Dedent(`
+        ⚙️ __*Настройки*__
+
+		• _Длинные ID_ — бот будет генерировать максимально возможные по длине ссылки, идеально для личных файлов\. Длинные ссылки буду генерироватся только для новых документов\.
+
+		• _Каналы и чаты_ — управление каналами и чата подключенными к боту в качестве ограничителя при скачивании ваших файлов\.
+
+		• _Язык_ — язык интерфейса бота\. По умолчанию используется язык твоего приложения Telegram\.
+    `),
+
+	SettingsButtonLongIDs:          "Длинные ID",
+	SettingsLongIDsEnabled:         "Генериация длинных ссылок включена",
+	SettingsLongIDsDisabled:        "Генериация длинных ссылок выключена",
+	SettingsButtonChannelsAndChats: "📢 Каналы и чаты",
+	SettingsLanguageChanged:        "Язык изменен",
+
+	Chats: dedent.Dedent(`
+		⚙️ __*Настройки*__ / 📢 __*Каналы и чаты*__
+
+		В данном разделе вы можете управлять подключенными чатами и каналами\.
+		Это эффективный инструмент для увелечения конверсии, так как позволяет ограничить скачивание файла только подписчиками вашего канала или чата\.
+	`),
+	ChatsConnect: dedent.Dedent(`
+		⚙️ __*Настройки*__ / 📢 __*Каналы и чаты*__ / __*Подключить*__
+
+		Чтобы добавить канал или чат, тебе нужно выполнить следующие действия:
+
+		1\. Добавьте @%s в администратраторы канала или чата с правами «Добавление подписчиков» \(Add User\)\.
+		2\. Отправь мне @username или приватную ссылку на канал или чат, так же ты можешь переслать любое сообщение из канала\.
+
+		Отправь /cancel, чтобы отменить подключение\.
+	`),
+	ChatsDetails: join(
+		"⚙️ __*Настройки*__ / 📢 __*Каналы и чаты*__ / __*%s*__",
+		"",
+		"*ID:* `%d`",
+		"*Тип:* `%s`",
+		"",
+		"📈 __Статистика__",
+		"",
+		"*Файлов:* `%d`",
+		"*Загрузок с подпиской:* `%d`",
+		"*Загрузок с новой подпиской*: `%d`",
+	),
+	ChatsDelete: dedent.Dedent(`
+		⚙️ __*Настройки*__ / 📢 __*Каналы и чаты*__ / __*%s*__
+
+		Уверены что хотите отвзять этот канал/группу?
+		Файл для скачивания которых требовалась подписка на этот канал/группу, станут доступны без нее\.
+		Это действие нельзя будет отменить\.
+	`),
+
+	ChatsButtonConnect:            "+ Подключить",
+	ChatsConnectButtonCancel:      "Я передумал",
+	ChatsConnectNotValid:          "⚠️ Для подключения канала или чата отправь мне его @username, приватную ссылку или перешли мне любое сообщение из канала",
+	ChatsConnectIsPrivate:         "⚠️ Нужно отправить @username или приватную ссылку на канал или чат, ты скинул пользователя :)",
+	ChatsConnectNotFound:          "⚠️ Чат не найден или бот не является админом, добавь бота в администраторы и повтори запрос",
+	ChatsConnectBotIsNotAdmin:     "⚠️ Бот не установлен администратором чата или канала, добавьте его в администраторы с правами «Добавление подписчиков» (Add User)",
+	ChatsConnectUserIsNotAdmin:    "⚠️ Ты не являешся администратором данного чата / канала",
+	ChatsConnectBotNotEnoughRight: "⚠️ Бот установлен администратором чата / канала, но ему не хватает прав «Добавление подписчиков» (Add User)",
+	ChatsConnectAlreadyConnected:  "👌 Канал / чат уже подключен",
+	ChatsConnectNotChannelForward: "⚠️ Пересланное сообщение не является сообщением из канала, для подключения группы или супергруппы отправь мне ее @username или приватную ссылку",
+	ChatsConnectBadJoinLink:       "⚠️ Не могу декодировать приватную ссылку попробуйте переслать сообщение из канала",
+	ChatsConnectBadInput:          "⚠️ Для подключения канала отправьте ссылку или @username канала / супергруппы, так же вы можете переслать сообщение с канала",
+	ChatsConnected:                "Канал / супергруппа подключена! Теперь вы можете установить ограничение на скачивание для всех существующих и новых файлов.",
+	ChatsDisconnected:             "Канал/группа отключена",
+
+	ChatTypeChannel:    "канал",
+	ChatTypeSuperGroup: "супергруппа",
+	ChatTypeGroup:      "группа",
+	ChatTypeUnknown:    "неизвестно",
+
+	BundleCollect: dedent.Dedent(`
+		📦 Отправь или перешли мне файлы, которые нужно объединить в набор\.
+		Текстовое сообщение станет описанием набора\.
+
+		Когда закончишь, нажми *«Готово»* и я дам тебе одну ссылку на все файлы\.
+	`),
+	BundleTitle: Plural{
+		One:  "📦 __Набор из %d файла__",
+		Few:  "📦 __Набор из %d файлов__",
+		Many: "📦 __Набор из %d файлов__",
+	},
+	BundleSent: "📦 Все файлы набора отправлены \\(%d\\)",
+
+	BundleDeleteConfirm: join(
+		"Уверены что хотите *удалить* этот набор?",
+		"",
+		"Пользователи больше не смогут получить доступ к файлам набора перейдя по ссылке.",
+		"Но у пользователей уже скачавших набор, файлы сохранятся в истории диалога с ботом.",
+	),
+
+	BundleButtonDone:                 "✅ Готово",
+	BundleButtonCancel:               "❌ Отмена",
+	BundleCollectCanceled:            "Создание набора отменено",
+	BundleCollectEmpty:               "⚠️ В наборе нет ни одного файла, отправь мне файлы или нажми «Отмена»",
+	BundleCollectFull:                "⚠️ В наборе может быть не больше %d файлов, нажми «Готово» чтобы создать набор",
+	BundleCollectAdded:               "Файл добавлен, в наборе файлов: %d",
+	BundleCollectCaption:             "Описание набора сохранено",
+	BundleCollectUnsupportedFileKind: "⚠️ Упс, я не могу добавить этот файл в набор, так как не поддерживаю его",
+	BundleCreateFailed:               "⚠️ Что-то пошло не так при создании набора",
+	BundleCreated:                    "✅ Набор создан",
+	BundleNotFound:                   "😐 Ничего не знаю о таком файле, проверь ссылку...",
+	BundleEmpty:                      "😐 В этом наборе не осталось доступных файлов",
+	BundleDeletedBefore:              "Набор был удален ранее",
+	BundleDeleted:                    "✅ Набор удален",
+	BundleDeletedByOwner:             "🙅‍♂️ Набор был удален владельцем",
+	BundleNotSubscribed:              "Я не наблюдаю тебя в подписчиках, подпишись чтобы получить доступ к файлам",
+	BundleAccessGranted:              "🔓 Доступ к файлам получен",
+	BundleCantCheckMembership:        "🙅‍♂️ Я не могу выдать тебе файлы, так как больше не являюсь админом канала на который требовалась подписка, свяжись с владельцем и передавай от меня привет!",
+
+	ReportButton:             "⚠️ Пожаловаться",
+	ReportRequest:            "Опиши, почему этот файл нарушает авторские права (до %d символов). Админы рассмотрят жалобу.",
+	ReportCanceled:           "Жалоба отменена",
+	ReportOwnFile:            "Нельзя пожаловаться на свой файл",
+	ReportAlreadyExists:      "Жалоба на этот файл уже отправлена, дождись решения админов",
+	ReportFileBlocked:        "Этот файл уже заблокирован",
+	ReportOnlyText:           "⚠️ Отправь текст обычным сообщением",
+	ReportBadLength:          "⚠️ Текст должен содержать от 1 до %d символов",
+	ReportNotAwait:           "Текст больше не ожидается, начни заново",
+	ReportSent:               "✅ Жалоба отправлена, админы скоро ее рассмотрят",
+	ReportOwnerBlocked:       "🚫 Твой файл «%s» заблокирован по жалобе на нарушение авторских прав.\n\nПричина: %s\n\nЕсли считаешь это ошибкой, можешь обжаловать решение.",
+	ReportOwnerAppealButton:  "Обжаловать",
+	ReportAppealRequest:      "Объясни, почему файл не нарушает авторские права (до %d символов). Админы рассмотрят апелляцию.",
+	ReportAppealNotAllowed:   "Это решение нельзя обжаловать",
+	ReportAppealSent:         "✅ Апелляция отправлена, админы скоро ее рассмотрят",
+	ReportOwnerAppealGranted: "✅ Апелляция одобрена, файл «%s» снова доступен",
+	ReportOwnerAppealDenied:  "❌ Апелляция отклонена, файл «%s» остается заблокированным",
+
+	AdminSummary:       "*__Общая__*",
+	AdminUsers:         "*Пользователи*: `%d`",
+	AdminActiveUsers:   "*Активные пользователи*: `%d`",
+	AdminBlockedUsers:  "*Заблокировали бота*: `%d`",
+	AdminUsersActivity: "*DAU / WAU / MAU*: `%d / %d / %d`",
+	AdminFiles:         "*Файлы*: `%d`",
+	AdminDownloads:     "*Загрузки*: `%d`",
+	AdminChats:         "*Чаты*: `%d`",
+	AdminRefs:          "*__Источники__*",
+	AdminReports:       "*Открытые жалобы*: `%d`",
+
+	AdminReportTitle:      "*__Жалоба \\#%d__*",
+	AdminAppealTitle:      "*__Апелляция по жалобе \\#%d__*",
+	AdminReportQueue:      "_Открытых жалоб в очереди: %d_",
+	AdminReportFile:       "*Файл*: %s",
+	AdminReportOwner:      "*Владелец*: %s",
+	AdminReportReporter:   "*Автор жалобы*: %s",
+	AdminReportReason:     "*Причина*: %s",
+	AdminReportCreatedAt:  "*Дата жалобы*: `%s`",
+	AdminReportResolvedAt: "*Дата блокировки*: `%s`",
+	AdminReportAppeal:     "*Апелляция*: %s",
+	AdminReportAppealedAt: "*Дата апелляции*: `%s`",
+	AdminReportNoReporter: "_удаленный пользователь_",
+
+	AdminReportsButton:       "📥 Очередь жалоб",
+	AdminReportButtonFile:    "📄 Показать файл",
+	AdminReportButtonApprove: "🚫 Заблокировать файл",
+	AdminReportButtonReject:  "✅ Отклонить жалобу",
+	AdminAppealButtonApprove: "✅ Разблокировать файл",
+	AdminAppealButtonReject:  "🚫 Оставить блокировку",
+	AdminReportQueueEmpty:    "Открытых жалоб нет",
+	AdminReportApproved:      "Файл заблокирован, владелец уведомлен",
+	AdminReportRejected:      "Жалоба отклонена",
+	AdminAppealApproved:      "Файл разблокирован, владелец уведомлен",
+	AdminAppealRejected:      "Апелляция отклонена, владелец уведомлен",
+	AdminReportResolvedEarly: "Жалоба уже рассмотрена",
+
+	AdminUsage: dedent.Dedent(`
+		*__Команды админа__*
+
+		/admin \- общая статистика
+		/admin file \<public id\> \- информация о файле
+		/admin ban \<user id\> \- забанить пользователя
+		/admin unban \<user id\> \- разбанить пользователя
+		/admin broadcast \- новая рассылка
+		/admin broadcasts \- последние рассылки
+		/admin campaigns \- кампании, см\. /admin campaign
+		/admin reserved \- названия, зарезервированные для своих ссылок
+		/admin reserve \<name\> \- зарезервировать название
+		/admin unreserve \<name\> \- снять резерв с названия
+		/admin audit \- последние действия админов
+	`),
+	AdminFileTitle:            "*__Файл__* `%s`",
+	AdminFileName:             "*Название*: %s",
+	AdminFileCreatedAt:        "*Загружен*: `%s`",
+	AdminFileOwner:            "*Владелец*: %s \\(`%d`\\)",
+	AdminFileStatus:           "*Статус*: %s",
+	AdminFileStatusAvailable:  "доступен",
+	AdminFileStatusBlocked:    "заблокирован админом",
+	AdminFileStatusCopyright:  "заблокирован по жалобе",
+	AdminFileStatusOwnerBan:   "владелец забанен",
+	AdminFileChats:            "*Чаты для подписки*: `%d`",
+	AdminAuditTitle:           "*__Журнал действий__*",
+	AdminAuditEmpty:           "_Действий пока нет_",
+	AdminAuditFileBlock:       "заблокировал файл `%d`",
+	AdminAuditFileUnblock:     "разблокировал файл `%d`",
+	AdminAuditUserBan:         "забанил пользователя `%d`",
+	AdminAuditUserUnban:       "разбанил пользователя `%d`",
+	AdminFileButtonBlock:      "🚫 Заблокировать файл",
+	AdminFileButtonUnblock:    "✅ Разблокировать файл",
+	AdminFileButtonBanOwner:   "🚫 Забанить владельца",
+	AdminFileButtonUnbanOwner: "✅ Разбанить владельца",
+
+	AdminFileNotFound:  "Файл не найден",
+	AdminUserNotFound:  "Пользователь не найден",
+	AdminFileBlocked:   "Файл заблокирован",
+	AdminFileUnblocked: "Файл разблокирован",
+	AdminUserBanned:    "Пользователь забанен",
+	AdminUserUnbanned:  "Пользователь разбанен",
+	AdminCantBanAdmin:  "Админов нельзя банить",
+
+	AdminBroadcastsButton:        "📣 Рассылки",
+	BroadcastTitle:               "*__Рассылка \\#%d__*",
+	BroadcastStatus:              "*Статус*: %s",
+	BroadcastStatusDraft:         "черновик",
+	BroadcastStatusRunning:       "идет",
+	BroadcastStatusDone:          "завершена",
+	BroadcastStatusCanceled:      "отменена",
+	BroadcastSegment:             "*Сегмент*: %s",
+	BroadcastSegmentAll:          "все пользователи",
+	BroadcastSegmentRef:          "ref `%s`",
+	BroadcastSegmentLanguage:     "язык `%s`",
+	BroadcastSegmentJoinedAfter:  "пришли после `%s`",
+	BroadcastAudience:            "*Аудитория*: `%d`",
+	BroadcastButtons:             "*Кнопки*: `%d`",
+	BroadcastProgress:            "*Обработано*: `%d` из `%d`",
+	BroadcastDelivered:           "*Доставлено*: `%d`",
+	BroadcastBlocked:             "*Заблокировали бота*: `%d`",
+	BroadcastFailed:              "*Ошибки*: `%d`",
+	BroadcastStartedAt:           "*Запущена*: `%s`",
+	BroadcastFinishedAt:          "*Завершена*: `%s`",
+	BroadcastListTitle:           "*__Рассылки__*",
+	BroadcastListEmpty:           "_Рассылок пока нет_",
+	BroadcastButtonPreview:       "👁 Предпросмотр",
+	BroadcastButtonButtons:       "🔗 Кнопки",
+	BroadcastButtonSegment:       "🎯 Сегмент",
+	BroadcastButtonStart:         "🚀 Запустить",
+	BroadcastButtonStartConfirm:  "🚀 Да, запустить",
+	BroadcastButtonCancel:        "✖️ Отменить рассылку",
+	BroadcastButtonCancelConfirm: "✖️ Да, отменить",
+
+	BroadcastRequest: "Отправь сообщение для рассылки: текст, фото, видео или любое другое сообщение. Оно будет скопировано пользователям как есть.",
+	BroadcastButtonsRequest: dedent.Dedent(`
+		Отправь до %d кнопок-ссылок, по одной на строку в формате:
+
+		Название - https://example.com
+
+		Отправь «-», чтобы убрать кнопки.
+	`),
+	BroadcastSegmentRequest: dedent.Dedent(`
+		Отправь условия сегмента, по одному на строку:
+
+		all — все пользователи
+		ref <ref> — пришли по ref ссылке
+		lang <code> — пользователи с языком, например lang ru
+		joined <YYYY-MM-DD> — пришли после даты
+	`),
+	BroadcastInvalidButtons:  "⚠️ Неверные кнопки, проверь формат и ссылки",
+	BroadcastInvalidSegment:  "⚠️ Неверный сегмент, проверь формат условий",
+	BroadcastNotAwait:        "Нечего редактировать, открой рассылку заново",
+	BroadcastInputCanceled:   "Отменено",
+	BroadcastPreviewSent:     "Предпросмотр отправлен",
+	BroadcastPreviewFailed:   "Не удалось отправить предпросмотр, возможно сообщение удалено",
+	BroadcastStartConfirm:    "Запустить доставку %d пользователям?",
+	BroadcastCancelConfirm:   "Отменить рассылку?",
+	BroadcastStarted:         "Рассылка запущена",
+	BroadcastCanceled:        "Рассылка отменена",
+	BroadcastEmptyAudience:   "В сегменте нет пользователей",
+	BroadcastAlreadyStarted:  "Рассылка уже запущена",
+	BroadcastAlreadyFinished: "Рассылка уже завершена",
+	BroadcastNotFound:        "Рассылка не найдена",
+
+	AdminCampaignsButton:  "📈 Кампании",
+	AdminCampaignsTitle:   "*__Кампании__*",
+	AdminCampaignsPeriod:  "*Период*: %s",
+	AdminCampaignsAllTime: "все время",
+	AdminCampaignsEmpty:   "_Кампаний пока нет_",
+	AdminCampaignsUsage: dedent.Dedent(`
+		*__Команды кампаний__*
+
+		/admin campaigns \[from\] \[to\] \- статистика кампаний за период, даты в формате YYYY\-MM\-DD
+		/admin campaign add \<ref\> \<cost\> \<name\> \- добавить кампанию
+		/admin campaign delete \<ref\> \- удалить кампанию
+	`),
+	AdminCampaignName:          "*%s* \\(`%s`\\)",
+	AdminCampaignUsers:         "*Пользователи*: `%d`, *загрузки*: `%d`",
+	AdminCampaignCost:          "*Стоимость*: `%s`, *за пользователя*: `%s`",
+	AdminCampaignLink:          "*Ссылка*: %s",
+	AdminCampaignCreated:       "Кампания добавлена, ссылка: %s",
+	AdminCampaignDeleted:       "Кампания удалена",
+	AdminCampaignNotFound:      "Кампания не найдена",
+	AdminCampaignExists:        "Кампания с таким ref уже существует",
+	AdminCampaignInvalidRef:    "Ref может содержать только латинские буквы, цифры и _ (до 32 символов)",
+	AdminCampaignInvalidCost:   "Неверная стоимость, используйте число вида 150 или 99.90",
+	AdminCampaignInvalidPeriod: "Неверный период, используйте даты в формате YYYY-MM-DD",
+
+	AdminReservedTitle:    "*__Зарезервированные ссылки__*",
+	AdminReservedEmpty:    "_Зарезервированных названий пока нет_",
+	AdminReservedAdded:    "Название %s зарезервировано, владельцы не смогут использовать его как свою ссылку",
+	AdminReservedDeleted:  "Название %s больше не зарезервировано",
+	AdminReservedNotFound: "Название не зарезервировано",
+	AdminReservedExists:   "Название уже зарезервировано",
+	AdminReservedInvalid:  "Название может содержать только латинские буквы, цифры, _ и - (до %d символов)",
+}
@@ -0,0 +1,490 @@
+package i18n
+
+// Texts is catalog of bot texts in one language.
+// Texts marked as MarkdownV2 are already escaped, other texts should be escaped before sending with MarkdownV2.
+// Format verbs of texts should be same in all languages.
+type Texts struct {
+	// Language of texts.
+	Lang Lang
+
+	// Name of language in this language.
+	LangName string
+
+	// common
+	CommonBack           string
+	CommonDisconnect     string
+	CommonYesIamSure     string
+	CommonYes            string
+	CommonNo             string
+	CommonRefresh        string
+	CommonDelete         string
+	CommonCancel         string
+	CommonRestrictions   string
+	CommonNothingChanged string
+
+	// cancel of conversation
+	CancelDone    string
+	CancelNothing string
+
+	// start and help (MarkdownV2)
+	Hello string
+	Help  string
+
+	// button on messages for users came by link
+	ButtonAbout string
+
+	UnsupportedFileKind   string
+	UploadOnlyAdmins      string
+	FileViolatesCopyright string
+	BlockedByAdmin        string
+	CantCheckMembership   string
+
+	// owned file (MarkdownV2)
+	FileCaptionDescription string
+	FileCaptionPublicLink  string
+	FileCaptionLinkedPost  string
+	FileCaptionStats       string
+	StatsDownloads         string
+	StatsUniqueDownloads   string
+	StatsWithSubscription  string
+	StatsNewSubscription   string
+
+	// owned file delete (Markdown)
+	FileDeleteConfirm string
+
+	FileNotSupported   string
+	FileAudioDisabled  string
+	FileAddFailed      string
+	FileDeletedBefore  string
+	FileDeleted        string
+	FileDeletedByOwner string
+	FilePostButton     string
+
+	// file restrictions (MarkdownV2)
+	FileRestrictions string
+
+	ChatPolicyAny       string
+	ChatPolicyAll       string
+	RestrictionDisabled string
+	RestrictionSet      string
+
+	// subscription request (MarkdownV2)
+	SubRequest    string
+	SubRequestAll string
+	SubRequestAny string
+
+	SubRequestButtonSubscribe   string
+	SubRequestButtonSubscribeTo string
+	SubRequestButtonCheck       string
+
+	FileNotSubscribed    string
+	FileAccessGranted    string
+	FilePasswordExpired  string
+	FileNotAvailableYet  string
+	FileExpired          string
+	FileLimitReached     string
+	FileUserLimitReached string
+
+	// file limits
+	LimitDisable                   string
+	LimitDisabled                  string
+	LimitNone                      string
+	LimitHours                     Plural
+	LimitDays                      Plural
+	LimitMaxDownloadsButton        string
+	LimitMaxDownloadsPerUserButton string
+	LimitExpiresAtButton           string
+	LimitAvailableFromButton       string
+
+	// file limits (MarkdownV2)
+	LimitMaxDownloads        string
+	LimitMaxDownloadsPerUser string
+	LimitExpiresAt           string
+	LimitAvailableFrom       string
+
+	// file limits in owned file caption (MarkdownV2)
+	LimitsCaption              string
+	LimitsCaptionDownloadsLeft string
+	LimitsCaptionPerUser       string
+	LimitsCaptionAvailableFrom string
+	LimitsCaptionExpired       string
+	LimitsCaptionExpiresAt     string
+	LimitsCaptionPassword      string
+
+	// file password (MarkdownV2)
+	PasswordRequest      string
+	PasswordSet          string
+	PasswordRestrictions string
+
+	PasswordCanceled      string
+	PasswordNotAwait      string
+	PasswordExceeded      string
+	PasswordInvalid       string
+	PasswordOnlyText      string
+	PasswordSetDone       string
+	PasswordDisabled      string
+	PasswordBadLength     string
+	PasswordFileNotFound  string
+	PasswordValueSet      string
+	PasswordButton        string
+	PasswordButtonSet     string
+	PasswordButtonChange  string
+	PasswordButtonDisable string
+
+	// file placements (MarkdownV2)
+	PlacementsButton     string
+	PlacementsTitle      string
+	PlacementsEmpty      string
+	PlacementsItem       string
+	PlacementsDirectName string
+	PlacementsAddButton  string
+	PlacementTitle       string
+	PlacementLink        string
+	PlacementDownloads   string
+	PlacementAdd         string
+	PlacementAdded       string
+
+	// file placements input and results
+	PlacementDeleted  string
+	PlacementNotFound string
+	PlacementBadName  string
+	PlacementsLimit   string
+	PlacementNotAwait string
+	PlacementOnlyText string
+	PlacementCanceled string
+
+	// edit of file (MarkdownV2)
+	FileEditButton            string
+	FileEdit                  string
+	FileEditButtonCaption     string
+	FileEditButtonName        string
+	FileEditButtonPost        string
+	FileEditButtonCaptionDrop string
+	FileEditButtonPostDrop    string
+
+	// edit of file input and results
+	FileEditCaptionPrompt  string
+	FileEditNamePrompt     string
+	FileEditPostPrompt     string
+	FileEditOnlyText       string
+	FileEditCaptionTooLong string
+	FileEditNameInvalid    string
+	FileEditPostInvalid    string
+	FileEditCaptionDropped string
+	FileEditPostDropped    string
+
+	// versions of file (MarkdownV2)
+	FileVersionsButton        string
+	FileVersions              string
+	FileVersionsCurrent       string
+	FileVersionsEmpty         string
+	FileVersionsItem          string
+	FileVersionsMore          string
+	FileVersionsUploadButton  string
+	FileVersionsRestoreButton string
+
+	// replace of file
+	FileReplacePrompt   string
+	FileReplaceOnlyFile string
+	FileVersionRestored string
+	FileVersionNotFound string
+
+	// regeneration of link (MarkdownV2)
+	FileLinkButton           string
+	FileLink                 string
+	FileLinkGraceNow         string
+	FileLinkGraceButton      string
+	FileLinkRegenerated      string
+	FileLinkRegeneratedGrace string
+	FileLinkRevoked          string
+
+	// custom link
+	FileLinkCustomButton   string
+	FileLinkCustomPrompt   string
+	FileLinkCustomInvalid  string
+	FileLinkCustomTaken    string
+	FileLinkCustomReserved string
+	FileLinkCustomSame     string
+
+	// library of files (MarkdownV2)
+	FilesEmpty string
+	FilesTitle string
+	FilesFound string
+	FilesQuery string
+
+	FilesNotFound          string
+	FilesSearchRequest     string
+	FilesSearchLength      string
+	FilesOrderCreatedAt    string
+	FilesOrderDownloads    string
+	FilesKindFilter        string
+	FilesSearchButton      string
+	FilesSearchResetButton string
+	FileUntitled           string
+
+	// kinds of files in library filter
+	KindAll       string
+	KindDocument  string
+	KindPhoto     string
+	KindVideo     string
+	KindAnimation string
+	KindAudio     string
+	KindVoice     string
+	KindSticker   string
+	KindVideoNote string
+	KindContact   string
+	KindLocation  string
+
+	// inline mode
+	InlineButtonDownload string
+	InlineSwitchPM       string
+
+	// download stats (MarkdownV2)
+	StatsPeriod     Plural
+	StatsNote       string
+	StatsDaily      string
+	StatsHourly     string
+	StatsFileTitle  string
+	StatsChatTitle  string
+	StatsPlacements string
+
+	StatsButton                string
+	StatsValueDownloads        string
+	StatsValueUnique           string
+	StatsValueNewSubscriptions string
+
+	// export of downloads
+	ExportEmpty   string
+	ExportCaption string
+
+	// settings (MarkdownV2)
+	Settings string
+
+	SettingsButtonLongIDs          string
+	SettingsLongIDsEnabled         string
+	SettingsLongIDsDisabled        string
+	SettingsButtonChannelsAndChats string
+	SettingsLanguageChanged        string
+
+	// settings / channels and chats (MarkdownV2)
+	Chats        string
+	ChatsConnect string
+	ChatsDetails string
+	ChatsDelete  string
+
+	ChatsButtonConnect            string
+	ChatsConnectButtonCancel      string
+	ChatsConnectNotValid          string
+	ChatsConnectIsPrivate         string
+	ChatsConnectNotFound          string
+	ChatsConnectBotIsNotAdmin     string
+	ChatsConnectUserIsNotAdmin    string
+	ChatsConnectBotNotEnoughRight string
+	ChatsConnectAlreadyConnected  string
+	ChatsConnectNotChannelForward string
+	ChatsConnectBadJoinLink       string
+	ChatsConnectBadInput          string
+	ChatsConnected                string
+	ChatsDisconnected             string
+
+	// types of chat
+	ChatTypeChannel    string
+	ChatTypeSuperGroup string
+	ChatTypeGroup      string
+	ChatTypeUnknown    string
+
+	// bundles (MarkdownV2)
+	BundleCollect string
+	BundleTitle   Plural
+	BundleSent    string
+
+	// bundle delete (Markdown)
+	BundleDeleteConfirm string
+
+	BundleButtonDone                 string
+	BundleButtonCancel               string
+	BundleCollectCanceled            string
+	BundleCollectEmpty               string
+	BundleCollectFull                string
+	BundleCollectAdded               string
+	BundleCollectCaption             string
+	BundleCollectUnsupportedFileKind string
+	BundleCreateFailed               string
+	BundleCreated                    string
+	BundleNotFound                   string
+	BundleEmpty                      string
+	BundleDeletedBefore              string
+	BundleDeleted                    string
+	BundleDeletedByOwner             string
+	BundleNotSubscribed              string
+	BundleAccessGranted              string
+	BundleCantCheckMembership        string
+
+	// copyright reports
+	ReportButton             string
+	ReportRequest            string
+	ReportCanceled           string
+	ReportOwnFile            string
+	ReportAlreadyExists      string
+	ReportFileBlocked        string
+	ReportOnlyText           string
+	ReportBadLength          string
+	ReportNotAwait           string
+	ReportSent               string
+	ReportOwnerBlocked       string
+	ReportOwnerAppealButton  string
+	ReportAppealRequest      string
+	ReportAppealNotAllowed   string
+	ReportAppealSent         string
+	ReportOwnerAppealGranted string
+	ReportOwnerAppealDenied  string
+
+	// admin stats (MarkdownV2)
+	AdminSummary       string
+	AdminUsers         string
+	AdminActiveUsers   string
+	AdminBlockedUsers  string
+	AdminUsersActivity string
+	AdminFiles         string
+	AdminDownloads     string
+	AdminChats         string
+	AdminRefs          string
+	AdminReports       string
+
+	// admin reports queue (MarkdownV2)
+	AdminReportTitle      string
+	AdminAppealTitle      string
+	AdminReportQueue      string
+	AdminReportFile       string
+	AdminReportOwner      string
+	AdminReportReporter   string
+	AdminReportReason     string
+	AdminReportCreatedAt  string
+	AdminReportResolvedAt string
+	AdminReportAppeal     string
+	AdminReportAppealedAt string
+	AdminReportNoReporter string
+
+	AdminReportsButton       string
+	AdminReportButtonFile    string
+	AdminReportButtonApprove string
+	AdminReportButtonReject  string
+	AdminAppealButtonApprove string
+	AdminAppealButtonReject  string
+	AdminReportQueueEmpty    string
+	AdminReportApproved      string
+	AdminReportRejected      string
+	AdminAppealApproved      string
+	AdminAppealRejected      string
+	AdminReportResolvedEarly string
+
+	// admin moderation (MarkdownV2)
+	AdminUsage                string
+	AdminFileTitle            string
+	AdminFileName             string
+	AdminFileCreatedAt        string
+	AdminFileOwner            string
+	AdminFileStatus           string
+	AdminFileStatusAvailable  string
+	AdminFileStatusBlocked    string
+	AdminFileStatusCopyright  string
+	AdminFileStatusOwnerBan   string
+	AdminFileChats            string
+	AdminAuditTitle           string
+	AdminAuditEmpty           string
+	AdminAuditFileBlock       string
+	AdminAuditFileUnblock     string
+	AdminAuditUserBan         string
+	AdminAuditUserUnban       string
+	AdminFileButtonBlock      string
+	AdminFileButtonUnblock    string
+	AdminFileButtonBanOwner   string
+	AdminFileButtonUnbanOwner string
+
+	// admin moderation results
+	AdminFileNotFound  string
+	AdminUserNotFound  string
+	AdminFileBlocked   string
+	AdminFileUnblocked string
+	AdminUserBanned    string
+	AdminUserUnbanned  string
+	AdminCantBanAdmin  string
+
+	// admin broadcast (MarkdownV2)
+	AdminBroadcastsButton        string
+	BroadcastTitle               string
+	BroadcastStatus              string
+	BroadcastStatusDraft         string
+	BroadcastStatusRunning       string
+	BroadcastStatusDone          string
+	BroadcastStatusCanceled      string
+	BroadcastSegment             string
+	BroadcastSegmentAll          string
+	BroadcastSegmentRef          string
+	BroadcastSegmentLanguage     string
+	BroadcastSegmentJoinedAfter  string
+	BroadcastAudience            string
+	BroadcastButtons             string
+	BroadcastProgress            string
+	BroadcastDelivered           string
+	BroadcastBlocked             string
+	BroadcastFailed              string
+	BroadcastStartedAt           string
+	BroadcastFinishedAt          string
+	BroadcastListTitle           string
+	BroadcastListEmpty           string
+	BroadcastButtonPreview       string
+	BroadcastButtonButtons       string
+	BroadcastButtonSegment       string
+	BroadcastButtonStart         string
+	BroadcastButtonStartConfirm  string
+	BroadcastButtonCancel        string
+	BroadcastButtonCancelConfirm string
+
+	// admin broadcast input and results
+	BroadcastRequest         string
+	BroadcastButtonsRequest  string
+	BroadcastSegmentRequest  string
+	BroadcastInvalidButtons  string
+	BroadcastInvalidSegment  string
+	BroadcastNotAwait        string
+	BroadcastInputCanceled   string
+	BroadcastPreviewSent     string
+	BroadcastPreviewFailed   string
+	BroadcastStartConfirm    string
+	BroadcastCancelConfirm   string
+	BroadcastStarted         string
+	BroadcastCanceled        string
+	BroadcastEmptyAudience   string
+	BroadcastAlreadyStarted  string
+	BroadcastAlreadyFinished string
+	BroadcastNotFound        string
+
+	// admin campaigns (MarkdownV2)
+	AdminCampaignsButton       string
+	AdminCampaignsTitle        string
+	AdminCampaignsPeriod       string
+	AdminCampaignsAllTime      string
+	AdminCampaignsEmpty        string
+	AdminCampaignsUsage        string
+	AdminCampaignName          string
+	AdminCampaignUsers         string
+	AdminCampaignCost          string
+	AdminCampaignLink          string
+	AdminCampaignCreated       string
+	AdminCampaignDeleted       string
+	AdminCampaignNotFound      string
+	AdminCampaignExists        string
+	AdminCampaignInvalidRef    string
+	AdminCampaignInvalidCost   string
+	AdminCampaignInvalidPeriod string
+
+	// admin reserved names of custom links (MarkdownV2)
+	AdminReservedTitle    string
+	AdminReservedEmpty    string
+	AdminReservedAdded    string
+	AdminReservedDeleted  string
+	AdminReservedNotFound string
+	AdminReservedExists   string
+	AdminReservedInvalid  string
+}
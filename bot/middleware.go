@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	tgbotapi "github.com/bots-house/telegram-bot-api"
 	"github.com/fatih/structs"
@@ -17,33 +18,44 @@ import (
 	"github.com/bots-house/share-file-bot/service"
 )
 
-const refDeepLinkPrefix = "ref_"
+const refDeepLinkPrefix = service.DeepLinkRefPrefix
 
-func extractRefFromMsg(msg *tgbotapi.Message) string {
-	if msg != nil && msg.Command() == cmdStart {
-		args := msg.CommandArguments()
+// extractRefFromMsg returns ref from /start deep-link and removes it from message text.
+// Ref is returned only if isRefAllowed returns true.
+func extractRefFromMsg(msg *tgbotapi.Message, isRefAllowed func(ref string) (bool, error)) (string, error) {
+	if msg == nil || msg.Command() != cmdStart {
+		return "", nil
+	}
+
+	args := msg.CommandArguments()
 
-		if !strings.HasPrefix(args, refDeepLinkPrefix) {
-			return ""
-		}
+	if !strings.HasPrefix(args, refDeepLinkPrefix) {
+		return "", nil
+	}
 
-		ref := strings.TrimPrefix(args, refDeepLinkPrefix)
+	ref := strings.TrimPrefix(args, refDeepLinkPrefix)
 
-		if !strings.Contains(ref, "-") {
-			msg.Text = "/start"
-			return ref
-		}
+	if !strings.Contains(ref, "-") {
+		msg.Text = "/start"
+	} else {
+		// keep placement of file, if any
+		items := strings.SplitN(ref, "-", 2)
 
-		items := strings.Split(ref, "-")
+		msg.Text = "/start " + items[1]
+
+		ref = items[0]
+	}
 
-		if len(items) > 1 {
-			msg.Text = "/start " + items[1]
-		}
+	allowed, err := isRefAllowed(ref)
+	if err != nil {
+		return "", errors.Wrap(err, "check ref")
+	}
 
-		return items[0]
+	if !allowed {
+		return "", nil
 	}
 
-	return ""
+	return ref, nil
 }
 
 func serializeStruct(v interface{}) map[string]interface{} {
@@ -61,9 +73,38 @@ func serializeStruct(v interface{}) map[string]interface{} {
 	return result
 }
 
+// handleMyChatMember saves that user blocked or unblocked bot in private chat.
+// Such updates are not passed to next handler, because user can't be answered.
+func handleMyChatMember(ctx context.Context, srv *service.Auth, cmu *tg.ChatMemberUpdated) error {
+	var blocked bool
+
+	switch {
+	case cmu.IsBotBlocked():
+		blocked = true
+	case cmu.IsBotUnblocked():
+		blocked = false
+	default:
+		return nil
+	}
+
+	ctx = log.With(ctx, "user", fmt.Sprintf("#%d", cmu.From.ID))
+
+	if err := srv.SetBotBlocked(ctx, &service.UserInfo{
+		ID:           cmu.From.ID,
+		FirstName:    cmu.From.FirstName,
+		LastName:     cmu.From.LastName,
+		Username:     cmu.From.UserName,
+		LanguageCode: cmu.From.LanguageCode,
+	}, blocked, time.Unix(cmu.Date, 0)); err != nil {
+		return errors.Wrap(err, "set bot blocked")
+	}
+
+	return nil
+}
+
 func newAuthMiddleware(srv *service.Auth) tg.Middleware {
 	return func(next tg.Handler) tg.Handler {
-		return tg.HandlerFunc(func(ctx context.Context, update *tgbotapi.Update) error {
+		return tg.HandlerFunc(func(ctx context.Context, update *tg.Update) error {
 			withSentryHub(ctx, func(hub *sentry.Hub) {
 				hub.AddBreadcrumb(&sentry.Breadcrumb{
 					Message:  "Update",
@@ -84,8 +125,14 @@ func newAuthMiddleware(srv *service.Auth) tg.Middleware {
 				tgUser = update.EditedMessage.From
 			case update.CallbackQuery != nil:
 				tgUser = update.CallbackQuery.From
+			case update.InlineQuery != nil:
+				tgUser = update.InlineQuery.From
+			case update.ChosenInlineResult != nil:
+				tgUser = update.ChosenInlineResult.From
 			case update.ChannelPost != nil:
 				tgUser = nil
+			case update.MyChatMember != nil:
+				return handleMyChatMember(ctx, srv, update.MyChatMember)
 			default:
 				log.Warn(ctx, "unsupported update", "id", update.UpdateID)
 				return nil
@@ -98,7 +145,12 @@ func newAuthMiddleware(srv *service.Auth) tg.Middleware {
 					ctx = log.With(ctx, "user", fmt.Sprintf("#%d", tgUser.ID))
 				}
 
-				ref := extractRefFromMsg(update.Message)
+				ref, err := extractRefFromMsg(update.Message, func(ref string) (bool, error) {
+					return srv.IsRefAllowed(ctx, ref)
+				})
+				if err != nil {
+					return errors.Wrap(err, "extract ref")
+				}
 
 				user, err := srv.Auth(ctx, &service.UserInfo{
 					ID:           tgUser.ID,
@@ -113,8 +165,16 @@ func newAuthMiddleware(srv *service.Auth) tg.Middleware {
 					return errors.Wrap(err, "auth service")
 				}
 
+				// refuse any interaction of banned user
+				if user.IsBanned {
+					log.Info(ctx, "refuse update of banned user", "update_id", update.UpdateID)
+					return nil
+				}
+
+				ctx = withUser(ctx, user)
+				ctx = withTexts(ctx, getUserTexts(user))
+
 				withSentryHub(ctx, func(hub *sentry.Hub) {
-					ctx = withUser(ctx, user)
 
 					hub.AddBreadcrumb(&sentry.Breadcrumb{
 						Message:  "User",
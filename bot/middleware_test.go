@@ -1,10 +1,16 @@
 package bot
 
 import (
+	"context"
 	"testing"
 
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/pkg/tg"
+	"github.com/bots-house/share-file-bot/service"
+	"github.com/bots-house/share-file-bot/store/memory"
 	tgbotapi "github.com/bots-house/telegram-bot-api"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestExtractRefFromMsg(t *testing.T) {
@@ -13,6 +19,7 @@ func TestExtractRefFromMsg(t *testing.T) {
 		Text         string
 		ExceptedText string
 		Ref          string
+		Unknown      bool
 	}{
 		{
 			Name:         "StartWithFile",
@@ -32,6 +39,19 @@ func TestExtractRefFromMsg(t *testing.T) {
 			ExceptedText: "/start LlOiBaweeonab_xFZ3xnVD1XxLZCjHPhlgeMCMMWyBSpocXcaf",
 			Ref:          "tgstat_1",
 		},
+		{
+			Name:         "StartWithFilePlacementAndRef",
+			Text:         "/start ref_tgstat_1-HndVA-12",
+			ExceptedText: "/start HndVA-12",
+			Ref:          "tgstat_1",
+		},
+		{
+			Name:         "StartWithFileAndUnknownRef",
+			Text:         "/start ref_spam-LlOiBaweeonab_xFZ3xnVD1XxLZCjHPhlgeMCMMWyBSpocXcaf",
+			ExceptedText: "/start LlOiBaweeonab_xFZ3xnVD1XxLZCjHPhlgeMCMMWyBSpocXcaf",
+			Ref:          "",
+			Unknown:      true,
+		},
 		{
 			Name:         "JustStart",
 			Text:         "/start",
@@ -50,10 +70,89 @@ func TestExtractRefFromMsg(t *testing.T) {
 				},
 			}}
 
-			ref := extractRefFromMsg(msg)
+			ref, err := extractRefFromMsg(msg, func(ref string) (bool, error) {
+				return !test.Unknown, nil
+			})
+
+			require.NoError(t, err)
 
 			assert.Equal(t, test.Ref, ref)
 			assert.Equal(t, test.ExceptedText, msg.Text)
 		})
 	}
 }
+
+func TestAuthMiddleware_Banned(t *testing.T) {
+	ctx := context.Background()
+	mem := memory.New()
+
+	banned := core.NewUser(1, "Banned", "", "", "en")
+	banned.IsBanned = true
+	require.NoError(t, mem.User().Add(ctx, banned))
+
+	var handled []core.UserID
+
+	handler := newAuthMiddleware(&service.Auth{UserStore: mem.User()})(
+		tg.HandlerFunc(func(ctx context.Context, update *tg.Update) error {
+			handled = append(handled, getUserCtx(ctx).ID)
+			return nil
+		}),
+	)
+
+	for _, id := range []int{1, 2} {
+		require.NoError(t, handler.HandleUpdate(ctx, &tg.Update{Update: tgbotapi.Update{
+			Message: &tgbotapi.Message{
+				From: &tgbotapi.User{ID: id, FirstName: "User"},
+				Text: "hello",
+			},
+		}}))
+	}
+
+	assert.Equal(t, []core.UserID{2}, handled)
+}
+
+func TestAuthMiddleware_MyChatMember(t *testing.T) {
+	ctx := context.Background()
+	mem := memory.New()
+
+	var handled int
+
+	handler := newAuthMiddleware(&service.Auth{UserStore: mem.User()})(
+		tg.HandlerFunc(func(ctx context.Context, update *tg.Update) error {
+			handled++
+			return nil
+		}),
+	)
+
+	newUpdate := func(chatType, oldStatus, newStatus string) *tg.Update {
+		return &tg.Update{MyChatMember: &tg.ChatMemberUpdated{
+			Chat:          tgbotapi.Chat{ID: 1, Type: chatType},
+			From:          tgbotapi.User{ID: 1, FirstName: "User"},
+			Date:          1605873600,
+			OldChatMember: tg.ChatMember{Status: oldStatus},
+			NewChatMember: tg.ChatMember{Status: newStatus},
+		}}
+	}
+
+	require.NoError(t, handler.HandleUpdate(ctx, newUpdate("private", tg.ChatMemberStatusMember, tg.ChatMemberStatusKicked)))
+
+	user, err := mem.User().Find(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, int64(1605873600), user.BotBlockedAt.Time.Unix())
+
+	// bot status in groups is not related to user
+	require.NoError(t, handler.HandleUpdate(ctx, newUpdate("group", tg.ChatMemberStatusKicked, tg.ChatMemberStatusMember)))
+
+	user, err = mem.User().Find(ctx, 1)
+	require.NoError(t, err)
+	require.True(t, user.BotBlockedAt.Valid)
+
+	require.NoError(t, handler.HandleUpdate(ctx, newUpdate("private", tg.ChatMemberStatusKicked, tg.ChatMemberStatusMember)))
+
+	user, err = mem.User().Find(ctx, 1)
+	require.NoError(t, err)
+	require.False(t, user.BotBlockedAt.Valid)
+	require.True(t, user.BotUnblockedAt.Valid)
+
+	require.Zero(t, handled)
+}
@@ -0,0 +1,284 @@
+package bot
+
+import (
+	"context"
+	"strings"
+
+	"github.com/bots-house/share-file-bot/bot/i18n"
+	"github.com/bots-house/share-file-bot/bot/state"
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/pkg/tg"
+	"github.com/bots-house/share-file-bot/service"
+	tgbotapi "github.com/bots-house/telegram-bot-api"
+)
+
+// callbackHandler adapts handler without parameters to tg.CallbackHandler.
+func callbackHandler(handler func(ctx context.Context, cbq *tgbotapi.CallbackQuery) error) tg.CallbackHandler {
+	return func(ctx context.Context, cbq *tgbotapi.CallbackQuery, _ tg.CallbackArgs) error {
+		return handler(ctx, cbq)
+	}
+}
+
+// fileLimitsPattern matches kinds of file limits.
+var fileLimitsPattern = strings.Join([]string{
+	fileLimitMaxDownloads,
+	fileLimitMaxDownloadsPerUser,
+	fileLimitExpiresAt,
+	fileLimitAvailableFrom,
+}, "|")
+
+func langsPattern() string {
+	langs := make([]string, len(i18n.Langs))
+	for i, lang := range i18n.Langs {
+		langs[i] = string(lang)
+	}
+	return strings.Join(langs, "|")
+}
+
+// newRouter registers handlers of commands, states and callback queries.
+func (bot *Bot) newRouter() *tg.Router {
+	r := tg.NewRouter()
+
+	// commands
+	r.Command(cmdStart, bot.onStart)
+	r.Command("help", bot.onHelp)
+	r.Command("admin", bot.onAdmin)
+	r.Command("settings", bot.onSettings)
+	r.Command("version", bot.onVersion)
+	r.Command(cmdBundle, bot.onBundle)
+	r.Command(cmdFiles, bot.onFiles)
+	r.Command(cmdCancel, bot.onCancel)
+
+	// states
+	r.State(state.SettingsChannelsAndChatsConnect, bot.onSettingsChannelsAndChatsConnectState)
+	r.State(state.BundleCollect, bot.onBundleCollectState)
+	r.State(state.FilePasswordEnter, bot.onFilePasswordEnterState)
+	r.State(state.FilePasswordSet, bot.onFilePasswordSetState)
+	r.State(state.FilesSearch, bot.onFilesSearchState)
+	r.State(state.ReportReason, bot.onReportReasonState)
+	r.State(state.ReportAppeal, bot.onReportAppealState)
+	r.State(state.BroadcastMessage, bot.onBroadcastMessageState)
+	r.State(state.BroadcastButtons, bot.onBroadcastButtonsState)
+	r.State(state.BroadcastSegment, bot.onBroadcastSegmentState)
+	r.State(state.FilePlacementName, bot.onFilePlacementNameState)
+	r.State(state.FileEditCaption, bot.onFileEditCaptionState)
+	r.State(state.FileEditName, bot.onFileEditNameState)
+	r.State(state.FileEditPost, bot.onFileEditPostState)
+	r.State(state.FileReplace, bot.onFileReplaceState)
+	r.State(state.FileLinkCustom, bot.onFileLinkCustomState)
+
+	// cancel of conversation
+	r.Callback(callbackCancel, callbackHandler(bot.onCancelCBQ))
+
+	// button on messages for users came by link
+	r.Callback(cmdStart, callbackHandler(bot.onPublicFileHelp))
+
+	// files library
+	r.Callback("files:{page:int}:{order:int}:{kind:int}:{search:0|1}", func(ctx context.Context, cbq *tgbotapi.CallbackQuery, args tg.CallbackArgs) error {
+		return bot.onFilesCBQ(ctx, cbq, &service.FileLibraryFilter{
+			Page:  args.Int("page"),
+			Order: core.FileOrder(args.Int("order")),
+			Kind:  core.Kind(args.Int("kind")),
+		}, args.String("search") == "1")
+	})
+	r.Callback(callbackFilesSearch, callbackHandler(bot.onFilesSearchCBQ))
+	r.Callback(callbackFilesSearchReset, callbackHandler(bot.onFilesSearchResetCBQ))
+	r.Callback("file:{id:int}:open", func(ctx context.Context, cbq *tgbotapi.CallbackQuery, args tg.CallbackArgs) error {
+		return bot.onFileOpenCBQ(ctx, cbq, core.FileID(args.Int("id")))
+	})
+
+	// file menu
+	r.Callback("file:{id:int}:refresh", func(ctx context.Context, cbq *tgbotapi.CallbackQuery, args tg.CallbackArgs) error {
+		return bot.onFileRefreshCBQ(ctx, cbq, args.Int("id"))
+	})
+	r.Callback("file:{id:int}:delete", func(ctx context.Context, cbq *tgbotapi.CallbackQuery, args tg.CallbackArgs) error {
+		return bot.onFileDeleteCBQ(ctx, cbq, args.Int("id"))
+	})
+	r.Callback("file:{id:int}:delete:confirm", func(ctx context.Context, cbq *tgbotapi.CallbackQuery, args tg.CallbackArgs) error {
+		return bot.onFileDeleteConfirmCBQ(ctx, cbq, args.Int("id"))
+	})
+
+	// file menu / stats
+	r.Callback("file:{id:int}:stats", func(ctx context.Context, cbq *tgbotapi.CallbackQuery, args tg.CallbackArgs) error {
+		return bot.onFileStatsCBQ(ctx, cbq, core.FileID(args.Int("id")))
+	})
+	r.Callback("file:{id:int}:export:{format:int}", func(ctx context.Context, cbq *tgbotapi.CallbackQuery, args tg.CallbackArgs) error {
+		return bot.onFileExportCBQ(ctx, cbq, core.FileID(args.Int("id")), service.ExportFormat(args.Int("format")))
+	})
+
+	// file menu / restrictions
+	r.Callback("file:{id:int}:restrictions", func(ctx context.Context, cbq *tgbotapi.CallbackQuery, args tg.CallbackArgs) error {
+		return bot.onFileRestrictionsCBQ(ctx, cbq, args.Int("id"))
+	})
+	r.Callback("file:{id:int}:restrictions:chat:check", func(ctx context.Context, cbq *tgbotapi.CallbackQuery, args tg.CallbackArgs) error {
+		return bot.onFileRestrictionsChatCheck(ctx, cbq, core.FileID(args.Int("id")))
+	})
+	r.Callback("file:{id:int}:restrictions:chat-subscription:{chat:int}:toggl", func(ctx context.Context, cbq *tgbotapi.CallbackQuery, args tg.CallbackArgs) error {
+		return bot.onFileRestrictionsSetChatCBQ(ctx, cbq, core.FileID(args.Int("id")), core.ChatID(args.Int("chat")))
+	})
+	r.Callback("file:{id:int}:restrictions:chat-policy:{policy:int}", func(ctx context.Context, cbq *tgbotapi.CallbackQuery, args tg.CallbackArgs) error {
+		return bot.onFileRestrictionsChatPolicyCBQ(ctx, cbq, core.FileID(args.Int("id")), core.ChatPolicy(args.Int("policy")))
+	})
+	r.Callback("file:{id:int}:restrictions:{limit:"+fileLimitsPattern+"}", func(ctx context.Context, cbq *tgbotapi.CallbackQuery, args tg.CallbackArgs) error {
+		return bot.onFileRestrictionsLimitCBQ(ctx, cbq, args.Int("id"), args.String("limit"))
+	})
+	r.Callback("file:{id:int}:restrictions:{limit:"+fileLimitsPattern+"}:{value:int}", func(ctx context.Context, cbq *tgbotapi.CallbackQuery, args tg.CallbackArgs) error {
+		return bot.onFileRestrictionsLimitSetCBQ(ctx, cbq, core.FileID(args.Int("id")), args.String("limit"), args.Int("value"))
+	})
+
+	// file menu / restrictions / password
+	r.Callback(callbackFilePasswordCancel, callbackHandler(bot.onFilePasswordCancelCBQ))
+	r.Callback("file:{id:int}:restrictions:password", func(ctx context.Context, cbq *tgbotapi.CallbackQuery, args tg.CallbackArgs) error {
+		return bot.onFileRestrictionsPasswordCBQ(ctx, cbq, args.Int("id"))
+	})
+	r.Callback("file:{id:int}:restrictions:password:set", func(ctx context.Context, cbq *tgbotapi.CallbackQuery, args tg.CallbackArgs) error {
+		return bot.onFilePasswordSetCBQ(ctx, cbq, core.FileID(args.Int("id")))
+	})
+	r.Callback("file:{id:int}:restrictions:password:disable", func(ctx context.Context, cbq *tgbotapi.CallbackQuery, args tg.CallbackArgs) error {
+		return bot.onFilePasswordDisableCBQ(ctx, cbq, core.FileID(args.Int("id")))
+	})
+
+	// file menu / placements
+	r.Callback(callbackFilePlacementsCancel, callbackHandler(bot.onFilePlacementsCancelCBQ))
+	r.Callback("file:{id:int}:placements", func(ctx context.Context, cbq *tgbotapi.CallbackQuery, args tg.CallbackArgs) error {
+		return bot.onFilePlacementsCBQ(ctx, cbq, core.FileID(args.Int("id")))
+	})
+	r.Callback("file:{id:int}:placements:add", func(ctx context.Context, cbq *tgbotapi.CallbackQuery, args tg.CallbackArgs) error {
+		return bot.onFilePlacementsAddCBQ(ctx, cbq, core.FileID(args.Int("id")))
+	})
+	r.Callback("file:{id:int}:placement:{placement:int}", func(ctx context.Context, cbq *tgbotapi.CallbackQuery, args tg.CallbackArgs) error {
+		return bot.onFilePlacementCBQ(ctx, cbq, core.FileID(args.Int("id")), core.PlacementID(args.Int("placement")))
+	})
+	r.Callback("file:{id:int}:placement:{placement:int}:delete", func(ctx context.Context, cbq *tgbotapi.CallbackQuery, args tg.CallbackArgs) error {
+		return bot.onFilePlacementDeleteCBQ(ctx, cbq, core.FileID(args.Int("id")), core.PlacementID(args.Int("placement")))
+	})
+
+	// file menu / edit
+	r.Callback("file:{id:int}:edit", func(ctx context.Context, cbq *tgbotapi.CallbackQuery, args tg.CallbackArgs) error {
+		return bot.onFileEditCBQ(ctx, cbq, core.FileID(args.Int("id")))
+	})
+	r.Callback("file:{id:int}:edit:caption", func(ctx context.Context, cbq *tgbotapi.CallbackQuery, args tg.CallbackArgs) error {
+		return bot.onFileEditCaptionCBQ(ctx, cbq, core.FileID(args.Int("id")))
+	})
+	r.Callback("file:{id:int}:edit:caption:clear", func(ctx context.Context, cbq *tgbotapi.CallbackQuery, args tg.CallbackArgs) error {
+		return bot.onFileEditCaptionClearCBQ(ctx, cbq, core.FileID(args.Int("id")))
+	})
+	r.Callback("file:{id:int}:edit:name", func(ctx context.Context, cbq *tgbotapi.CallbackQuery, args tg.CallbackArgs) error {
+		return bot.onFileEditNameCBQ(ctx, cbq, core.FileID(args.Int("id")))
+	})
+	r.Callback("file:{id:int}:edit:post", func(ctx context.Context, cbq *tgbotapi.CallbackQuery, args tg.CallbackArgs) error {
+		return bot.onFileEditPostCBQ(ctx, cbq, core.FileID(args.Int("id")))
+	})
+	r.Callback("file:{id:int}:edit:post:clear", func(ctx context.Context, cbq *tgbotapi.CallbackQuery, args tg.CallbackArgs) error {
+		return bot.onFileEditPostClearCBQ(ctx, cbq, core.FileID(args.Int("id")))
+	})
+
+	// file menu / versions
+	r.Callback("file:{id:int}:versions", func(ctx context.Context, cbq *tgbotapi.CallbackQuery, args tg.CallbackArgs) error {
+		return bot.onFileVersionsCBQ(ctx, cbq, core.FileID(args.Int("id")))
+	})
+	r.Callback("file:{id:int}:versions:upload", func(ctx context.Context, cbq *tgbotapi.CallbackQuery, args tg.CallbackArgs) error {
+		return bot.onFileVersionsUploadCBQ(ctx, cbq, core.FileID(args.Int("id")))
+	})
+	r.Callback("file:{id:int}:version:{version:int}:restore", func(ctx context.Context, cbq *tgbotapi.CallbackQuery, args tg.CallbackArgs) error {
+		return bot.onFileVersionRestoreCBQ(ctx, cbq, core.FileID(args.Int("id")), core.FileVersionID(args.Int("version")))
+	})
+
+	// file menu / link
+	r.Callback("file:{id:int}:link", func(ctx context.Context, cbq *tgbotapi.CallbackQuery, args tg.CallbackArgs) error {
+		return bot.onFileLinkCBQ(ctx, cbq, core.FileID(args.Int("id")))
+	})
+	r.Callback("file:{id:int}:link:custom", func(ctx context.Context, cbq *tgbotapi.CallbackQuery, args tg.CallbackArgs) error {
+		return bot.onFileLinkCustomCBQ(ctx, cbq, core.FileID(args.Int("id")))
+	})
+	r.Callback("file:{id:int}:link:regen:{hours:int}", func(ctx context.Context, cbq *tgbotapi.CallbackQuery, args tg.CallbackArgs) error {
+		return bot.onFileLinkRegenCBQ(ctx, cbq, core.FileID(args.Int("id")), args.Int("hours"))
+	})
+
+	// file report
+	r.Callback("file:{id:int}:report", func(ctx context.Context, cbq *tgbotapi.CallbackQuery, args tg.CallbackArgs) error {
+		return bot.onFileReportCBQ(ctx, cbq, core.FileID(args.Int("id")))
+	})
+	r.Callback(callbackReportCancel, callbackHandler(bot.onReportCancelCBQ))
+	r.Callback("report:{id:int}:appeal", func(ctx context.Context, cbq *tgbotapi.CallbackQuery, args tg.CallbackArgs) error {
+		return bot.onReportAppealCBQ(ctx, cbq, core.ReportID(args.Int("id")))
+	})
+
+	// admin
+	r.Callback(callbackAdmin, callbackHandler(bot.onAdminCBQ))
+	r.Callback(callbackAdminCampaigns, callbackHandler(bot.onAdminCampaignsCBQ))
+
+	// admin / reports
+	r.Callback(callbackAdminReports, callbackHandler(bot.onAdminReportsCBQ))
+	r.Callback("admin:report:{id:int}:file", func(ctx context.Context, cbq *tgbotapi.CallbackQuery, args tg.CallbackArgs) error {
+		return bot.onAdminReportFileCBQ(ctx, cbq, core.ReportID(args.Int("id")))
+	})
+	r.Callback("admin:report:{id:int}:{action:approve|reject}", func(ctx context.Context, cbq *tgbotapi.CallbackQuery, args tg.CallbackArgs) error {
+		return bot.onAdminReportResolveCBQ(ctx, cbq, core.ReportID(args.Int("id")), args.String("action") == "approve")
+	})
+
+	// admin / file moderation
+	r.Callback("admin:file:{id:int}:{action:block|unblock|ban|unban}", func(ctx context.Context, cbq *tgbotapi.CallbackQuery, args tg.CallbackArgs) error {
+		return bot.onAdminFileActionCBQ(ctx, cbq, core.FileID(args.Int("id")), args.String("action"))
+	})
+
+	// admin / broadcasts
+	r.Callback(callbackAdminBroadcasts, callbackHandler(bot.onAdminBroadcastsCBQ))
+	r.Callback(callbackAdminBroadcastInputCancel, callbackHandler(bot.onBroadcastInputCancelCBQ))
+	r.Callback("admin:broadcast:{id:int}:{action:refresh|preview|buttons|segment|start|start-confirm|cancel|cancel-confirm}", func(ctx context.Context, cbq *tgbotapi.CallbackQuery, args tg.CallbackArgs) error {
+		return bot.onAdminBroadcastCBQ(ctx, cbq, core.BroadcastID(args.Int("id")), args.String("action"))
+	})
+
+	// bundle menu
+	r.Callback("bundle:{id:int}:refresh", func(ctx context.Context, cbq *tgbotapi.CallbackQuery, args tg.CallbackArgs) error {
+		return bot.onBundleRefreshCBQ(ctx, cbq, core.BundleID(args.Int("id")))
+	})
+	r.Callback("bundle:{id:int}:delete", func(ctx context.Context, cbq *tgbotapi.CallbackQuery, args tg.CallbackArgs) error {
+		return bot.onBundleDeleteCBQ(ctx, cbq, core.BundleID(args.Int("id")))
+	})
+	r.Callback("bundle:{id:int}:delete:confirm", func(ctx context.Context, cbq *tgbotapi.CallbackQuery, args tg.CallbackArgs) error {
+		return bot.onBundleDeleteConfirmCBQ(ctx, cbq, core.BundleID(args.Int("id")))
+	})
+
+	// bundle menu / restrictions
+	r.Callback("bundle:{id:int}:restrictions", func(ctx context.Context, cbq *tgbotapi.CallbackQuery, args tg.CallbackArgs) error {
+		return bot.onBundleRestrictionsCBQ(ctx, cbq, core.BundleID(args.Int("id")))
+	})
+	r.Callback("bundle:{id:int}:restrictions:chat:check", func(ctx context.Context, cbq *tgbotapi.CallbackQuery, args tg.CallbackArgs) error {
+		return bot.onBundleRestrictionsChatCheck(ctx, cbq, core.BundleID(args.Int("id")))
+	})
+	r.Callback("bundle:{id:int}:restrictions:chat-sub:{chat:int}:toggl", func(ctx context.Context, cbq *tgbotapi.CallbackQuery, args tg.CallbackArgs) error {
+		return bot.onBundleRestrictionsSetChatCBQ(ctx, cbq, core.BundleID(args.Int("id")), core.ChatID(args.Int("chat")))
+	})
+	r.Callback("bundle:{id:int}:restrictions:chat-policy:{policy:int}", func(ctx context.Context, cbq *tgbotapi.CallbackQuery, args tg.CallbackArgs) error {
+		return bot.onBundleRestrictionsChatPolicyCBQ(ctx, cbq, core.BundleID(args.Int("id")), core.ChatPolicy(args.Int("policy")))
+	})
+
+	// settings
+	r.Callback(callbackSettings, callbackHandler(bot.onSettingsCallbackQuery))
+	r.Callback(callbackSettingsLongIDs, callbackHandler(bot.onSettingsToggleLongIDsCBQ))
+	r.Callback("settings:language:{lang:"+langsPattern()+"}", func(ctx context.Context, cbq *tgbotapi.CallbackQuery, args tg.CallbackArgs) error {
+		return bot.onSettingsLanguageCBQ(ctx, cbq, args.String("lang"))
+	})
+
+	// settings / channels and chats
+	r.Callback(callbackSettingsChannelsAndChats, callbackHandler(bot.onSettingsChannelsAndChats))
+	r.Callback(callbackSettingsChannelsAndChatsConnect, callbackHandler(bot.onSettingsChannelsAndChatsConnect))
+	r.Callback("settings:channels-and-chats:{id:int}", func(ctx context.Context, cbq *tgbotapi.CallbackQuery, args tg.CallbackArgs) error {
+		return bot.onSettingsChannelsAndChatsDetails(ctx, getUserCtx(ctx), cbq, core.ChatID(args.Int("id")))
+	})
+	r.Callback("settings:channels-and-chats:{id:int}:stats", func(ctx context.Context, cbq *tgbotapi.CallbackQuery, args tg.CallbackArgs) error {
+		return bot.onSettingsChannelsAndChatsStats(ctx, getUserCtx(ctx), cbq, core.ChatID(args.Int("id")))
+	})
+	r.Callback("settings:channels-and-chats:{id:int}:export:{format:int}", func(ctx context.Context, cbq *tgbotapi.CallbackQuery, args tg.CallbackArgs) error {
+		return bot.onSettingsChannelsAndChatsExport(ctx, getUserCtx(ctx), cbq, core.ChatID(args.Int("id")), service.ExportFormat(args.Int("format")))
+	})
+	r.Callback("settings:channels-and-chats:{id:int}:delete", func(ctx context.Context, cbq *tgbotapi.CallbackQuery, args tg.CallbackArgs) error {
+		return bot.onSettingsChannelsAndChatsDelete(ctx, getUserCtx(ctx), cbq, core.ChatID(args.Int("id")))
+	})
+	r.Callback("settings:channels-and-chats:{id:int}:delete:confirm", func(ctx context.Context, cbq *tgbotapi.CallbackQuery, args tg.CallbackArgs) error {
+		return bot.onSettingsChannelsAndChatsDeleteConfirm(ctx, getUserCtx(ctx), cbq, core.ChatID(args.Int("id")))
+	})
+
+	return r
+}
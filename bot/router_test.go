@@ -0,0 +1,161 @@
+package bot
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/bots-house/share-file-bot/bot/i18n"
+	"github.com/bots-house/share-file-bot/bot/state"
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/service"
+	"github.com/bots-house/share-file-bot/store/memory"
+	tgbotapi "github.com/bots-house/telegram-bot-api"
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBot_NewRouter(t *testing.T) {
+	assert.NotPanics(t, func() {
+		(&Bot{}).newRouter()
+	})
+}
+
+// fakeTelegramServer answers Bot API methods with success.
+type fakeTelegramServer struct{}
+
+func (fakeTelegramServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	method := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+
+	var result interface{}
+
+	switch method {
+	case "getMe":
+		result = tgbotapi.User{ID: 1, UserName: "test_bot"}
+	case "answerCallbackQuery":
+		result = true
+	default:
+		result = tgbotapi.Message{MessageID: 1, Chat: &tgbotapi.Chat{ID: 1}}
+	}
+
+	body, _ := json.Marshal(result)
+
+	_ = json.NewEncoder(w).Encode(tgbotapi.APIResponse{
+		Ok:     true,
+		Result: body,
+	})
+}
+
+func TestBot_RouterCallback(t *testing.T) {
+	ctx := context.Background()
+	mem := memory.New()
+
+	server, err := miniredis.Run()
+	require.NoError(t, err)
+	t.Cleanup(server.Close)
+
+	rdb := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { _ = rdb.Close() })
+
+	httpServer := httptest.NewServer(fakeTelegramServer{})
+	t.Cleanup(httpServer.Close)
+
+	client, err := tgbotapi.NewBotAPIWithClient("token", httpServer.URL+"/bot%s/%s", httpServer.Client())
+	require.NoError(t, err)
+
+	bot := &Bot{
+		client: client,
+		state:  state.NewRedisStore(rdb, "state"),
+		fileSrv: &service.File{
+			File:        mem.File(),
+			User:        mem.User(),
+			Download:    mem.Download(),
+			RevokedLink: mem.RevokedLink(),
+			Bundle:      mem.Bundle(),
+			Redis:       rdb,
+			Txier:       mem.Tx,
+		},
+		chatSrv: &service.Chat{
+			File:     mem.File(),
+			Chat:     mem.Chat(),
+			Download: mem.Download(),
+			Txier:    mem.Tx,
+		},
+	}
+
+	router := bot.newRouter()
+
+	user := core.NewUser(1, "Owner", "", "", "en")
+	require.NoError(t, mem.User().Add(ctx, user))
+
+	// target file has two digit id, other files are to detect wrong parsed id
+	var file *core.File
+	for i := 0; i < 12; i++ {
+		file = core.NewFile("telegram", "", core.KindDocument, "text/plain", 100, "file.txt", user.ID, false, core.Metadata{})
+		require.NoError(t, mem.File().Add(ctx, file))
+	}
+	require.Equal(t, core.FileID(12), file.ID)
+
+	ctx = withTexts(withUser(ctx, user), i18n.Get(i18n.LangEnglish))
+
+	dispatch := func(t *testing.T, data string) bool {
+		t.Helper()
+
+		ok, err := router.HandleCallback(ctx, &tgbotapi.CallbackQuery{
+			ID:      "1",
+			From:    &tgbotapi.User{ID: int(user.ID)},
+			Message: &tgbotapi.Message{MessageID: 10, Chat: &tgbotapi.Chat{ID: int64(user.ID)}},
+			Data:    data,
+		})
+		require.NoError(t, err)
+
+		return ok
+	}
+
+	t.Run("PasswordSet", func(t *testing.T) {
+		require.True(t, dispatch(t, "file:12:restrictions:password:set"))
+
+		st, err := bot.state.Get(ctx, user.ID)
+		require.NoError(t, err)
+		require.Equal(t, state.FilePasswordSet, st)
+
+		// password is awaited for file from callback data
+		changed, err := bot.fileSrv.SetPassword(ctx, user, "secret")
+		require.NoError(t, err)
+		require.Equal(t, file.ID, changed.ID)
+	})
+
+	t.Run("LimitSet", func(t *testing.T) {
+		require.True(t, dispatch(t, "file:12:restrictions:"+fileLimitMaxDownloadsPerUser+":7"))
+
+		changed, err := mem.File().Query().ID(file.ID).One(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 7, changed.Restriction.MaxDownloadsPerUser)
+		require.Zero(t, changed.Restriction.MaxDownloads)
+	})
+
+	t.Run("ChatPolicy", func(t *testing.T) {
+		require.True(t, dispatch(t, fmt.Sprintf("file:12:restrictions:chat-policy:%d", core.ChatPolicyAny)))
+
+		changed, err := mem.File().Query().ID(file.ID).One(ctx)
+		require.NoError(t, err)
+		require.Equal(t, core.ChatPolicyAny, changed.Restriction.ChatPolicy)
+	})
+
+	t.Run("Unmatched", func(t *testing.T) {
+		for _, data := range []string{
+			"file:abc:restrictions:password:set",
+			"file:12:restrictions:password:unknown",
+			"file:12:restrictions:" + fileLimitMaxDownloadsPerUser + ":-1",
+			"unknown",
+		} {
+			require.False(t, dispatch(t, data), data)
+		}
+	})
+}
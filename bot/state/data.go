@@ -0,0 +1,24 @@
+package state
+
+import "github.com/bots-house/share-file-bot/core"
+
+// Data is payload of state, collected while user goes through conversation.
+type Data struct {
+	// FileID is file being edited.
+	FileID core.FileID `json:"file_id,omitempty"`
+
+	// MessageID is id of message with prompt of current step.
+	MessageID int `json:"message_id,omitempty"`
+
+	// Step is index of current step of conversation.
+	Step int `json:"step,omitempty"`
+
+	// Inputs contains inputs of user collected on previous steps.
+	Inputs []string `json:"inputs,omitempty"`
+}
+
+// Next saves input of current step and moves conversation to next step.
+func (data *Data) Next(input string) {
+	data.Inputs = append(data.Inputs, input)
+	data.Step++
+}
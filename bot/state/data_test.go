@@ -0,0 +1,37 @@
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestData_Next(t *testing.T) {
+	data := &Data{FileID: 1}
+
+	data.Next("first")
+	data.Next("second")
+
+	assert.Equal(t, 2, data.Step)
+	assert.Equal(t, []string{"first", "second"}, data.Inputs)
+}
+
+func TestState_TTL(t *testing.T) {
+	assert.NotZero(t, SettingsChannelsAndChatsConnect.TTL())
+	assert.Zero(t, Empty.TTL())
+
+	// conversations with input awaited by services
+	for _, s := range []State{
+		BundleCollect,
+		FilePasswordEnter,
+		FilePasswordSet,
+		ReportReason,
+		ReportAppeal,
+		BroadcastMessage,
+		BroadcastButtons,
+		BroadcastSegment,
+	} {
+		assert.Equal(t, time.Hour, s.TTL(), s.String())
+	}
+}
@@ -2,6 +2,7 @@ package state
 
 import (
 	"context"
+	"encoding/json"
 	"strconv"
 	"strings"
 
@@ -33,13 +34,45 @@ func (rs *RedisStore) getKey(id core.UserID) string {
 	return strings.Join(parts, ":")
 }
 
+func (rs *RedisStore) getDataKey(id core.UserID) string {
+	return rs.getKey(id) + ":data"
+}
+
 func (rs *RedisStore) Set(ctx context.Context, id core.UserID, state State) error {
-	key := rs.getKey(id)
-	val := strconv.Itoa(int(state))
+	return rs.SetData(ctx, id, state, nil)
+}
+
+func (rs *RedisStore) SetData(ctx context.Context, id core.UserID, state State, data *Data) error {
+	if state == Empty {
+		return rs.Del(ctx, id)
+	}
+
+	key, dataKey := rs.getKey(id), rs.getDataKey(id)
+	ttl := state.TTL()
+
+	var payload []byte
+	if data != nil {
+		var err error
+		payload, err = json.Marshal(data)
+		if err != nil {
+			return errors.Wrap(err, "marshal data")
+		}
+	}
+
+	if _, err := rs.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, key, strconv.Itoa(int(state)), ttl)
 
-	if err := rs.client.Set(ctx, key, val, 0).Err(); err != nil {
-		return errors.Wrap(err, "set key failed")
+		if payload != nil {
+			pipe.Set(ctx, dataKey, payload, ttl)
+		} else {
+			pipe.Del(ctx, dataKey)
+		}
+
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "set keys failed")
 	}
+
 	return nil
 }
 
@@ -51,18 +84,40 @@ func (rs *RedisStore) Get(ctx context.Context, id core.UserID) (State, error) {
 		return Empty, errors.Wrap(err, "get value")
 	}
 
-	state, err := strconv.Atoi(val)
+	return parseState(val)
+}
+
+func (rs *RedisStore) GetData(ctx context.Context, id core.UserID) (State, *Data, error) {
+	vals, err := rs.client.MGet(ctx, rs.getKey(id), rs.getDataKey(id)).Result()
 	if err != nil {
-		return Empty, errors.Wrap(err, "parse value")
+		return Empty, nil, errors.Wrap(err, "get values")
 	}
 
-	return State(state), nil
+	val, ok := vals[0].(string)
+	if !ok {
+		return Empty, nil, nil
+	}
+
+	state, err := parseState(val)
+	if err != nil {
+		return Empty, nil, err
+	}
+
+	payload, ok := vals[1].(string)
+	if !ok {
+		return state, nil, nil
+	}
+
+	data := &Data{}
+	if err := json.Unmarshal([]byte(payload), data); err != nil {
+		return Empty, nil, errors.Wrap(err, "unmarshal data")
+	}
+
+	return state, data, nil
 }
 
 func (rs *RedisStore) Del(ctx context.Context, id core.UserID) error {
-	key := rs.getKey(id)
-
-	if err := rs.client.Del(ctx, key).Err(); err == redis.Nil {
+	if err := rs.client.Del(ctx, rs.getKey(id), rs.getDataKey(id)).Err(); err == redis.Nil {
 		return nil
 	} else if err != nil {
 		return errors.Wrap(err, "del key")
@@ -70,3 +125,12 @@ func (rs *RedisStore) Del(ctx context.Context, id core.UserID) error {
 
 	return nil
 }
+
+func parseState(val string) (State, error) {
+	state, err := strconv.Atoi(val)
+	if err != nil {
+		return Empty, errors.Wrap(err, "parse value")
+	}
+
+	return State(state), nil
+}
@@ -1,8 +1,50 @@
 package state
 
+import "time"
+
 type State int8
 
 const (
 	Empty State = iota
 	SettingsChannelsAndChatsConnect
+	BundleCollect
+	FilePasswordEnter
+	FilePasswordSet
+	FilesSearch
+	ReportReason
+	ReportAppeal
+	BroadcastMessage
+	BroadcastButtons
+	BroadcastSegment
+	FilePlacementName
+	FileEditCaption
+	FileEditName
+	FileEditPost
+	FileReplace
+	FileLinkCustom
 )
+
+// ttls contains expiration of states, states not listed here never expire.
+var ttls = map[State]time.Duration{
+	SettingsChannelsAndChatsConnect: 15 * time.Minute,
+	FileEditCaption:                 15 * time.Minute,
+	FileEditName:                    15 * time.Minute,
+	FileEditPost:                    15 * time.Minute,
+	FileReplace:                     15 * time.Minute,
+	FileLinkCustom:                  15 * time.Minute,
+
+	// same as expiration of input awaited by services
+	BundleCollect:     time.Hour,
+	FilePasswordEnter: time.Hour,
+	FilePasswordSet:   time.Hour,
+	ReportReason:      time.Hour,
+	ReportAppeal:      time.Hour,
+	BroadcastMessage:  time.Hour,
+	BroadcastButtons:  time.Hour,
+	BroadcastSegment:  time.Hour,
+}
+
+// TTL returns duration after which state of user is reset, zero means no expiration.
+func (s State) TTL() time.Duration {
+	return ttls[s]
+}
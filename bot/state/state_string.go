@@ -10,11 +10,26 @@ func _() {
 	var x [1]struct{}
 	_ = x[Empty-0]
 	_ = x[SettingsChannelsAndChatsConnect-1]
+	_ = x[BundleCollect-2]
+	_ = x[FilePasswordEnter-3]
+	_ = x[FilePasswordSet-4]
+	_ = x[FilesSearch-5]
+	_ = x[ReportReason-6]
+	_ = x[ReportAppeal-7]
+	_ = x[BroadcastMessage-8]
+	_ = x[BroadcastButtons-9]
+	_ = x[BroadcastSegment-10]
+	_ = x[FilePlacementName-11]
+	_ = x[FileEditCaption-12]
+	_ = x[FileEditName-13]
+	_ = x[FileEditPost-14]
+	_ = x[FileReplace-15]
+	_ = x[FileLinkCustom-16]
 }
 
-const _State_name = "EmptySettingsChannelsAndChatsConnect"
+const _State_name = "EmptySettingsChannelsAndChatsConnectBundleCollectFilePasswordEnterFilePasswordSetFilesSearchReportReasonReportAppealBroadcastMessageBroadcastButtonsBroadcastSegmentFilePlacementNameFileEditCaptionFileEditNameFileEditPostFileReplaceFileLinkCustom"
 
-var _State_index = [...]uint8{0, 5, 36}
+var _State_index = [...]uint8{0, 5, 36, 49, 66, 81, 92, 104, 116, 132, 148, 164, 181, 196, 208, 220, 231, 245}
 
 func (i State) String() string {
 	if i < 0 || i >= State(len(_State_index)-1) {
@@ -10,4 +10,12 @@ type Store interface {
 	Get(ctx context.Context, id core.UserID) (State, error)
 	Set(ctx context.Context, id core.UserID, state State) error
 	Del(ctx context.Context, id core.UserID) error
+
+	// GetData returns state of user with data.
+	// Data is nil, if state was set without data.
+	GetData(ctx context.Context, id core.UserID) (State, *Data, error)
+
+	// SetData sets state of user with data.
+	// State and data expires together after TTL of state.
+	SetData(ctx context.Context, id core.UserID, state State, data *Data) error
 }
@@ -0,0 +1,109 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+//go:generate stringer -type AuditAction -trimprefix AuditAction
+
+// AuditAction define moderation action of admin.
+type AuditAction int8
+
+const (
+	// AuditActionFileBlock means admin blocked file.
+	AuditActionFileBlock AuditAction = iota
+
+	// AuditActionFileUnblock means admin unblocked file.
+	AuditActionFileUnblock
+
+	// AuditActionUserBan means admin banned user.
+	AuditActionUserBan
+
+	// AuditActionUserUnban means admin unbanned user.
+	AuditActionUserUnban
+)
+
+var ErrInvalidAuditAction = errors.New("audit action is invalid")
+
+func ParseAuditAction(v string) (AuditAction, error) {
+	switch v {
+	case "FileBlock":
+		return AuditActionFileBlock, nil
+	case "FileUnblock":
+		return AuditActionFileUnblock, nil
+	case "UserBan":
+		return AuditActionUserBan, nil
+	case "UserUnban":
+		return AuditActionUserUnban, nil
+	default:
+		return AuditActionFileBlock, ErrInvalidAuditAction
+	}
+}
+
+// AuditEntryID it's alias for audit log entry identifier.
+type AuditEntryID int
+
+// AuditEntry is record of audit log about moderation action of admin.
+type AuditEntry struct {
+	// Unique ID of entry.
+	ID AuditEntryID
+
+	// Reference to admin who performs action.
+	AdminID UserID
+
+	// Performed action.
+	Action AuditAction
+
+	// ID of affected user. Zero if action is not related to user.
+	UserID UserID
+
+	// ID of affected file. Zero if action is not related to file.
+	// It's not reference, so entry is kept after file deletion.
+	FileID FileID
+
+	// Time when action was performed.
+	CreatedAt time.Time
+}
+
+// NewFileAuditEntry creates entry of action related to file.
+func NewFileAuditEntry(adminID UserID, action AuditAction, fileID FileID) *AuditEntry {
+	return &AuditEntry{
+		AdminID:   adminID,
+		Action:    action,
+		FileID:    fileID,
+		CreatedAt: time.Now(),
+	}
+}
+
+// NewUserAuditEntry creates entry of action related to user.
+func NewUserAuditEntry(adminID UserID, action AuditAction, userID UserID) *AuditEntry {
+	return &AuditEntry{
+		AdminID:   adminID,
+		Action:    action,
+		UserID:    userID,
+		CreatedAt: time.Now(),
+	}
+}
+
+type AuditEntryStoreQuery interface {
+	AdminID(id UserID) AuditEntryStoreQuery
+	UserID(id UserID) AuditEntryStoreQuery
+	FileID(id FileID) AuditEntryStoreQuery
+
+	// Return at most n entries, applied only to All.
+	Limit(n int) AuditEntryStoreQuery
+
+	// All returns entries ordered from newest to oldest.
+	All(ctx context.Context) ([]*AuditEntry, error)
+	Count(ctx context.Context) (int, error)
+}
+
+// AuditEntryStore define persistence interface for AuditEntry.
+type AuditEntryStore interface {
+	// Add AuditEntry to store. Update ID.
+	Add(ctx context.Context, entry *AuditEntry) error
+
+	Query() AuditEntryStoreQuery
+}
@@ -0,0 +1,295 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+)
+
+//go:generate stringer -type BroadcastStatus -trimprefix BroadcastStatus
+
+// BroadcastStatus define step of broadcast lifecycle.
+type BroadcastStatus int8
+
+const (
+	// BroadcastStatusDraft means broadcast is composed by admin and not started yet.
+	BroadcastStatusDraft BroadcastStatus = iota
+
+	// BroadcastStatusRunning means broadcast is delivering to users.
+	BroadcastStatusRunning
+
+	// BroadcastStatusDone means broadcast is delivered to all users of segment.
+	BroadcastStatusDone
+
+	// BroadcastStatusCanceled means broadcast was canceled by admin.
+	BroadcastStatusCanceled
+)
+
+var ErrInvalidBroadcastStatus = errors.New("broadcast status is invalid")
+
+func ParseBroadcastStatus(v string) (BroadcastStatus, error) {
+	switch v {
+	case "Draft":
+		return BroadcastStatusDraft, nil
+	case "Running":
+		return BroadcastStatusRunning, nil
+	case "Done":
+		return BroadcastStatusDone, nil
+	case "Canceled":
+		return BroadcastStatusCanceled, nil
+	default:
+		return BroadcastStatusDraft, ErrInvalidBroadcastStatus
+	}
+}
+
+// BroadcastID it's alias for broadcast identifier.
+type BroadcastID int
+
+// BroadcastButton is URL button attached to broadcast message.
+type BroadcastButton struct {
+	Text string `json:"text"`
+	URL  string `json:"url"`
+}
+
+// BroadcastSegment define users targeted by broadcast.
+// Conditions are combined, empty segment means all users.
+type BroadcastSegment struct {
+	// Users came by deep-link with this ref.
+	Ref null.String
+
+	// Users with this language.
+	Language null.String
+
+	// Users joined bot after this time.
+	JoinedAfter null.Time
+}
+
+// IsAll returns true if segment targets all users.
+func (seg BroadcastSegment) IsAll() bool {
+	return !seg.Ref.Valid && !seg.Language.Valid && !seg.JoinedAfter.Valid
+}
+
+// Broadcast is message of admin delivered to users of bot.
+type Broadcast struct {
+	// Unique ID of broadcast.
+	ID BroadcastID
+
+	// Reference to admin who composed broadcast.
+	AuthorID UserID
+
+	// Broadcast message is copy of message in this chat.
+	FromChatID int64
+	MessageID  int
+
+	// Buttons attached to message.
+	Buttons []BroadcastButton
+
+	// Users targeted by broadcast.
+	Segment BroadcastSegment
+
+	// Current step of lifecycle.
+	Status BroadcastStatus
+
+	// Users are processed in order of ID, it's ID of last processed user.
+	// Used to resume broadcast after restart.
+	LastUserID UserID
+
+	// Count of users in segment when broadcast was started.
+	Total int
+
+	// Count of users by delivery result.
+	Delivered int
+	Blocked   int
+	Failed    int
+
+	// Time when broadcast was created.
+	CreatedAt time.Time
+
+	// Time when broadcast was started.
+	StartedAt null.Time
+
+	// Time when broadcast was done or canceled.
+	FinishedAt null.Time
+}
+
+var (
+	ErrBroadcastNotFound   = errors.New("broadcast not found")
+	ErrBroadcastNotDraft   = errors.New("broadcast is already started")
+	ErrBroadcastFinished   = errors.New("broadcast is already finished")
+	ErrBroadcastNotRunning = errors.New("broadcast is not running")
+)
+
+// NewBroadcast creates draft of broadcast with message from chat.
+func NewBroadcast(authorID UserID, fromChatID int64, messageID int) *Broadcast {
+	return &Broadcast{
+		AuthorID:   authorID,
+		FromChatID: fromChatID,
+		MessageID:  messageID,
+		Buttons:    []BroadcastButton{},
+		Status:     BroadcastStatusDraft,
+		CreatedAt:  time.Now(),
+	}
+}
+
+// Processed returns count of users broadcast was processed for.
+func (broadcast *Broadcast) Processed() int {
+	return broadcast.Delivered + broadcast.Blocked + broadcast.Failed
+}
+
+// Start starts delivery of draft to total users.
+func (broadcast *Broadcast) Start(total int) error {
+	if broadcast.Status != BroadcastStatusDraft {
+		return ErrBroadcastNotDraft
+	}
+
+	broadcast.Status = BroadcastStatusRunning
+	broadcast.Total = total
+	broadcast.StartedAt = null.TimeFrom(time.Now())
+
+	return nil
+}
+
+// Cancel cancels draft or running broadcast.
+func (broadcast *Broadcast) Cancel() error {
+	if broadcast.Status != BroadcastStatusDraft && broadcast.Status != BroadcastStatusRunning {
+		return ErrBroadcastFinished
+	}
+
+	broadcast.Status = BroadcastStatusCanceled
+	broadcast.FinishedAt = null.TimeFrom(time.Now())
+
+	return nil
+}
+
+// Finish marks running broadcast as done.
+func (broadcast *Broadcast) Finish() error {
+	if broadcast.Status != BroadcastStatusRunning {
+		return ErrBroadcastNotRunning
+	}
+
+	broadcast.Status = BroadcastStatusDone
+	broadcast.FinishedAt = null.TimeFrom(time.Now())
+
+	return nil
+}
+
+// Register counts result of delivery and moves cursor to user of delivery.
+func (broadcast *Broadcast) Register(delivery *BroadcastDelivery) {
+	switch delivery.Status {
+	case BroadcastDeliveryStatusDelivered:
+		broadcast.Delivered++
+	case BroadcastDeliveryStatusBlocked:
+		broadcast.Blocked++
+	case BroadcastDeliveryStatusFailed:
+		broadcast.Failed++
+	}
+
+	broadcast.LastUserID = delivery.UserID
+}
+
+type BroadcastStoreQuery interface {
+	ID(id BroadcastID) BroadcastStoreQuery
+	Status(statuses ...BroadcastStatus) BroadcastStoreQuery
+
+	// Return at most n broadcasts, applied only to All.
+	Limit(n int) BroadcastStoreQuery
+
+	// All returns broadcasts from newest to oldest.
+	All(ctx context.Context) ([]*Broadcast, error)
+	One(ctx context.Context) (*Broadcast, error)
+	Count(ctx context.Context) (int, error)
+}
+
+// BroadcastStore define persistence interface for Broadcast.
+type BroadcastStore interface {
+	// Add Broadcast to store. Update ID.
+	Add(ctx context.Context, broadcast *Broadcast) error
+
+	// Update broadcast in store.
+	Update(ctx context.Context, broadcast *Broadcast) error
+
+	Query() BroadcastStoreQuery
+}
+
+//go:generate stringer -type BroadcastDeliveryStatus -trimprefix BroadcastDeliveryStatus
+
+// BroadcastDeliveryStatus define result of broadcast delivery to user.
+type BroadcastDeliveryStatus int8
+
+const (
+	// BroadcastDeliveryStatusDelivered means message was delivered.
+	BroadcastDeliveryStatusDelivered BroadcastDeliveryStatus = iota
+
+	// BroadcastDeliveryStatusBlocked means user blocked bot.
+	BroadcastDeliveryStatusBlocked
+
+	// BroadcastDeliveryStatusFailed means message was not delivered because of other error.
+	BroadcastDeliveryStatusFailed
+)
+
+var ErrInvalidBroadcastDeliveryStatus = errors.New("broadcast delivery status is invalid")
+
+func ParseBroadcastDeliveryStatus(v string) (BroadcastDeliveryStatus, error) {
+	switch v {
+	case "Delivered":
+		return BroadcastDeliveryStatusDelivered, nil
+	case "Blocked":
+		return BroadcastDeliveryStatusBlocked, nil
+	case "Failed":
+		return BroadcastDeliveryStatusFailed, nil
+	default:
+		return BroadcastDeliveryStatusDelivered, ErrInvalidBroadcastDeliveryStatus
+	}
+}
+
+// BroadcastDelivery is result of broadcast delivery to one user.
+type BroadcastDelivery struct {
+	// Reference to broadcast.
+	BroadcastID BroadcastID
+
+	// Reference to user.
+	UserID UserID
+
+	// Result of delivery.
+	Status BroadcastDeliveryStatus
+
+	// Error of failed delivery.
+	Error null.String
+
+	// Time of delivery.
+	CreatedAt time.Time
+}
+
+// NewBroadcastDelivery creates delivery of broadcast to user.
+func NewBroadcastDelivery(
+	broadcastID BroadcastID,
+	userID UserID,
+	status BroadcastDeliveryStatus,
+	err string,
+) *BroadcastDelivery {
+	return &BroadcastDelivery{
+		BroadcastID: broadcastID,
+		UserID:      userID,
+		Status:      status,
+		Error:       null.NewString(err, err != ""),
+		CreatedAt:   time.Now(),
+	}
+}
+
+type BroadcastDeliveryStoreQuery interface {
+	BroadcastID(id BroadcastID) BroadcastDeliveryStoreQuery
+	UserID(id UserID) BroadcastDeliveryStoreQuery
+	Status(status BroadcastDeliveryStatus) BroadcastDeliveryStoreQuery
+
+	Count(ctx context.Context) (int, error)
+}
+
+// BroadcastDeliveryStore define persistence interface for BroadcastDelivery.
+type BroadcastDeliveryStore interface {
+	// Add BroadcastDelivery to store.
+	Add(ctx context.Context, delivery *BroadcastDelivery) error
+
+	Query() BroadcastDeliveryStoreQuery
+}
@@ -0,0 +1,84 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bots-house/share-file-bot/pkg/secretid"
+	"github.com/volatiletech/null/v8"
+)
+
+// BundleID it's alias for bundle identifier.
+type BundleID int
+
+// ZeroBundleID means bundle is not set.
+const ZeroBundleID = BundleID(0)
+
+// Bundle represents ordered set of files shared by single public link.
+type Bundle struct {
+	// Unique ID of Bundle.
+	ID BundleID
+
+	// Public Bundle ID
+	PublicID string
+
+	// Caption of bundle
+	Caption null.String
+
+	// Contains restrictions for download.
+	// Limits of files are checked per file.
+	Restriction ChatRestrictions
+
+	// Files of bundle in order of delivery.
+	FileIDs []FileID
+
+	// Reference to user who creates bundle.
+	OwnerID UserID
+
+	// Time when bundle was created.
+	CreatedAt time.Time
+}
+
+func (bundle *Bundle) RegenPublicID() {
+	bundle.PublicID = secretid.Generate(secretid.IsLong(bundle.PublicID))
+}
+
+func NewBundle(
+	caption string,
+	fileIDs []FileID,
+	ownerID UserID,
+	longID bool,
+) *Bundle {
+	return &Bundle{
+		PublicID:  secretid.Generate(longID),
+		Caption:   null.NewString(caption, caption != ""),
+		FileIDs:   fileIDs,
+		OwnerID:   ownerID,
+		CreatedAt: time.Now(),
+	}
+}
+
+var ErrBundleNotFound = errors.New("bundle not found")
+
+type BundleStoreQuery interface {
+	ID(id BundleID) BundleStoreQuery
+	OwnerID(id UserID) BundleStoreQuery
+	PublicID(ids ...string) BundleStoreQuery
+
+	All(ctx context.Context) ([]*Bundle, error)
+	One(ctx context.Context) (*Bundle, error)
+	Delete(ctx context.Context) error
+	Count(ctx context.Context) (int, error)
+}
+
+// BundleStore define persistence interface for Bundle.
+type BundleStore interface {
+	// Add Bundle with files to store. Update ID.
+	Add(ctx context.Context, bundle *Bundle) error
+
+	// Update bundle and it's files in store.
+	Update(ctx context.Context, bundle *Bundle) error
+
+	Query() BundleStoreQuery
+}
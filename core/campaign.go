@@ -0,0 +1,60 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// CampaignID it's alias for campaign identifier.
+type CampaignID int
+
+// Campaign is registered source of users, who came by deep-link with ref (ref_*).
+type Campaign struct {
+	// Unique ID of campaign.
+	ID CampaignID
+
+	// Ref used in deep-link, unique.
+	Ref string
+
+	// Name of campaign for admins.
+	Name string
+
+	// Cost of campaign in cents.
+	Cost int
+
+	// Time when campaign was registered.
+	CreatedAt time.Time
+}
+
+// NewCampaign creates campaign with provided ref.
+func NewCampaign(ref, name string, cost int) *Campaign {
+	return &Campaign{
+		Ref:       ref,
+		Name:      name,
+		Cost:      cost,
+		CreatedAt: time.Now(),
+	}
+}
+
+var ErrCampaignNotFound = errors.New("campaign not found")
+
+type CampaignStoreQuery interface {
+	ID(id CampaignID) CampaignStoreQuery
+	Ref(ref string) CampaignStoreQuery
+
+	// One returns first matched campaign.
+	One(ctx context.Context) (*Campaign, error)
+
+	// All returns campaigns ordered from newest to oldest.
+	All(ctx context.Context) ([]*Campaign, error)
+
+	// Delete all matched campaigns.
+	Delete(ctx context.Context) (int, error)
+}
+
+// CampaignStore define persistence interface for campaigns.
+type CampaignStore interface {
+	Add(ctx context.Context, campaign *Campaign) error
+	Query() CampaignStoreQuery
+}
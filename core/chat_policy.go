@@ -0,0 +1,29 @@
+package core
+
+import "errors"
+
+//go:generate stringer -type ChatPolicy -trimprefix ChatPolicy
+
+// ChatPolicy define how subscription to restriction chats is checked.
+type ChatPolicy int8
+
+const (
+	// ChatPolicyAll requires subscription to all chats.
+	ChatPolicyAll ChatPolicy = iota
+
+	// ChatPolicyAny requires subscription to at least one of chats.
+	ChatPolicyAny
+)
+
+var ErrInvalidChatPolicy = errors.New("chat policy is invalid")
+
+func ParseChatPolicy(v string) (ChatPolicy, error) {
+	switch v {
+	case "All":
+		return ChatPolicyAll, nil
+	case "Any":
+		return ChatPolicyAny, nil
+	default:
+		return ChatPolicyAll, ErrInvalidChatPolicy
+	}
+}
@@ -20,11 +20,26 @@ type Download struct {
 	// References to user. Can be null.
 	UserID UserID
 
+	// Reference to bundle, if file was downloaded as part of bundle. Zero means null.
+	BundleID BundleID
+
+	// Reference to placement, if file was downloaded by its link. Zero means null.
+	PlacementID PlacementID
+
+	// Number of file version was sent. Zero means null.
+	FileVersion int
+
 	// If true, means user was requested to subscription and successefuly subscribed,
 	// False means, user was already subscribed,
 	// Null means check is disable.
 	NewSubscription null.Bool
 
+	// Chats which subscription was required for download.
+	ChatIDs []ChatID
+
+	// Source of download.
+	Source DownloadSource
+
 	// At time when download was happen
 	At time.Time
 }
@@ -41,6 +56,40 @@ func NewDownload(fileID FileID, userID UserID) *Download {
 	}
 }
 
+// NewFileDownload creates download of current version of file.
+func NewFileDownload(file *File, userID UserID) *Download {
+	dwn := NewDownload(file.ID, userID)
+	dwn.FileVersion = file.Version
+	return dwn
+}
+
+// NewInlineDownload creates download of file sent by owner via inline mode.
+func NewInlineDownload(file *File, userID UserID) *Download {
+	dwn := NewFileDownload(file, userID)
+	dwn.Source = DownloadSourceInline
+	return dwn
+}
+
+// NewBundleDownloads creates downloads of each delivered bundle file.
+// All downloads share the same time, so it's can be grouped back to one bundle download.
+func NewBundleDownloads(bundleID BundleID, files []*File, userID UserID) []*Download {
+	at := time.Now()
+
+	result := make([]*Download, len(files))
+
+	for i, file := range files {
+		result[i] = &Download{
+			FileID:      file.ID,
+			UserID:      userID,
+			BundleID:    bundleID,
+			FileVersion: file.Version,
+			At:          at,
+		}
+	}
+
+	return result
+}
+
 // FileDownloadStats of file.
 type FileDownloadStats struct {
 	// Total downloads count
@@ -64,8 +113,54 @@ type ChatDownloadStats struct {
 	NewSubscription int
 }
 
+// DownloadSeriesPoint is downloads in one bucket of time series.
+type DownloadSeriesPoint struct {
+	// Start of bucket.
+	At time.Time
+
+	// Downloads count
+	Total int
+
+	// Unique users count
+	Unique int
+
+	// Downloads with new subscription
+	NewSubscription int
+}
+
+// DownloadSeriesQuery define window and bucket size of downloads time series.
+type DownloadSeriesQuery struct {
+	// Start of first bucket, inclusive.
+	From time.Time
+
+	// End of window, exclusive.
+	To time.Time
+
+	// Size of bucket.
+	Step time.Duration
+}
+
+// DownloadLogEntry is download joined with info about user.
+type DownloadLogEntry struct {
+	// ID of download, used as cursor of log.
+	ID DownloadID
+
+	// Reference to user. Zero means user was deleted.
+	UserID UserID
+
+	// Username of user from Telegram (optional)
+	Username null.String
+
+	// Time when download was happen
+	At time.Time
+
+	// See Download.NewSubscription.
+	NewSubscription null.Bool
+}
+
 type DownloadStoreQuery interface {
 	FileID(id FileID) DownloadStoreQuery
+	UserID(id UserID) DownloadStoreQuery
 
 	Count(ctx context.Context) (int, error)
 }
@@ -73,6 +168,31 @@ type DownloadStoreQuery interface {
 type DownloadStore interface {
 	Add(ctx context.Context, download *Download) error
 	GetFileStats(ctx context.Context, id FileID) (*FileDownloadStats, error)
+	GetBundleStats(ctx context.Context, id BundleID) (*FileDownloadStats, error)
+
+	// GetChatStats returns subscriptions of downloads required subscription to chat.
 	GetChatStats(ctx context.Context, id ChatID) (*ChatDownloadStats, error)
+
+	// GetFilePlacementStats returns downloads of file grouped by placement ordered by placement ID.
+	// Downloads without placement are included with zero placement ID, if any.
+	GetFilePlacementStats(ctx context.Context, id FileID) ([]*PlacementDownloadStats, error)
+
+	// GetFileVersionStats returns downloads of file grouped by version ordered by version.
+	GetFileVersionStats(ctx context.Context, id FileID) ([]*FileVersionDownloadStats, error)
+
+	// GetFileSeries returns downloads of file bucketed by time.
+	// Buckets without downloads are included with zero values.
+	GetFileSeries(ctx context.Context, id FileID, query *DownloadSeriesQuery) ([]*DownloadSeriesPoint, error)
+
+	// GetChatSeries returns downloads required subscription to chat bucketed by time.
+	// Buckets without downloads are included with zero values.
+	GetChatSeries(ctx context.Context, id ChatID, query *DownloadSeriesQuery) ([]*DownloadSeriesPoint, error)
+
+	// GetFileLog returns at most limit downloads of file with ID greater than after, ordered by ID.
+	GetFileLog(ctx context.Context, id FileID, after DownloadID, limit int) ([]*DownloadLogEntry, error)
+
+	// GetChatLog returns at most limit downloads required subscription to chat with ID greater than after, ordered by ID.
+	GetChatLog(ctx context.Context, id ChatID, after DownloadID, limit int) ([]*DownloadLogEntry, error)
+
 	Query() DownloadStoreQuery
 }
@@ -0,0 +1,29 @@
+package core
+
+import "errors"
+
+//go:generate stringer -type DownloadSource -trimprefix DownloadSource
+
+// DownloadSource define how file was delivered to user.
+type DownloadSource int8
+
+const (
+	// DownloadSourceLink means file was downloaded by public link.
+	DownloadSourceLink DownloadSource = iota
+
+	// DownloadSourceInline means file was sent by owner via inline mode.
+	DownloadSourceInline
+)
+
+var ErrInvalidDownloadSource = errors.New("download source is invalid")
+
+func ParseDownloadSource(v string) (DownloadSource, error) {
+	switch v {
+	case "Link":
+		return DownloadSourceLink, nil
+	case "Inline":
+		return DownloadSourceInline, nil
+	default:
+		return DownloadSourceLink, ErrInvalidDownloadSource
+	}
+}
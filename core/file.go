@@ -5,6 +5,7 @@ import (
 	"errors"
 	"time"
 
+	"github.com/bots-house/share-file-bot/pkg/password"
 	"github.com/bots-house/share-file-bot/pkg/secretid"
 	"github.com/volatiletech/null/v8"
 )
@@ -12,17 +13,121 @@ import (
 // FileID it's alias for share id.
 type FileID int
 
+// ChatRestrictions requires subscription to chats before download.
+type ChatRestrictions struct {
+	// Request subscription to this chats.
+	ChatIDs []ChatID
+
+	// Policy of subscription check when restricted by few chats.
+	ChatPolicy ChatPolicy
+}
+
+func (cr *ChatRestrictions) HasChats() bool {
+	return len(cr.ChatIDs) > 0
+}
+
+// HasChat returns true if subscription to chat is required.
+func (cr *ChatRestrictions) HasChat(id ChatID) bool {
+	for _, v := range cr.ChatIDs {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
+
+// ToggleChat adds chat to restriction or removes it, if chat already added.
+// Returns true if chat was added.
+func (cr *ChatRestrictions) ToggleChat(id ChatID) bool {
+	for i, v := range cr.ChatIDs {
+		if v == id {
+			cr.ChatIDs = append(cr.ChatIDs[:i:i], cr.ChatIDs[i+1:]...)
+			return false
+		}
+	}
+
+	cr.ChatIDs = append(cr.ChatIDs, id)
+
+	return true
+}
+
 type DownloadRestrictions struct {
-	// Request subscription to this chat. Zero means null.
-	ChatID ChatID
+	ChatRestrictions
+
+	// Max total downloads of file. Zero means unlimited.
+	MaxDownloads int
+
+	// Max downloads of file by one user. Zero means unlimited.
+	MaxDownloadsPerUser int
+
+	// File is not available after this time.
+	ExpiresAt null.Time
+
+	// File is not available before this time.
+	AvailableFrom null.Time
+
+	// Salted hash of password required for download. Empty means null.
+	PasswordHash string
+}
+
+func (dr *DownloadRestrictions) HasMaxDownloads() bool {
+	return dr.MaxDownloads > 0
+}
+
+func (dr *DownloadRestrictions) HasMaxDownloadsPerUser() bool {
+	return dr.MaxDownloadsPerUser > 0
 }
 
-func (dr *DownloadRestrictions) HasChatID() bool {
-	return dr.ChatID != 0
+// IsExpired returns true if file is expired at specified time.
+func (dr *DownloadRestrictions) IsExpired(at time.Time) bool {
+	return dr.ExpiresAt.Valid && !at.Before(dr.ExpiresAt.Time)
+}
+
+// IsAvailableYet returns true if file is available at specified time.
+func (dr *DownloadRestrictions) IsAvailableYet(at time.Time) bool {
+	return !dr.AvailableFrom.Valid || !at.Before(dr.AvailableFrom.Time)
+}
+
+func (dr *DownloadRestrictions) HasPassword() bool {
+	return dr.PasswordHash != ""
+}
+
+// SetPassword sets hash of password, empty password disables restriction.
+func (dr *DownloadRestrictions) SetPassword(pwd string) {
+	if pwd == "" {
+		dr.PasswordHash = ""
+	} else {
+		dr.PasswordHash = password.Hash(pwd)
+	}
+}
+
+// CheckPassword returns true if password matches.
+func (dr *DownloadRestrictions) CheckPassword(pwd string) bool {
+	ok, err := password.Check(dr.PasswordHash, pwd)
+	return err == nil && ok
 }
 
 func (dr *DownloadRestrictions) Any() bool {
-	return dr.HasChatID()
+	return dr.HasChats() ||
+		dr.HasPassword() ||
+		dr.HasMaxDownloads() ||
+		dr.HasMaxDownloadsPerUser() ||
+		dr.ExpiresAt.Valid ||
+		dr.AvailableFrom.Valid
+}
+
+// TextEntity is formatting entity of text, like bold or link.
+// Offset and Length are in UTF-16 code units, as in Telegram.
+type TextEntity struct {
+	Type   string `json:"type"`
+	Offset int    `json:"offset"`
+	Length int    `json:"length"`
+
+	// URL of text_link entity.
+	URL string `json:"url,omitempty"`
+
+	// Mentioned user of text_mention entity.
+	UserID UserID `json:"user_id,omitempty"`
 }
 
 // File represents shared file.
@@ -30,7 +135,7 @@ type File struct { //nolint:maligned
 	// Unique ID of File.
 	ID FileID
 
-	// Telegram File ID
+	// Telegram File ID, empty for contact and location.
 	TelegramID string
 
 	// Public File ID
@@ -39,9 +144,15 @@ type File struct { //nolint:maligned
 	// If true, file violates copyright and is not available
 	IsViolatesCopyright null.Bool
 
+	// If true, file is blocked by admin and is not available
+	IsBlocked bool
+
 	// Caption of file
 	Caption null.String
 
+	// Formatting entities of caption
+	CaptionEntities []TextEntity
+
 	// Kind of file
 	Kind Kind
 
@@ -63,6 +174,9 @@ type File struct { //nolint:maligned
 	// It's URI of post with tg:// scheme.
 	LinkedPostURI null.String
 
+	// Number of current version, starts from 1 and incremented on each replace of file.
+	Version int
+
 	// Reference to user who uploads file.
 	OwnerID UserID
 
@@ -78,6 +192,7 @@ func (file *File) SetLinkedPostURI(v string) {
 	file.LinkedPostURI.SetValid(v)
 }
 
+// RegenPublicID replaces generated public ID by new one of the same length, used on collision.
 func (file *File) RegenPublicID() {
 	file.PublicID = secretid.Generate(secretid.IsLong(file.PublicID))
 }
@@ -98,6 +213,7 @@ func NewFile(
 		PublicID:   secretid.Generate(longID),
 		Caption:    null.NewString(caption, caption != ""),
 		Kind:       kind,
+		Version:    1,
 		Metadata:   md,
 		MIMEType:   null.NewString(mimeType, mimeType != ""),
 		Size:       size,
@@ -109,12 +225,44 @@ func NewFile(
 
 var ErrFileNotFound = errors.New("file not found")
 
+// FileOrder define sort order of files in query.
+type FileOrder int8
+
+const (
+	// FileOrderCreatedAt sorts files from newest to oldest.
+	FileOrderCreatedAt FileOrder = iota
+
+	// FileOrderDownloads sorts files from most to least downloaded.
+	FileOrderDownloads
+)
+
 type FileStoreQuery interface {
-	ID(id FileID) FileStoreQuery
+	ID(ids ...FileID) FileStoreQuery
 	OwnerID(id UserID) FileStoreQuery
 	PublicID(ids ...string) FileStoreQuery
 	RestrictionChatID(id ChatID) FileStoreQuery
 
+	// Filter files by kind.
+	Kind(kinds ...Kind) FileStoreQuery
+
+	// Search files by substring of name or caption, case insensitive.
+	Search(text string) FileStoreQuery
+
+	// Filter files not blocked by admin and not violating copyright.
+	NotModerated() FileStoreQuery
+
+	// Order of result, applied only to All and One.
+	OrderBy(order FileOrder) FileStoreQuery
+
+	// Skip first n files, applied only to All and One.
+	Offset(n int) FileStoreQuery
+
+	// Return at most n files, applied only to All.
+	Limit(n int) FileStoreQuery
+
+	// Lock selected files until end of transaction, applied only to All and One.
+	ForUpdate() FileStoreQuery
+
 	All(ctx context.Context) ([]*File, error)
 	One(ctx context.Context) (*File, error)
 	Delete(ctx context.Context) error
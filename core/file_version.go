@@ -0,0 +1,109 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+)
+
+// FileVersionID it's alias for file version identifier.
+type FileVersionID int
+
+// FileVersion is previous content of file, archived when owner replaces it.
+// Current content of file is stored in File itself.
+type FileVersion struct {
+	// Unique ID of version.
+	ID FileVersionID
+
+	// Reference to file.
+	FileID FileID
+
+	// Number of version, see File.Version.
+	Version int
+
+	// Telegram File ID, empty for contact and location.
+	TelegramID string
+
+	// Kind of file
+	Kind Kind
+
+	// MIMEType of file
+	MIMEType null.String
+
+	// File name
+	Name string
+
+	// File size in bytes
+	Size int
+
+	// Metadata contains metadata of file depends by kind.
+	Metadata Metadata
+
+	// Time when version was replaced by next one.
+	ReplacedAt time.Time
+}
+
+// NewFileVersion creates version from current content of file.
+func NewFileVersion(file *File) *FileVersion {
+	return &FileVersion{
+		FileID:     file.ID,
+		Version:    file.Version,
+		TelegramID: file.TelegramID,
+		Kind:       file.Kind,
+		MIMEType:   file.MIMEType,
+		Name:       file.Name,
+		Size:       file.Size,
+		Metadata:   file.Metadata,
+		ReplacedAt: time.Now(),
+	}
+}
+
+// SetContent replaces content of file by content of version
+// and increments number of file version, so content becomes new version.
+func (file *File) SetContent(version *FileVersion) {
+	file.TelegramID = version.TelegramID
+	file.Kind = version.Kind
+	file.MIMEType = version.MIMEType
+	file.Name = version.Name
+	file.Size = version.Size
+	file.Metadata = version.Metadata
+	file.Version++
+}
+
+// FileVersionDownloadStats is downloads of file made when it had one version.
+type FileVersionDownloadStats struct {
+	// Number of version. Zero means downloads made before versions were tracked.
+	Version int
+
+	// Total downloads count
+	Total int
+
+	// Unique downloads count
+	Unique int
+}
+
+var ErrFileVersionNotFound = errors.New("file version not found")
+
+type FileVersionStoreQuery interface {
+	ID(id FileVersionID) FileVersionStoreQuery
+	FileID(id FileID) FileVersionStoreQuery
+
+	// Return at most n versions, applied only to All.
+	Limit(n int) FileVersionStoreQuery
+
+	// One returns first matched version.
+	One(ctx context.Context) (*FileVersion, error)
+
+	// All returns versions ordered from newest to oldest.
+	All(ctx context.Context) ([]*FileVersion, error)
+
+	Count(ctx context.Context) (int, error)
+}
+
+// FileVersionStore define persistence interface for versions of file.
+type FileVersionStore interface {
+	Add(ctx context.Context, version *FileVersion) error
+	Query() FileVersionStoreQuery
+}
@@ -17,6 +17,10 @@ const (
 	KindPhoto
 	KindVideo
 	KindVoice
+	KindSticker
+	KindVideoNote
+	KindContact
+	KindLocation
 )
 
 var (
@@ -37,7 +41,25 @@ func ParseKind(v string) (Kind, error) {
 		return KindVoice, nil
 	case "Photo":
 		return KindPhoto, nil
+	case "Sticker":
+		return KindSticker, nil
+	case "VideoNote":
+		return KindVideoNote, nil
+	case "Contact":
+		return KindContact, nil
+	case "Location":
+		return KindLocation, nil
 	default:
 		return KindUnknown, ErrInvalidKind
 	}
 }
+
+// HasCaption returns true if Telegram allows caption for messages of this kind.
+func (k Kind) HasCaption() bool {
+	switch k {
+	case KindSticker, KindVideoNote, KindContact, KindLocation:
+		return false
+	default:
+		return true
+	}
+}
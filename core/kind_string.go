@@ -15,11 +15,15 @@ func _() {
 	_ = x[KindPhoto-4]
 	_ = x[KindVideo-5]
 	_ = x[KindVoice-6]
+	_ = x[KindSticker-7]
+	_ = x[KindVideoNote-8]
+	_ = x[KindContact-9]
+	_ = x[KindLocation-10]
 }
 
-const _Kind_name = "UnknownDocumentAnimationAudioPhotoVideoVoice"
+const _Kind_name = "UnknownDocumentAnimationAudioPhotoVideoVoiceStickerVideoNoteContactLocation"
 
-var _Kind_index = [...]uint8{0, 7, 15, 24, 29, 34, 39, 44}
+var _Kind_index = [...]uint8{0, 7, 15, 24, 29, 34, 39, 44, 51, 60, 67, 75}
 
 func (i Kind) String() string {
 	if i < 0 || i >= Kind(len(_Kind_index)-1) {
@@ -1,8 +1,11 @@
 package core
 
 type Metadata struct {
-	Audio  *MetadataAudio   `json:"audio,omitempty"`
-	Stcker *MetadataSticker `json:"stcker,omitempty"`
+	Audio     *MetadataAudio     `json:"audio,omitempty"`
+	Sticker   *MetadataSticker   `json:"sticker,omitempty"`
+	VideoNote *MetadataVideoNote `json:"video_note,omitempty"`
+	Contact   *MetadataContact   `json:"contact,omitempty"`
+	Location  *MetadataLocation  `json:"location,omitempty"`
 }
 
 type MetadataAudio struct {
@@ -15,6 +18,24 @@ type MetadataSticker struct {
 	Emoji   string `json:"emoji,omitempty"`
 }
 
+type MetadataVideoNote struct {
+	Length   int `json:"length,omitempty"`
+	Duration int `json:"duration,omitempty"`
+}
+
+// MetadataContact contains shared contact, it's resent as is.
+type MetadataContact struct {
+	PhoneNumber string `json:"phone_number"`
+	FirstName   string `json:"first_name"`
+	LastName    string `json:"last_name,omitempty"`
+}
+
+// MetadataLocation contains shared point on map, it's resent as is.
+type MetadataLocation struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
 func NewMetadataAudio(title, performer string) Metadata {
 	return Metadata{
 		Audio: &MetadataAudio{
@@ -23,3 +44,40 @@ func NewMetadataAudio(title, performer string) Metadata {
 		},
 	}
 }
+
+func NewMetadataSticker(setName, emoji string) Metadata {
+	return Metadata{
+		Sticker: &MetadataSticker{
+			SetName: setName,
+			Emoji:   emoji,
+		},
+	}
+}
+
+func NewMetadataVideoNote(length, duration int) Metadata {
+	return Metadata{
+		VideoNote: &MetadataVideoNote{
+			Length:   length,
+			Duration: duration,
+		},
+	}
+}
+
+func NewMetadataContact(phoneNumber, firstName, lastName string) Metadata {
+	return Metadata{
+		Contact: &MetadataContact{
+			PhoneNumber: phoneNumber,
+			FirstName:   firstName,
+			LastName:    lastName,
+		},
+	}
+}
+
+func NewMetadataLocation(latitude, longitude float64) Metadata {
+	return Metadata{
+		Location: &MetadataLocation{
+			Latitude:  latitude,
+			Longitude: longitude,
+		},
+	}
+}
@@ -0,0 +1,72 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// PlacementID it's alias for placement identifier.
+type PlacementID int
+
+// Placement is tracking link of file created by owner for one place of publication,
+// e.g. post in channel or forum thread.
+type Placement struct {
+	// Unique ID of placement, used in deep-link.
+	ID PlacementID
+
+	// Reference to file.
+	FileID FileID
+
+	// Name of placement for owner.
+	Name string
+
+	// Time when placement was created.
+	CreatedAt time.Time
+}
+
+// NewPlacement creates placement of file.
+func NewPlacement(fileID FileID, name string) *Placement {
+	return &Placement{
+		FileID:    fileID,
+		Name:      name,
+		CreatedAt: time.Now(),
+	}
+}
+
+// PlacementDownloadStats is downloads of file made by link of one placement.
+type PlacementDownloadStats struct {
+	// Reference to placement. Zero means downloads by link without placement.
+	PlacementID PlacementID
+
+	// Total downloads count
+	Total int
+
+	// Unique downloads count
+	Unique int
+}
+
+var ErrPlacementNotFound = errors.New("placement not found")
+
+type PlacementStoreQuery interface {
+	ID(id PlacementID) PlacementStoreQuery
+	FileID(id FileID) PlacementStoreQuery
+
+	// One returns first matched placement.
+	One(ctx context.Context) (*Placement, error)
+
+	// All returns placements ordered by ID.
+	All(ctx context.Context) ([]*Placement, error)
+
+	Count(ctx context.Context) (int, error)
+
+	// Delete all matched placements.
+	// Downloads made by placement link are kept without placement.
+	Delete(ctx context.Context) (int, error)
+}
+
+// PlacementStore define persistence interface for placements.
+type PlacementStore interface {
+	Add(ctx context.Context, placement *Placement) error
+	Query() PlacementStoreQuery
+}
@@ -0,0 +1,204 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+)
+
+//go:generate stringer -type ReportStatus -trimprefix ReportStatus
+
+// ReportStatus define step of copyright takedown workflow.
+type ReportStatus int8
+
+const (
+	// ReportStatusPending means report is waiting for admin decision.
+	ReportStatusPending ReportStatus = iota
+
+	// ReportStatusApproved means admin approved report and file is blocked.
+	ReportStatusApproved
+
+	// ReportStatusRejected means admin rejected report.
+	ReportStatusRejected
+
+	// ReportStatusAppealed means owner of blocked file appealed and waiting for admin decision.
+	ReportStatusAppealed
+
+	// ReportStatusAppealApproved means admin approved appeal and file is available again.
+	ReportStatusAppealApproved
+
+	// ReportStatusAppealRejected means admin rejected appeal and file stays blocked.
+	ReportStatusAppealRejected
+)
+
+var ErrInvalidReportStatus = errors.New("report status is invalid")
+
+func ParseReportStatus(v string) (ReportStatus, error) {
+	switch v {
+	case "Pending":
+		return ReportStatusPending, nil
+	case "Approved":
+		return ReportStatusApproved, nil
+	case "Rejected":
+		return ReportStatusRejected, nil
+	case "Appealed":
+		return ReportStatusAppealed, nil
+	case "AppealApproved":
+		return ReportStatusAppealApproved, nil
+	case "AppealRejected":
+		return ReportStatusAppealRejected, nil
+	default:
+		return ReportStatusPending, ErrInvalidReportStatus
+	}
+}
+
+// ReportID it's alias for report identifier.
+type ReportID int
+
+// Report is complaint of user about file violating copyright.
+type Report struct {
+	// Unique ID of report.
+	ID ReportID
+
+	// Reference to reported file.
+	FileID FileID
+
+	// Reference to user who reports file. Zero means user was deleted.
+	ReporterID UserID
+
+	// Reason of report provided by reporter.
+	Reason string
+
+	// Current step of workflow.
+	Status ReportStatus
+
+	// Time when report was created.
+	CreatedAt time.Time
+
+	// Reference to admin who approved or rejected report. Zero means null.
+	ResolvedBy UserID
+
+	// Time when report was approved or rejected.
+	ResolvedAt null.Time
+
+	// Text of appeal provided by owner of file.
+	Appeal null.String
+
+	// Time when owner of file appealed.
+	AppealedAt null.Time
+
+	// Reference to admin who approved or rejected appeal. Zero means null.
+	AppealResolvedBy UserID
+
+	// Time when appeal was approved or rejected.
+	AppealResolvedAt null.Time
+}
+
+var (
+	ErrReportNotFound         = errors.New("report not found")
+	ErrReportAlreadyResolved  = errors.New("report already resolved")
+	ErrReportCantBeAppealed   = errors.New("report can't be appealed")
+	ErrReportAppealNotPending = errors.New("report appeal is not pending")
+)
+
+func NewReport(fileID FileID, reporterID UserID, reason string) *Report {
+	return &Report{
+		FileID:     fileID,
+		ReporterID: reporterID,
+		Reason:     reason,
+		Status:     ReportStatusPending,
+		CreatedAt:  time.Now(),
+	}
+}
+
+// IsOpen returns true if report is waiting for admin decision.
+func (report *Report) IsOpen() bool {
+	return report.Status == ReportStatusPending || report.Status == ReportStatusAppealed
+}
+
+// IsFileBlocked returns true if file should be blocked by this report.
+func (report *Report) IsFileBlocked() bool {
+	switch report.Status {
+	case ReportStatusApproved, ReportStatusAppealed, ReportStatusAppealRejected:
+		return true
+	default:
+		return false
+	}
+}
+
+// Resolve approves or rejects pending report.
+func (report *Report) Resolve(adminID UserID, approve bool) error {
+	if report.Status != ReportStatusPending {
+		return ErrReportAlreadyResolved
+	}
+
+	if approve {
+		report.Status = ReportStatusApproved
+	} else {
+		report.Status = ReportStatusRejected
+	}
+
+	report.ResolvedBy = adminID
+	report.ResolvedAt = null.TimeFrom(time.Now())
+
+	return nil
+}
+
+// MakeAppeal appeals approved report.
+func (report *Report) MakeAppeal(text string) error {
+	if report.Status != ReportStatusApproved {
+		return ErrReportCantBeAppealed
+	}
+
+	report.Status = ReportStatusAppealed
+	report.Appeal = null.StringFrom(text)
+	report.AppealedAt = null.TimeFrom(time.Now())
+
+	return nil
+}
+
+// ResolveAppeal approves or rejects appeal of report.
+func (report *Report) ResolveAppeal(adminID UserID, approve bool) error {
+	if report.Status != ReportStatusAppealed {
+		return ErrReportAppealNotPending
+	}
+
+	if approve {
+		report.Status = ReportStatusAppealApproved
+	} else {
+		report.Status = ReportStatusAppealRejected
+	}
+
+	report.AppealResolvedBy = adminID
+	report.AppealResolvedAt = null.TimeFrom(time.Now())
+
+	return nil
+}
+
+type ReportStoreQuery interface {
+	ID(id ReportID) ReportStoreQuery
+	FileID(id FileID) ReportStoreQuery
+	ReporterID(id UserID) ReportStoreQuery
+	Status(statuses ...ReportStatus) ReportStoreQuery
+
+	// Return at most n reports, applied only to All.
+	Limit(n int) ReportStoreQuery
+
+	// All returns reports ordered by ID.
+	All(ctx context.Context) ([]*Report, error)
+	One(ctx context.Context) (*Report, error)
+	Count(ctx context.Context) (int, error)
+}
+
+// ReportStore define persistence interface for Report.
+type ReportStore interface {
+	// Add Report to store. Update ID.
+	Add(ctx context.Context, report *Report) error
+
+	// Update report in store.
+	Update(ctx context.Context, report *Report) error
+
+	Query() ReportStoreQuery
+}
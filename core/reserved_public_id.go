@@ -0,0 +1,51 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+)
+
+// ReservedPublicID is name reserved by admin, which can't be used as custom public ID of file.
+type ReservedPublicID struct {
+	// Reserved name in lower case, names are matched case-insensitive.
+	Name string
+
+	// Reference to admin who reserved name.
+	AdminID UserID
+
+	// Time when name was reserved.
+	CreatedAt time.Time
+}
+
+// NewReservedPublicID creates reserved name.
+func NewReservedPublicID(name string, adminID UserID) *ReservedPublicID {
+	return &ReservedPublicID{
+		Name:      strings.ToLower(name),
+		AdminID:   adminID,
+		CreatedAt: time.Now(),
+	}
+}
+
+var ErrReservedPublicIDNotFound = errors.New("reserved public id not found")
+
+type ReservedPublicIDStoreQuery interface {
+	// Name filters by name, case-insensitive.
+	Name(name string) ReservedPublicIDStoreQuery
+
+	// One returns first matched name.
+	One(ctx context.Context) (*ReservedPublicID, error)
+
+	// All returns names ordered alphabetically.
+	All(ctx context.Context) ([]*ReservedPublicID, error)
+
+	// Delete all matched names.
+	Delete(ctx context.Context) (int, error)
+}
+
+// ReservedPublicIDStore define persistence interface for names reserved by admins.
+type ReservedPublicIDStore interface {
+	Add(ctx context.Context, reserved *ReservedPublicID) error
+	Query() ReservedPublicIDStoreQuery
+}
@@ -0,0 +1,57 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// RevokedLink is previous public ID of file, replaced by owner when link is leaked.
+// During grace period revoked link still resolves to file.
+type RevokedLink struct {
+	// Revoked public ID of file.
+	PublicID string
+
+	// Reference to file.
+	FileID FileID
+
+	// Time until link still resolves to file.
+	// Equal to RevokedAt, if link is revoked without grace period.
+	ActiveUntil time.Time
+
+	// Time when link was revoked.
+	RevokedAt time.Time
+}
+
+// NewRevokedLink creates revoked link of file with current public ID.
+func NewRevokedLink(file *File, grace time.Duration) *RevokedLink {
+	now := time.Now()
+
+	return &RevokedLink{
+		PublicID:    file.PublicID,
+		FileID:      file.ID,
+		ActiveUntil: now.Add(grace),
+		RevokedAt:   now,
+	}
+}
+
+// IsActive returns true if link still resolves to file at specified time.
+func (link *RevokedLink) IsActive(at time.Time) bool {
+	return at.Before(link.ActiveUntil)
+}
+
+var ErrRevokedLinkNotFound = errors.New("revoked link not found")
+
+type RevokedLinkStoreQuery interface {
+	PublicID(id string) RevokedLinkStoreQuery
+	FileID(id FileID) RevokedLinkStoreQuery
+
+	One(ctx context.Context) (*RevokedLink, error)
+	Count(ctx context.Context) (int, error)
+}
+
+// RevokedLinkStore define persistence interface for revoked links of files.
+type RevokedLinkStore interface {
+	Add(ctx context.Context, link *RevokedLink) error
+	Query() RevokedLinkStoreQuery
+}
@@ -31,6 +31,9 @@ type User struct {
 	// True, if user is admin of bot.
 	IsAdmin bool
 
+	// True, if user is banned by admin and can't use bot.
+	IsBanned bool
+
 	// Settings of user
 	Settings UserSettings
 
@@ -42,6 +45,66 @@ type User struct {
 
 	// Time when user info was updated
 	UpdatedAt null.Time
+
+	// Time when bot found that user blocked it (optional).
+	// Reset when user interacts with bot again.
+	BotBlockedAt null.Time
+
+	// Time when user unblocked bot last time (optional).
+	BotUnblockedAt null.Time
+
+	// Time of last interaction with bot (optional).
+	// Updated not more often than UserLastSeenPrecision.
+	LastSeenAt null.Time
+}
+
+// UserLastSeenPrecision is precision of User.LastSeenAt,
+// so we don't update user on each interaction.
+const UserLastSeenPrecision = time.Hour
+
+// BlockBot marks that user blocked bot at t.
+// Returns false, if it's already known.
+func (user *User) BlockBot(at time.Time) bool {
+	if user.BotBlockedAt.Valid {
+		return false
+	}
+
+	user.BotBlockedAt = null.TimeFrom(at)
+
+	return true
+}
+
+// UnblockBot marks that user unblocked bot at t.
+// Returns false, if bot was not blocked.
+func (user *User) UnblockBot(at time.Time) bool {
+	if !user.BotBlockedAt.Valid {
+		return false
+	}
+
+	user.BotBlockedAt = null.Time{}
+	user.BotUnblockedAt = null.TimeFrom(at)
+
+	return true
+}
+
+// Seen updates time of last interaction with bot.
+// Returns false, if previous value is fresh enough.
+func (user *User) Seen(at time.Time) bool {
+	if user.LastSeenAt.Valid && at.Sub(user.LastSeenAt.Time) < UserLastSeenPrecision {
+		return false
+	}
+
+	user.LastSeenAt = null.TimeFrom(at)
+
+	return true
+}
+
+// GetLanguage returns language of user selected in settings or language of Telegram client.
+func (user *User) GetLanguage() string {
+	if user.Settings.Language != "" {
+		return user.Settings.Language
+	}
+	return user.LanguageCode
 }
 
 func (user *User) Patch(do func(*User)) bool {
@@ -96,12 +159,39 @@ func NewUser(
 var ErrUserNotFound = errors.New("user not found")
 
 type UserStoreQuery interface {
+	// Users came by deep-link with ref.
+	Ref(ref string) UserStoreQuery
+
+	// Users with language selected in settings or language of Telegram client starting with lang.
+	Language(lang string) UserStoreQuery
+
+	JoinedAfter(t time.Time) UserStoreQuery
+
+	// Users with ID greater than id, used to iterate over users.
+	IDGreaterThan(id UserID) UserStoreQuery
+
+	// Users who didn't block bot.
+	NotBlockedBot() UserStoreQuery
+
+	// Users who blocked bot.
+	BlockedBot() UserStoreQuery
+
+	// Return at most n users, applied only to All.
+	Limit(n int) UserStoreQuery
+
+	// All returns users ordered by ID.
+	All(ctx context.Context) ([]*User, error)
 	Count(ctx context.Context) (int, error)
 }
 
 type UserRefStatsItem struct {
-	Ref   null.String
+	Ref null.String
+
+	// Count of users came by ref.
 	Count int
+
+	// Count of downloads made by these users in same period.
+	Downloads int
 }
 
 type UserRefStats []UserRefStatsItem
@@ -112,7 +202,13 @@ type UserStore interface {
 	Find(ctx context.Context, id UserID) (*User, error)
 	Update(ctx context.Context, user *User) error
 
-	RefStats(ctx context.Context) (UserRefStats, error)
+	// RefStats returns users grouped by ref, who joined in [from, to), and their downloads in same period.
+	// Zero from or to means that period is not bounded.
+	RefStats(ctx context.Context, from, to time.Time) (UserRefStats, error)
+
+	// CountActive returns count of users who interacted with bot
+	// or downloaded some file since t.
+	CountActive(ctx context.Context, since time.Time) (int, error)
 
 	Query() UserStoreQuery
 }
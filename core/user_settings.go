@@ -11,6 +11,10 @@ type UserSettings struct {
 	// If true, bot generate super long id's for user files.
 	LongIDs bool `json:"long_ids"`
 
+	// Language of bot interface selected by user.
+	// If empty, language of Telegram client is used.
+	Language string `json:"language,omitempty"`
+
 	// Timestamp of last update of user settings.
 	UpdatedAt null.Time `json:"updated_at"`
 }
@@ -28,6 +32,11 @@ func (settings *UserSettings) Patch(do func(*UserSettings)) bool {
 		updated = true
 	}
 
+	if newSettings.Language != settings.Language {
+		settings.Language = newSettings.Language
+		updated = true
+	}
+
 	if updated {
 		settings.UpdatedAt = null.TimeFrom(time.Now())
 	}
@@ -5,6 +5,7 @@ go 1.15
 
 require (
 	github.com/DATA-DOG/go-txdb v0.1.4
+	github.com/alicebob/miniredis/v2 v2.14.3
 	github.com/bots-house/telegram-bot-api v1.0.1-0.20201118162257-7fc66cc9f4c9
 	github.com/brianvoe/gofakeit/v5 v5.11.2
 	github.com/fatih/structs v1.1.0
@@ -29,6 +30,7 @@ require (
 	github.com/volatiletech/null/v8 v8.1.2
 	github.com/volatiletech/sqlboiler/v4 v4.5.0
 	github.com/volatiletech/strmangle v0.0.1
+	golang.org/x/crypto v0.0.0-20210322153248-0c34fe9e7dc2
 	golang.org/x/sync v0.0.0-20210220032951-036812b2e83c
 	golang.org/x/xerrors v0.0.0-20200804184101-5ec99f83aff1 // indirect
 	gopkg.in/yaml.v3 v3.0.0-20210107192922-496545a6307b // indirect
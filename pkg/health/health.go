@@ -42,12 +42,15 @@ func Check(ctx context.Context, addr string) error {
 	return nil
 }
 
-// NewHandler creates a healthcheck handler
+// NewHandler creates a healthcheck handler.
+// If db is nil, only availability of server is checked.
 func NewHandler(db *sql.DB) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
 
-		if err := db.PingContext(ctx); err != nil {
+		if db == nil {
+			_, _ = io.WriteString(w, "👌")
+		} else if err := db.PingContext(ctx); err != nil {
 			http.Error(w, "💩", http.StatusInternalServerError)
 			log.Error(ctx, "healthcheck fail", "err", err)
 		} else {
@@ -0,0 +1,71 @@
+// Package password implements salted hashing of passwords with PBKDF2-HMAC-SHA256.
+package password
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"strconv"
+	"strings"
+
+	"github.com/friendsofgo/errors"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+const (
+	scheme     = "pbkdf2-sha256"
+	iterations = 10000
+	saltLength = 16
+	keyLength  = sha256.Size
+)
+
+var ErrInvalidHash = errors.New("invalid password hash")
+
+var encoding = base64.RawStdEncoding
+
+// Hash returns encoded salted hash of password.
+// Format is `pbkdf2-sha256$<iterations>$<salt>$<key>`.
+func Hash(password string) string {
+	salt := make([]byte, saltLength)
+
+	if _, err := rand.Read(salt); err != nil {
+		panic("read random salt")
+	}
+
+	key := pbkdf2.Key([]byte(password), salt, iterations, keyLength, sha256.New)
+
+	return strings.Join([]string{
+		scheme,
+		strconv.Itoa(iterations),
+		encoding.EncodeToString(salt),
+		encoding.EncodeToString(key),
+	}, "$")
+}
+
+// Check returns true if password matches hash.
+func Check(hash string, password string) (bool, error) {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 4 || parts[0] != scheme {
+		return false, ErrInvalidHash
+	}
+
+	iter, err := strconv.Atoi(parts[1])
+	if err != nil || iter <= 0 {
+		return false, ErrInvalidHash
+	}
+
+	salt, err := encoding.DecodeString(parts[2])
+	if err != nil {
+		return false, ErrInvalidHash
+	}
+
+	key, err := encoding.DecodeString(parts[3])
+	if err != nil || len(key) != keyLength {
+		return false, ErrInvalidHash
+	}
+
+	actual := pbkdf2.Key([]byte(password), salt, iter, keyLength, sha256.New)
+
+	return subtle.ConstantTimeCompare(key, actual) == 1, nil
+}
@@ -0,0 +1,46 @@
+package password
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheck_Reference(t *testing.T) {
+	// generated by python: hashlib.pbkdf2_hmac("sha256", b"secret", b"0123456789abcdef", 10000)
+	const hash = "pbkdf2-sha256$10000$MDEyMzQ1Njc4OWFiY2RlZg$6umsHhz0yhb+YIJ/FUgCrsOB+tK+gGdB+tvI2KPIHns"
+
+	ok, err := Check(hash, "secret")
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = Check(hash, "secret2")
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestHash(t *testing.T) {
+	hash := Hash("secret")
+
+	assert.True(t, strings.HasPrefix(hash, "pbkdf2-sha256$10000$"))
+	assert.NotEqual(t, hash, Hash("secret"), "hash should be salted")
+
+	ok, err := Check(hash, "secret")
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = Check(hash, "Secret")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	for _, invalid := range []string{
+		"plain",
+		"pbkdf2-sha256$0$MDEyMzQ1Njc4OWFiY2RlZg$6umsHhz0yhb+YIJ/FUgCrsOB+tK+gGdB+tvI2KPIHns",
+		"pbkdf2-sha256$10000$MDEyMzQ1Njc4OWFiY2RlZg$",
+	} {
+		_, err = Check(invalid, "secret")
+		assert.Equal(t, ErrInvalidHash, err, invalid)
+	}
+}
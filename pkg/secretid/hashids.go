@@ -38,5 +38,11 @@ func (hids *HashIDs) Decode(hash string) (int, error) {
 	if err != nil {
 		return 0, errors.Wrap(err, "decode id with hash ids")
 	}
+
+	// hash of empty or multiple ids is valid for hashids, but not for us
+	if len(ids) != 1 {
+		return 0, ErrInvalidHash
+	}
+
 	return ids[0], nil
 }
@@ -1,6 +1,40 @@
 package secretid
 
+import "errors"
+
+// Strategies of public ids, see Parse.
+const (
+	// StrategyNanoID generates random public ids.
+	StrategyNanoID = "nanoid"
+
+	// StrategyHashIDs encodes id of entity to public id with salt.
+	StrategyHashIDs = "hashids"
+)
+
+var (
+	ErrInvalidHash     = errors.New("invalid hash")
+	ErrUnknownStrategy = errors.New("unknown secret id strategy")
+)
+
+// SecretID encodes id of entity to public id and back.
 type SecretID interface {
 	Encode(id int) string
 	Decode(hash string) (int, error)
 }
+
+// Parse returns secret id of strategy.
+// Nil is returned for StrategyNanoID, because random ids can't be decoded.
+func Parse(strategy string, salt string) (SecretID, error) {
+	switch strategy {
+	case StrategyNanoID:
+		return nil, nil
+	case StrategyHashIDs:
+		hids, err := NewHashIDs(salt)
+		if err != nil {
+			return nil, err
+		}
+		return hids, nil
+	default:
+		return nil, ErrUnknownStrategy
+	}
+}
@@ -0,0 +1,44 @@
+package secretid
+
+import (
+	"testing"
+
+	"github.com/friendsofgo/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHashIDs(t *testing.T) {
+	hids, err := NewHashIDs("salt")
+	require.NoError(t, err)
+
+	for _, id := range []int{1, 2, 100, 123456789} {
+		hash := hids.Encode(id)
+		require.NotEmpty(t, hash)
+
+		decoded, err := hids.Decode(hash)
+		require.NoError(t, err)
+		require.Equal(t, id, decoded)
+	}
+
+	other, err := NewHashIDs("other salt")
+	require.NoError(t, err)
+	require.NotEqual(t, hids.Encode(1), other.Encode(1))
+
+	for _, hash := range []string{"", "spring_catalog", "catalog-v2"} {
+		_, err := hids.Decode(hash)
+		require.Error(t, err, hash)
+	}
+}
+
+func TestParse(t *testing.T) {
+	sid, err := Parse(StrategyNanoID, "salt")
+	require.NoError(t, err)
+	require.Nil(t, sid)
+
+	sid, err = Parse(StrategyHashIDs, "salt")
+	require.NoError(t, err)
+	require.IsType(t, &HashIDs{}, sid)
+
+	_, err = Parse("uuid", "salt")
+	require.True(t, errors.Is(err, ErrUnknownStrategy))
+}
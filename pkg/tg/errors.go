@@ -2,6 +2,8 @@ package tg
 
 import (
 	"errors"
+	"net/http"
+	"time"
 
 	tgbotapi "github.com/bots-house/telegram-bot-api"
 )
@@ -30,11 +32,41 @@ func IsNotEnoughRightsToExportChatInviteLink(err error) bool {
 }
 
 func isTelegramErr(err error, msg string) bool {
+	tgErr, ok := asTelegramErr(err)
+	return ok && tgErr.Message == msg
+}
+
+// asTelegramErr extracts Telegram API error from err.
+// MakeRequest returns error by pointer, other methods by value, so both are supported.
+func asTelegramErr(err error) (tgbotapi.Error, bool) {
 	var tgErr tgbotapi.Error
 	if errors.As(err, &tgErr) {
-		return tgErr.Message == msg
+		return tgErr, true
+	}
+
+	var tgErrPtr *tgbotapi.Error
+	if errors.As(err, &tgErrPtr) && tgErrPtr != nil {
+		return *tgErrPtr, true
 	}
-	return false
+
+	return tgbotapi.Error{}, false
+}
+
+// IsBotBlockedByUser returns true if bot can't write to user,
+// because user blocked bot, was deactivated or never started bot.
+func IsBotBlockedByUser(err error) bool {
+	tgErr, ok := asTelegramErr(err)
+	return ok && tgErr.Code == http.StatusForbidden
+}
+
+// GetRetryAfter returns delay required by Telegram flood control.
+func GetRetryAfter(err error) (time.Duration, bool) {
+	tgErr, ok := asTelegramErr(err)
+	if !ok || tgErr.Code != http.StatusTooManyRequests {
+		return 0, false
+	}
+
+	return time.Duration(tgErr.RetryAfter) * time.Second, true
 }
 
 func IsBotIsNotMemberOfSupergroup(err error) bool {
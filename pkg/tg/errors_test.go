@@ -0,0 +1,39 @@
+package tg
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	tgbotapi "github.com/bots-house/telegram-bot-api"
+	"github.com/friendsofgo/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsBotBlockedByUser(t *testing.T) {
+	blocked := tgbotapi.Error{
+		Code:    http.StatusForbidden,
+		Message: "Forbidden: bot was blocked by the user",
+	}
+
+	assert.True(t, IsBotBlockedByUser(blocked))
+	assert.True(t, IsBotBlockedByUser(&blocked))
+	assert.True(t, IsBotBlockedByUser(errors.Wrap(&blocked, "copy message")))
+	assert.False(t, IsBotBlockedByUser(tgbotapi.Error{Code: http.StatusBadRequest}))
+	assert.False(t, IsBotBlockedByUser(errors.New("network error")))
+}
+
+func TestGetRetryAfter(t *testing.T) {
+	err := &tgbotapi.Error{
+		Code:               http.StatusTooManyRequests,
+		Message:            "Too Many Requests: retry after 5",
+		ResponseParameters: tgbotapi.ResponseParameters{RetryAfter: 5},
+	}
+
+	delay, ok := GetRetryAfter(errors.Wrap(err, "copy message"))
+	assert.True(t, ok)
+	assert.Equal(t, 5*time.Second, delay)
+
+	_, ok = GetRetryAfter(tgbotapi.Error{Code: http.StatusForbidden})
+	assert.False(t, ok)
+}
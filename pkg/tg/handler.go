@@ -2,17 +2,15 @@ package tg
 
 import (
 	"context"
-
-	tgbotapi "github.com/bots-house/telegram-bot-api"
 )
 
 type Handler interface {
-	HandleUpdate(ctx context.Context, update *tgbotapi.Update) error
+	HandleUpdate(ctx context.Context, update *Update) error
 }
 
-type HandlerFunc func(ctx context.Context, update *tgbotapi.Update) error
+type HandlerFunc func(ctx context.Context, update *Update) error
 
-func (hf HandlerFunc) HandleUpdate(ctx context.Context, update *tgbotapi.Update) error {
+func (hf HandlerFunc) HandleUpdate(ctx context.Context, update *Update) error {
 	return hf(ctx, update)
 }
 
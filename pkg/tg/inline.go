@@ -0,0 +1,46 @@
+package tg
+
+import (
+	tgbotapi "github.com/bots-house/telegram-bot-api"
+)
+
+// InlineQueryResultCachedSticker is a link to sticker stored on Telegram servers.
+// It's missing in used version of library.
+type InlineQueryResultCachedSticker struct {
+	Type                string                         `json:"type"`            // required
+	ID                  string                         `json:"id"`              // required
+	StickerID           string                         `json:"sticker_file_id"` // required
+	ReplyMarkup         *tgbotapi.InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	InputMessageContent interface{}                    `json:"input_message_content,omitempty"`
+}
+
+// NewInlineQueryResultCachedSticker creates inline query result with cached sticker.
+func NewInlineQueryResultCachedSticker(id, stickerID string) InlineQueryResultCachedSticker {
+	return InlineQueryResultCachedSticker{
+		Type:      "sticker",
+		ID:        id,
+		StickerID: stickerID,
+	}
+}
+
+// InlineQueryResultContact is a contact with phone number.
+// It's missing in used version of library.
+type InlineQueryResultContact struct {
+	Type                string                         `json:"type"`         // required
+	ID                  string                         `json:"id"`           // required
+	PhoneNumber         string                         `json:"phone_number"` // required
+	FirstName           string                         `json:"first_name"`   // required
+	LastName            string                         `json:"last_name,omitempty"`
+	ReplyMarkup         *tgbotapi.InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	InputMessageContent interface{}                    `json:"input_message_content,omitempty"`
+}
+
+// NewInlineQueryResultContact creates inline query result with contact.
+func NewInlineQueryResultContact(id, phoneNumber, firstName string) InlineQueryResultContact {
+	return InlineQueryResultContact{
+		Type:        "contact",
+		ID:          id,
+		PhoneNumber: phoneNumber,
+		FirstName:   firstName,
+	}
+}
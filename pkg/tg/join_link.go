@@ -33,7 +33,7 @@ type JoinLinkPayload struct {
 }
 
 func (payload *JoinLinkPayload) BotChatID() int64 {
-	return MTProtoToBotID(payload.ChatID)
+	return MTProtoToBotID(int64(payload.ChatID))
 }
 
 func DecodeJoinLinkPayload(encodedPayload string) (*JoinLinkPayload, error) {
@@ -1,6 +1,13 @@
 package tg
 
-import "strings"
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf16"
+
+	tgbotapi "github.com/bots-house/telegram-bot-api"
+)
 
 var escapeMDReplacer = strings.NewReplacer(
 	"_", `\_`,
@@ -27,3 +34,168 @@ var escapeMDReplacer = strings.NewReplacer(
 func EscapeMD(txt string) string {
 	return escapeMDReplacer.Replace(txt)
 }
+
+var escapeMDCodeReplacer = strings.NewReplacer(
+	"`", "\\`",
+	`\`, `\\`,
+)
+
+var escapeMDURLReplacer = strings.NewReplacer(
+	")", `\)`,
+	`\`, `\\`,
+)
+
+// mdSpan is part of text formatted by entity.
+type mdSpan struct {
+	start, end  int
+	open, close string
+	code        bool
+}
+
+func newMDSpan(entity tgbotapi.MessageEntity) (*mdSpan, bool) {
+	span := &mdSpan{
+		start: entity.Offset,
+		end:   entity.Offset + entity.Length,
+	}
+
+	switch entity.Type {
+	case "bold":
+		span.open, span.close = "*", "*"
+	case "italic":
+		span.open, span.close = "_", "_"
+	case "underline":
+		span.open, span.close = "__", "__"
+	case "strikethrough":
+		span.open, span.close = "~", "~"
+	case "spoiler":
+		span.open, span.close = "||", "||"
+	case "code":
+		span.open, span.close, span.code = "`", "`", true
+	case "pre":
+		span.open, span.close, span.code = "```\n", "```", true
+	case "text_link":
+		span.open, span.close = "[", "]("+escapeMDURLReplacer.Replace(entity.URL)+")"
+	case "text_mention":
+		if entity.User == nil {
+			return nil, false
+		}
+		span.open, span.close = "[", fmt.Sprintf("](tg://user?id=%d)", entity.User.ID)
+	default:
+		return nil, false
+	}
+
+	return span, true
+}
+
+// FormatMD converts text with formatting entities to MarkdownV2.
+// Entities without formatting (mentions, hashtags, urls, etc) are detected by Telegram itself, so they are skipped.
+func FormatMD(text string, entities []tgbotapi.MessageEntity) string {
+	if len(entities) == 0 {
+		return EscapeMD(text)
+	}
+
+	// offsets of entities are in UTF-16 code units
+	units := utf16.Encode([]rune(text))
+
+	spans := make([]*mdSpan, 0, len(entities))
+
+	for _, entity := range entities {
+		span, ok := newMDSpan(entity)
+		if !ok {
+			continue
+		}
+
+		if span.start < 0 {
+			span.start = 0
+		}
+
+		if span.end > len(units) {
+			span.end = len(units)
+		}
+
+		if span.start >= span.end {
+			continue
+		}
+
+		spans = append(spans, span)
+	}
+
+	// outer spans are opened first
+	sort.SliceStable(spans, func(i, j int) bool {
+		if spans[i].start != spans[j].start {
+			return spans[i].start < spans[j].start
+		}
+		return spans[i].end > spans[j].end
+	})
+
+	var (
+		result strings.Builder
+		opened []*mdSpan
+		next   int
+		inCode int
+
+		// underscores of adjacent italic and underline markers should be separated
+		afterUnderscore bool
+	)
+
+	writeMarker := func(marker string) {
+		if afterUnderscore && strings.HasPrefix(marker, "_") {
+			result.WriteString("\r")
+		}
+
+		result.WriteString(marker)
+		afterUnderscore = strings.HasSuffix(marker, "_")
+	}
+
+	for pos := 0; ; {
+		for i := len(opened) - 1; i >= 0; i-- {
+			if span := opened[i]; span.end == pos {
+				writeMarker(span.close)
+				if span.code {
+					inCode--
+				}
+				opened = append(opened[:i], opened[i+1:]...)
+			}
+		}
+
+		for ; next < len(spans) && spans[next].start == pos; next++ {
+			span := spans[next]
+
+			writeMarker(span.open)
+			if span.code {
+				inCode++
+			}
+			opened = append(opened, span)
+		}
+
+		if pos == len(units) {
+			break
+		}
+
+		// write text until next marker
+		end := len(units)
+
+		for _, span := range opened {
+			if span.end < end {
+				end = span.end
+			}
+		}
+
+		if next < len(spans) && spans[next].start < end {
+			end = spans[next].start
+		}
+
+		segment := string(utf16.Decode(units[pos:end]))
+
+		if inCode > 0 {
+			result.WriteString(escapeMDCodeReplacer.Replace(segment))
+		} else {
+			result.WriteString(EscapeMD(segment))
+		}
+
+		afterUnderscore = false
+		pos = end
+	}
+
+	return result.String()
+}
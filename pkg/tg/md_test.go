@@ -0,0 +1,94 @@
+package tg
+
+import (
+	"testing"
+
+	tgbotapi "github.com/bots-house/telegram-bot-api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatMD(t *testing.T) {
+	for _, test := range []struct {
+		Name     string
+		Text     string
+		Entities []tgbotapi.MessageEntity
+		Excepted string
+	}{
+		{
+			Name:     "NoEntities",
+			Text:     "Hello, world!",
+			Excepted: `Hello, world\!`,
+		},
+		{
+			Name: "Bold",
+			Text: "Hello, world!",
+			Entities: []tgbotapi.MessageEntity{
+				{Type: "bold", Offset: 7, Length: 5},
+			},
+			Excepted: `Hello, *world*\!`,
+		},
+		{
+			Name: "Nested",
+			Text: "bold italic",
+			Entities: []tgbotapi.MessageEntity{
+				{Type: "bold", Offset: 0, Length: 11},
+				{Type: "italic", Offset: 5, Length: 6},
+			},
+			Excepted: `*bold _italic_*`,
+		},
+		{
+			Name: "ItalicUnderline",
+			Text: "text",
+			Entities: []tgbotapi.MessageEntity{
+				{Type: "underline", Offset: 0, Length: 4},
+				{Type: "italic", Offset: 0, Length: 4},
+			},
+			Excepted: "__\r_text_\r__",
+		},
+		{
+			Name: "Code",
+			Text: "run a_b`c",
+			Entities: []tgbotapi.MessageEntity{
+				{Type: "code", Offset: 4, Length: 5},
+			},
+			Excepted: "run `a_b\\`c`",
+		},
+		{
+			Name: "TextLink",
+			Text: "see docs.",
+			Entities: []tgbotapi.MessageEntity{
+				{Type: "text_link", Offset: 4, Length: 4, URL: "https://example.com/a_(b)"},
+			},
+			Excepted: `see [docs](https://example.com/a_(b\))\.`,
+		},
+		{
+			Name: "UTF16Offsets",
+			Text: "👋 hi",
+			Entities: []tgbotapi.MessageEntity{
+				{Type: "bold", Offset: 3, Length: 2},
+			},
+			Excepted: `👋 *hi*`,
+		},
+		{
+			Name: "SkipNotFormatting",
+			Text: "@user #tag",
+			Entities: []tgbotapi.MessageEntity{
+				{Type: "mention", Offset: 0, Length: 5},
+				{Type: "hashtag", Offset: 6, Length: 4},
+			},
+			Excepted: `@user \#tag`,
+		},
+		{
+			Name: "OutOfRange",
+			Text: "abc",
+			Entities: []tgbotapi.MessageEntity{
+				{Type: "bold", Offset: 1, Length: 10},
+			},
+			Excepted: `a*bc*`,
+		},
+	} {
+		t.Run(test.Name, func(t *testing.T) {
+			assert.Equal(t, test.Excepted, FormatMD(test.Text, test.Entities))
+		})
+	}
+}
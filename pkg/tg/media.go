@@ -0,0 +1,21 @@
+package tg
+
+// InputMediaAudio contains an audio for displaying as part of a media group.
+// It's missing in used version of library.
+type InputMediaAudio struct {
+	Type      string `json:"type"`
+	Media     string `json:"media"`
+	Caption   string `json:"caption"`
+	ParseMode string `json:"parse_mode"`
+	Duration  int    `json:"duration,omitempty"`
+	Performer string `json:"performer,omitempty"`
+	Title     string `json:"title,omitempty"`
+}
+
+// NewInputMediaAudio creates a new InputMediaAudio.
+func NewInputMediaAudio(media string) InputMediaAudio {
+	return InputMediaAudio{
+		Type:  "audio",
+		Media: media,
+	}
+}
@@ -9,6 +9,6 @@ func BotToMTProtoID(id int64) int64 {
 }
 
 // MTProtoToBotID converts MTProto to Bot ID
-func MTProtoToBotID(id int32) int64 {
-	return -(int64(id) + idOffset)
+func MTProtoToBotID(id int64) int64 {
+	return -(id + idOffset)
 }
@@ -0,0 +1,241 @@
+package tg
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/bots-house/share-file-bot/pkg/log"
+	tgbotapi "github.com/bots-house/telegram-bot-api"
+	"github.com/friendsofgo/errors"
+)
+
+const (
+	defaultPollerTimeout    = 30 * time.Second
+	defaultPollerLimit      = 100
+	defaultPollerRetryDelay = 3 * time.Second
+	defaultPollerMaxRetries = 3
+
+	// pollerCommitTimeout is timeout of offset commit on exit.
+	pollerCommitTimeout = 5 * time.Second
+)
+
+// Poller receives updates using long polling (getUpdates) and pass them to handler.
+//
+// Updates are handled one by one in order of arrival.
+// Offset of update is committed only when handler returns without error,
+// so failed update will be received again on next iteration.
+// After MaxRetries failed attempts update is skipped and logged.
+type Poller struct {
+	// Client used for calling getUpdates.
+	Client *tgbotapi.BotAPI
+
+	// Handler of received updates.
+	Handler Handler
+
+	// Timeout of long polling request.
+	Timeout time.Duration
+
+	// Limit of updates received by one request.
+	Limit int
+
+	// RetryDelay is delay between failed attempts.
+	RetryDelay time.Duration
+
+	// MaxRetries of update handling before skip it.
+	MaxRetries int
+
+	// OnError is called when handler or getUpdates returns error.
+	// Update is nil, when getUpdates call failed.
+	OnError func(ctx context.Context, update *Update, err error)
+
+	offset int
+
+	failedUpdateID int
+	failedAttempts int
+}
+
+// NewPoller creates a poller with default settings.
+func NewPoller(client *tgbotapi.BotAPI, handler Handler) *Poller {
+	return &Poller{
+		Client:     client,
+		Handler:    handler,
+		Timeout:    defaultPollerTimeout,
+		Limit:      defaultPollerLimit,
+		RetryDelay: defaultPollerRetryDelay,
+		MaxRetries: defaultPollerMaxRetries,
+	}
+}
+
+type pollerResult struct {
+	updates []Update
+	err     error
+}
+
+// Run receives and handles updates until ctx is done.
+// Handling of current update is finished before exit,
+// offset of handled updates is committed to Telegram.
+func (poller *Poller) Run(ctx context.Context) error {
+	defer poller.commit(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		default:
+		}
+
+		// getUpdates can't be canceled, so we wait for it in background.
+		// Received but not handled updates will be returned by Telegram again,
+		// because offset is not committed.
+		results := make(chan pollerResult, 1)
+
+		go func(offset int) {
+			updates, err := poller.getUpdates(offset, poller.Limit, poller.Timeout)
+			results <- pollerResult{updates: updates, err: err}
+		}(poller.offset)
+
+		var result pollerResult
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case result = <-results:
+		}
+
+		if result.err != nil {
+			poller.onError(ctx, nil, errors.Wrap(result.err, "get updates"))
+
+			if !poller.sleep(ctx) {
+				return nil
+			}
+
+			continue
+		}
+
+		if !poller.handle(ctx, result.updates) && !poller.sleep(ctx) {
+			return nil
+		}
+	}
+}
+
+// handle updates in order, returns false if some of them failed.
+func (poller *Poller) handle(ctx context.Context, updates []Update) bool {
+	for i := range updates {
+		update := &updates[i]
+
+		if update.UpdateID < poller.offset {
+			continue
+		}
+
+		if err := poller.Handler.HandleUpdate(ctx, update); err != nil {
+			poller.onError(ctx, update, err)
+
+			if poller.failedUpdateID != update.UpdateID {
+				poller.failedUpdateID = update.UpdateID
+				poller.failedAttempts = 0
+			}
+
+			poller.failedAttempts++
+
+			if poller.failedAttempts < poller.MaxRetries {
+				return false
+			}
+
+			log.Error(ctx, "skip update after max retries",
+				"update_id", update.UpdateID,
+				"type", update.Type(),
+				"err", err,
+			)
+		}
+
+		poller.offset = update.UpdateID + 1
+
+		select {
+		case <-ctx.Done():
+			return true
+		default:
+		}
+	}
+
+	return true
+}
+
+// commit offset of handled updates, otherwise Telegram returns them again after restart.
+// Offset is committed only by next getUpdates call, so we make short one.
+func (poller *Poller) commit(ctx context.Context) {
+	if poller.offset == 0 {
+		return
+	}
+
+	// parent ctx is already done, so commit has own one with same logger
+	ctx, cancel := context.WithTimeout(
+		log.WithLogger(context.Background(), log.Logger(ctx)),
+		pollerCommitTimeout,
+	)
+	defer cancel()
+
+	done := make(chan error, 1)
+
+	go func(offset int) {
+		_, err := poller.getUpdates(offset, 1, 0)
+		done <- err
+	}(poller.offset)
+
+	select {
+	case <-ctx.Done():
+		poller.onError(ctx, nil, errors.Wrap(ctx.Err(), "commit offset"))
+	case err := <-done:
+		if err != nil {
+			poller.onError(ctx, nil, errors.Wrap(err, "commit offset"))
+		}
+	}
+}
+
+func (poller *Poller) getUpdates(offset int, limit int, timeout time.Duration) ([]Update, error) {
+	params := url.Values{}
+
+	if offset != 0 {
+		params.Set("offset", strconv.Itoa(offset))
+	}
+
+	if limit > 0 {
+		params.Set("limit", strconv.Itoa(limit))
+	}
+
+	params.Set("timeout", strconv.Itoa(int(timeout.Seconds())))
+
+	res, err := poller.Client.MakeRequest("getUpdates", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var updates []Update
+
+	if err := json.Unmarshal(res.Result, &updates); err != nil {
+		return nil, errors.Wrap(err, "unmarshal updates")
+	}
+
+	return updates, nil
+}
+
+func (poller *Poller) onError(ctx context.Context, update *Update, err error) {
+	if poller.OnError != nil {
+		poller.OnError(ctx, update, err)
+	}
+}
+
+// sleep for retry delay, returns false if context is done.
+func (poller *Poller) sleep(ctx context.Context) bool {
+	timer := time.NewTimer(poller.RetryDelay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
@@ -0,0 +1,184 @@
+package tg
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	tgbotapi "github.com/bots-house/telegram-bot-api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeUpdatesServer struct {
+	mu      sync.Mutex
+	updates []int
+	offsets []int
+}
+
+func (srv *fakeUpdatesServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var result interface{}
+
+	switch r.URL.Path {
+	case "/bottoken/getMe":
+		result = tgbotapi.User{ID: 1, UserName: "test_bot"}
+	case "/bottoken/getUpdates":
+		srv.mu.Lock()
+
+		offset, _ := strconv.Atoi(r.FormValue("offset"))
+		srv.offsets = append(srv.offsets, offset)
+
+		updates := []Update{}
+		for _, id := range srv.updates {
+			if id >= offset {
+				updates = append(updates, Update{Update: tgbotapi.Update{UpdateID: id}})
+			}
+		}
+
+		srv.mu.Unlock()
+
+		result = updates
+	default:
+		http.NotFound(w, r)
+		return
+	}
+
+	body, _ := json.Marshal(result)
+
+	_ = json.NewEncoder(w).Encode(tgbotapi.APIResponse{
+		Ok:     true,
+		Result: body,
+	})
+}
+
+func (srv *fakeUpdatesServer) getOffsets() []int {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	return append([]int(nil), srv.offsets...)
+}
+
+func newTestPoller(t *testing.T, srv *fakeUpdatesServer, handler Handler) *Poller {
+	t.Helper()
+
+	server := httptest.NewServer(srv)
+	t.Cleanup(server.Close)
+
+	client, err := tgbotapi.NewBotAPIWithClient("token", server.URL+"/bot%s/%s", server.Client())
+	require.NoError(t, err)
+
+	poller := NewPoller(client, handler)
+	poller.RetryDelay = time.Millisecond
+
+	return poller
+}
+
+func TestPoller_Run(t *testing.T) {
+	t.Run("CommitAfterSuccess", func(t *testing.T) {
+		srv := &fakeUpdatesServer{updates: []int{10, 11, 12}}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var handled []int
+
+		poller := newTestPoller(t, srv, HandlerFunc(func(ctx context.Context, update *Update) error {
+			handled = append(handled, update.UpdateID)
+			if update.UpdateID == 12 {
+				cancel()
+			}
+			return nil
+		}))
+
+		require.NoError(t, poller.Run(ctx))
+
+		assert.Equal(t, []int{10, 11, 12}, handled)
+		assert.Equal(t, 13, poller.offset)
+		assert.Equal(t, []int{0, 13}, srv.getOffsets())
+	})
+
+	t.Run("RetryFailed", func(t *testing.T) {
+		srv := &fakeUpdatesServer{updates: []int{10, 11}}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var (
+			handled  []int
+			failures int
+		)
+
+		poller := newTestPoller(t, srv, HandlerFunc(func(ctx context.Context, update *Update) error {
+			handled = append(handled, update.UpdateID)
+
+			if update.UpdateID == 11 && failures == 0 {
+				failures++
+				return errors.New("temporary error")
+			}
+
+			if update.UpdateID == 11 {
+				cancel()
+			}
+
+			return nil
+		}))
+
+		require.NoError(t, poller.Run(ctx))
+
+		assert.Equal(t, []int{10, 11, 11}, handled)
+		// last call commits offset on exit
+		assert.Equal(t, []int{0, 11, 12}, srv.getOffsets())
+	})
+
+	t.Run("SkipAfterMaxRetries", func(t *testing.T) {
+		srv := &fakeUpdatesServer{updates: []int{10, 11}}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var errs int
+
+		poller := newTestPoller(t, srv, HandlerFunc(func(ctx context.Context, update *Update) error {
+			if update.UpdateID == 10 {
+				return errors.New("permanent error")
+			}
+
+			cancel()
+
+			return nil
+		}))
+
+		poller.OnError = func(ctx context.Context, update *Update, err error) {
+			errs++
+		}
+
+		require.NoError(t, poller.Run(ctx))
+
+		assert.Equal(t, poller.MaxRetries, errs)
+		assert.Equal(t, 12, poller.offset)
+
+		offsets := srv.getOffsets()
+		assert.Equal(t, 12, offsets[len(offsets)-1])
+	})
+
+	t.Run("NoCommitWithoutUpdates", func(t *testing.T) {
+		srv := &fakeUpdatesServer{}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		poller := newTestPoller(t, srv, HandlerFunc(func(ctx context.Context, update *Update) error {
+			return nil
+		}))
+
+		require.NoError(t, poller.Run(ctx))
+
+		assert.Empty(t, srv.getOffsets())
+	})
+}
@@ -0,0 +1,276 @@
+package tg
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	tgbotapi "github.com/bots-house/telegram-bot-api"
+	"github.com/friendsofgo/errors"
+)
+
+const (
+	// CallbackDataMaxLength is max length of callback data of inline keyboard button in bytes.
+	CallbackDataMaxLength = 64
+
+	// callbackIntMaxLength is max count of digits in int parameter of callback pattern.
+	callbackIntMaxLength = 10
+)
+
+// MessageHandler handles message of user.
+type MessageHandler func(ctx context.Context, msg *tgbotapi.Message) error
+
+// CallbackHandler handles callback query, args contains parameters parsed from callback data.
+type CallbackHandler func(ctx context.Context, cbq *tgbotapi.CallbackQuery, args CallbackArgs) error
+
+// CallbackArgs is typed parameters of matched callback pattern.
+type CallbackArgs struct {
+	ints map[string]int
+	strs map[string]string
+}
+
+// Int returns value of int parameter.
+// Panics, if pattern has no int parameter with this name.
+func (args CallbackArgs) Int(name string) int {
+	v, ok := args.ints[name]
+	if !ok {
+		panic(fmt.Sprintf("tg: callback has no int parameter %q", name))
+	}
+	return v
+}
+
+// String returns value of enum parameter.
+// Panics, if pattern has no enum parameter with this name.
+func (args CallbackArgs) String(name string) string {
+	v, ok := args.strs[name]
+	if !ok {
+		panic(fmt.Sprintf("tg: callback has no enum parameter %q", name))
+	}
+	return v
+}
+
+type callbackParam struct {
+	name  string
+	isInt bool
+}
+
+type callbackRoute struct {
+	pattern string
+	re      *regexp.Regexp
+	params  []callbackParam
+	handler CallbackHandler
+}
+
+func (route *callbackRoute) match(data string) (CallbackArgs, bool) {
+	args := CallbackArgs{}
+
+	match := route.re.FindStringSubmatch(data)
+	if match == nil {
+		return args, false
+	}
+
+	args.ints = map[string]int{}
+	args.strs = map[string]string{}
+
+	for i, param := range route.params {
+		value := match[i+1]
+
+		if !param.isInt {
+			args.strs[param.name] = value
+			continue
+		}
+
+		v, err := strconv.Atoi(value)
+		if err != nil {
+			return args, false
+		}
+
+		args.ints[param.name] = v
+	}
+
+	return args, true
+}
+
+var reCallbackParam = regexp.MustCompile(`\{([a-z_]+):([a-z0-9|_-]+)\}`)
+
+// compileCallbackPattern compiles pattern like "file:{id:int}:limit:{kind:min|max}" to regexp.
+// Returns max length of data matched by pattern.
+func compileCallbackPattern(pattern string) (*regexp.Regexp, []callbackParam, int, error) {
+	var (
+		expr   strings.Builder
+		params []callbackParam
+		length int
+		last   int
+		names  = map[string]bool{}
+	)
+
+	expr.WriteString("^")
+
+	for _, loc := range reCallbackParam.FindAllStringSubmatchIndex(pattern, -1) {
+		literal := pattern[last:loc[0]]
+		if strings.ContainsAny(literal, "{}") {
+			return nil, nil, 0, errors.New("invalid parameter syntax")
+		}
+
+		expr.WriteString(regexp.QuoteMeta(literal))
+		length += len(literal)
+		last = loc[1]
+
+		name, kind := pattern[loc[2]:loc[3]], pattern[loc[4]:loc[5]]
+
+		if names[name] {
+			return nil, nil, 0, errors.Errorf("duplicate parameter %q", name)
+		}
+		names[name] = true
+
+		if kind == "int" {
+			expr.WriteString(fmt.Sprintf(`(\d{1,%d})`, callbackIntMaxLength))
+			length += callbackIntMaxLength
+			params = append(params, callbackParam{name: name, isInt: true})
+			continue
+		}
+
+		values := strings.Split(kind, "|")
+
+		longest := 0
+		for i, value := range values {
+			if value == "" {
+				return nil, nil, 0, errors.Errorf("empty value of parameter %q", name)
+			}
+
+			if len(value) > longest {
+				longest = len(value)
+			}
+
+			values[i] = regexp.QuoteMeta(value)
+		}
+
+		expr.WriteString("(" + strings.Join(values, "|") + ")")
+		length += longest
+		params = append(params, callbackParam{name: name})
+	}
+
+	literal := pattern[last:]
+	if strings.ContainsAny(literal, "{}") {
+		return nil, nil, 0, errors.New("invalid parameter syntax")
+	}
+
+	expr.WriteString(regexp.QuoteMeta(literal))
+	expr.WriteString("$")
+	length += len(literal)
+
+	re, err := regexp.Compile(expr.String())
+	if err != nil {
+		return nil, nil, 0, errors.Wrap(err, "compile regexp")
+	}
+
+	return re, params, length, nil
+}
+
+// Router dispatches messages by command or state of user
+// and callback queries by pattern of data.
+//
+// Handlers should be registered before handling of updates.
+type Router struct {
+	commands  map[string]MessageHandler
+	states    map[fmt.Stringer]MessageHandler
+	callbacks []*callbackRoute
+}
+
+// NewRouter creates router without routes.
+func NewRouter() *Router {
+	return &Router{
+		commands: map[string]MessageHandler{},
+		states:   map[fmt.Stringer]MessageHandler{},
+	}
+}
+
+// Command registers handler of command without leading slash.
+// Panics, if command is already registered.
+func (r *Router) Command(name string, handler MessageHandler) {
+	if _, ok := r.commands[name]; ok {
+		panic(fmt.Sprintf("tg: command %q is already registered", name))
+	}
+
+	r.commands[name] = handler
+}
+
+// State registers handler of messages sent by user in state.
+// Panics, if state is already registered.
+func (r *Router) State(state fmt.Stringer, handler MessageHandler) {
+	if _, ok := r.states[state]; ok {
+		panic(fmt.Sprintf("tg: state %s is already registered", state))
+	}
+
+	r.states[state] = handler
+}
+
+// Callback registers handler of callback queries with data matched by pattern.
+//
+// Pattern consists of literal text and parameters in braces:
+//   - {name:int} matches non-negative number up to 10 digits;
+//   - {name:a|b|c} matches one of listed values.
+//
+// Panics, if pattern is invalid, already registered
+// or can match data longer than CallbackDataMaxLength.
+func (r *Router) Callback(pattern string, handler CallbackHandler) {
+	re, params, length, err := compileCallbackPattern(pattern)
+	if err != nil {
+		panic(fmt.Sprintf("tg: invalid callback pattern %q: %v", pattern, err))
+	}
+
+	if length > CallbackDataMaxLength {
+		panic(fmt.Sprintf("tg: callback pattern %q can match %d bytes, limit is %d",
+			pattern, length, CallbackDataMaxLength,
+		))
+	}
+
+	for _, route := range r.callbacks {
+		if route.pattern == pattern {
+			panic(fmt.Sprintf("tg: callback pattern %q is already registered", pattern))
+		}
+	}
+
+	r.callbacks = append(r.callbacks, &callbackRoute{
+		pattern: pattern,
+		re:      re,
+		params:  params,
+		handler: handler,
+	})
+}
+
+// HandleCommand calls handler of message command.
+// Returns false, if message is not command or command is not registered.
+func (r *Router) HandleCommand(ctx context.Context, msg *tgbotapi.Message) (bool, error) {
+	handler, ok := r.commands[msg.Command()]
+	if !ok || !msg.IsCommand() {
+		return false, nil
+	}
+
+	return true, handler(ctx, msg)
+}
+
+// HandleState calls handler of state.
+// Returns false, if state is not registered.
+func (r *Router) HandleState(ctx context.Context, state fmt.Stringer, msg *tgbotapi.Message) (bool, error) {
+	handler, ok := r.states[state]
+	if !ok {
+		return false, nil
+	}
+
+	return true, handler(ctx, msg)
+}
+
+// HandleCallback calls handler of first pattern matched by callback query data.
+// Returns false, if no pattern matched.
+func (r *Router) HandleCallback(ctx context.Context, cbq *tgbotapi.CallbackQuery) (bool, error) {
+	for _, route := range r.callbacks {
+		if args, ok := route.match(cbq.Data); ok {
+			return true, route.handler(ctx, cbq, args)
+		}
+	}
+
+	return false, nil
+}
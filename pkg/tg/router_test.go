@@ -0,0 +1,177 @@
+package tg
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	tgbotapi "github.com/bots-house/telegram-bot-api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testState int
+
+func (s testState) String() string {
+	return "test"
+}
+
+func TestRouter_Callback(t *testing.T) {
+	r := NewRouter()
+
+	var (
+		route string
+		args  CallbackArgs
+	)
+
+	handler := func(name string) CallbackHandler {
+		return func(ctx context.Context, cbq *tgbotapi.CallbackQuery, a CallbackArgs) error {
+			route, args = name, a
+			return nil
+		}
+	}
+
+	r.Callback("start", handler("start"))
+	r.Callback("file:{id:int}:refresh", handler("refresh"))
+	r.Callback("file:{id:int}:limit:{kind:max|user-max}:{value:int}", handler("limit"))
+
+	for _, test := range []struct {
+		Data  string
+		Route string
+		Ints  map[string]int
+		Strs  map[string]string
+	}{
+		{Data: "start", Route: "start"},
+		{Data: "file:10:refresh", Route: "refresh", Ints: map[string]int{"id": 10}},
+		{
+			Data:  "file:1:limit:user-max:25",
+			Route: "limit",
+			Ints:  map[string]int{"id": 1, "value": 25},
+			Strs:  map[string]string{"kind": "user-max"},
+		},
+		{Data: "start:1"},
+		{Data: "xfile:10:refresh"},
+		{Data: "file:10:refresh:confirm"},
+		{Data: "file:abc:refresh"},
+		{Data: "file:-1:refresh"},
+		{Data: "file:12345678901:refresh"},
+		{Data: "file:1:limit:min:25"},
+	} {
+		t.Run(test.Data, func(t *testing.T) {
+			route, args = "", CallbackArgs{}
+
+			ok, err := r.HandleCallback(context.Background(), &tgbotapi.CallbackQuery{Data: test.Data})
+			require.NoError(t, err)
+
+			assert.Equal(t, test.Route != "", ok)
+			assert.Equal(t, test.Route, route)
+
+			for name, value := range test.Ints {
+				assert.Equal(t, value, args.Int(name))
+			}
+
+			for name, value := range test.Strs {
+				assert.Equal(t, value, args.String(name))
+			}
+		})
+	}
+}
+
+func TestRouter_CallbackPanics(t *testing.T) {
+	handler := func(ctx context.Context, cbq *tgbotapi.CallbackQuery, args CallbackArgs) error {
+		return nil
+	}
+
+	for _, test := range []struct {
+		Name    string
+		Pattern string
+	}{
+		{"TooLong", strings.Repeat("a", CallbackDataMaxLength+1)},
+		{"TooLongWithParams", "file:{id:int}:" + strings.Repeat("a", CallbackDataMaxLength-15)},
+		{"TooLongEnum", strings.Repeat("a", CallbackDataMaxLength-5) + "{kind:short|longest}"},
+		{"Unclosed", "file:{id:int"},
+		{"UnknownSyntax", "file:{id}"},
+		{"EmptyEnumValue", "file:{kind:a||b}"},
+		{"DuplicateParam", "file:{id:int}:{id:int}"},
+	} {
+		t.Run(test.Name, func(t *testing.T) {
+			assert.Panics(t, func() {
+				NewRouter().Callback(test.Pattern, handler)
+			})
+		})
+	}
+
+	t.Run("Duplicate", func(t *testing.T) {
+		r := NewRouter()
+		r.Callback("file:{id:int}", handler)
+
+		assert.Panics(t, func() {
+			r.Callback("file:{id:int}", handler)
+		})
+	})
+
+	t.Run("MaxLength", func(t *testing.T) {
+		assert.NotPanics(t, func() {
+			NewRouter().Callback("file:{id:int}:"+strings.Repeat("a", CallbackDataMaxLength-16), handler)
+		})
+	})
+
+	t.Run("UnknownArg", func(t *testing.T) {
+		args := CallbackArgs{}
+
+		assert.Panics(t, func() { args.Int("id") })
+		assert.Panics(t, func() { args.String("kind") })
+	})
+}
+
+func TestRouter_Command(t *testing.T) {
+	r := NewRouter()
+
+	var called string
+
+	r.Command("start", func(ctx context.Context, msg *tgbotapi.Message) error {
+		called = msg.Text
+		return nil
+	})
+
+	assert.Panics(t, func() {
+		r.Command("start", func(ctx context.Context, msg *tgbotapi.Message) error { return nil })
+	})
+
+	ok, err := r.HandleCommand(context.Background(), &tgbotapi.Message{
+		Text:     "/start payload",
+		Entities: &[]tgbotapi.MessageEntity{{Type: "bot_command", Offset: 0, Length: 6}},
+	})
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "/start payload", called)
+
+	ok, err = r.HandleCommand(context.Background(), &tgbotapi.Message{Text: "start"})
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestRouter_State(t *testing.T) {
+	r := NewRouter()
+
+	called := false
+
+	r.State(testState(1), func(ctx context.Context, msg *tgbotapi.Message) error {
+		called = true
+		return nil
+	})
+
+	assert.Panics(t, func() {
+		r.State(testState(1), func(ctx context.Context, msg *tgbotapi.Message) error { return nil })
+	})
+
+	ok, err := r.HandleState(context.Background(), testState(2), &tgbotapi.Message{})
+	require.NoError(t, err)
+	assert.False(t, ok)
+	assert.False(t, called)
+
+	ok, err = r.HandleState(context.Background(), testState(1), &tgbotapi.Message{})
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.True(t, called)
+}
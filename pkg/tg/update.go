@@ -0,0 +1,79 @@
+package tg
+
+import (
+	tgbotapi "github.com/bots-house/telegram-bot-api"
+)
+
+// Statuses of chat member.
+const (
+	ChatMemberStatusCreator       = "creator"
+	ChatMemberStatusAdministrator = "administrator"
+	ChatMemberStatusMember        = "member"
+	ChatMemberStatusRestricted    = "restricted"
+	ChatMemberStatusLeft          = "left"
+	ChatMemberStatusKicked        = "kicked"
+)
+
+// Update extends tgbotapi.Update with fields missing in used version of library.
+type Update struct {
+	tgbotapi.Update
+
+	// MyChatMember is sent when status of bot in chat was changed.
+	// In private chats it's sent when user blocks or unblocks bot.
+	MyChatMember *ChatMemberUpdated `json:"my_chat_member"`
+}
+
+// ChatMember contains information about one member of a chat.
+type ChatMember struct {
+	User   *tgbotapi.User `json:"user"`
+	Status string         `json:"status"`
+}
+
+// ChatMemberUpdated represents changes in the status of a chat member.
+type ChatMemberUpdated struct {
+	Chat          tgbotapi.Chat `json:"chat"`
+	From          tgbotapi.User `json:"from"`
+	Date          int64         `json:"date"`
+	OldChatMember ChatMember    `json:"old_chat_member"`
+	NewChatMember ChatMember    `json:"new_chat_member"`
+}
+
+// IsBotBlocked returns true, if user blocked bot in private chat.
+func (cmu *ChatMemberUpdated) IsBotBlocked() bool {
+	return cmu.Chat.IsPrivate() && cmu.NewChatMember.Status == ChatMemberStatusKicked
+}
+
+// IsBotUnblocked returns true, if user unblocked bot in private chat.
+func (cmu *ChatMemberUpdated) IsBotUnblocked() bool {
+	return cmu.Chat.IsPrivate() &&
+		cmu.OldChatMember.Status == ChatMemberStatusKicked &&
+		cmu.NewChatMember.Status == ChatMemberStatusMember
+}
+
+// Type returns name of update payload field, like message or callback_query.
+func (update *Update) Type() string {
+	switch {
+	case update.Message != nil:
+		return "message"
+	case update.EditedMessage != nil:
+		return "edited_message"
+	case update.ChannelPost != nil:
+		return "channel_post"
+	case update.EditedChannelPost != nil:
+		return "edited_channel_post"
+	case update.InlineQuery != nil:
+		return "inline_query"
+	case update.ChosenInlineResult != nil:
+		return "chosen_inline_result"
+	case update.CallbackQuery != nil:
+		return "callback_query"
+	case update.ShippingQuery != nil:
+		return "shipping_query"
+	case update.PreCheckoutQuery != nil:
+		return "pre_checkout_query"
+	case update.MyChatMember != nil:
+		return "my_chat_member"
+	default:
+		return "unknown"
+	}
+}
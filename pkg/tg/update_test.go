@@ -0,0 +1,43 @@
+package tg
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdate_MyChatMember(t *testing.T) {
+	const payload = `{
+		"update_id": 10,
+		"my_chat_member": {
+			"chat": {"id": 1, "type": "private"},
+			"from": {"id": 1, "first_name": "User"},
+			"date": 1605873600,
+			"old_chat_member": {"user": {"id": 2, "is_bot": true, "first_name": "Bot"}, "status": "member"},
+			"new_chat_member": {"user": {"id": 2, "is_bot": true, "first_name": "Bot"}, "status": "kicked"}
+		}
+	}`
+
+	var update Update
+
+	require.NoError(t, json.Unmarshal([]byte(payload), &update))
+
+	assert.Equal(t, 10, update.UpdateID)
+	require.NotNil(t, update.MyChatMember)
+	assert.Equal(t, 1, update.MyChatMember.From.ID)
+	assert.Equal(t, int64(1605873600), update.MyChatMember.Date)
+	assert.True(t, update.MyChatMember.IsBotBlocked())
+	assert.False(t, update.MyChatMember.IsBotUnblocked())
+
+	update.MyChatMember.OldChatMember.Status = ChatMemberStatusKicked
+	update.MyChatMember.NewChatMember.Status = ChatMemberStatusMember
+
+	assert.False(t, update.MyChatMember.IsBotBlocked())
+	assert.True(t, update.MyChatMember.IsBotUnblocked())
+
+	update.MyChatMember.Chat.Type = "group"
+
+	assert.False(t, update.MyChatMember.IsBotUnblocked())
+}
@@ -2,8 +2,11 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/pkg/log"
+	"github.com/bots-house/share-file-bot/store"
 	"github.com/friendsofgo/errors"
 	"golang.org/x/sync/errgroup"
 )
@@ -13,18 +16,59 @@ type Admin struct {
 	File     core.FileStore
 	Download core.DownloadStore
 	Chat     core.ChatStore
+	Audit    core.AuditEntryStore
+	Campaign core.CampaignStore
+	Txier    store.Txier
+
+	ReservedPublicID core.ReservedPublicIDStore
 }
 
 type AdminSummaryStats struct {
-	Users     int
-	Files     int
-	Downloads int
-	Chats     int
+	Users        int
+	ActiveUsers  int // users who didn't block bot
+	BlockedUsers int // users who blocked bot
+	Files        int
+	Downloads    int
+	Chats        int
+
+	// Users who interacted with bot or downloaded file during last day, week and month.
+	DAU int
+	WAU int
+	MAU int
 
 	UsersByRefs core.UserRefStats
 }
 
-var ErrUserIsNotAdmin = errors.New("user is not admin")
+var (
+	ErrUserIsNotAdmin = errors.New("user is not admin")
+	ErrFileBlocked    = errors.New("file is blocked by admin")
+	ErrOwnerIsBanned  = errors.New("owner is banned by admin")
+	ErrCantBanAdmin   = errors.New("admin can't be banned")
+	ErrNothingChanged = errors.New("nothing changed")
+)
+
+// AuditLogMaxLimit is max count of audit log entries returned at once.
+const AuditLogMaxLimit = 50
+
+// AdminFile is file with owner and stats for admin inspection.
+type AdminFile struct {
+	*OwnedFile
+	Owner *core.User
+}
+
+// checkOwnerIsNotBanned returns ErrOwnerIsBanned if owner of content is banned.
+func checkOwnerIsNotBanned(ctx context.Context, users core.UserStore, id core.UserID) error {
+	owner, err := users.Find(ctx, id)
+	if err != nil {
+		return errors.Wrap(err, "find owner")
+	}
+
+	if owner.IsBanned {
+		return ErrOwnerIsBanned
+	}
+
+	return nil
+}
 
 func (srv *Admin) getStats(ctx context.Context) (*AdminSummaryStats, error) {
 	stats := &AdminSummaryStats{}
@@ -42,6 +86,53 @@ func (srv *Admin) getStats(ctx context.Context) (*AdminSummaryStats, error) {
 		return nil
 	})
 
+	wg.Go(func() error {
+		users, err := srv.User.Query().NotBlockedBot().Count(ctx)
+		if err != nil {
+			return errors.Wrap(err, "count active users")
+		}
+
+		stats.ActiveUsers = users
+
+		return nil
+	})
+
+	wg.Go(func() error {
+		users, err := srv.User.Query().BlockedBot().Count(ctx)
+		if err != nil {
+			return errors.Wrap(err, "count blocked users")
+		}
+
+		stats.BlockedUsers = users
+
+		return nil
+	})
+
+	now := time.Now()
+
+	for _, period := range []struct {
+		Name     string
+		Duration time.Duration
+		Result   *int
+	}{
+		{"dau", 24 * time.Hour, &stats.DAU},
+		{"wau", 7 * 24 * time.Hour, &stats.WAU},
+		{"mau", 30 * 24 * time.Hour, &stats.MAU},
+	} {
+		period := period
+
+		wg.Go(func() error {
+			users, err := srv.User.CountActive(ctx, now.Add(-period.Duration))
+			if err != nil {
+				return errors.Wrapf(err, "count %s", period.Name)
+			}
+
+			*period.Result = users
+
+			return nil
+		})
+	}
+
 	wg.Go(func() error {
 		docs, err := srv.File.Query().Count(ctx)
 		if err != nil {
@@ -76,7 +167,7 @@ func (srv *Admin) getStats(ctx context.Context) (*AdminSummaryStats, error) {
 	})
 
 	wg.Go(func() error {
-		refs, err := srv.User.RefStats(ctx)
+		refs, err := srv.User.RefStats(ctx, time.Time{}, time.Time{})
 		if err != nil {
 			return errors.Wrap(err, "count user refs")
 		}
@@ -113,3 +204,164 @@ func (srv *Admin) SummaryStats(ctx context.Context, user *core.User) (*AdminSumm
 
 	return stats, nil
 }
+
+func (srv *Admin) newAdminFile(ctx context.Context, file *core.File) (*AdminFile, error) {
+	stats, err := srv.Download.GetFileStats(ctx, file.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "get file stats")
+	}
+
+	owner, err := srv.User.Find(ctx, file.OwnerID)
+	if err != nil {
+		return nil, errors.Wrap(err, "find owner")
+	}
+
+	return &AdminFile{
+		OwnedFile: &OwnedFile{
+			File:  file,
+			Stats: stats,
+		},
+		Owner: owner,
+	}, nil
+}
+
+// GetFileByPublicID returns file for inspection by admin.
+func (srv *Admin) GetFileByPublicID(ctx context.Context, user *core.User, publicID string) (*AdminFile, error) {
+	if err := srv.isHasPermissions(ctx, user); err != nil {
+		return nil, err
+	}
+
+	file, err := srv.File.Query().PublicID(publicID).One(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "find file by public id")
+	}
+
+	return srv.newAdminFile(ctx, file)
+}
+
+// GetFileByID returns file for inspection by admin.
+func (srv *Admin) GetFileByID(ctx context.Context, user *core.User, id core.FileID) (*AdminFile, error) {
+	if err := srv.isHasPermissions(ctx, user); err != nil {
+		return nil, err
+	}
+
+	file, err := srv.File.Query().ID(id).One(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "find file by id")
+	}
+
+	return srv.newAdminFile(ctx, file)
+}
+
+// SetFileBlocked blocks or unblocks file and writes action to audit log.
+func (srv *Admin) SetFileBlocked(ctx context.Context, user *core.User, id core.FileID, blocked bool) (*AdminFile, error) {
+	if err := srv.isHasPermissions(ctx, user); err != nil {
+		return nil, err
+	}
+
+	var file *core.File
+
+	if err := srv.Txier(ctx, func(ctx context.Context) error {
+		var err error
+
+		file, err = srv.File.Query().ID(id).One(ctx)
+		if err != nil {
+			return errors.Wrap(err, "find file by id")
+		}
+
+		if file.IsBlocked == blocked {
+			return ErrNothingChanged
+		}
+
+		file.IsBlocked = blocked
+
+		if err := srv.File.Update(ctx, file); err != nil {
+			return errors.Wrap(err, "update file")
+		}
+
+		action := core.AuditActionFileUnblock
+		if blocked {
+			action = core.AuditActionFileBlock
+		}
+
+		if err := srv.Audit.Add(ctx, core.NewFileAuditEntry(user.ID, action, file.ID)); err != nil {
+			return errors.Wrap(err, "add audit entry")
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	log.Info(ctx, "set file blocked", "file_id", file.ID, "blocked", blocked)
+
+	return srv.newAdminFile(ctx, file)
+}
+
+// SetUserBanned bans or unbans user and writes action to audit log.
+// Banned user can't use bot and their files are not served.
+func (srv *Admin) SetUserBanned(ctx context.Context, user *core.User, id core.UserID, banned bool) (*core.User, error) {
+	if err := srv.isHasPermissions(ctx, user); err != nil {
+		return nil, err
+	}
+
+	var target *core.User
+
+	if err := srv.Txier(ctx, func(ctx context.Context) error {
+		var err error
+
+		target, err = srv.User.Find(ctx, id)
+		if err != nil {
+			return errors.Wrap(err, "find user")
+		}
+
+		if target.IsAdmin {
+			return ErrCantBanAdmin
+		}
+
+		if target.IsBanned == banned {
+			return ErrNothingChanged
+		}
+
+		target.IsBanned = banned
+
+		if err := srv.User.Update(ctx, target); err != nil {
+			return errors.Wrap(err, "update user")
+		}
+
+		action := core.AuditActionUserUnban
+		if banned {
+			action = core.AuditActionUserBan
+		}
+
+		if err := srv.Audit.Add(ctx, core.NewUserAuditEntry(user.ID, action, target.ID)); err != nil {
+			return errors.Wrap(err, "add audit entry")
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	log.Info(ctx, "set user banned", "target_id", target.ID, "banned", banned)
+
+	return target, nil
+}
+
+// AuditLog returns last moderation actions of admins.
+func (srv *Admin) AuditLog(ctx context.Context, user *core.User, limit int) ([]*core.AuditEntry, error) {
+	if err := srv.isHasPermissions(ctx, user); err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 || limit > AuditLogMaxLimit {
+		limit = AuditLogMaxLimit
+	}
+
+	entries, err := srv.Audit.Query().Limit(limit).All(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "query audit log")
+	}
+
+	return entries, nil
+}
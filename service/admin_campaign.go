@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/pkg/log"
+	"github.com/friendsofgo/errors"
+)
+
+var (
+	ErrCampaignInvalidRef    = errors.New("invalid campaign ref")
+	ErrCampaignInvalidCost   = errors.New("invalid campaign cost")
+	ErrCampaignAlreadyExists = errors.New("campaign with same ref already exists")
+)
+
+// ref is part of deep-link ref_<ref>-<file>, so it can't contain dash.
+var reCampaignRef = regexp.MustCompile(`^[a-zA-Z0-9_]{1,32}$`)
+
+// CampaignStats is campaign with stats of users came by its ref.
+type CampaignStats struct {
+	*core.Campaign
+
+	// Users joined by ref during period.
+	Users int
+
+	// Downloads made by these users.
+	Downloads int
+}
+
+// CostPerUser returns cost of one user in cents, or zero if nobody came.
+func (stats *CampaignStats) CostPerUser() float64 {
+	if stats.Users == 0 {
+		return 0
+	}
+
+	return float64(stats.Cost) / float64(stats.Users)
+}
+
+// ParseCampaignCost parses cost like 150 or 99.90 to cents.
+func ParseCampaignCost(v string) (int, error) {
+	cost, err := strconv.ParseFloat(strings.Replace(v, ",", ".", 1), 64)
+	if err != nil || cost < 0 || math.IsInf(cost, 0) || cost > math.MaxInt32/100 {
+		return 0, ErrCampaignInvalidCost
+	}
+
+	return int(math.Round(cost * 100)), nil
+}
+
+// CreateCampaign registers campaign with ref.
+func (srv *Admin) CreateCampaign(ctx context.Context, user *core.User, ref, name string, cost int) (*core.Campaign, error) {
+	if err := srv.isHasPermissions(ctx, user); err != nil {
+		return nil, err
+	}
+
+	if !reCampaignRef.MatchString(ref) {
+		return nil, ErrCampaignInvalidRef
+	}
+
+	if cost < 0 {
+		return nil, ErrCampaignInvalidCost
+	}
+
+	campaign := core.NewCampaign(ref, name, cost)
+
+	if err := srv.Txier(ctx, func(ctx context.Context) error {
+		_, err := srv.Campaign.Query().Ref(ref).One(ctx)
+		if err == nil {
+			return ErrCampaignAlreadyExists
+		} else if err != core.ErrCampaignNotFound {
+			return errors.Wrap(err, "find campaign")
+		}
+
+		return srv.Campaign.Add(ctx, campaign)
+	}); err != nil {
+		return nil, err
+	}
+
+	log.Info(ctx, "campaign created", "ref", ref, "admin_id", user.ID)
+
+	return campaign, nil
+}
+
+// DeleteCampaign deletes campaign by ref. Users came by ref are not affected.
+func (srv *Admin) DeleteCampaign(ctx context.Context, user *core.User, ref string) error {
+	if err := srv.isHasPermissions(ctx, user); err != nil {
+		return err
+	}
+
+	count, err := srv.Campaign.Query().Ref(ref).Delete(ctx)
+	if err != nil {
+		return errors.Wrap(err, "delete campaign")
+	}
+
+	if count == 0 {
+		return core.ErrCampaignNotFound
+	}
+
+	log.Info(ctx, "campaign deleted", "ref", ref, "admin_id", user.ID)
+
+	return nil
+}
+
+// CampaignsStats returns registered campaigns with stats of users joined in [from, to).
+// Zero from or to means that period is not bounded.
+func (srv *Admin) CampaignsStats(ctx context.Context, user *core.User, from, to time.Time) ([]*CampaignStats, error) {
+	if err := srv.isHasPermissions(ctx, user); err != nil {
+		return nil, err
+	}
+
+	campaigns, err := srv.Campaign.Query().All(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "query campaigns")
+	}
+
+	refs, err := srv.User.RefStats(ctx, from, to)
+	if err != nil {
+		return nil, errors.Wrap(err, "get ref stats")
+	}
+
+	index := make(map[string]core.UserRefStatsItem, len(refs))
+	for _, item := range refs {
+		if item.Ref.Valid {
+			index[item.Ref.String] = item
+		}
+	}
+
+	result := make([]*CampaignStats, len(campaigns))
+
+	for i, campaign := range campaigns {
+		item := index[campaign.Ref]
+
+		result[i] = &CampaignStats{
+			Campaign:  campaign,
+			Users:     item.Count,
+			Downloads: item.Downloads,
+		}
+	}
+
+	return result, nil
+}
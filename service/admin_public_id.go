@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/pkg/log"
+	"github.com/friendsofgo/errors"
+)
+
+var ErrPublicIDAlreadyReserved = errors.New("public id is already reserved")
+
+// ReservePublicID adds name to blocklist of custom public ids.
+// Files which already use this name keep it.
+func (srv *Admin) ReservePublicID(ctx context.Context, user *core.User, name string) (*core.ReservedPublicID, error) {
+	if err := srv.isHasPermissions(ctx, user); err != nil {
+		return nil, err
+	}
+
+	if len(name) > FilePublicIDMaxLength || !reFilePublicID.MatchString(name) {
+		return nil, ErrFilePublicIDInvalid
+	}
+
+	reserved := core.NewReservedPublicID(name, user.ID)
+
+	if err := srv.Txier(ctx, func(ctx context.Context) error {
+		_, err := srv.ReservedPublicID.Query().Name(name).One(ctx)
+		if err == nil {
+			return ErrPublicIDAlreadyReserved
+		} else if !errors.Is(err, core.ErrReservedPublicIDNotFound) {
+			return errors.Wrap(err, "find reserved public id")
+		}
+
+		return srv.ReservedPublicID.Add(ctx, reserved)
+	}); err != nil {
+		return nil, err
+	}
+
+	log.Info(ctx, "public id reserved", "name", reserved.Name, "admin_id", user.ID)
+
+	return reserved, nil
+}
+
+// UnreservePublicID removes name from blocklist of custom public ids.
+func (srv *Admin) UnreservePublicID(ctx context.Context, user *core.User, name string) error {
+	if err := srv.isHasPermissions(ctx, user); err != nil {
+		return err
+	}
+
+	count, err := srv.ReservedPublicID.Query().Name(name).Delete(ctx)
+	if err != nil {
+		return errors.Wrap(err, "delete reserved public id")
+	}
+
+	if count == 0 {
+		return core.ErrReservedPublicIDNotFound
+	}
+
+	log.Info(ctx, "public id unreserved", "name", name, "admin_id", user.ID)
+
+	return nil
+}
+
+// ReservedPublicIDs returns blocklist of custom public ids.
+func (srv *Admin) ReservedPublicIDs(ctx context.Context, user *core.User) ([]*core.ReservedPublicID, error) {
+	if err := srv.isHasPermissions(ctx, user); err != nil {
+		return nil, err
+	}
+
+	items, err := srv.ReservedPublicID.Query().All(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "query reserved public ids")
+	}
+
+	return items, nil
+}
@@ -0,0 +1,239 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/service"
+	"github.com/bots-house/share-file-bot/store/memory"
+	"github.com/friendsofgo/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestAdmin_Moderation(t *testing.T) {
+	ctx := context.Background()
+	mem := memory.New()
+
+	srv := &service.Admin{
+		User:     mem.User(),
+		File:     mem.File(),
+		Download: mem.Download(),
+		Chat:     mem.Chat(),
+		Audit:    mem.Audit(),
+		Txier:    mem.Tx,
+	}
+
+	fileSrv := &service.File{
+		File:     mem.File(),
+		User:     mem.User(),
+		Download: mem.Download(),
+		Txier:    mem.Tx,
+	}
+
+	owner := core.NewUser(1, "Owner", "", "", "en")
+	admin := core.NewUser(2, "Admin", "", "", "en")
+	admin.IsAdmin = true
+
+	for _, user := range []*core.User{owner, admin} {
+		require.NoError(t, mem.User().Add(ctx, user))
+	}
+
+	file := core.NewFile("telegram", "", core.KindDocument, "text/plain", 100, "file.txt", owner.ID, false, core.Metadata{})
+	require.NoError(t, mem.File().Add(ctx, file))
+
+	_, err := srv.SetFileBlocked(ctx, owner, file.ID, true)
+	require.True(t, errors.Is(err, service.ErrUserIsNotAdmin))
+
+	// blocked file is not served
+	result, err := srv.SetFileBlocked(ctx, admin, file.ID, true)
+	require.NoError(t, err)
+	require.True(t, result.IsBlocked)
+	require.Equal(t, owner.ID, result.Owner.ID)
+
+	_, err = srv.SetFileBlocked(ctx, admin, file.ID, true)
+	require.True(t, errors.Is(err, service.ErrNothingChanged))
+
+	_, err = fileSrv.GetFileByPublicID(ctx, owner, file.PublicID)
+	require.True(t, errors.Is(err, service.ErrFileBlocked))
+
+	_, err = srv.SetFileBlocked(ctx, admin, file.ID, false)
+	require.NoError(t, err)
+
+	_, err = fileSrv.GetFileByPublicID(ctx, owner, file.PublicID)
+	require.NoError(t, err)
+
+	// files of banned user are not served
+	_, err = srv.SetUserBanned(ctx, admin, admin.ID, true)
+	require.True(t, errors.Is(err, service.ErrCantBanAdmin))
+
+	banned, err := srv.SetUserBanned(ctx, admin, owner.ID, true)
+	require.NoError(t, err)
+	require.True(t, banned.IsBanned)
+
+	_, err = fileSrv.GetFileByPublicID(ctx, owner, file.PublicID)
+	require.True(t, errors.Is(err, service.ErrOwnerIsBanned))
+
+	// every action is written to audit log
+	entries, err := srv.AuditLog(ctx, admin, 10)
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	require.Equal(t, core.AuditActionUserBan, entries[0].Action)
+	require.Equal(t, owner.ID, entries[0].UserID)
+	require.Equal(t, core.AuditActionFileUnblock, entries[1].Action)
+	require.Equal(t, core.AuditActionFileBlock, entries[2].Action)
+	require.Equal(t, file.ID, entries[2].FileID)
+	require.Equal(t, admin.ID, entries[2].AdminID)
+}
+
+func TestAdmin_SummaryStats(t *testing.T) {
+	ctx := context.Background()
+	mem := memory.New()
+
+	srv := &service.Admin{
+		User:     mem.User(),
+		File:     mem.File(),
+		Download: mem.Download(),
+		Chat:     mem.Chat(),
+		Audit:    mem.Audit(),
+		Txier:    mem.Tx,
+	}
+
+	now := time.Now()
+
+	admin := core.NewUser(1, "Admin", "", "", "en")
+	admin.IsAdmin = true
+	admin.LastSeenAt = null.TimeFrom(now)
+
+	weekly := core.NewUser(2, "Weekly", "", "", "en")
+	weekly.LastSeenAt = null.TimeFrom(now.Add(-3 * 24 * time.Hour))
+
+	monthly := core.NewUser(3, "Monthly", "", "", "en")
+	monthly.LastSeenAt = null.TimeFrom(now.Add(-20 * 24 * time.Hour))
+	monthly.BlockBot(now)
+
+	inactive := core.NewUser(4, "Inactive", "", "", "en")
+
+	for _, user := range []*core.User{admin, weekly, monthly, inactive} {
+		require.NoError(t, mem.User().Add(ctx, user))
+	}
+
+	file := core.NewFile("telegram", "", core.KindDocument, "text/plain", 100, "file.txt", admin.ID, false, core.Metadata{})
+	require.NoError(t, mem.File().Add(ctx, file))
+
+	// download makes user active too
+	dwn := core.NewDownload(file.ID, inactive.ID)
+	dwn.At = now.Add(-time.Hour)
+	require.NoError(t, mem.Download().Add(ctx, dwn))
+
+	stats, err := srv.SummaryStats(ctx, admin)
+	require.NoError(t, err)
+
+	require.Equal(t, 4, stats.Users)
+	require.Equal(t, 3, stats.ActiveUsers)
+	require.Equal(t, 1, stats.BlockedUsers)
+	require.Equal(t, 2, stats.DAU)
+	require.Equal(t, 3, stats.WAU)
+	require.Equal(t, 4, stats.MAU)
+}
+
+func TestAdmin_Campaigns(t *testing.T) {
+	ctx := context.Background()
+	mem := memory.New()
+
+	srv := &service.Admin{
+		User:     mem.User(),
+		File:     mem.File(),
+		Download: mem.Download(),
+		Chat:     mem.Chat(),
+		Audit:    mem.Audit(),
+		Campaign: mem.Campaign(),
+		Txier:    mem.Tx,
+	}
+
+	admin := core.NewUser(1, "Admin", "", "", "en")
+	admin.IsAdmin = true
+	require.NoError(t, mem.User().Add(ctx, admin))
+
+	_, err := srv.CreateCampaign(ctx, core.NewUser(2, "User", "", "", "en"), "ads", "Ads", 100)
+	require.True(t, errors.Is(err, service.ErrUserIsNotAdmin))
+
+	_, err = srv.CreateCampaign(ctx, admin, "bad-ref", "Ads", 100)
+	require.True(t, errors.Is(err, service.ErrCampaignInvalidRef))
+
+	_, err = srv.CreateCampaign(ctx, admin, "ads", "Ads", 15000)
+	require.NoError(t, err)
+
+	_, err = srv.CreateCampaign(ctx, admin, "ads", "Ads again", 100)
+	require.True(t, errors.Is(err, service.ErrCampaignAlreadyExists))
+
+	_, err = srv.CreateCampaign(ctx, admin, "blog", "Blog", 0)
+	require.NoError(t, err)
+
+	joinedAt := time.Date(2020, time.November, 20, 12, 0, 0, 0, time.UTC)
+
+	for i, ref := range []string{"ads", "ads", "ads", "other"} {
+		user := core.NewUser(core.UserID(10+i), "User", "", "", "en")
+		user.Ref = null.StringFrom(ref)
+		user.JoinedAt = joinedAt.Add(time.Duration(i) * 24 * time.Hour)
+		require.NoError(t, mem.User().Add(ctx, user))
+	}
+
+	file := core.NewFile("telegram", "", core.KindDocument, "text/plain", 100, "file.txt", admin.ID, false, core.Metadata{})
+	require.NoError(t, mem.File().Add(ctx, file))
+
+	for i, userID := range []core.UserID{10, 11} {
+		download := core.NewDownload(file.ID, userID)
+		download.At = joinedAt.Add(time.Duration(i)*24*time.Hour + time.Hour)
+		require.NoError(t, mem.Download().Add(ctx, download))
+	}
+
+	stats, err := srv.CampaignsStats(ctx, admin, time.Time{}, time.Time{})
+	require.NoError(t, err)
+	require.Len(t, stats, 2)
+
+	require.Equal(t, "blog", stats[0].Ref)
+	require.Zero(t, stats[0].Users)
+	require.Zero(t, stats[0].CostPerUser())
+
+	require.Equal(t, "ads", stats[1].Ref)
+	require.Equal(t, 3, stats[1].Users)
+	require.Equal(t, 2, stats[1].Downloads)
+	require.Equal(t, 5000.0, stats[1].CostPerUser())
+
+	// only users joined and downloads made in period
+	stats, err = srv.CampaignsStats(ctx, admin, joinedAt.Add(24*time.Hour), joinedAt.Add(48*time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, 1, stats[1].Users)
+	require.Equal(t, 1, stats[1].Downloads)
+
+	require.NoError(t, srv.DeleteCampaign(ctx, admin, "blog"))
+	require.True(t, errors.Is(srv.DeleteCampaign(ctx, admin, "blog"), core.ErrCampaignNotFound))
+}
+
+func TestParseCampaignCost(t *testing.T) {
+	for _, test := range []struct {
+		Input string
+		Cost  int
+		Err   bool
+	}{
+		{Input: "150", Cost: 15000},
+		{Input: "99.90", Cost: 9990},
+		{Input: "0,5", Cost: 50},
+		{Input: "0", Cost: 0},
+		{Input: "-1", Err: true},
+		{Input: "free", Err: true},
+		{Input: "1e100", Err: true},
+	} {
+		cost, err := service.ParseCampaignCost(test.Input)
+		if test.Err {
+			require.Error(t, err, test.Input)
+			continue
+		}
+
+		require.NoError(t, err, test.Input)
+		require.Equal(t, test.Cost, cost, test.Input)
+	}
+}
@@ -11,7 +11,11 @@ import (
 )
 
 type Auth struct {
-	UserStore core.UserStore
+	UserStore     core.UserStore
+	CampaignStore core.CampaignStore
+
+	// AcceptUnknownRefs allows refs of deep-links without registered campaign.
+	AcceptUnknownRefs bool
 }
 
 type UserInfo struct {
@@ -36,6 +40,8 @@ func (srv *Auth) createUser(ctx context.Context, info *UserInfo) (*core.User, er
 		user.Ref = null.StringFrom(info.Ref)
 	}
 
+	user.Seen(user.JoinedAt)
+
 	log.Info(ctx, "create new user")
 	if err := srv.UserStore.Add(ctx, user); err != nil {
 		return nil, errors.Wrap(err, "add user to store")
@@ -62,17 +68,33 @@ func (srv *Auth) updateUserIfNeed(ctx context.Context, user *core.User, info *Us
 		update = true
 	}
 
+	if info.LanguageCode != "" && user.LanguageCode != info.LanguageCode {
+		user.LanguageCode = info.LanguageCode
+		update = true
+	}
+
 	// update ref only if provided
 	if info.Ref != "" && user.Ref.String != info.Ref {
 		user.Ref = null.NewString(info.Ref, info.Ref != "")
 		update = true
 	}
 
+	now := time.Now()
+
+	// user interacts with bot, so it's not blocked anymore
+	if user.UnblockBot(now) {
+		update = true
+	}
+
+	if user.Seen(now) {
+		update = true
+	}
+
 	if !update {
 		return user, nil
 	}
 
-	user.UpdatedAt = null.TimeFrom(time.Now())
+	user.UpdatedAt = null.TimeFrom(now)
 
 	log.Info(ctx, "update user info")
 	if err := srv.UserStore.Update(ctx, user); err != nil {
@@ -104,6 +126,53 @@ func (srv *Auth) Auth(ctx context.Context, info *UserInfo) (*core.User, error) {
 	return user, nil
 }
 
+// IsRefAllowed returns true, if user can be attributed to ref.
+func (srv *Auth) IsRefAllowed(ctx context.Context, ref string) (bool, error) {
+	if srv.AcceptUnknownRefs {
+		return true, nil
+	}
+
+	_, err := srv.CampaignStore.Query().Ref(ref).One(ctx)
+	if err == core.ErrCampaignNotFound {
+		return false, nil
+	} else if err != nil {
+		return false, errors.Wrap(err, "find campaign")
+	}
+
+	return true, nil
+}
+
+// SetBotBlocked saves that user blocked (or unblocked) bot at t.
+// User is created, if it's unknown yet.
+func (srv *Auth) SetBotBlocked(ctx context.Context, info *UserInfo, blocked bool, at time.Time) error {
+	user, err := srv.UserStore.Find(ctx, core.UserID(info.ID))
+	if err == core.ErrUserNotFound {
+		user, err = srv.createUser(ctx, info)
+	}
+	if err != nil {
+		return errors.Wrap(err, "get user")
+	}
+
+	var updated bool
+
+	if blocked {
+		updated = user.BlockBot(at)
+	} else {
+		updated = user.UnblockBot(at)
+	}
+
+	if !updated {
+		return nil
+	}
+
+	log.Info(ctx, "user changed bot status", "blocked", blocked)
+	if err := srv.UserStore.Update(ctx, user); err != nil {
+		return errors.Wrap(err, "update user")
+	}
+
+	return nil
+}
+
 func (srv *Auth) SettingsToggleLongIDs(ctx context.Context, user *core.User) (bool, error) {
 	updated := user.Settings.Patch(func(settings *core.UserSettings) {
 		settings.LongIDs = !settings.LongIDs
@@ -118,3 +187,18 @@ func (srv *Auth) SettingsToggleLongIDs(ctx context.Context, user *core.User) (bo
 
 	return user.Settings.LongIDs, nil
 }
+
+// SettingsSetLanguage sets language of bot interface selected by user.
+func (srv *Auth) SettingsSetLanguage(ctx context.Context, user *core.User, lang string) error {
+	updated := user.Settings.Patch(func(settings *core.UserSettings) {
+		settings.Language = lang
+	})
+
+	if updated {
+		if err := srv.UserStore.Update(ctx, user); err != nil {
+			return errors.Wrap(err, "update user")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/service"
+	"github.com/bots-house/share-file-bot/store/memory"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuth_SetBotBlocked(t *testing.T) {
+	ctx := context.Background()
+	mem := memory.New()
+
+	srv := &service.Auth{UserStore: mem.User()}
+
+	info := &service.UserInfo{ID: 1, FirstName: "User", LanguageCode: "en"}
+
+	// unknown user is created
+	blockedAt := time.Now().Add(-time.Hour)
+	require.NoError(t, srv.SetBotBlocked(ctx, info, true, blockedAt))
+
+	user, err := mem.User().Find(ctx, 1)
+	require.NoError(t, err)
+	require.True(t, user.BotBlockedAt.Time.Equal(blockedAt))
+	require.True(t, user.LastSeenAt.Valid)
+
+	// repeated block doesn't change time
+	require.NoError(t, srv.SetBotBlocked(ctx, info, true, time.Now()))
+
+	user, err = mem.User().Find(ctx, 1)
+	require.NoError(t, err)
+	require.True(t, user.BotBlockedAt.Time.Equal(blockedAt))
+
+	unblockedAt := time.Now()
+	require.NoError(t, srv.SetBotBlocked(ctx, info, false, unblockedAt))
+
+	user, err = mem.User().Find(ctx, 1)
+	require.NoError(t, err)
+	require.False(t, user.BotBlockedAt.Valid)
+	require.True(t, user.BotUnblockedAt.Time.Equal(unblockedAt))
+
+	// interaction with bot means it's unblocked
+	require.NoError(t, srv.SetBotBlocked(ctx, info, true, time.Now()))
+
+	user, err = srv.Auth(ctx, info)
+	require.NoError(t, err)
+	require.False(t, user.BotBlockedAt.Valid)
+}
+
+func TestAuth_LastSeen(t *testing.T) {
+	ctx := context.Background()
+	mem := memory.New()
+
+	srv := &service.Auth{UserStore: mem.User()}
+
+	info := &service.UserInfo{ID: 1, FirstName: "User", LanguageCode: "en"}
+
+	user, err := srv.Auth(ctx, info)
+	require.NoError(t, err)
+	require.True(t, user.LastSeenAt.Valid)
+
+	seenAt := user.LastSeenAt.Time
+
+	// fresh enough, so not updated
+	user, err = srv.Auth(ctx, info)
+	require.NoError(t, err)
+	require.True(t, user.LastSeenAt.Time.Equal(seenAt))
+
+	user.LastSeenAt.Time = seenAt.Add(-2 * core.UserLastSeenPrecision)
+	require.NoError(t, mem.User().Update(ctx, user))
+
+	user, err = srv.Auth(ctx, info)
+	require.NoError(t, err)
+	require.True(t, user.LastSeenAt.Time.After(seenAt.Add(-core.UserLastSeenPrecision)))
+}
+
+func TestAuth_IsRefAllowed(t *testing.T) {
+	ctx := context.Background()
+	mem := memory.New()
+
+	require.NoError(t, mem.Campaign().Add(ctx, core.NewCampaign("ads", "Ads", 0)))
+
+	srv := &service.Auth{
+		UserStore:     mem.User(),
+		CampaignStore: mem.Campaign(),
+	}
+
+	allowed, err := srv.IsRefAllowed(ctx, "ads")
+	require.NoError(t, err)
+	require.True(t, allowed)
+
+	allowed, err = srv.IsRefAllowed(ctx, "unknown")
+	require.NoError(t, err)
+	require.False(t, allowed)
+
+	srv.AcceptUnknownRefs = true
+
+	allowed, err = srv.IsRefAllowed(ctx, "unknown")
+	require.NoError(t, err)
+	require.True(t, allowed)
+}
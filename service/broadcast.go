@@ -0,0 +1,723 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/pkg/log"
+	"github.com/bots-house/share-file-bot/pkg/tg"
+	"github.com/bots-house/share-file-bot/store"
+	tgbotapi "github.com/bots-house/telegram-bot-api"
+	"github.com/friendsofgo/errors"
+	"github.com/go-redis/redis/v8"
+	"github.com/volatiletech/null/v8"
+)
+
+const (
+	// BroadcastMaxButtons is max count of buttons attached to broadcast.
+	BroadcastMaxButtons = 8
+
+	// BroadcastButtonTextMaxLength is max length of button text in runes.
+	BroadcastButtonTextMaxLength = 64
+
+	// BroadcastListLimit is count of recent broadcasts returned by List.
+	BroadcastListLimit = 10
+
+	broadcastEditTTL = time.Hour
+
+	// users loaded at once by delivery job.
+	broadcastBatchSize = 100
+
+	// default delivery rate, Telegram allows about 30 messages per second.
+	broadcastDefaultRate = 25
+
+	broadcastDefaultPollInterval = 5 * time.Second
+)
+
+var (
+	ErrBroadcastNotAwaited      = errors.New("broadcast edit is not awaited")
+	ErrBroadcastInvalidButtons  = errors.New("invalid broadcast buttons")
+	ErrBroadcastInvalidSegment  = errors.New("invalid broadcast segment")
+	ErrBroadcastEmptyAudience   = errors.New("broadcast audience is empty")
+	errBroadcastDeliveryStopped = errors.New("broadcast delivery stopped")
+)
+
+// BroadcastSender delivers broadcast message to user.
+type BroadcastSender interface {
+	Send(ctx context.Context, broadcast *core.Broadcast, userID core.UserID) error
+}
+
+// TelegramBroadcastSender sends copy of broadcast message with buttons.
+type TelegramBroadcastSender struct {
+	Telegram *tgbotapi.BotAPI
+}
+
+func (sender *TelegramBroadcastSender) Send(ctx context.Context, broadcast *core.Broadcast, userID core.UserID) error {
+	params := url.Values{}
+
+	params.Set("chat_id", strconv.Itoa(int(userID)))
+	params.Set("from_chat_id", strconv.FormatInt(broadcast.FromChatID, 10))
+	params.Set("message_id", strconv.Itoa(broadcast.MessageID))
+
+	if len(broadcast.Buttons) > 0 {
+		rows := make([][]tgbotapi.InlineKeyboardButton, len(broadcast.Buttons))
+		for i, button := range broadcast.Buttons {
+			rows[i] = tgbotapi.NewInlineKeyboardRow(
+				tgbotapi.NewInlineKeyboardButtonURL(button.Text, button.URL),
+			)
+		}
+
+		markup, err := json.Marshal(tgbotapi.NewInlineKeyboardMarkup(rows...))
+		if err != nil {
+			return errors.Wrap(err, "marshal reply markup")
+		}
+
+		params.Set("reply_markup", string(markup))
+	}
+
+	_, err := sender.Telegram.MakeRequest("copyMessage", params)
+
+	return err
+}
+
+// Broadcast implements delivery of admin messages to users of bot.
+//
+// Admin composes draft from any message, attaches buttons and picks segment of users.
+// Started broadcasts are delivered by Run in background.
+// Users are processed in order of ID and progress is saved after each user,
+// so delivery is resumed after restart.
+type Broadcast struct {
+	Broadcast         core.BroadcastStore
+	BroadcastDelivery core.BroadcastDeliveryStore
+	User              core.UserStore
+	Redis             redis.UniversalClient
+	Txier             store.Txier
+	Sender            BroadcastSender
+
+	// Max count of messages per second.
+	Rate int
+
+	// Interval of checks for started broadcasts.
+	PollInterval time.Duration
+}
+
+// BroadcastInfo is broadcast with count of users in its segment.
+type BroadcastInfo struct {
+	*core.Broadcast
+
+	Audience int
+}
+
+// BroadcastEdit define what part of draft is awaited from admin.
+type BroadcastEdit string
+
+const (
+	BroadcastEditButtons BroadcastEdit = "buttons"
+	BroadcastEditSegment BroadcastEdit = "segment"
+)
+
+func (srv *Broadcast) getEditKey(userID core.UserID, edit BroadcastEdit) string {
+	return fmt.Sprintf("share-file-bot:users:%d:broadcast:%s", userID, edit)
+}
+
+func (srv *Broadcast) isAdmin(user *core.User) error {
+	if !user.IsAdmin {
+		return ErrUserIsNotAdmin
+	}
+
+	return nil
+}
+
+// audience returns query of users targeted by segment.
+func (srv *Broadcast) audience(segment core.BroadcastSegment) core.UserStoreQuery {
+	query := srv.User.Query().NotBlockedBot()
+
+	if segment.Ref.Valid {
+		query = query.Ref(segment.Ref.String)
+	}
+
+	if segment.Language.Valid {
+		query = query.Language(segment.Language.String)
+	}
+
+	if segment.JoinedAfter.Valid {
+		query = query.JoinedAfter(segment.JoinedAfter.Time)
+	}
+
+	return query
+}
+
+func (srv *Broadcast) getInfo(ctx context.Context, broadcast *core.Broadcast) (*BroadcastInfo, error) {
+	audience, err := srv.audience(broadcast.Segment).Count(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "count audience")
+	}
+
+	return &BroadcastInfo{
+		Broadcast: broadcast,
+		Audience:  audience,
+	}, nil
+}
+
+func (srv *Broadcast) getDraft(ctx context.Context, id core.BroadcastID) (*core.Broadcast, error) {
+	broadcast, err := srv.Broadcast.Query().ID(id).One(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "get broadcast")
+	}
+
+	if broadcast.Status != core.BroadcastStatusDraft {
+		return nil, core.ErrBroadcastNotDraft
+	}
+
+	return broadcast, nil
+}
+
+// Create creates draft of broadcast from message of admin.
+func (srv *Broadcast) Create(ctx context.Context, admin *core.User, chatID int64, messageID int) (*BroadcastInfo, error) {
+	if err := srv.isAdmin(admin); err != nil {
+		return nil, err
+	}
+
+	broadcast := core.NewBroadcast(admin.ID, chatID, messageID)
+
+	if err := srv.Broadcast.Add(ctx, broadcast); err != nil {
+		return nil, errors.Wrap(err, "add broadcast")
+	}
+
+	return srv.getInfo(ctx, broadcast)
+}
+
+// Get returns broadcast by id.
+func (srv *Broadcast) Get(ctx context.Context, admin *core.User, id core.BroadcastID) (*BroadcastInfo, error) {
+	if err := srv.isAdmin(admin); err != nil {
+		return nil, err
+	}
+
+	broadcast, err := srv.Broadcast.Query().ID(id).One(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "get broadcast")
+	}
+
+	return srv.getInfo(ctx, broadcast)
+}
+
+// List returns recent broadcasts.
+func (srv *Broadcast) List(ctx context.Context, admin *core.User) ([]*core.Broadcast, error) {
+	if err := srv.isAdmin(admin); err != nil {
+		return nil, err
+	}
+
+	return srv.Broadcast.Query().Limit(BroadcastListLimit).All(ctx)
+}
+
+// Preview sends broadcast message to admin.
+func (srv *Broadcast) Preview(ctx context.Context, admin *core.User, id core.BroadcastID) error {
+	if err := srv.isAdmin(admin); err != nil {
+		return err
+	}
+
+	broadcast, err := srv.Broadcast.Query().ID(id).One(ctx)
+	if err != nil {
+		return errors.Wrap(err, "get broadcast")
+	}
+
+	if err := srv.Sender.Send(ctx, broadcast, admin.ID); err != nil {
+		return errors.Wrap(err, "send preview")
+	}
+
+	return nil
+}
+
+// RequestEdit registers admin as awaiting for buttons or segment of draft.
+func (srv *Broadcast) RequestEdit(ctx context.Context, admin *core.User, id core.BroadcastID, edit BroadcastEdit) error {
+	if err := srv.isAdmin(admin); err != nil {
+		return err
+	}
+
+	if _, err := srv.getDraft(ctx, id); err != nil {
+		return err
+	}
+
+	key := srv.getEditKey(admin.ID, edit)
+
+	if err := srv.Redis.Set(ctx, key, int(id), broadcastEditTTL).Err(); err != nil {
+		return errors.Wrap(err, "set edit key")
+	}
+
+	return nil
+}
+
+// CancelEdit cancels waiting for buttons or segment.
+func (srv *Broadcast) CancelEdit(ctx context.Context, admin *core.User) error {
+	err := srv.Redis.Del(ctx,
+		srv.getEditKey(admin.ID, BroadcastEditButtons),
+		srv.getEditKey(admin.ID, BroadcastEditSegment),
+	).Err()
+	if err != nil {
+		return errors.Wrap(err, "del edit keys")
+	}
+
+	return nil
+}
+
+// popEdit returns id of awaited draft and deletes key.
+func (srv *Broadcast) popEdit(ctx context.Context, admin *core.User, edit BroadcastEdit) (core.BroadcastID, error) {
+	key := srv.getEditKey(admin.ID, edit)
+
+	val, err := srv.Redis.Get(ctx, key).Result()
+	if err == redis.Nil {
+		return 0, ErrBroadcastNotAwaited
+	} else if err != nil {
+		return 0, errors.Wrap(err, "get edit key")
+	}
+
+	id, err := strconv.Atoi(val)
+	if err != nil {
+		return 0, errors.Wrap(err, "parse edit key")
+	}
+
+	if err := srv.Redis.Del(ctx, key).Err(); err != nil {
+		return 0, errors.Wrap(err, "del edit key")
+	}
+
+	return core.BroadcastID(id), nil
+}
+
+// updateDraft applies do to awaited draft.
+func (srv *Broadcast) updateDraft(
+	ctx context.Context,
+	admin *core.User,
+	edit BroadcastEdit,
+	do func(broadcast *core.Broadcast),
+) (*BroadcastInfo, error) {
+	if err := srv.isAdmin(admin); err != nil {
+		return nil, err
+	}
+
+	id, err := srv.popEdit(ctx, admin, edit)
+	if err != nil {
+		return nil, err
+	}
+
+	var broadcast *core.Broadcast
+
+	if err := srv.Txier(ctx, func(ctx context.Context) error {
+		broadcast, err = srv.getDraft(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		do(broadcast)
+
+		return srv.Broadcast.Update(ctx, broadcast)
+	}); err != nil {
+		return nil, err
+	}
+
+	return srv.getInfo(ctx, broadcast)
+}
+
+// SetButtons sets buttons of awaited draft from text, see ParseBroadcastButtons.
+func (srv *Broadcast) SetButtons(ctx context.Context, admin *core.User, text string) (*BroadcastInfo, error) {
+	buttons, err := ParseBroadcastButtons(text)
+	if err != nil {
+		return nil, err
+	}
+
+	return srv.updateDraft(ctx, admin, BroadcastEditButtons, func(broadcast *core.Broadcast) {
+		broadcast.Buttons = buttons
+	})
+}
+
+// SetSegment sets segment of awaited draft from text, see ParseBroadcastSegment.
+func (srv *Broadcast) SetSegment(ctx context.Context, admin *core.User, text string) (*BroadcastInfo, error) {
+	segment, err := ParseBroadcastSegment(text)
+	if err != nil {
+		return nil, err
+	}
+
+	return srv.updateDraft(ctx, admin, BroadcastEditSegment, func(broadcast *core.Broadcast) {
+		broadcast.Segment = segment
+	})
+}
+
+// Start starts delivery of draft.
+func (srv *Broadcast) Start(ctx context.Context, admin *core.User, id core.BroadcastID) (*BroadcastInfo, error) {
+	if err := srv.isAdmin(admin); err != nil {
+		return nil, err
+	}
+
+	var info *BroadcastInfo
+
+	if err := srv.Txier(ctx, func(ctx context.Context) error {
+		broadcast, err := srv.getDraft(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		info, err = srv.getInfo(ctx, broadcast)
+		if err != nil {
+			return err
+		}
+
+		if info.Audience == 0 {
+			return ErrBroadcastEmptyAudience
+		}
+
+		if err := broadcast.Start(info.Audience); err != nil {
+			return err
+		}
+
+		return srv.Broadcast.Update(ctx, broadcast)
+	}); err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
+
+// Cancel cancels draft or running broadcast.
+func (srv *Broadcast) Cancel(ctx context.Context, admin *core.User, id core.BroadcastID) (*BroadcastInfo, error) {
+	if err := srv.isAdmin(admin); err != nil {
+		return nil, err
+	}
+
+	var broadcast *core.Broadcast
+
+	if err := srv.Txier(ctx, func(ctx context.Context) error {
+		var err error
+
+		broadcast, err = srv.Broadcast.Query().ID(id).One(ctx)
+		if err != nil {
+			return errors.Wrap(err, "get broadcast")
+		}
+
+		if err := broadcast.Cancel(); err != nil {
+			return err
+		}
+
+		return srv.Broadcast.Update(ctx, broadcast)
+	}); err != nil {
+		return nil, err
+	}
+
+	return srv.getInfo(ctx, broadcast)
+}
+
+// Run delivers started broadcasts until context is done.
+func (srv *Broadcast) Run(ctx context.Context) error {
+	interval := srv.PollInterval
+	if interval == 0 {
+		interval = broadcastDefaultPollInterval
+	}
+
+	for {
+		found, err := srv.RunOnce(ctx)
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		if err != nil {
+			log.Error(ctx, "broadcast delivery failed", "err", err)
+		}
+
+		if !found || err != nil {
+			if !sleepCtx(ctx, interval) {
+				return nil
+			}
+		}
+	}
+}
+
+// RunOnce delivers oldest running broadcast.
+// Returns false if there is no running broadcasts.
+func (srv *Broadcast) RunOnce(ctx context.Context) (bool, error) {
+	broadcasts, err := srv.Broadcast.Query().Status(core.BroadcastStatusRunning).All(ctx)
+	if err != nil {
+		return false, errors.Wrap(err, "query running broadcasts")
+	}
+
+	if len(broadcasts) == 0 {
+		return false, nil
+	}
+
+	// broadcasts are ordered from newest to oldest
+	broadcast := broadcasts[len(broadcasts)-1]
+
+	ctx = log.With(ctx, "broadcast_id", broadcast.ID)
+
+	return true, srv.deliver(ctx, broadcast)
+}
+
+func (srv *Broadcast) deliver(ctx context.Context, broadcast *core.Broadcast) error {
+	rate := srv.Rate
+	if rate <= 0 {
+		rate = broadcastDefaultRate
+	}
+
+	ticker := time.NewTicker(time.Second / time.Duration(rate))
+	defer ticker.Stop()
+
+	log.Info(ctx, "deliver broadcast", "last_user_id", broadcast.LastUserID)
+
+	for {
+		users, err := srv.audience(broadcast.Segment).
+			IDGreaterThan(broadcast.LastUserID).
+			Limit(broadcastBatchSize).
+			All(ctx)
+		if err != nil {
+			return errors.Wrap(err, "query users")
+		}
+
+		if len(users) == 0 {
+			return srv.finish(ctx, broadcast.ID)
+		}
+
+		for _, user := range users {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-ticker.C:
+			}
+
+			delivery, err := srv.send(ctx, broadcast, user)
+			if err != nil {
+				return err
+			}
+
+			broadcast, err = srv.register(ctx, broadcast.ID, delivery)
+			if errors.Is(err, errBroadcastDeliveryStopped) {
+				log.Info(ctx, "broadcast delivery stopped")
+				return nil
+			} else if err != nil {
+				return errors.Wrap(err, "register delivery")
+			}
+		}
+	}
+}
+
+// send sends broadcast to user, waiting for flood control if needed.
+func (srv *Broadcast) send(ctx context.Context, broadcast *core.Broadcast, user *core.User) (*core.BroadcastDelivery, error) {
+	for {
+		err := srv.Sender.Send(ctx, broadcast, user.ID)
+
+		// don't register delivery interrupted by shutdown
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		if delay, ok := tg.GetRetryAfter(err); ok {
+			log.Info(ctx, "broadcast flood control", "retry_after", delay)
+			if !sleepCtx(ctx, delay) {
+				return nil, ctx.Err()
+			}
+			continue
+		}
+
+		switch {
+		case err == nil:
+			return core.NewBroadcastDelivery(broadcast.ID, user.ID, core.BroadcastDeliveryStatusDelivered, ""), nil
+		case tg.IsBotBlockedByUser(err):
+			return core.NewBroadcastDelivery(broadcast.ID, user.ID, core.BroadcastDeliveryStatusBlocked, err.Error()), nil
+		default:
+			return core.NewBroadcastDelivery(broadcast.ID, user.ID, core.BroadcastDeliveryStatusFailed, err.Error()), nil
+		}
+	}
+}
+
+// register saves delivery and progress of broadcast.
+// Returns errBroadcastDeliveryStopped if broadcast was canceled.
+func (srv *Broadcast) register(ctx context.Context, id core.BroadcastID, delivery *core.BroadcastDelivery) (*core.Broadcast, error) {
+	var broadcast *core.Broadcast
+
+	if err := srv.Txier(ctx, func(ctx context.Context) error {
+		var err error
+
+		// reload broadcast, because it can be canceled by admin
+		broadcast, err = srv.Broadcast.Query().ID(id).One(ctx)
+		if err != nil {
+			return errors.Wrap(err, "get broadcast")
+		}
+
+		if err := srv.BroadcastDelivery.Add(ctx, delivery); err != nil {
+			return errors.Wrap(err, "add delivery")
+		}
+
+		if delivery.Status == core.BroadcastDeliveryStatusBlocked {
+			if err := srv.markBotBlocked(ctx, delivery.UserID); err != nil {
+				return errors.Wrap(err, "mark bot blocked")
+			}
+		}
+
+		broadcast.Register(delivery)
+
+		return srv.Broadcast.Update(ctx, broadcast)
+	}); err != nil {
+		return nil, err
+	}
+
+	if broadcast.Status != core.BroadcastStatusRunning {
+		return nil, errBroadcastDeliveryStopped
+	}
+
+	return broadcast, nil
+}
+
+func (srv *Broadcast) markBotBlocked(ctx context.Context, id core.UserID) error {
+	user, err := srv.User.Find(ctx, id)
+	if err != nil {
+		return errors.Wrap(err, "find user")
+	}
+
+	if !user.BlockBot(time.Now()) {
+		return nil
+	}
+
+	return srv.User.Update(ctx, user)
+}
+
+func (srv *Broadcast) finish(ctx context.Context, id core.BroadcastID) error {
+	return srv.Txier(ctx, func(ctx context.Context) error {
+		broadcast, err := srv.Broadcast.Query().ID(id).One(ctx)
+		if err != nil {
+			return errors.Wrap(err, "get broadcast")
+		}
+
+		if broadcast.Status != core.BroadcastStatusRunning {
+			return nil
+		}
+
+		if err := broadcast.Finish(); err != nil {
+			return err
+		}
+
+		log.Info(ctx, "broadcast done",
+			"delivered", broadcast.Delivered,
+			"blocked", broadcast.Blocked,
+			"failed", broadcast.Failed,
+		)
+
+		return srv.Broadcast.Update(ctx, broadcast)
+	})
+}
+
+// sleepCtx sleeps for delay, returns false if context is done.
+func sleepCtx(ctx context.Context, delay time.Duration) bool {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+var broadcastLanguageRegexp = regexp.MustCompile(`^[a-z]{2}$`)
+
+// ParseBroadcastButtons parses buttons, one per line in format: Text - URL.
+// Single dash removes all buttons.
+func ParseBroadcastButtons(text string) ([]core.BroadcastButton, error) {
+	text = strings.TrimSpace(text)
+
+	if text == "-" {
+		return []core.BroadcastButton{}, nil
+	}
+
+	buttons := []core.BroadcastButton{}
+
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		i := strings.LastIndex(line, " - ")
+		if i == -1 {
+			return nil, ErrBroadcastInvalidButtons
+		}
+
+		title := strings.TrimSpace(line[:i])
+		link := strings.TrimSpace(line[i+3:])
+
+		if title == "" || utf8.RuneCountInString(title) > BroadcastButtonTextMaxLength {
+			return nil, ErrBroadcastInvalidButtons
+		}
+
+		u, err := url.Parse(link)
+		if err != nil || u.Host == "" || (u.Scheme != "https" && u.Scheme != "http") {
+			return nil, ErrBroadcastInvalidButtons
+		}
+
+		buttons = append(buttons, core.BroadcastButton{
+			Text: title,
+			URL:  link,
+		})
+	}
+
+	if len(buttons) == 0 || len(buttons) > BroadcastMaxButtons {
+		return nil, ErrBroadcastInvalidButtons
+	}
+
+	return buttons, nil
+}
+
+// ParseBroadcastSegment parses segment conditions, one per line:
+//
+//	all
+//	ref <ref>
+//	lang <language code>
+//	joined <YYYY-MM-DD>
+func ParseBroadcastSegment(text string) (core.BroadcastSegment, error) {
+	segment := core.BroadcastSegment{}
+
+	for _, line := range strings.Split(strings.TrimSpace(text), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		cond := strings.ToLower(fields[0])
+
+		if cond == "all" && len(fields) == 1 {
+			segment = core.BroadcastSegment{}
+			continue
+		}
+
+		if len(fields) != 2 {
+			return segment, ErrBroadcastInvalidSegment
+		}
+
+		value := fields[1]
+
+		switch cond {
+		case "ref":
+			segment.Ref = null.StringFrom(value)
+		case "lang":
+			value = strings.ToLower(value)
+			if !broadcastLanguageRegexp.MatchString(value) {
+				return segment, ErrBroadcastInvalidSegment
+			}
+			segment.Language = null.StringFrom(value)
+		case "joined":
+			t, err := time.Parse("2006-01-02", value)
+			if err != nil {
+				return segment, ErrBroadcastInvalidSegment
+			}
+			segment.JoinedAfter = null.TimeFrom(t)
+		default:
+			return segment, ErrBroadcastInvalidSegment
+		}
+	}
+
+	return segment, nil
+}
@@ -0,0 +1,243 @@
+package service_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/service"
+	"github.com/bots-house/share-file-bot/store/memory"
+	tgbotapi "github.com/bots-house/telegram-bot-api"
+	"github.com/friendsofgo/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+type fakeBroadcastSender struct {
+	errs map[core.UserID][]error
+	sent []core.UserID
+}
+
+func (sender *fakeBroadcastSender) Send(ctx context.Context, broadcast *core.Broadcast, userID core.UserID) error {
+	if errs := sender.errs[userID]; len(errs) > 0 {
+		sender.errs[userID] = errs[1:]
+		return errs[0]
+	}
+
+	sender.sent = append(sender.sent, userID)
+
+	return nil
+}
+
+func TestBroadcast_Deliver(t *testing.T) {
+	ctx := context.Background()
+	mem := memory.New()
+
+	sender := &fakeBroadcastSender{
+		errs: map[core.UserID][]error{
+			// flood control is retried
+			2: {&tgbotapi.Error{
+				Code:               http.StatusTooManyRequests,
+				ResponseParameters: tgbotapi.ResponseParameters{RetryAfter: 0},
+			}},
+			3: {&tgbotapi.Error{Code: http.StatusForbidden, Message: "Forbidden: bot was blocked by the user"}},
+			4: {&tgbotapi.Error{Code: http.StatusBadRequest, Message: "Bad Request: chat not found"}},
+		},
+	}
+
+	srv := &service.Broadcast{
+		Broadcast:         mem.Broadcast(),
+		BroadcastDelivery: mem.BroadcastDelivery(),
+		User:              mem.User(),
+		Txier:             mem.Tx,
+		Sender:            sender,
+		Rate:              1000,
+	}
+
+	admin := core.NewUser(1, "Admin", "", "", "en")
+	admin.IsAdmin = true
+	require.NoError(t, mem.User().Add(ctx, admin))
+
+	for id := core.UserID(2); id <= 5; id++ {
+		require.NoError(t, mem.User().Add(ctx, core.NewUser(id, "User", "", "", "en")))
+	}
+
+	// other language is not targeted
+	require.NoError(t, mem.User().Add(ctx, core.NewUser(6, "User", "", "", "ru")))
+
+	_, err := srv.Create(ctx, core.NewUser(7, "User", "", "", "en"), 1, 1)
+	require.True(t, errors.Is(err, service.ErrUserIsNotAdmin))
+
+	info, err := srv.Create(ctx, admin, int64(admin.ID), 100)
+	require.NoError(t, err)
+	require.Equal(t, 6, info.Audience)
+
+	info.Segment.Language = null.StringFrom("en")
+	require.NoError(t, mem.Broadcast().Update(ctx, info.Broadcast))
+
+	found, err := srv.RunOnce(ctx)
+	require.NoError(t, err)
+	require.False(t, found, "draft is not delivered")
+
+	info, err = srv.Start(ctx, admin, info.ID)
+	require.NoError(t, err)
+	require.Equal(t, 5, info.Audience)
+
+	_, err = srv.Start(ctx, admin, info.ID)
+	require.True(t, errors.Is(err, core.ErrBroadcastNotDraft))
+
+	found, err = srv.RunOnce(ctx)
+	require.NoError(t, err)
+	require.True(t, found)
+
+	require.Equal(t, []core.UserID{1, 2, 5}, sender.sent)
+
+	info, err = srv.Get(ctx, admin, info.ID)
+	require.NoError(t, err)
+	require.Equal(t, core.BroadcastStatusDone, info.Status)
+	require.Equal(t, 5, info.Total)
+	require.Equal(t, 3, info.Delivered)
+	require.Equal(t, 1, info.Blocked)
+	require.Equal(t, 1, info.Failed)
+	require.Equal(t, core.UserID(5), info.LastUserID)
+
+	// user who blocked bot is excluded from audience
+	blocked, err := mem.User().Find(ctx, 3)
+	require.NoError(t, err)
+	require.True(t, blocked.BotBlockedAt.Valid)
+	require.Equal(t, 4, info.Audience)
+
+	count, err := mem.BroadcastDelivery().Query().
+		BroadcastID(info.ID).
+		Status(core.BroadcastDeliveryStatusFailed).
+		Count(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	_, err = srv.Cancel(ctx, admin, info.ID)
+	require.True(t, errors.Is(err, core.ErrBroadcastFinished))
+}
+
+func TestBroadcast_Resume(t *testing.T) {
+	ctx := context.Background()
+	mem := memory.New()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	sender := &cancelingBroadcastSender{after: 2, cancel: cancel}
+
+	srv := &service.Broadcast{
+		Broadcast:         mem.Broadcast(),
+		BroadcastDelivery: mem.BroadcastDelivery(),
+		User:              mem.User(),
+		Txier:             mem.Tx,
+		Sender:            sender,
+		Rate:              1000,
+		PollInterval:      time.Millisecond,
+	}
+
+	admin := core.NewUser(1, "Admin", "", "", "en")
+	admin.IsAdmin = true
+	require.NoError(t, mem.User().Add(ctx, admin))
+
+	for id := core.UserID(2); id <= 4; id++ {
+		require.NoError(t, mem.User().Add(ctx, core.NewUser(id, "User", "", "", "en")))
+	}
+
+	info, err := srv.Create(ctx, admin, int64(admin.ID), 100)
+	require.NoError(t, err)
+
+	_, err = srv.Start(ctx, admin, info.ID)
+	require.NoError(t, err)
+
+	// delivery is interrupted by shutdown after second user
+	require.NoError(t, srv.Run(ctx))
+
+	ctx = context.Background()
+
+	info, err = srv.Get(ctx, admin, info.ID)
+	require.NoError(t, err)
+	require.Equal(t, core.BroadcastStatusRunning, info.Status)
+	require.Equal(t, core.UserID(2), info.LastUserID)
+
+	// delivery is resumed from next user
+	sender.cancel = nil
+
+	_, err = srv.RunOnce(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []core.UserID{1, 2, 3, 4}, sender.sent)
+
+	info, err = srv.Get(ctx, admin, info.ID)
+	require.NoError(t, err)
+	require.Equal(t, core.BroadcastStatusDone, info.Status)
+	require.Equal(t, 4, info.Delivered)
+}
+
+// cancelingBroadcastSender cancels context after some messages.
+type cancelingBroadcastSender struct {
+	after  int
+	cancel context.CancelFunc
+	sent   []core.UserID
+}
+
+func (sender *cancelingBroadcastSender) Send(ctx context.Context, broadcast *core.Broadcast, userID core.UserID) error {
+	if sender.cancel != nil && len(sender.sent) == sender.after {
+		sender.cancel()
+		return ctx.Err()
+	}
+
+	sender.sent = append(sender.sent, userID)
+
+	return nil
+}
+
+func TestParseBroadcastButtons(t *testing.T) {
+	buttons, err := service.ParseBroadcastButtons("Site - https://example.com\nChannel - Name - https://t.me/channel")
+	require.NoError(t, err)
+	require.Equal(t, []core.BroadcastButton{
+		{Text: "Site", URL: "https://example.com"},
+		{Text: "Channel - Name", URL: "https://t.me/channel"},
+	}, buttons)
+
+	buttons, err = service.ParseBroadcastButtons("-")
+	require.NoError(t, err)
+	require.Empty(t, buttons)
+
+	for _, text := range []string{
+		"",
+		"Site",
+		"Site - example.com",
+		"Site - javascript:alert(1)",
+		" - https://example.com",
+	} {
+		_, err := service.ParseBroadcastButtons(text)
+		require.True(t, errors.Is(err, service.ErrBroadcastInvalidButtons), text)
+	}
+}
+
+func TestParseBroadcastSegment(t *testing.T) {
+	segment, err := service.ParseBroadcastSegment("ref ads\nLANG EN\njoined 2020-11-20")
+	require.NoError(t, err)
+	require.Equal(t, core.BroadcastSegment{
+		Ref:         null.StringFrom("ads"),
+		Language:    null.StringFrom("en"),
+		JoinedAfter: null.TimeFrom(time.Date(2020, time.November, 20, 0, 0, 0, 0, time.UTC)),
+	}, segment)
+
+	segment, err = service.ParseBroadcastSegment("all")
+	require.NoError(t, err)
+	require.True(t, segment.IsAll())
+
+	for _, text := range []string{
+		"ref",
+		"lang english",
+		"joined yesterday",
+		"country us",
+	} {
+		_, err := service.ParseBroadcastSegment(text)
+		require.True(t, errors.Is(err, service.ErrBroadcastInvalidSegment), text)
+	}
+}
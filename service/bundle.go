@@ -0,0 +1,581 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/pkg/log"
+	"github.com/bots-house/share-file-bot/pkg/secretid"
+	"github.com/bots-house/share-file-bot/store"
+	tgbotapi "github.com/bots-house/telegram-bot-api"
+	"github.com/friendsofgo/errors"
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	// BundleMaxFiles is limit of files in one bundle.
+	BundleMaxFiles = 100
+
+	bundleDraftTTL = time.Hour
+)
+
+var (
+	ErrBundleEmpty = errors.New("bundle has no files")
+	ErrBundleFull  = errors.New("bundle has too many files")
+)
+
+type Bundle struct {
+	Bundle   core.BundleStore
+	File     core.FileStore
+	User     core.UserStore
+	Chat     core.ChatStore
+	Download core.DownloadStore
+	Telegram *tgbotapi.BotAPI
+	Redis    redis.UniversalClient
+	Txier    store.Txier
+
+	RevokedLink core.RevokedLinkStore
+
+	// Encoder of public ids of new files, random ids are used if nil.
+	SecretID secretid.SecretID
+
+	IsUsersCanUploadFiles bool
+}
+
+type OwnedBundle struct {
+	*core.Bundle
+	Files []*core.File
+	Stats *core.FileDownloadStats
+}
+
+type BundleDownloadResult struct {
+	Bundle         *core.Bundle
+	Files          []*core.File
+	OwnedBundle    *OwnedBundle
+	ChatSubRequest *ChatSubRequest
+}
+
+func (srv *Bundle) getDraftFilesKey(userID core.UserID) string {
+	return fmt.Sprintf("share-file-bot:users:%d:bundle:files", userID)
+}
+
+func (srv *Bundle) getDraftCaptionKey(userID core.UserID) string {
+	return fmt.Sprintf("share-file-bot:users:%d:bundle:caption", userID)
+}
+
+func (srv *Bundle) getSubAwaitKey(userID core.UserID, bundleID core.BundleID) string {
+	return fmt.Sprintf("share-file-bot:users:%d:subscription:bundle:%d", userID, bundleID)
+}
+
+// CollectStart begins new bundle draft of user. Previous draft is dropped.
+func (srv *Bundle) CollectStart(ctx context.Context, user *core.User) error {
+	if !user.IsAdmin && !srv.IsUsersCanUploadFiles {
+		return ErrUsersCantUploadFiles
+	}
+
+	return srv.CollectCancel(ctx, user)
+}
+
+// CollectAdd appends file to bundle draft and returns count of files in draft.
+func (srv *Bundle) CollectAdd(ctx context.Context, user *core.User, in *InputFile) (int, error) {
+	key := srv.getDraftFilesKey(user.ID)
+
+	count, err := srv.Redis.LLen(ctx, key).Result()
+	if err != nil {
+		return 0, errors.Wrap(err, "get draft length")
+	}
+
+	if count >= BundleMaxFiles {
+		return int(count), ErrBundleFull
+	}
+
+	body, err := json.Marshal(in)
+	if err != nil {
+		return 0, errors.Wrap(err, "marshal input file")
+	}
+
+	pipe := srv.Redis.TxPipeline()
+	length := pipe.RPush(ctx, key, body)
+	pipe.Expire(ctx, key, bundleDraftTTL)
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, errors.Wrap(err, "push to draft")
+	}
+
+	return int(length.Val()), nil
+}
+
+// CollectCaption sets caption of bundle draft.
+func (srv *Bundle) CollectCaption(ctx context.Context, user *core.User, caption string) error {
+	key := srv.getDraftCaptionKey(user.ID)
+
+	if err := srv.Redis.Set(ctx, key, caption, bundleDraftTTL).Err(); err != nil {
+		return errors.Wrap(err, "set caption")
+	}
+
+	return nil
+}
+
+// CollectCancel drops bundle draft of user.
+func (srv *Bundle) CollectCancel(ctx context.Context, user *core.User) error {
+	if err := srv.Redis.Del(ctx,
+		srv.getDraftFilesKey(user.ID),
+		srv.getDraftCaptionKey(user.ID),
+	).Err(); err != nil {
+		return errors.Wrap(err, "delete draft")
+	}
+
+	return nil
+}
+
+// CollectDone creates bundle from draft of user.
+func (srv *Bundle) CollectDone(ctx context.Context, user *core.User) (*OwnedBundle, error) {
+	items, err := srv.Redis.LRange(ctx, srv.getDraftFilesKey(user.ID), 0, -1).Result()
+	if err != nil {
+		return nil, errors.Wrap(err, "get draft files")
+	}
+
+	if len(items) == 0 {
+		return nil, ErrBundleEmpty
+	}
+
+	caption, err := srv.Redis.Get(ctx, srv.getDraftCaptionKey(user.ID)).Result()
+	if err != nil && err != redis.Nil {
+		return nil, errors.Wrap(err, "get draft caption")
+	}
+
+	inputs := make([]*InputFile, len(items))
+
+	for i, item := range items {
+		in := &InputFile{}
+
+		if err := json.Unmarshal([]byte(item), in); err != nil {
+			return nil, errors.Wrapf(err, "unmarshal draft file #%d", i)
+		}
+
+		inputs[i] = in
+	}
+
+	var (
+		bundle *core.Bundle
+		files  = make([]*core.File, len(inputs))
+	)
+
+	if err := srv.Txier(ctx, func(ctx context.Context) error {
+		fileIDs := make([]core.FileID, len(inputs))
+
+		for i, in := range inputs {
+			file := core.NewFile(
+				in.FileID,
+				in.Caption,
+				in.Kind,
+				in.MIMEType,
+				in.Size,
+				in.Name,
+				user.ID,
+				user.Settings.LongIDs,
+				in.Metadata,
+			)
+			file.CaptionEntities = in.CaptionEntities
+
+			if err := srv.File.Add(ctx, file); err != nil {
+				return errors.Wrapf(err, "add file #%d", i)
+			}
+
+			if err := encodePublicID(ctx, srv.SecretID, srv.File, file, srv.isPublicIDUsed); err != nil {
+				return errors.Wrapf(err, "encode public id of file #%d", i)
+			}
+
+			files[i] = file
+			fileIDs[i] = file.ID
+		}
+
+		bundle = core.NewBundle(caption, fileIDs, user.ID, user.Settings.LongIDs)
+
+		log.Info(ctx, "create bundle", "files", len(fileIDs))
+		if err := srv.Bundle.Add(ctx, bundle); err != nil {
+			return errors.Wrap(err, "add bundle to store")
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := srv.CollectCancel(ctx, user); err != nil {
+		log.Warn(ctx, "can't delete bundle draft", "err", err)
+	}
+
+	return srv.newOwnedBundle(ctx, bundle, files)
+}
+
+// getFiles returns files of bundle in order of delivery.
+func (srv *Bundle) getFiles(ctx context.Context, bundle *core.Bundle) ([]*core.File, error) {
+	if len(bundle.FileIDs) == 0 {
+		return []*core.File{}, nil
+	}
+
+	files, err := srv.File.Query().ID(bundle.FileIDs...).All(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "query files")
+	}
+
+	byID := make(map[core.FileID]*core.File, len(files))
+	for _, file := range files {
+		byID[file.ID] = file
+	}
+
+	result := make([]*core.File, 0, len(files))
+	for _, id := range bundle.FileIDs {
+		if file, ok := byID[id]; ok {
+			result = append(result, file)
+		}
+	}
+
+	return result, nil
+}
+
+func (srv *Bundle) newOwnedBundle(ctx context.Context, bundle *core.Bundle, files []*core.File) (*OwnedBundle, error) {
+	if files == nil {
+		var err error
+
+		files, err = srv.getFiles(ctx, bundle)
+		if err != nil {
+			return nil, errors.Wrap(err, "get bundle files")
+		}
+	}
+
+	stats, err := srv.Download.GetBundleStats(ctx, bundle.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "get bundle stats")
+	}
+
+	return &OwnedBundle{
+		Bundle: bundle,
+		Files:  files,
+		Stats:  stats,
+	}, nil
+}
+
+func (srv *Bundle) toDownloadResult(ctx context.Context, user *core.User, bundle *core.Bundle) (*BundleDownloadResult, error) {
+	// if user is owner of this bundle we just display it
+	if bundle.OwnerID == user.ID {
+		owned, err := srv.newOwnedBundle(ctx, bundle, nil)
+		if err != nil {
+			return nil, errors.Wrap(err, "get owned bundle")
+		}
+		return &BundleDownloadResult{
+			OwnedBundle: owned,
+		}, nil
+	}
+
+	if err := checkOwnerIsNotBanned(ctx, srv.User, bundle.OwnerID); err != nil {
+		return nil, err
+	}
+
+	// check user subscription
+	if bundle.Restriction.HasChats() {
+		sub, err := checkChatsSubscription(ctx, srv.Chat, srv.Telegram, user, &bundle.Restriction)
+		if err != nil {
+			return nil, errors.Wrap(err, "check bundle restrictions chat")
+		}
+
+		if sub != nil {
+			sub.BundleID = bundle.ID
+
+			// add user to subscription await list
+			key := srv.getSubAwaitKey(user.ID, bundle.ID)
+			if err := registerSubAwait(ctx, srv.Redis, key); err != nil {
+				return nil, errors.Wrap(err, "can't add user to await list")
+			}
+
+			return &BundleDownloadResult{
+				ChatSubRequest: sub,
+			}, nil
+		}
+	}
+
+	return srv.registerDownload(ctx, user, bundle)
+}
+
+// RegisterDownload of bundle deferred by subscription request.
+func (srv *Bundle) RegisterDownload(ctx context.Context, user *core.User, bundle *core.Bundle) (*BundleDownloadResult, error) {
+	if err := checkOwnerIsNotBanned(ctx, srv.User, bundle.OwnerID); err != nil {
+		return nil, err
+	}
+
+	return srv.registerDownload(ctx, user, bundle)
+}
+
+// registerDownload of each bundle file available for download.
+func (srv *Bundle) registerDownload(ctx context.Context, user *core.User, bundle *core.Bundle) (*BundleDownloadResult, error) {
+	files, err := srv.getFiles(ctx, bundle)
+	if err != nil {
+		return nil, errors.Wrap(err, "get bundle files")
+	}
+
+	available := make([]*core.File, 0, len(files))
+
+	for _, file := range files {
+		if file.IsViolatesCopyright.Valid && file.IsViolatesCopyright.Bool {
+			continue
+		}
+
+		if file.IsBlocked {
+			continue
+		}
+
+		// bundle has no password prompt, so protected file is not delivered
+		if file.Restriction.HasPassword() {
+			continue
+		}
+
+		available = append(available, file)
+	}
+
+	var sub bool
+
+	if bundle.Restriction.HasChats() {
+		sub, err = hasSubAwait(ctx, srv.Redis, srv.getSubAwaitKey(user.ID, bundle.ID))
+		if err != nil {
+			return nil, errors.Wrap(err, "check sub await")
+		}
+	}
+
+	if err := srv.Txier(ctx, func(ctx context.Context) error {
+		available, err = srv.filterFilesByLimits(ctx, user, available)
+		if err != nil {
+			return err
+		}
+
+		if len(available) == 0 {
+			return ErrBundleEmpty
+		}
+
+		downloads := core.NewBundleDownloads(bundle.ID, available, user.ID)
+
+		if bundle.Restriction.HasChats() {
+			for _, download := range downloads {
+				download.SetNewSubscription(sub)
+				download.ChatIDs = bundle.Restriction.ChatIDs
+			}
+		}
+
+		log.Info(ctx, "register bundle download", "bundle_id", bundle.ID, "files", len(downloads))
+		for _, download := range downloads {
+			if err := srv.Download.Add(ctx, download); err != nil {
+				return errors.Wrap(err, "add download to store")
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &BundleDownloadResult{
+		Bundle: bundle,
+		Files:  available,
+	}, nil
+}
+
+// filterFilesByLimits returns files which time and quota restrictions allow download by user.
+// Files with quota are locked, so concurrent downloads can't exceed it. Should be called in transaction.
+func (srv *Bundle) filterFilesByLimits(ctx context.Context, user *core.User, files []*core.File) ([]*core.File, error) {
+	result := make([]*core.File, 0, len(files))
+
+	for _, file := range files {
+		if file.Restriction.HasMaxDownloads() || file.Restriction.HasMaxDownloadsPerUser() {
+			locked, err := srv.File.Query().ID(file.ID).ForUpdate().One(ctx)
+			if err != nil {
+				return nil, errors.Wrap(err, "lock file")
+			}
+			file = locked
+		}
+
+		err := checkFileRestrictionsLimits(ctx, srv.Download, user, file)
+		switch {
+		case isFileLimitError(err):
+			continue
+		case err != nil:
+			return nil, errors.Wrap(err, "check file limits")
+		}
+
+		result = append(result, file)
+	}
+
+	return result, nil
+}
+
+// GetBundleByID returns bundle owned by user for manage.
+// Bundle of other user is not found.
+func (srv *Bundle) GetBundleByID(
+	ctx context.Context,
+	user *core.User,
+	id core.BundleID,
+) (*OwnedBundle, error) {
+	bundle, err := srv.Bundle.Query().OwnerID(user.ID).ID(id).One(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "find bundle by id")
+	}
+
+	owned, err := srv.newOwnedBundle(ctx, bundle, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "get owned bundle")
+	}
+
+	return owned, nil
+}
+
+func (srv *Bundle) GetBundleByPublicID(
+	ctx context.Context,
+	user *core.User,
+	publicID string,
+) (*BundleDownloadResult, error) {
+	bundle, err := srv.Bundle.Query().PublicID(publicID).One(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "find bundle by public id")
+	}
+
+	return srv.toDownloadResult(ctx, user, bundle)
+}
+
+type BundleChatRestrictionStatus struct {
+	Ok     bool
+	Sub    *ChatSubRequest
+	Bundle *core.Bundle
+}
+
+func (srv *Bundle) CheckBundleRestrictionsChat(
+	ctx context.Context,
+	user *core.User,
+	id core.BundleID,
+) (*BundleChatRestrictionStatus, error) {
+	bundle, err := srv.Bundle.Query().ID(id).One(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "query bundle by id")
+	}
+
+	if err := checkOwnerIsNotBanned(ctx, srv.User, bundle.OwnerID); err != nil {
+		return nil, err
+	}
+
+	sub, err := checkChatsSubscription(ctx, srv.Chat, srv.Telegram, user, &bundle.Restriction)
+	if err != nil {
+		return nil, err
+	}
+
+	if sub != nil {
+		sub.BundleID = bundle.ID
+	}
+
+	return &BundleChatRestrictionStatus{
+		Ok:     sub == nil,
+		Sub:    sub,
+		Bundle: bundle,
+	}, nil
+}
+
+// DeleteBundle with all it's files.
+func (srv *Bundle) DeleteBundle(
+	ctx context.Context,
+	user *core.User,
+	id core.BundleID,
+) error {
+	return srv.Txier(ctx, func(ctx context.Context) error {
+		bundle, err := srv.Bundle.Query().OwnerID(user.ID).ID(id).One(ctx)
+		if err != nil {
+			return errors.Wrap(err, "query bundle")
+		}
+
+		if err := srv.Bundle.Query().ID(bundle.ID).Delete(ctx); err != nil {
+			return errors.Wrap(err, "delete bundle in store")
+		}
+
+		if len(bundle.FileIDs) == 0 {
+			return nil
+		}
+
+		if err := srv.File.Query().
+			OwnerID(user.ID).
+			ID(bundle.FileIDs...).
+			Delete(ctx); err != nil && !errors.Is(err, core.ErrFileNotFound) {
+			return errors.Wrap(err, "delete files in store")
+		}
+
+		return nil
+	})
+}
+
+type SetBundleChatRestrictionResult struct {
+	Chat    *core.Chat
+	Bundle  *core.Bundle
+	Disable bool
+}
+
+// SetChatRestriction toggles subscription to specified chat in bundle restriction.
+func (srv *Bundle) SetChatRestriction(
+	ctx context.Context,
+	user *core.User,
+	bundleID core.BundleID,
+	chatID core.ChatID,
+) (*SetBundleChatRestrictionResult, error) {
+	log.Info(ctx,
+		"set bundle chat restriction",
+		"user_id", user.ID,
+		"bundle_id", bundleID,
+		"chat_id", chatID,
+	)
+
+	bundle, err := srv.Bundle.Query().OwnerID(user.ID).ID(bundleID).One(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "query bundle")
+	}
+
+	chat, err := srv.Chat.Query().OwnerID(user.ID).ID(chatID).One(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "query chat")
+	}
+
+	disable := !bundle.Restriction.ToggleChat(chat.ID)
+
+	if err := srv.Bundle.Update(ctx, bundle); err != nil {
+		return nil, errors.Wrap(err, "update bundle")
+	}
+
+	return &SetBundleChatRestrictionResult{
+		Chat:    chat,
+		Bundle:  bundle,
+		Disable: disable,
+	}, nil
+}
+
+// SetChatPolicy sets policy of subscription check, when bundle restricted by few chats.
+func (srv *Bundle) SetChatPolicy(
+	ctx context.Context,
+	user *core.User,
+	bundleID core.BundleID,
+	policy core.ChatPolicy,
+) (*core.Bundle, error) {
+	log.Info(ctx, "set bundle chat policy", "bundle_id", bundleID, "policy", policy.String())
+
+	bundle, err := srv.Bundle.Query().OwnerID(user.ID).ID(bundleID).One(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "query bundle")
+	}
+
+	bundle.Restriction.ChatPolicy = policy
+
+	if err := srv.Bundle.Update(ctx, bundle); err != nil {
+		return nil, errors.Wrap(err, "update bundle")
+	}
+
+	return bundle, nil
+}
+
+func (srv *Bundle) isPublicIDUsed(ctx context.Context, publicID string) (bool, error) {
+	return isPublicIDUsed(ctx, publicID, srv.File, srv.Bundle, srv.RevokedLink)
+}
@@ -0,0 +1,173 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/service"
+	"github.com/bots-house/share-file-bot/store/memory"
+	"github.com/friendsofgo/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestBundle_GetBundleByID(t *testing.T) {
+	ctx := context.Background()
+	mem := memory.New()
+
+	srv := &service.Bundle{
+		Bundle:   mem.Bundle(),
+		File:     mem.File(),
+		User:     mem.User(),
+		Download: mem.Download(),
+		Txier:    mem.Tx,
+	}
+
+	owner := core.NewUser(1, "Owner", "", "", "en")
+	user := core.NewUser(2, "User", "", "", "en")
+
+	for _, u := range []*core.User{owner, user} {
+		require.NoError(t, mem.User().Add(ctx, u))
+	}
+
+	file := core.NewFile("telegram", "", core.KindDocument, "text/plain", 100, "file.txt", owner.ID, false, core.Metadata{})
+	require.NoError(t, mem.File().Add(ctx, file))
+
+	bundle := core.NewBundle("", []core.FileID{file.ID}, owner.ID, false)
+	require.NoError(t, mem.Bundle().Add(ctx, bundle))
+
+	t.Run("Owner", func(t *testing.T) {
+		owned, err := srv.GetBundleByID(ctx, owner, bundle.ID)
+		require.NoError(t, err)
+		require.Equal(t, bundle.ID, owned.ID)
+		require.Len(t, owned.Files, 1)
+	})
+
+	t.Run("NotOwner", func(t *testing.T) {
+		_, err := srv.GetBundleByID(ctx, user, bundle.ID)
+		require.True(t, errors.Is(err, core.ErrBundleNotFound))
+
+		// bundle is not delivered to user
+		count, err := mem.Download().Query().UserID(user.ID).Count(ctx)
+		require.NoError(t, err)
+		require.Zero(t, count)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		_, err := srv.GetBundleByID(ctx, owner, bundle.ID+1)
+		require.True(t, errors.Is(err, core.ErrBundleNotFound))
+	})
+}
+
+func TestBundle_SkipPasswordProtectedFiles(t *testing.T) {
+	ctx := context.Background()
+	mem := memory.New()
+
+	srv := &service.Bundle{
+		Bundle:   mem.Bundle(),
+		File:     mem.File(),
+		User:     mem.User(),
+		Download: mem.Download(),
+		Txier:    mem.Tx,
+	}
+
+	owner := core.NewUser(1, "Owner", "", "", "en")
+	user := core.NewUser(2, "User", "", "", "en")
+
+	for _, u := range []*core.User{owner, user} {
+		require.NoError(t, mem.User().Add(ctx, u))
+	}
+
+	open := core.NewFile("telegram", "", core.KindDocument, "text/plain", 100, "open.txt", owner.ID, false, core.Metadata{})
+	require.NoError(t, mem.File().Add(ctx, open))
+
+	protected := core.NewFile("telegram", "", core.KindDocument, "text/plain", 100, "protected.txt", owner.ID, false, core.Metadata{})
+	protected.Restriction.SetPassword("secret")
+	require.NoError(t, mem.File().Add(ctx, protected))
+
+	bundle := core.NewBundle("", []core.FileID{open.ID, protected.ID}, owner.ID, false)
+	require.NoError(t, mem.Bundle().Add(ctx, bundle))
+
+	result, err := srv.GetBundleByPublicID(ctx, user, bundle.PublicID)
+	require.NoError(t, err)
+	require.Len(t, result.Files, 1)
+	require.Equal(t, open.ID, result.Files[0].ID)
+
+	count, err := mem.Download().Query().FileID(protected.ID).Count(ctx)
+	require.NoError(t, err)
+	require.Zero(t, count)
+
+	// bundle of only protected files is empty
+	only := core.NewBundle("", []core.FileID{protected.ID}, owner.ID, false)
+	require.NoError(t, mem.Bundle().Add(ctx, only))
+
+	_, err = srv.GetBundleByPublicID(ctx, user, only.PublicID)
+	require.True(t, errors.Is(err, service.ErrBundleEmpty))
+}
+
+func TestBundle_SkipFilesByLimits(t *testing.T) {
+	ctx := context.Background()
+	mem := memory.New()
+
+	srv := &service.Bundle{
+		Bundle:   mem.Bundle(),
+		File:     mem.File(),
+		User:     mem.User(),
+		Download: mem.Download(),
+		Txier:    mem.Tx,
+	}
+
+	owner := core.NewUser(1, "Owner", "", "", "en")
+	first := core.NewUser(2, "First", "", "", "en")
+	second := core.NewUser(3, "Second", "", "", "en")
+
+	for _, u := range []*core.User{owner, first, second} {
+		require.NoError(t, mem.User().Add(ctx, u))
+	}
+
+	newFile := func(name string, patch func(restriction *core.DownloadRestrictions)) *core.File {
+		file := core.NewFile("telegram", "", core.KindDocument, "text/plain", 100, name, owner.ID, false, core.Metadata{})
+		patch(&file.Restriction)
+		require.NoError(t, mem.File().Add(ctx, file))
+		return file
+	}
+
+	open := newFile("open.txt", func(restriction *core.DownloadRestrictions) {})
+	once := newFile("once.txt", func(restriction *core.DownloadRestrictions) {
+		restriction.MaxDownloads = 1
+	})
+	expired := newFile("expired.txt", func(restriction *core.DownloadRestrictions) {
+		restriction.ExpiresAt = null.TimeFrom(time.Now().Add(-time.Hour))
+	})
+	later := newFile("later.txt", func(restriction *core.DownloadRestrictions) {
+		restriction.AvailableFrom = null.TimeFrom(time.Now().Add(time.Hour))
+	})
+
+	bundle := core.NewBundle("", []core.FileID{open.ID, once.ID, expired.ID, later.ID}, owner.ID, false)
+	require.NoError(t, mem.Bundle().Add(ctx, bundle))
+
+	result, err := srv.GetBundleByPublicID(ctx, first, bundle.PublicID)
+	require.NoError(t, err)
+	require.Len(t, result.Files, 2)
+	require.Equal(t, open.ID, result.Files[0].ID)
+	require.Equal(t, once.ID, result.Files[1].ID)
+
+	// limit of downloads is reached by first user
+	result, err = srv.GetBundleByPublicID(ctx, second, bundle.PublicID)
+	require.NoError(t, err)
+	require.Len(t, result.Files, 1)
+	require.Equal(t, open.ID, result.Files[0].ID)
+
+	count, err := mem.Download().Query().FileID(once.ID).Count(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	// bundle of only unavailable files is empty
+	only := core.NewBundle("", []core.FileID{once.ID, expired.ID, later.ID}, owner.ID, false)
+	require.NoError(t, mem.Bundle().Add(ctx, only))
+
+	_, err = srv.GetBundleByPublicID(ctx, second, only.PublicID)
+	require.True(t, errors.Is(err, service.ErrBundleEmpty))
+}
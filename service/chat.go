@@ -319,6 +319,43 @@ func (info *ChannelPostInfo) Link() string {
 	return fmt.Sprintf("tg://%s?%s", action, args.Encode())
 }
 
+var (
+	rePostLink = regexp.MustCompile(
+		`^(?:https?://)?(?:t|telegram)\.me/(?:c/(\d+)|(?:s/)?([A-Za-z][A-Za-z0-9_]{3,31}))/(\d+)/?(?:[?#].*)?$`,
+	)
+
+	ErrInvalidPostLink = errors.New("invalid post link")
+)
+
+// ParsePostLink parses link to channel post, like t.me/channel/10 or t.me/c/1234567890/10 for private channels.
+func ParsePostLink(link string) (*ChannelPostInfo, error) {
+	match := rePostLink.FindStringSubmatch(strings.TrimSpace(link))
+	if match == nil {
+		return nil, ErrInvalidPostLink
+	}
+
+	postID, err := strconv.Atoi(match[3])
+	if err != nil || postID == 0 {
+		return nil, ErrInvalidPostLink
+	}
+
+	info := &ChannelPostInfo{
+		ChatUsername: match[2],
+		PostID:       postID,
+	}
+
+	if match[1] != "" {
+		peerID, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil || peerID == 0 {
+			return nil, ErrInvalidPostLink
+		}
+
+		info.ChatID = tg.MTProtoToBotID(peerID)
+	}
+
+	return info, nil
+}
+
 // ProcessChannelPostURIes called on each channel post and should scan for backlinks to bot.
 //
 // Flow:
@@ -407,6 +444,8 @@ func ExtractDeepLinkPublicID(botUsername string, uries []string) ([]string, erro
 			payload = payload[idx+1:]
 		}
 
+		payload, _ = SplitDeepLinkPlacement(payload)
+
 		publicIDs = append(publicIDs, payload)
 	}
 
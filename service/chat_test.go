@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestExtractDeepLinksPayload(t *testing.T) {
@@ -20,11 +21,15 @@ func TestExtractDeepLinksPayload(t *testing.T) {
 				"https://t.me/cleepy_bot?start=dVQK8",
 				"https://t.me/cleepy_bot?start=buJ9U30UdIMl1On6c0eUrxQ3UPKkinE1xcSGQPLT2BEcsDlVN9",
 				"https://t.me/cleepy_bot?start=ref_crosser-HndVA",
+				"https://t.me/cleepy_bot?start=Zx1Lq-12",
+				"https://t.me/cleepy_bot?start=ref_crosser-Zx1Lq-12",
 			},
 			Result: []string{
 				"dVQK8",
 				"buJ9U30UdIMl1On6c0eUrxQ3UPKkinE1xcSGQPLT2BEcsDlVN9",
 				"HndVA",
+				"Zx1Lq",
+				"Zx1Lq",
 			},
 		},
 	} {
@@ -33,3 +38,37 @@ func TestExtractDeepLinksPayload(t *testing.T) {
 		assert.Equal(t, test.Error, err)
 	}
 }
+
+func TestParsePostLink(t *testing.T) {
+	for _, test := range []struct {
+		Link string
+		URI  string
+	}{
+		{"https://t.me/share_file_news/10", "tg://resolve?domain=share_file_news&post=10"},
+		{"t.me/share_file_news/10?single", "tg://resolve?domain=share_file_news&post=10"},
+		{"https://t.me/s/share_file_news/10", "tg://resolve?domain=share_file_news&post=10"},
+		{"https://telegram.me/share_file_news/10/", "tg://resolve?domain=share_file_news&post=10"},
+		{"https://t.me/c/1129109101/25", "tg://privatepost?channel=1129109101&post=25"},
+	} {
+		t.Run(test.Link, func(t *testing.T) {
+			info, err := ParsePostLink(test.Link)
+			require.NoError(t, err)
+			assert.Equal(t, test.URI, info.Link())
+		})
+	}
+
+	for _, link := range []string{
+		"",
+		"share_file_news/10",
+		"https://t.me/share_file_news",
+		"https://t.me/share_file_news/0",
+		"https://t.me/joinchat/AAAAAES_pid_l6flZONwGQ",
+		"https://example.com/share_file_news/10",
+		"https://t.me/c/0/10",
+	} {
+		t.Run("Invalid"+link, func(t *testing.T) {
+			_, err := ParsePostLink(link)
+			assert.Equal(t, ErrInvalidPostLink, err)
+		})
+	}
+}
@@ -0,0 +1,228 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+)
+
+// DownloadExportPageSize is count of downloads fetched from store at once.
+const DownloadExportPageSize = 1000
+
+// ExportFormat define format of downloads export.
+type ExportFormat int8
+
+const (
+	ExportFormatCSV ExportFormat = iota
+	ExportFormatJSON
+)
+
+var ErrInvalidExportFormat = errors.New("export format is invalid")
+
+// Ext returns file extension of format without dot.
+func (format ExportFormat) Ext() string {
+	switch format {
+	case ExportFormatJSON:
+		return "json"
+	default:
+		return "csv"
+	}
+}
+
+type downloadLogFetcher func(ctx context.Context, after core.DownloadID, limit int) ([]*core.DownloadLogEntry, error)
+
+type downloadLogRecord struct {
+	UserID          int         `json:"user_id"`
+	Username        null.String `json:"username"`
+	At              time.Time   `json:"at"`
+	NewSubscription null.Bool   `json:"new_subscription"`
+}
+
+func newDownloadLogRecord(entry *core.DownloadLogEntry) *downloadLogRecord {
+	return &downloadLogRecord{
+		UserID:          int(entry.UserID),
+		Username:        entry.Username,
+		At:              entry.At.UTC(),
+		NewSubscription: entry.NewSubscription,
+	}
+}
+
+// downloadLogEncoder writes records of log one by one.
+type downloadLogEncoder interface {
+	Encode(record *downloadLogRecord) error
+	Close() error
+}
+
+type downloadLogCSVEncoder struct {
+	w *csv.Writer
+}
+
+func newDownloadLogCSVEncoder(w io.Writer) (*downloadLogCSVEncoder, error) {
+	enc := &downloadLogCSVEncoder{w: csv.NewWriter(w)}
+
+	if err := enc.w.Write([]string{"user_id", "username", "at", "new_subscription"}); err != nil {
+		return nil, errors.Wrap(err, "write header")
+	}
+
+	return enc, nil
+}
+
+func (enc *downloadLogCSVEncoder) Encode(record *downloadLogRecord) error {
+	username := ""
+	if record.Username.Valid {
+		username = record.Username.String
+	}
+
+	newSub := ""
+	if record.NewSubscription.Valid {
+		newSub = strconv.FormatBool(record.NewSubscription.Bool)
+	}
+
+	return enc.w.Write([]string{
+		strconv.Itoa(record.UserID),
+		username,
+		record.At.Format(time.RFC3339),
+		newSub,
+	})
+}
+
+func (enc *downloadLogCSVEncoder) Close() error {
+	enc.w.Flush()
+	return enc.w.Error()
+}
+
+// downloadLogJSONEncoder writes records as JSON array without buffering them.
+type downloadLogJSONEncoder struct {
+	w     io.Writer
+	count int
+}
+
+func newDownloadLogJSONEncoder(w io.Writer) (*downloadLogJSONEncoder, error) {
+	if _, err := io.WriteString(w, "["); err != nil {
+		return nil, errors.Wrap(err, "write array start")
+	}
+
+	return &downloadLogJSONEncoder{w: w}, nil
+}
+
+func (enc *downloadLogJSONEncoder) Encode(record *downloadLogRecord) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return errors.Wrap(err, "marshal record")
+	}
+
+	if enc.count > 0 {
+		data = append([]byte(",\n"), data...)
+	}
+
+	enc.count++
+
+	_, err = enc.w.Write(data)
+	return err
+}
+
+func (enc *downloadLogJSONEncoder) Close() error {
+	_, err := io.WriteString(enc.w, "]\n")
+	return err
+}
+
+func newDownloadLogEncoder(w io.Writer, format ExportFormat) (downloadLogEncoder, error) {
+	switch format {
+	case ExportFormatCSV:
+		return newDownloadLogCSVEncoder(w)
+	case ExportFormatJSON:
+		return newDownloadLogJSONEncoder(w)
+	default:
+		return nil, ErrInvalidExportFormat
+	}
+}
+
+// exportDownloadLog writes log page by page to w and returns count of written records.
+func exportDownloadLog(
+	ctx context.Context,
+	w io.Writer,
+	format ExportFormat,
+	fetch downloadLogFetcher,
+) (int, error) {
+	enc, err := newDownloadLogEncoder(w, format)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		after core.DownloadID
+		total int
+	)
+
+	for {
+		entries, err := fetch(ctx, after, DownloadExportPageSize)
+		if err != nil {
+			return total, errors.Wrap(err, "fetch log page")
+		}
+
+		for _, entry := range entries {
+			if err := enc.Encode(newDownloadLogRecord(entry)); err != nil {
+				return total, errors.Wrap(err, "encode record")
+			}
+		}
+
+		total += len(entries)
+
+		if len(entries) < DownloadExportPageSize {
+			break
+		}
+
+		after = entries[len(entries)-1].ID
+	}
+
+	if err := enc.Close(); err != nil {
+		return total, errors.Wrap(err, "close encoder")
+	}
+
+	return total, nil
+}
+
+// ExportDownloads writes downloads of owned file to w.
+// Returns count of exported downloads.
+func (srv *File) ExportDownloads(
+	ctx context.Context,
+	user *core.User,
+	id core.FileID,
+	format ExportFormat,
+	w io.Writer,
+) (int, error) {
+	file, err := srv.File.Query().OwnerID(user.ID).ID(id).One(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "query file")
+	}
+
+	return exportDownloadLog(ctx, w, format, func(ctx context.Context, after core.DownloadID, limit int) ([]*core.DownloadLogEntry, error) {
+		return srv.Download.GetFileLog(ctx, file.ID, after, limit)
+	})
+}
+
+// ExportDownloads writes downloads of files restricted by owned chat to w.
+// Returns count of exported downloads.
+func (srv *Chat) ExportDownloads(
+	ctx context.Context,
+	user *core.User,
+	id core.ChatID,
+	format ExportFormat,
+	w io.Writer,
+) (int, error) {
+	chat, err := srv.Chat.Query().OwnerID(user.ID).ID(id).One(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "query chat")
+	}
+
+	return exportDownloadLog(ctx, w, format, func(ctx context.Context, after core.DownloadID, limit int) ([]*core.DownloadLogEntry, error) {
+		return srv.Download.GetChatLog(ctx, chat.ID, after, limit)
+	})
+}
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/volatiletech/null/v8"
+)
+
+func newFakeDownloadLog(n int) downloadLogFetcher {
+	at := time.Date(2020, time.November, 20, 15, 0, 0, 0, time.UTC)
+
+	return func(ctx context.Context, after core.DownloadID, limit int) ([]*core.DownloadLogEntry, error) {
+		result := []*core.DownloadLogEntry{}
+
+		for id := int(after) + 1; id <= n && len(result) < limit; id++ {
+			result = append(result, &core.DownloadLogEntry{
+				ID:              core.DownloadID(id),
+				UserID:          core.UserID(id * 10),
+				Username:        null.NewString("user", id%2 == 0),
+				At:              at,
+				NewSubscription: null.NewBool(true, id == 1),
+			})
+		}
+
+		return result, nil
+	}
+}
+
+func TestExportDownloadLog(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("CSV", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+
+		total, err := exportDownloadLog(ctx, buf, ExportFormatCSV, newFakeDownloadLog(2))
+		assert.NoError(t, err)
+		assert.Equal(t, 2, total)
+		assert.Equal(t, "user_id,username,at,new_subscription\n"+
+			"10,,2020-11-20T15:00:00Z,true\n"+
+			"20,user,2020-11-20T15:00:00Z,\n", buf.String())
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+
+		total, err := exportDownloadLog(ctx, buf, ExportFormatJSON, newFakeDownloadLog(2))
+		assert.NoError(t, err)
+		assert.Equal(t, 2, total)
+		assert.JSONEq(t, `[
+			{"user_id": 10, "username": null, "at": "2020-11-20T15:00:00Z", "new_subscription": true},
+			{"user_id": 20, "username": "user", "at": "2020-11-20T15:00:00Z", "new_subscription": null}
+		]`, buf.String())
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+
+		total, err := exportDownloadLog(ctx, buf, ExportFormatJSON, newFakeDownloadLog(0))
+		assert.NoError(t, err)
+		assert.Zero(t, total)
+		assert.JSONEq(t, `[]`, buf.String())
+	})
+
+	t.Run("Paging", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+
+		total, err := exportDownloadLog(ctx, buf, ExportFormatCSV, newFakeDownloadLog(DownloadExportPageSize*2+1))
+		assert.NoError(t, err)
+		assert.Equal(t, DownloadExportPageSize*2+1, total)
+	})
+}
@@ -0,0 +1,232 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/friendsofgo/errors"
+)
+
+const (
+	SeriesStepHour = time.Hour
+	SeriesStepDay  = 24 * time.Hour
+
+	// SeriesMaxPoints is max count of buckets in one series.
+	SeriesMaxPoints = 31 * 24
+
+	statsDailyWindow  = 14 * SeriesStepDay
+	statsHourlyWindow = 24 * SeriesStepHour
+)
+
+// DownloadPeriodDays is lengths of periods compared on statistics screen.
+var DownloadPeriodDays = []int{7, 30}
+
+var ErrInvalidSeriesWindow = errors.New("invalid series window")
+
+// DownloadPeriod is downloads over last days compared with previous period of same length.
+type DownloadPeriod struct {
+	Days int
+
+	Current  *core.DownloadSeriesPoint
+	Previous *core.DownloadSeriesPoint
+}
+
+// Change returns change of total downloads against previous period in percents.
+func (period *DownloadPeriod) Change() (int, bool) {
+	return PercentChange(period.Current.Total, period.Previous.Total)
+}
+
+// PercentChange returns change of value against previous in percents.
+// If previous is zero, change is undefined and false is returned.
+func PercentChange(current, previous int) (int, bool) {
+	if previous == 0 {
+		return 0, false
+	}
+
+	return (current - previous) * 100 / previous, true
+}
+
+// DownloadStats is downloads statistics of file or chat.
+type DownloadStats struct {
+	Periods []*DownloadPeriod
+
+	// Downloads by day for last two weeks.
+	Daily []*core.DownloadSeriesPoint
+
+	// Downloads by hour for last day.
+	Hourly []*core.DownloadSeriesPoint
+
+	// Downloads by placement, only for files with placements.
+	Placements []*PlacementStats
+}
+
+type downloadSeriesFetcher func(ctx context.Context, query *core.DownloadSeriesQuery) ([]*core.DownloadSeriesPoint, error)
+
+// newDownloadSeriesQuery returns query of series with buckets aligned to step,
+// last bucket contains now.
+func newDownloadSeriesQuery(now time.Time, step, window time.Duration) (*core.DownloadSeriesQuery, error) {
+	if step <= 0 || window < step {
+		return nil, ErrInvalidSeriesWindow
+	}
+
+	points := window / step
+	if points > SeriesMaxPoints {
+		return nil, ErrInvalidSeriesWindow
+	}
+
+	to := now.UTC().Truncate(step).Add(step)
+
+	return &core.DownloadSeriesQuery{
+		From: to.Add(-points * step),
+		To:   to,
+		Step: step,
+	}, nil
+}
+
+func getDownloadSeries(
+	ctx context.Context,
+	fetch downloadSeriesFetcher,
+	step, window time.Duration,
+) ([]*core.DownloadSeriesPoint, error) {
+	query, err := newDownloadSeriesQuery(time.Now(), step, window)
+	if err != nil {
+		return nil, err
+	}
+
+	return fetch(ctx, query)
+}
+
+func getDownloadPeriod(
+	ctx context.Context,
+	fetch downloadSeriesFetcher,
+	now time.Time,
+	days int,
+) (*DownloadPeriod, error) {
+	length := time.Duration(days) * SeriesStepDay
+
+	series, err := fetch(ctx, &core.DownloadSeriesQuery{
+		From: now.Add(-2 * length),
+		To:   now,
+		Step: length,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "fetch series")
+	}
+
+	if len(series) != 2 {
+		return nil, errors.Errorf("expected 2 points of series, got %d", len(series))
+	}
+
+	return &DownloadPeriod{
+		Days:     days,
+		Previous: series[0],
+		Current:  series[1],
+	}, nil
+}
+
+func getDownloadStats(ctx context.Context, fetch downloadSeriesFetcher) (*DownloadStats, error) {
+	now := time.Now()
+
+	stats := &DownloadStats{
+		Periods: make([]*DownloadPeriod, len(DownloadPeriodDays)),
+	}
+
+	for i, days := range DownloadPeriodDays {
+		period, err := getDownloadPeriod(ctx, fetch, now, days)
+		if err != nil {
+			return nil, errors.Wrapf(err, "get period of %d days", days)
+		}
+		stats.Periods[i] = period
+	}
+
+	var err error
+
+	stats.Daily, err = getDownloadSeries(ctx, fetch, SeriesStepDay, statsDailyWindow)
+	if err != nil {
+		return nil, errors.Wrap(err, "get daily series")
+	}
+
+	stats.Hourly, err = getDownloadSeries(ctx, fetch, SeriesStepHour, statsHourlyWindow)
+	if err != nil {
+		return nil, errors.Wrap(err, "get hourly series")
+	}
+
+	return stats, nil
+}
+
+func (srv *File) newDownloadSeriesFetcher(id core.FileID) downloadSeriesFetcher {
+	return func(ctx context.Context, query *core.DownloadSeriesQuery) ([]*core.DownloadSeriesPoint, error) {
+		return srv.Download.GetFileSeries(ctx, id, query)
+	}
+}
+
+// GetDownloadSeries returns downloads series of owned file over window ending now.
+func (srv *File) GetDownloadSeries(
+	ctx context.Context,
+	user *core.User,
+	id core.FileID,
+	step, window time.Duration,
+) ([]*core.DownloadSeriesPoint, error) {
+	file, err := srv.File.Query().OwnerID(user.ID).ID(id).One(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "query file")
+	}
+
+	return getDownloadSeries(ctx, srv.newDownloadSeriesFetcher(file.ID), step, window)
+}
+
+// GetDownloadStats returns downloads statistics of owned file.
+func (srv *File) GetDownloadStats(ctx context.Context, user *core.User, id core.FileID) (*DownloadStats, error) {
+	file, err := srv.File.Query().OwnerID(user.ID).ID(id).One(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "query file")
+	}
+
+	stats, err := getDownloadStats(ctx, srv.newDownloadSeriesFetcher(file.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	direct, placements, err := srv.getPlacementStats(ctx, file)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(placements) > 0 {
+		stats.Placements = append([]*PlacementStats{direct}, placements...)
+	}
+
+	return stats, nil
+}
+
+func (srv *Chat) newDownloadSeriesFetcher(id core.ChatID) downloadSeriesFetcher {
+	return func(ctx context.Context, query *core.DownloadSeriesQuery) ([]*core.DownloadSeriesPoint, error) {
+		return srv.Download.GetChatSeries(ctx, id, query)
+	}
+}
+
+// GetDownloadSeries returns downloads series of files restricted by owned chat over window ending now.
+func (srv *Chat) GetDownloadSeries(
+	ctx context.Context,
+	user *core.User,
+	id core.ChatID,
+	step, window time.Duration,
+) ([]*core.DownloadSeriesPoint, error) {
+	chat, err := srv.Chat.Query().OwnerID(user.ID).ID(id).One(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "query chat")
+	}
+
+	return getDownloadSeries(ctx, srv.newDownloadSeriesFetcher(chat.ID), step, window)
+}
+
+// GetDownloadStats returns downloads statistics of files restricted by owned chat.
+func (srv *Chat) GetDownloadStats(ctx context.Context, user *core.User, id core.ChatID) (*DownloadStats, error) {
+	chat, err := srv.Chat.Query().OwnerID(user.ID).ID(id).One(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "query chat")
+	}
+
+	return getDownloadStats(ctx, srv.newDownloadSeriesFetcher(chat.ID))
+}
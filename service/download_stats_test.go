@@ -0,0 +1,52 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDownloadSeriesQuery(t *testing.T) {
+	now := time.Date(2020, time.November, 20, 15, 42, 0, 0, time.UTC)
+
+	query, err := newDownloadSeriesQuery(now, SeriesStepDay, 7*SeriesStepDay)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2020, time.November, 14, 0, 0, 0, 0, time.UTC), query.From)
+	assert.Equal(t, time.Date(2020, time.November, 21, 0, 0, 0, 0, time.UTC), query.To)
+
+	query, err = newDownloadSeriesQuery(now, SeriesStepHour, 3*SeriesStepHour)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2020, time.November, 20, 13, 0, 0, 0, time.UTC), query.From)
+	assert.Equal(t, time.Date(2020, time.November, 20, 16, 0, 0, 0, time.UTC), query.To)
+
+	_, err = newDownloadSeriesQuery(now, SeriesStepHour, 0)
+	assert.Equal(t, ErrInvalidSeriesWindow, err)
+
+	_, err = newDownloadSeriesQuery(now, SeriesStepHour, 365*SeriesStepDay)
+	assert.Equal(t, ErrInvalidSeriesWindow, err)
+}
+
+func TestDownloadPeriod_Change(t *testing.T) {
+	for _, test := range []struct {
+		Current  int
+		Previous int
+		Change   int
+		Ok       bool
+	}{
+		{Current: 15, Previous: 10, Change: 50, Ok: true},
+		{Current: 5, Previous: 10, Change: -50, Ok: true},
+		{Current: 10, Previous: 10, Change: 0, Ok: true},
+		{Current: 10, Previous: 0, Change: 0, Ok: false},
+	} {
+		period := &DownloadPeriod{
+			Current:  &core.DownloadSeriesPoint{Total: test.Current},
+			Previous: &core.DownloadSeriesPoint{Total: test.Previous},
+		}
+
+		change, ok := period.Change()
+		assert.Equal(t, test.Change, change)
+		assert.Equal(t, test.Ok, ok)
+	}
+}
@@ -3,24 +3,40 @@ package service
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/bots-house/share-file-bot/core"
 	"github.com/bots-house/share-file-bot/pkg/log"
+	"github.com/bots-house/share-file-bot/pkg/secretid"
 	"github.com/bots-house/share-file-bot/pkg/tg"
+	"github.com/bots-house/share-file-bot/store"
 	tgbotapi "github.com/bots-house/telegram-bot-api"
 	"github.com/friendsofgo/errors"
 	"github.com/go-redis/redis/v8"
+	"github.com/volatiletech/null/v8"
 	"golang.org/x/sync/errgroup"
 )
 
 type File struct {
 	File     core.FileStore
+	User     core.UserStore
 	Chat     core.ChatStore
 	Telegram *tgbotapi.BotAPI
 	Redis    redis.UniversalClient
 	Download core.DownloadStore
 
+	Placement   core.PlacementStore
+	FileVersion core.FileVersionStore
+	RevokedLink core.RevokedLinkStore
+	Bundle      core.BundleStore
+	Txier       store.Txier
+
+	ReservedPublicID core.ReservedPublicIDStore
+
+	// Encoder of public ids of new files, random ids are used if nil.
+	SecretID secretid.SecretID
+
 	IsUsersCanUploadFiles bool
 }
 
@@ -32,6 +48,8 @@ type InputFile struct {
 	Name     string
 	Size     int
 
+	CaptionEntities []core.TextEntity
+
 	Metadata core.Metadata
 }
 
@@ -76,38 +94,56 @@ func (srv *File) AddFile(
 		user.Settings.LongIDs,
 		in.Metadata,
 	)
+	doc.CaptionEntities = in.CaptionEntities
 
 	log.Info(ctx, "create file",
 		"name", in.Name,
 		"size", in.Size,
 		"kind", in.Kind.String(),
 	)
-	if err := srv.File.Add(ctx, doc); err != nil {
-		return nil, errors.Wrap(err, "add file to store")
+	if err := srv.Txier(ctx, func(ctx context.Context) error {
+		if err := srv.File.Add(ctx, doc); err != nil {
+			return errors.Wrap(err, "add file to store")
+		}
+
+		return encodePublicID(ctx, srv.SecretID, srv.File, doc, srv.isPublicIDUsed)
+	}); err != nil {
+		return nil, err
 	}
 
 	return srv.newOwnedFile(ctx, doc)
 }
 
-type ChatSubRequest struct {
-	FileID core.FileID
-
+// SubRequestChat is chat which user should join to get access.
+type SubRequestChat struct {
 	Title    string
 	Username string
 	JoinLink string
 }
 
-func (sub *ChatSubRequest) Link() string {
-	if sub.Username != "" {
-		return "https://t.me/" + sub.Username
+func (chat *SubRequestChat) Link() string {
+	if chat.Username != "" {
+		return "https://t.me/" + chat.Username
 	}
-	return sub.JoinLink
+	return chat.JoinLink
+}
+
+type ChatSubRequest struct {
+	FileID   core.FileID
+	BundleID core.BundleID
+
+	// With ChatPolicyAny it's enough to join one of chats.
+	Policy core.ChatPolicy
+
+	// Chats where user is not member yet.
+	Chats []*SubRequestChat
 }
 
 type DownloadResult struct {
-	File           *core.File
-	OwnedFile      *OwnedFile
-	ChatSubRequest *ChatSubRequest
+	File            *core.File
+	OwnedFile       *OwnedFile
+	ChatSubRequest  *ChatSubRequest
+	PasswordRequest *PasswordRequest
 }
 
 var (
@@ -120,7 +156,88 @@ func (srv *File) checkFileRestrictionsChat(
 	user *core.User,
 	file *core.File,
 ) (*ChatSubRequest, error) {
-	chat, err := srv.Chat.Query().ID(file.Restriction.ChatID).One(ctx)
+	sub, err := checkChatsSubscription(ctx, srv.Chat, srv.Telegram, user, &file.Restriction.ChatRestrictions)
+	if err != nil {
+		return nil, err
+	}
+
+	if sub != nil {
+		sub.FileID = file.ID
+	}
+
+	return sub, nil
+}
+
+// checkChatsSubscription concurrently checks membership of user in each restriction chat.
+// Returns subscription request with missing chats, if restriction is not satisfied by policy, otherwise nil.
+func checkChatsSubscription(
+	ctx context.Context,
+	chats core.ChatStore,
+	client *tgbotapi.BotAPI,
+	user *core.User,
+	restriction *core.ChatRestrictions,
+) (*ChatSubRequest, error) {
+	var (
+		wg      sync.WaitGroup
+		missing = make([]*SubRequestChat, len(restriction.ChatIDs))
+		errs    = make([]error, len(restriction.ChatIDs))
+	)
+
+	for i, chatID := range restriction.ChatIDs {
+		i, chatID := i, chatID
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			missing[i], errs[i] = checkChatSubscription(ctx, chats, client, user, chatID)
+		}()
+	}
+
+	wg.Wait()
+
+	sub := &ChatSubRequest{
+		Policy: restriction.ChatPolicy,
+	}
+
+	var firstErr error
+
+	for i := range restriction.ChatIDs {
+		switch {
+		case errs[i] != nil:
+			if firstErr == nil {
+				firstErr = errs[i]
+			}
+		case missing[i] == nil:
+			// one subscription is enough
+			if restriction.ChatPolicy == core.ChatPolicyAny {
+				return nil, nil
+			}
+		default:
+			sub.Chats = append(sub.Chats, missing[i])
+		}
+	}
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	if len(sub.Chats) == 0 {
+		return nil, nil
+	}
+
+	return sub, nil
+}
+
+// checkChatSubscription returns chat to join,
+// if user is not member of chat, otherwise nil.
+func checkChatSubscription(
+	ctx context.Context,
+	chats core.ChatStore,
+	client *tgbotapi.BotAPI,
+	user *core.User,
+	chatID core.ChatID,
+) (*SubRequestChat, error) {
+	chat, err := chats.Query().ID(chatID).One(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "query chat")
 	}
@@ -132,12 +249,12 @@ func (srv *File) checkFileRestrictionsChat(
 
 	g.Go(func() error {
 		var err error
-		tgChat, err = srv.Telegram.GetChat(tgbotapi.ChatConfig{
+		tgChat, err = client.GetChat(tgbotapi.ChatConfig{
 			ChatID: chat.TelegramID,
 		})
 
 		if tgChat.UserName == "" && tgChat.InviteLink == "" {
-			link, err := srv.Telegram.GetInviteLink(tgbotapi.ChatConfig{
+			link, err := client.GetInviteLink(tgbotapi.ChatConfig{
 				ChatID: tgChat.ID,
 			})
 			if err != nil {
@@ -155,7 +272,7 @@ func (srv *File) checkFileRestrictionsChat(
 
 	g.Go(func() error {
 		var err error
-		tgMember, err = srv.Telegram.GetChatMember(tgbotapi.ChatConfigWithUser{
+		tgMember, err = client.GetChatMember(tgbotapi.ChatConfigWithUser{
 			ChatID: chat.TelegramID,
 			UserID: int(user.ID),
 		})
@@ -169,8 +286,7 @@ func (srv *File) checkFileRestrictionsChat(
 	}
 
 	if !(tgMember.IsMember() || tgMember.IsAdministrator() || tgMember.IsCreator()) {
-		return &ChatSubRequest{
-			FileID:   file.ID,
+		return &SubRequestChat{
 			Title:    chat.Title,
 			Username: tgChat.UserName,
 			JoinLink: tgChat.InviteLink,
@@ -185,24 +301,29 @@ func (srv *File) getSubAwaitKey(userID core.UserID, fileID core.FileID) string {
 }
 
 func (srv *File) registerSubAwait(ctx context.Context, user *core.User, fileID core.FileID) error {
-	key := srv.getSubAwaitKey(user.ID, fileID)
-	if err := srv.Redis.Set(ctx, key, true, time.Hour).Err(); err != nil {
+	return registerSubAwait(ctx, srv.Redis, srv.getSubAwaitKey(user.ID, fileID))
+}
+
+func (srv *File) hasSubAwait(ctx context.Context, user *core.User, fileID core.FileID) (bool, error) {
+	return hasSubAwait(ctx, srv.Redis, srv.getSubAwaitKey(user.ID, fileID))
+}
+
+func registerSubAwait(ctx context.Context, rdb redis.UniversalClient, key string) error {
+	if err := rdb.Set(ctx, key, true, time.Hour).Err(); err != nil {
 		return errors.Wrap(err, "set key")
 	}
 	return nil
 }
 
-func (srv *File) hasSubAwait(ctx context.Context, user *core.User, fileID core.FileID) (bool, error) {
-	key := srv.getSubAwaitKey(user.ID, fileID)
-
-	if err := srv.Redis.Get(ctx, key).Err(); err == redis.Nil {
+func hasSubAwait(ctx context.Context, rdb redis.UniversalClient, key string) (bool, error) {
+	if err := rdb.Get(ctx, key).Err(); err == redis.Nil {
 		return false, nil
 	} else if err != nil {
 		return false, errors.Wrap(err, "get key")
 	}
 
 	go func() {
-		if err := srv.Redis.Del(ctx, key).Err(); err != nil {
+		if err := rdb.Del(ctx, key).Err(); err != nil {
 			log.Warn(ctx, "can't delete key", "key", key, "err", err)
 		}
 	}()
@@ -210,7 +331,85 @@ func (srv *File) hasSubAwait(ctx context.Context, user *core.User, fileID core.F
 	return true, nil
 }
 
-func (srv *File) toDownloadResult(ctx context.Context, user *core.User, file *core.File) (*DownloadResult, error) {
+var (
+	ErrFileExpired                   = errors.New("file is expired")
+	ErrFileDownloadsLimitReached     = errors.New("file downloads limit reached")
+	ErrFileUserDownloadsLimitReached = errors.New("file downloads limit per user reached")
+)
+
+// FileNotAvailableYetError is returned when file is requested before activation time.
+type FileNotAvailableYetError struct {
+	From time.Time
+}
+
+func (err *FileNotAvailableYetError) Error() string {
+	return fmt.Sprintf("file is not available until %s", err.From.Format(time.RFC3339))
+}
+
+// checkFileRestrictionsLimits checks time and quota restrictions of file.
+func checkFileRestrictionsLimits(
+	ctx context.Context,
+	downloads core.DownloadStore,
+	user *core.User,
+	file *core.File,
+) error {
+	now := time.Now()
+
+	if !file.Restriction.IsAvailableYet(now) {
+		return &FileNotAvailableYetError{From: file.Restriction.AvailableFrom.Time}
+	}
+
+	if file.Restriction.IsExpired(now) {
+		return ErrFileExpired
+	}
+
+	if file.Restriction.HasMaxDownloads() {
+		total, err := downloads.Query().FileID(file.ID).Count(ctx)
+		if err != nil {
+			return errors.Wrap(err, "count file downloads")
+		}
+
+		if total >= file.Restriction.MaxDownloads {
+			return ErrFileDownloadsLimitReached
+		}
+	}
+
+	if file.Restriction.HasMaxDownloadsPerUser() {
+		total, err := downloads.Query().FileID(file.ID).UserID(user.ID).Count(ctx)
+		if err != nil {
+			return errors.Wrap(err, "count user downloads")
+		}
+
+		if total >= file.Restriction.MaxDownloadsPerUser {
+			return ErrFileUserDownloadsLimitReached
+		}
+	}
+
+	return nil
+}
+
+// isFileLimitError returns true if err is returned by checkFileRestrictionsLimits because of restriction.
+func isFileLimitError(err error) bool {
+	var notAvailableYet *FileNotAvailableYetError
+
+	return errors.Is(err, ErrFileExpired) ||
+		errors.Is(err, ErrFileDownloadsLimitReached) ||
+		errors.Is(err, ErrFileUserDownloadsLimitReached) ||
+		errors.As(err, &notAvailableYet)
+}
+
+// toDownloadResult returns result of download file by user.
+// Placement is zero, if file is requested not by placement link.
+func (srv *File) toDownloadResult(
+	ctx context.Context,
+	user *core.User,
+	file *core.File,
+	placement core.PlacementID,
+) (*DownloadResult, error) {
+	if err := srv.checkFileModeration(ctx, file); err != nil {
+		return nil, err
+	}
+
 	// if user is owner of this docs we just display it
 	if file.OwnerID == user.ID {
 		ownedFile, err := srv.newOwnedFile(ctx, file)
@@ -222,8 +421,26 @@ func (srv *File) toDownloadResult(ctx context.Context, user *core.User, file *co
 		}, nil
 	}
 
+	if err := checkFileRestrictionsLimits(ctx, srv.Download, user, file); err != nil {
+		return nil, err
+	}
+
+	// check password
+	unlocked, err := srv.isPasswordUnlocked(ctx, user, file)
+	if err != nil {
+		return nil, errors.Wrap(err, "check password unlock")
+	}
+
+	if !unlocked {
+		if err := srv.rememberPlacement(ctx, user, file, placement); err != nil {
+			return nil, err
+		}
+
+		return srv.requestPassword(ctx, user, file)
+	}
+
 	// check user subscription
-	if file.Restriction.HasChatID() {
+	if file.Restriction.HasChats() {
 		sub, err := srv.checkFileRestrictionsChat(ctx, user, file)
 		if err != nil {
 			return nil, errors.Wrap(err, "check file restrictions chat")
@@ -235,33 +452,84 @@ func (srv *File) toDownloadResult(ctx context.Context, user *core.User, file *co
 				return nil, errors.Wrap(err, "can't add user to await list")
 			}
 
+			if err := srv.rememberPlacement(ctx, user, file, placement); err != nil {
+				return nil, err
+			}
+
 			return &DownloadResult{
 				ChatSubRequest: sub,
 			}, nil
 		}
 	}
 
-	return srv.RegisterDownload(ctx, user, file)
+	return srv.registerDownload(ctx, user, file, placement)
 }
 
+// RegisterDownload of file deferred by subscription request.
 func (srv *File) RegisterDownload(ctx context.Context, user *core.User, file *core.File) (*DownloadResult, error) {
+	if err := srv.checkFileModeration(ctx, file); err != nil {
+		return nil, err
+	}
+
+	var placement core.PlacementID
+
+	if file.Restriction.HasChats() {
+		var err error
+
+		placement, err = srv.popPlacement(ctx, user, file)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return srv.registerDownload(ctx, user, file, placement)
+}
+
+func (srv *File) registerDownload(
+	ctx context.Context,
+	user *core.User,
+	file *core.File,
+	placement core.PlacementID,
+) (*DownloadResult, error) {
 	// register download
-	download := core.NewDownload(file.ID, user.ID)
+	download := core.NewFileDownload(file, user.ID)
+	download.PlacementID = placement
 
-	if file.Restriction.HasChatID() {
+	if file.Restriction.HasChats() {
 		sub, err := srv.hasSubAwait(ctx, user, file.ID)
 		if err != nil {
 			return nil, errors.Wrap(err, "check sub await")
 		}
 
 		download.SetNewSubscription(sub)
+		download.ChatIDs = file.Restriction.ChatIDs
 	}
 
-	log.Info(ctx, "register download", "file_id", file.ID)
-	if err := srv.Download.Add(ctx, download); err != nil {
-		return nil, errors.Wrap(err, "add download to store")
+	log.Info(ctx, "register download", "file_id", file.ID, "placement_id", placement)
+	if err := srv.Txier(ctx, func(ctx context.Context) error {
+		if file.Restriction.HasMaxDownloads() || file.Restriction.HasMaxDownloadsPerUser() {
+			// recheck limits with lock of file, so concurrent downloads can't exceed them
+			locked, err := srv.File.Query().ID(file.ID).ForUpdate().One(ctx)
+			if err != nil {
+				return errors.Wrap(err, "lock file")
+			}
+
+			if err := checkFileRestrictionsLimits(ctx, srv.Download, user, locked); err != nil {
+				return err
+			}
+		}
+
+		if err := srv.Download.Add(ctx, download); err != nil {
+			return errors.Wrap(err, "add download to store")
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
+	srv.consumePasswordUnlock(ctx, user, file)
+
 	return &DownloadResult{
 		File: file,
 	}, nil
@@ -269,7 +537,7 @@ func (srv *File) RegisterDownload(ctx context.Context, user *core.User, file *co
 
 type ChatRestrictionStatus struct {
 	Ok   bool
-	Chat *core.Chat
+	Sub  *ChatSubRequest
 	File *core.File
 }
 
@@ -283,29 +551,31 @@ func (srv *File) CheckFileRestrictionsChat(
 		return nil, errors.Wrap(err, "query file by id")
 	}
 
-	if !file.Restriction.HasChatID() {
-		return &ChatRestrictionStatus{Ok: true, File: file}, nil
+	if err := srv.checkFileModeration(ctx, file); err != nil {
+		return nil, err
+	}
+
+	if err := checkFileRestrictionsLimits(ctx, srv.Download, user, file); err != nil {
+		return nil, err
 	}
 
-	chat, err := srv.Chat.Query().ID(file.Restriction.ChatID).One(ctx)
+	unlocked, err := srv.isPasswordUnlocked(ctx, user, file)
 	if err != nil {
-		return nil, errors.Wrap(err, "query chat by restriction")
+		return nil, errors.Wrap(err, "check password unlock")
 	}
 
-	member, err := srv.Telegram.GetChatMember(tgbotapi.ChatConfigWithUser{
-		ChatID: chat.TelegramID,
-		UserID: int(user.ID),
-	})
+	if !unlocked {
+		return nil, ErrFilePasswordRequired
+	}
 
-	if tg.IsCantCheckChatMember(err) {
-		return nil, ErrCantCheckMembership
-	} else if err != nil {
-		return nil, errors.Wrap(err, "get chat member")
+	sub, err := srv.checkFileRestrictionsChat(ctx, user, file)
+	if err != nil {
+		return nil, err
 	}
 
 	return &ChatRestrictionStatus{
-		Ok:   member.IsMember() || member.IsAdministrator() || member.IsCreator(),
-		Chat: chat,
+		Ok:   sub == nil,
+		Sub:  sub,
 		File: file,
 	}, nil
 }
@@ -320,26 +590,63 @@ func (srv *File) GetFileByID(
 		return nil, errors.Wrap(err, "find file by id")
 	}
 
-	return srv.toDownloadResult(ctx, user, doc)
+	// owner can manage file even if it's moderated
+	if doc.OwnerID == user.ID {
+		ownedFile, err := srv.newOwnedFile(ctx, doc)
+		if err != nil {
+			return nil, errors.Wrap(err, "get owned doc")
+		}
+		return &DownloadResult{
+			OwnedFile: ownedFile,
+		}, nil
+	}
+
+	return srv.toDownloadResult(ctx, user, doc, 0)
 }
 
 var ErrFileViolatesCopyright = errors.New("file violates copyright")
 
+// checkFileModeration returns error if file can't be served because of moderation.
+func (srv *File) checkFileModeration(ctx context.Context, file *core.File) error {
+	if file.IsViolatesCopyright.Valid && file.IsViolatesCopyright.Bool {
+		return ErrFileViolatesCopyright
+	}
+
+	if file.IsBlocked {
+		return ErrFileBlocked
+	}
+
+	return checkOwnerIsNotBanned(ctx, srv.User, file.OwnerID)
+}
+
 func (srv *File) GetFileByPublicID(
 	ctx context.Context,
 	user *core.User,
 	publicID string,
 ) (*DownloadResult, error) {
-	file, err := srv.File.Query().PublicID(publicID).One(ctx)
+	return srv.getFileByPublicID(ctx, user, publicID, 0)
+}
+
+func (srv *File) getFileByPublicID(
+	ctx context.Context,
+	user *core.User,
+	publicID string,
+	placement core.PlacementID,
+) (*DownloadResult, error) {
+	file, err := srv.findFileByPublicID(ctx, publicID)
+	if errors.Is(err, core.ErrFileNotFound) {
+		file, err = srv.getFileByRevokedLink(ctx, publicID)
+	}
 	if err != nil {
 		return nil, errors.Wrap(err, "find file by public id")
 	}
 
-	if file.IsViolatesCopyright.Valid && file.IsViolatesCopyright.Bool {
-		return nil, ErrFileViolatesCopyright
+	placement, err = srv.checkPlacement(ctx, file, placement)
+	if err != nil {
+		return nil, err
 	}
 
-	return srv.toDownloadResult(ctx, user, file)
+	return srv.toDownloadResult(ctx, user, file, placement)
 }
 
 func (srv *File) DeleteFile(
@@ -364,7 +671,7 @@ type SetChatRestrictionResult struct {
 	Disable bool
 }
 
-// SetChatRestriction changes chat restriction to specified chat.
+// SetChatRestriction toggles subscription to specified chat in file restriction.
 func (srv *File) SetChatRestriction(
 	ctx context.Context,
 	user *core.User,
@@ -389,13 +696,7 @@ func (srv *File) SetChatRestriction(
 		return nil, errors.Wrap(err, "query chat")
 	}
 
-	disable := file.Restriction.ChatID == chatID
-
-	if disable {
-		file.Restriction.ChatID = core.ZeroChatID
-	} else {
-		file.Restriction.ChatID = chat.ID
-	}
+	disable := !file.Restriction.ToggleChat(chat.ID)
 
 	if err := srv.File.Update(ctx, file); err != nil {
 		return nil, errors.Wrap(err, "update file")
@@ -407,3 +708,94 @@ func (srv *File) SetChatRestriction(
 		Disable: disable,
 	}, nil
 }
+
+// updateRestriction applies patch to restrictions of file owned by user.
+func (srv *File) updateRestriction(
+	ctx context.Context,
+	user *core.User,
+	fileID core.FileID,
+	patch func(restriction *core.DownloadRestrictions),
+) (*core.File, error) {
+	file, err := srv.File.Query().OwnerID(user.ID).ID(fileID).One(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "query file")
+	}
+
+	patch(&file.Restriction)
+
+	if err := srv.File.Update(ctx, file); err != nil {
+		return nil, errors.Wrap(err, "update file")
+	}
+
+	return file, nil
+}
+
+// SetDownloadsLimit sets max total downloads of file. Zero disables limit.
+func (srv *File) SetDownloadsLimit(
+	ctx context.Context,
+	user *core.User,
+	fileID core.FileID,
+	limit int,
+) (*core.File, error) {
+	log.Info(ctx, "set downloads limit", "file_id", fileID, "limit", limit)
+
+	return srv.updateRestriction(ctx, user, fileID, func(restriction *core.DownloadRestrictions) {
+		restriction.MaxDownloads = limit
+	})
+}
+
+// SetUserDownloadsLimit sets max downloads of file by one user. Zero disables limit.
+func (srv *File) SetUserDownloadsLimit(
+	ctx context.Context,
+	user *core.User,
+	fileID core.FileID,
+	limit int,
+) (*core.File, error) {
+	log.Info(ctx, "set user downloads limit", "file_id", fileID, "limit", limit)
+
+	return srv.updateRestriction(ctx, user, fileID, func(restriction *core.DownloadRestrictions) {
+		restriction.MaxDownloadsPerUser = limit
+	})
+}
+
+// SetExpiresAt sets time of file expiration. Null disables expiration.
+func (srv *File) SetExpiresAt(
+	ctx context.Context,
+	user *core.User,
+	fileID core.FileID,
+	at null.Time,
+) (*core.File, error) {
+	log.Info(ctx, "set expires at", "file_id", fileID, "at", at)
+
+	return srv.updateRestriction(ctx, user, fileID, func(restriction *core.DownloadRestrictions) {
+		restriction.ExpiresAt = at
+	})
+}
+
+// SetAvailableFrom sets time of file activation. Null makes file available right now.
+func (srv *File) SetAvailableFrom(
+	ctx context.Context,
+	user *core.User,
+	fileID core.FileID,
+	at null.Time,
+) (*core.File, error) {
+	log.Info(ctx, "set available from", "file_id", fileID, "at", at)
+
+	return srv.updateRestriction(ctx, user, fileID, func(restriction *core.DownloadRestrictions) {
+		restriction.AvailableFrom = at
+	})
+}
+
+// SetChatPolicy sets policy of subscription check, when file restricted by few chats.
+func (srv *File) SetChatPolicy(
+	ctx context.Context,
+	user *core.User,
+	fileID core.FileID,
+	policy core.ChatPolicy,
+) (*core.File, error) {
+	log.Info(ctx, "set chat policy", "file_id", fileID, "policy", policy.String())
+
+	return srv.updateRestriction(ctx, user, fileID, func(restriction *core.DownloadRestrictions) {
+		restriction.ChatPolicy = policy
+	})
+}
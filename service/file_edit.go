@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/pkg/log"
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+)
+
+const (
+	// FileCaptionMaxLength is max length of caption in UTF-16 code units, limited by Telegram.
+	FileCaptionMaxLength = 1024
+
+	// FileNameMaxLength is max length of file name in runes.
+	FileNameMaxLength = 255
+)
+
+var (
+	ErrFileCaptionTooLong = errors.New("file caption is too long")
+	ErrFileNameInvalid    = errors.New("file name is invalid")
+)
+
+// updateFile applies patch to file owned by user.
+func (srv *File) updateFile(
+	ctx context.Context,
+	user *core.User,
+	fileID core.FileID,
+	patch func(file *core.File),
+) (*OwnedFile, error) {
+	file, err := srv.File.Query().OwnerID(user.ID).ID(fileID).One(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "query file")
+	}
+
+	patch(file)
+
+	if err := srv.File.Update(ctx, file); err != nil {
+		return nil, errors.Wrap(err, "update file")
+	}
+
+	return srv.newOwnedFile(ctx, file)
+}
+
+// SetCaption sets caption of file with formatting entities. Empty caption removes it.
+func (srv *File) SetCaption(
+	ctx context.Context,
+	user *core.User,
+	fileID core.FileID,
+	caption string,
+	entities []core.TextEntity,
+) (*OwnedFile, error) {
+	if len(utf16.Encode([]rune(caption))) > FileCaptionMaxLength {
+		return nil, ErrFileCaptionTooLong
+	}
+
+	log.Info(ctx, "set file caption", "file_id", fileID, "length", len(caption))
+
+	return srv.updateFile(ctx, user, fileID, func(file *core.File) {
+		file.Caption = null.NewString(caption, caption != "")
+
+		if caption != "" {
+			file.CaptionEntities = entities
+		} else {
+			file.CaptionEntities = nil
+		}
+	})
+}
+
+// RenameFile sets name of file shown in library and search.
+// Name of document sent by Telegram is not changed.
+func (srv *File) RenameFile(
+	ctx context.Context,
+	user *core.User,
+	fileID core.FileID,
+	name string,
+) (*OwnedFile, error) {
+	name = strings.TrimSpace(name)
+
+	if name == "" || utf8.RuneCountInString(name) > FileNameMaxLength || strings.ContainsAny(name, "\r\n") {
+		return nil, ErrFileNameInvalid
+	}
+
+	log.Info(ctx, "rename file", "file_id", fileID, "name", name)
+
+	return srv.updateFile(ctx, user, fileID, func(file *core.File) {
+		file.Name = name
+	})
+}
+
+// SetLinkedPost links file to channel post. Nil post unlinks it.
+func (srv *File) SetLinkedPost(
+	ctx context.Context,
+	user *core.User,
+	fileID core.FileID,
+	post *ChannelPostInfo,
+) (*OwnedFile, error) {
+	uri := null.String{}
+	if post != nil {
+		uri = null.StringFrom(post.Link())
+	}
+
+	log.Info(ctx, "set file linked post", "file_id", fileID, "uri", uri.String)
+
+	return srv.updateFile(ctx, user, fileID, func(file *core.File) {
+		file.LinkedPostURI = uri
+	})
+}
@@ -0,0 +1,81 @@
+package service_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/service"
+	"github.com/bots-house/share-file-bot/store/memory"
+	"github.com/friendsofgo/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFile_Edit(t *testing.T) {
+	ctx := context.Background()
+	mem := memory.New()
+
+	srv := &service.File{
+		File:     mem.File(),
+		User:     mem.User(),
+		Download: mem.Download(),
+		Txier:    mem.Tx,
+	}
+
+	owner := core.NewUser(1, "Owner", "", "", "en")
+	user := core.NewUser(2, "User", "", "", "en")
+
+	for _, u := range []*core.User{owner, user} {
+		require.NoError(t, mem.User().Add(ctx, u))
+	}
+
+	file := core.NewFile("telegram", "old", core.KindDocument, "text/plain", 100, "file.txt", owner.ID, false, core.Metadata{})
+	require.NoError(t, mem.File().Add(ctx, file))
+
+	// caption
+	entities := []core.TextEntity{{Type: "bold", Offset: 0, Length: 3}}
+
+	_, err := srv.SetCaption(ctx, user, file.ID, "new caption", entities)
+	require.True(t, errors.Is(err, core.ErrFileNotFound))
+
+	_, err = srv.SetCaption(ctx, owner, file.ID, strings.Repeat("a", service.FileCaptionMaxLength+1), nil)
+	require.True(t, errors.Is(err, service.ErrFileCaptionTooLong))
+
+	edited, err := srv.SetCaption(ctx, owner, file.ID, "new caption", entities)
+	require.NoError(t, err)
+	require.Equal(t, "new caption", edited.Caption.String)
+	require.Equal(t, entities, edited.CaptionEntities)
+
+	edited, err = srv.SetCaption(ctx, owner, file.ID, "", nil)
+	require.NoError(t, err)
+	require.False(t, edited.Caption.Valid)
+	require.Empty(t, edited.CaptionEntities)
+
+	// name
+	for _, name := range []string{" ", "a\nb", strings.Repeat("a", service.FileNameMaxLength+1)} {
+		_, err = srv.RenameFile(ctx, owner, file.ID, name)
+		require.True(t, errors.Is(err, service.ErrFileNameInvalid), name)
+	}
+
+	edited, err = srv.RenameFile(ctx, owner, file.ID, " report.txt ")
+	require.NoError(t, err)
+	require.Equal(t, "report.txt", edited.Name)
+
+	// linked post
+	edited, err = srv.SetLinkedPost(ctx, owner, file.ID, &service.ChannelPostInfo{
+		ChatUsername: "share_file_news",
+		PostID:       10,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "tg://resolve?domain=share_file_news&post=10", edited.LinkedPostURI.String)
+
+	edited, err = srv.SetLinkedPost(ctx, owner, file.ID, nil)
+	require.NoError(t, err)
+	require.False(t, edited.HasLinkedPostURI())
+
+	found, err := mem.File().Query().ID(file.ID).One(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "report.txt", found.Name)
+	require.False(t, found.Caption.Valid)
+}
@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/pkg/log"
+	"github.com/friendsofgo/errors"
+)
+
+// FileInlinePageSize is count of files in one answer to inline query.
+// Telegram allows at most 50 results per answer.
+const FileInlinePageSize = 20
+
+// fileInlineKinds are kinds of files which can be sent as inline result.
+var fileInlineKinds = []core.Kind{
+	core.KindDocument,
+	core.KindAnimation,
+	core.KindAudio,
+	core.KindPhoto,
+	core.KindVideo,
+	core.KindVoice,
+	core.KindSticker,
+	core.KindVideoNote,
+	core.KindContact,
+	core.KindLocation,
+}
+
+// FileInlinePage is one page of owner files found by inline query.
+type FileInlinePage struct {
+	Files []*core.File
+
+	// Offset of next page. Zero means no more pages.
+	NextOffset int
+}
+
+// SearchInline returns files owned by user, which name or caption contains query.
+// Empty query returns all files of user.
+func (srv *File) SearchInline(
+	ctx context.Context,
+	user *core.User,
+	text string,
+	offset int,
+) (*FileInlinePage, error) {
+	text = strings.TrimSpace(text)
+
+	if utf8.RuneCountInString(text) > FileLibrarySearchMaxLength {
+		return nil, ErrFileLibrarySearchInvalidLength
+	}
+
+	if offset < 0 {
+		offset = 0
+	}
+
+	// files of banned owner are not delivered
+	if user.IsBanned {
+		return &FileInlinePage{Files: []*core.File{}}, nil
+	}
+
+	// inline results are sent by telegram id, so moderated files are excluded by query,
+	// as well as files which can't be sent inline, to keep pages full
+	query := srv.File.Query().
+		OwnerID(user.ID).
+		NotModerated().
+		Kind(fileInlineKinds...)
+
+	if text != "" {
+		query = query.Search(text)
+	}
+
+	// query one extra file to detect next page
+	files, err := query.
+		OrderBy(core.FileOrderCreatedAt).
+		Offset(offset).
+		Limit(FileInlinePageSize + 1).
+		All(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "query files")
+	}
+
+	result := &FileInlinePage{
+		Files: files,
+	}
+
+	if len(files) > FileInlinePageSize {
+		result.Files = files[:FileInlinePageSize]
+		result.NextOffset = offset + FileInlinePageSize
+	}
+
+	return result, nil
+}
+
+// RegisterInlineDownload registers download of file sent by owner via inline mode.
+func (srv *File) RegisterInlineDownload(
+	ctx context.Context,
+	user *core.User,
+	id core.FileID,
+) error {
+	file, err := srv.File.Query().
+		OwnerID(user.ID).
+		ID(id).
+		One(ctx)
+	if err != nil {
+		return errors.Wrap(err, "query file")
+	}
+
+	if err := srv.checkFileModeration(ctx, file); err != nil {
+		return err
+	}
+
+	log.Info(ctx, "register inline download", "file_id", file.ID)
+	if err := srv.Download.Add(ctx, core.NewInlineDownload(file, user.ID)); err != nil {
+		return errors.Wrap(err, "add download to store")
+	}
+
+	return nil
+}
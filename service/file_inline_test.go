@@ -0,0 +1,228 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/service"
+	"github.com/bots-house/share-file-bot/store/memory"
+	"github.com/friendsofgo/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+// recordingDownloadStore remembers downloads added to store.
+type recordingDownloadStore struct {
+	core.DownloadStore
+	added []*core.Download
+}
+
+func (store *recordingDownloadStore) Add(ctx context.Context, dwn *core.Download) error {
+	if err := store.DownloadStore.Add(ctx, dwn); err != nil {
+		return err
+	}
+
+	store.added = append(store.added, dwn)
+
+	return nil
+}
+
+func TestFile_SearchInline(t *testing.T) {
+	ctx := context.Background()
+	mem := memory.New()
+
+	srv := &service.File{
+		File: mem.File(),
+	}
+
+	owner := core.NewUser(1, "Owner", "", "", "en")
+	require.NoError(t, mem.User().Add(ctx, owner))
+
+	stranger := core.NewUser(2, "Stranger", "", "", "en")
+	require.NoError(t, mem.User().Add(ctx, stranger))
+
+	addFile := func(ownerID core.UserID, name, caption string) *core.File {
+		file := core.NewFile("telegram", caption, core.KindDocument, "text/plain", 100, name, ownerID, false, core.Metadata{})
+		require.NoError(t, mem.File().Add(ctx, file))
+		return file
+	}
+
+	report := addFile(owner.ID, "report.pdf", "")
+	notes := addFile(owner.ID, "notes.txt", "Quarterly REPORT draft")
+	addFile(owner.ID, "photo.jpg", "")
+	addFile(stranger.ID, "report.docx", "")
+
+	violates := addFile(owner.ID, "report.zip", "")
+	violates.IsViolatesCopyright = null.BoolFrom(true)
+	require.NoError(t, mem.File().Update(ctx, violates))
+
+	fileIDs := func(files []*core.File) []core.FileID {
+		result := make([]core.FileID, len(files))
+		for i, file := range files {
+			result[i] = file.ID
+		}
+		return result
+	}
+
+	t.Run("Query", func(t *testing.T) {
+		page, err := srv.SearchInline(ctx, owner, "  report ", 0)
+		require.NoError(t, err)
+
+		// matched by name and caption, case insensitive, without files of other users and violates copyright
+		require.ElementsMatch(t, []core.FileID{report.ID, notes.ID}, fileIDs(page.Files))
+		require.Zero(t, page.NextOffset)
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		page, err := srv.SearchInline(ctx, owner, "", 0)
+		require.NoError(t, err)
+		require.Len(t, page.Files, 3)
+
+		for _, file := range page.Files {
+			require.Equal(t, owner.ID, file.OwnerID)
+		}
+	})
+
+	t.Run("OwnerScope", func(t *testing.T) {
+		page, err := srv.SearchInline(ctx, stranger, "", 0)
+		require.NoError(t, err)
+		require.Len(t, page.Files, 1)
+		require.Equal(t, stranger.ID, page.Files[0].OwnerID)
+
+		page, err = srv.SearchInline(ctx, stranger, "notes", 0)
+		require.NoError(t, err)
+		require.Empty(t, page.Files)
+	})
+
+	t.Run("Blocked", func(t *testing.T) {
+		blocked := addFile(owner.ID, "blocked report.txt", "")
+
+		page, err := srv.SearchInline(ctx, owner, "report", 0)
+		require.NoError(t, err)
+		require.Contains(t, fileIDs(page.Files), blocked.ID)
+
+		blocked.IsBlocked = true
+		require.NoError(t, mem.File().Update(ctx, blocked))
+
+		page, err = srv.SearchInline(ctx, owner, "report", 0)
+		require.NoError(t, err)
+		require.ElementsMatch(t, []core.FileID{report.ID, notes.ID}, fileIDs(page.Files))
+	})
+
+	t.Run("OwnerBanned", func(t *testing.T) {
+		banned := *owner
+		banned.IsBanned = true
+
+		page, err := srv.SearchInline(ctx, &banned, "", 0)
+		require.NoError(t, err)
+		require.Empty(t, page.Files)
+		require.Zero(t, page.NextOffset)
+	})
+
+	t.Run("UnknownKind", func(t *testing.T) {
+		unknown := core.NewFile("telegram", "", core.KindUnknown, "", 100, "unknown report", owner.ID, false, core.Metadata{})
+		require.NoError(t, mem.File().Add(ctx, unknown))
+
+		// unknown kind can't be sent inline
+		page, err := srv.SearchInline(ctx, owner, "report", 0)
+		require.NoError(t, err)
+		require.ElementsMatch(t, []core.FileID{report.ID, notes.ID}, fileIDs(page.Files))
+	})
+
+	t.Run("TooLong", func(t *testing.T) {
+		text := fmt.Sprintf("%0*d", service.FileLibrarySearchMaxLength+1, 0)
+
+		_, err := srv.SearchInline(ctx, owner, text, 0)
+		require.True(t, errors.Is(err, service.ErrFileLibrarySearchInvalidLength))
+	})
+
+	t.Run("Pages", func(t *testing.T) {
+		user := core.NewUser(3, "Collector", "", "", "en")
+		require.NoError(t, mem.User().Add(ctx, user))
+
+		total := service.FileInlinePageSize + 5
+		for i := 0; i < total; i++ {
+			addFile(user.ID, fmt.Sprintf("file_%d.txt", i), "")
+		}
+
+		page, err := srv.SearchInline(ctx, user, "", 0)
+		require.NoError(t, err)
+		require.Len(t, page.Files, service.FileInlinePageSize)
+		require.Equal(t, service.FileInlinePageSize, page.NextOffset)
+
+		next, err := srv.SearchInline(ctx, user, "", page.NextOffset)
+		require.NoError(t, err)
+		require.Len(t, next.Files, total-service.FileInlinePageSize)
+		require.Zero(t, next.NextOffset)
+
+		require.NotContains(t, fileIDs(next.Files), page.Files[0].ID)
+	})
+}
+
+func TestFile_RegisterInlineDownload(t *testing.T) {
+	ctx := context.Background()
+	mem := memory.New()
+
+	downloads := &recordingDownloadStore{DownloadStore: mem.Download()}
+
+	srv := &service.File{
+		File:     mem.File(),
+		User:     mem.User(),
+		Download: downloads,
+	}
+
+	owner := core.NewUser(1, "Owner", "", "", "en")
+	require.NoError(t, mem.User().Add(ctx, owner))
+
+	stranger := core.NewUser(2, "Stranger", "", "", "en")
+	require.NoError(t, mem.User().Add(ctx, stranger))
+
+	file := core.NewFile("telegram", "", core.KindDocument, "text/plain", 100, "file.txt", owner.ID, false, core.Metadata{})
+	file.Version = 3
+	require.NoError(t, mem.File().Add(ctx, file))
+
+	t.Run("Owner", func(t *testing.T) {
+		require.NoError(t, srv.RegisterInlineDownload(ctx, owner, file.ID))
+
+		require.Len(t, downloads.added, 1)
+
+		dwn := downloads.added[0]
+		require.Equal(t, core.DownloadSourceInline, dwn.Source)
+		require.Equal(t, file.ID, dwn.FileID)
+		require.Equal(t, owner.ID, dwn.UserID)
+		require.Equal(t, 3, dwn.FileVersion)
+		require.Zero(t, dwn.BundleID)
+		require.Zero(t, dwn.PlacementID)
+
+		count, err := mem.Download().Query().FileID(file.ID).Count(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+	})
+
+	t.Run("NotOwner", func(t *testing.T) {
+		err := srv.RegisterInlineDownload(ctx, stranger, file.ID)
+		require.True(t, errors.Is(err, core.ErrFileNotFound))
+
+		require.Len(t, downloads.added, 1)
+	})
+
+	t.Run("Blocked", func(t *testing.T) {
+		blocked := core.NewFile("telegram", "", core.KindDocument, "text/plain", 100, "blocked.txt", owner.ID, false, core.Metadata{})
+		blocked.IsBlocked = true
+		require.NoError(t, mem.File().Add(ctx, blocked))
+
+		err := srv.RegisterInlineDownload(ctx, owner, blocked.ID)
+		require.True(t, errors.Is(err, service.ErrFileBlocked))
+
+		require.Len(t, downloads.added, 1)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		err := srv.RegisterInlineDownload(ctx, owner, file.ID+100)
+		require.True(t, errors.Is(err, core.ErrFileNotFound))
+
+		require.Len(t, downloads.added, 1)
+	})
+}
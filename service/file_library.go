@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/friendsofgo/errors"
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	// FileLibraryPageSize is count of files on one page of library.
+	FileLibraryPageSize = 8
+
+	// FileLibrarySearchMaxLength is max length of search query in runes.
+	FileLibrarySearchMaxLength = 64
+
+	fileLibrarySearchTTL = 24 * time.Hour
+)
+
+var ErrFileLibrarySearchInvalidLength = errors.New("invalid search query length")
+
+// FileLibraryFilter define filters of owner files list.
+type FileLibraryFilter struct {
+	// Zero value means any kind.
+	Kind core.Kind
+
+	Order core.FileOrder
+
+	// Substring of name or caption. Empty means no search.
+	Search string
+
+	// Page number, starts from zero.
+	Page int
+}
+
+// FileLibraryPage is one page of owner files.
+type FileLibraryPage struct {
+	Files []*core.File
+
+	// Total count of files matched by filter.
+	Total int
+
+	Page  int
+	Pages int
+}
+
+func (page *FileLibraryPage) HasPrev() bool {
+	return page.Page > 0
+}
+
+func (page *FileLibraryPage) HasNext() bool {
+	return page.Page+1 < page.Pages
+}
+
+func (srv *File) newLibraryQuery(user *core.User, filter *FileLibraryFilter) core.FileStoreQuery {
+	query := srv.File.Query().OwnerID(user.ID)
+
+	if filter.Kind != core.KindUnknown {
+		query = query.Kind(filter.Kind)
+	}
+
+	if filter.Search != "" {
+		query = query.Search(filter.Search)
+	}
+
+	return query
+}
+
+// GetLibraryPage returns page of files owned by user.
+func (srv *File) GetLibraryPage(
+	ctx context.Context,
+	user *core.User,
+	filter *FileLibraryFilter,
+) (*FileLibraryPage, error) {
+	total, err := srv.newLibraryQuery(user, filter).Count(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "count files")
+	}
+
+	pages := (total + FileLibraryPageSize - 1) / FileLibraryPageSize
+
+	page := filter.Page
+	if page >= pages {
+		page = pages - 1
+	}
+	if page < 0 {
+		page = 0
+	}
+
+	result := &FileLibraryPage{
+		Files: []*core.File{},
+		Total: total,
+		Page:  page,
+		Pages: pages,
+	}
+
+	if total == 0 {
+		return result, nil
+	}
+
+	result.Files, err = srv.newLibraryQuery(user, filter).
+		OrderBy(filter.Order).
+		Offset(page * FileLibraryPageSize).
+		Limit(FileLibraryPageSize).
+		All(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "query files")
+	}
+
+	return result, nil
+}
+
+func (srv *File) getLibrarySearchKey(userID core.UserID) string {
+	return fmt.Sprintf("share-file-bot:users:%d:library:search", userID)
+}
+
+// SetLibrarySearch saves search query of user library. Empty query resets search.
+func (srv *File) SetLibrarySearch(ctx context.Context, user *core.User, text string) (string, error) {
+	text = strings.TrimSpace(text)
+
+	key := srv.getLibrarySearchKey(user.ID)
+
+	if text == "" {
+		if err := srv.Redis.Del(ctx, key).Err(); err != nil {
+			return "", errors.Wrap(err, "delete search key")
+		}
+		return "", nil
+	}
+
+	if utf8.RuneCountInString(text) > FileLibrarySearchMaxLength {
+		return "", ErrFileLibrarySearchInvalidLength
+	}
+
+	if err := srv.Redis.Set(ctx, key, text, fileLibrarySearchTTL).Err(); err != nil {
+		return "", errors.Wrap(err, "set search key")
+	}
+
+	return text, nil
+}
+
+// GetLibrarySearch returns saved search query of user library.
+func (srv *File) GetLibrarySearch(ctx context.Context, user *core.User) (string, error) {
+	text, err := srv.Redis.Get(ctx, srv.getLibrarySearchKey(user.ID)).Result()
+	if err == redis.Nil {
+		return "", nil
+	} else if err != nil {
+		return "", errors.Wrap(err, "get search key")
+	}
+
+	return text, nil
+}
@@ -0,0 +1,196 @@
+package service_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/service"
+	"github.com/bots-house/share-file-bot/store/memory"
+	"github.com/friendsofgo/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestFile_RestrictionsLimits(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func(t *testing.T, restriction core.DownloadRestrictions) (*service.File, *core.File, []*core.User) {
+		t.Helper()
+
+		mem := memory.New()
+
+		srv := &service.File{
+			File:        mem.File(),
+			User:        mem.User(),
+			Download:    mem.Download(),
+			RevokedLink: mem.RevokedLink(),
+			Bundle:      mem.Bundle(),
+			Txier:       mem.Tx,
+		}
+
+		users := make([]*core.User, 4)
+		for i := range users {
+			users[i] = core.NewUser(core.UserID(i+1), "User", "", "", "en")
+			require.NoError(t, mem.User().Add(ctx, users[i]))
+		}
+
+		file := core.NewFile("telegram", "", core.KindDocument, "text/plain", 100, "file.txt", users[0].ID, false, core.Metadata{})
+		file.Restriction = restriction
+		require.NoError(t, mem.File().Add(ctx, file))
+
+		return srv, file, users
+	}
+
+	download := func(srv *service.File, user *core.User, file *core.File) error {
+		result, err := srv.GetFileByPublicID(ctx, user, file.PublicID)
+		if err != nil {
+			return err
+		}
+
+		if result.File == nil {
+			return errors.New("file is not delivered")
+		}
+
+		return nil
+	}
+
+	t.Run("MaxDownloads", func(t *testing.T) {
+		srv, file, users := setup(t, core.DownloadRestrictions{MaxDownloads: 2})
+
+		require.NoError(t, download(srv, users[1], file))
+		require.NoError(t, download(srv, users[2], file))
+
+		// limit is reached
+		err := download(srv, users[3], file)
+		require.True(t, errors.Is(err, service.ErrFileDownloadsLimitReached))
+
+		err = download(srv, users[1], file)
+		require.True(t, errors.Is(err, service.ErrFileDownloadsLimitReached))
+
+		// owner is not limited
+		result, err := srv.GetFileByPublicID(ctx, users[0], file.PublicID)
+		require.NoError(t, err)
+		require.NotNil(t, result.OwnedFile)
+	})
+
+	t.Run("MaxDownloadsPerUser", func(t *testing.T) {
+		srv, file, users := setup(t, core.DownloadRestrictions{MaxDownloadsPerUser: 2})
+
+		require.NoError(t, download(srv, users[1], file))
+		require.NoError(t, download(srv, users[1], file))
+
+		// limit is reached only for this user
+		err := download(srv, users[1], file)
+		require.True(t, errors.Is(err, service.ErrFileUserDownloadsLimitReached))
+
+		require.NoError(t, download(srv, users[2], file))
+	})
+
+	t.Run("ExpiresAt", func(t *testing.T) {
+		now := time.Now()
+
+		srv, file, users := setup(t, core.DownloadRestrictions{
+			ExpiresAt: null.TimeFrom(now.Add(time.Hour)),
+		})
+
+		require.NoError(t, download(srv, users[1], file))
+
+		srv, file, users = setup(t, core.DownloadRestrictions{
+			ExpiresAt: null.TimeFrom(now.Add(-time.Millisecond)),
+		})
+
+		err := download(srv, users[1], file)
+		require.True(t, errors.Is(err, service.ErrFileExpired))
+
+		// file is expired exactly at expiration time
+		require.False(t, file.Restriction.IsExpired(file.Restriction.ExpiresAt.Time.Add(-time.Nanosecond)))
+		require.True(t, file.Restriction.IsExpired(file.Restriction.ExpiresAt.Time))
+	})
+
+	t.Run("AvailableFrom", func(t *testing.T) {
+		now := time.Now()
+		from := now.Add(time.Hour)
+
+		srv, file, users := setup(t, core.DownloadRestrictions{
+			AvailableFrom: null.TimeFrom(from),
+		})
+
+		err := download(srv, users[1], file)
+
+		var notYet *service.FileNotAvailableYetError
+		require.True(t, errors.As(err, &notYet))
+		require.True(t, from.Equal(notYet.From))
+
+		srv, file, users = setup(t, core.DownloadRestrictions{
+			AvailableFrom: null.TimeFrom(now.Add(-time.Millisecond)),
+		})
+
+		require.NoError(t, download(srv, users[1], file))
+
+		// file is available exactly at activation time
+		require.False(t, file.Restriction.IsAvailableYet(file.Restriction.AvailableFrom.Time.Add(-time.Nanosecond)))
+		require.True(t, file.Restriction.IsAvailableYet(file.Restriction.AvailableFrom.Time))
+	})
+
+	t.Run("ActivationWindow", func(t *testing.T) {
+		now := time.Now()
+
+		srv, file, users := setup(t, core.DownloadRestrictions{
+			AvailableFrom: null.TimeFrom(now.Add(-time.Hour)),
+			ExpiresAt:     null.TimeFrom(now.Add(time.Hour)),
+		})
+
+		require.NoError(t, download(srv, users[1], file))
+
+		srv, file, users = setup(t, core.DownloadRestrictions{
+			AvailableFrom: null.TimeFrom(now.Add(-2 * time.Hour)),
+			ExpiresAt:     null.TimeFrom(now.Add(-time.Hour)),
+		})
+
+		err := download(srv, users[1], file)
+		require.True(t, errors.Is(err, service.ErrFileExpired))
+	})
+
+	t.Run("ConcurrentMaxDownloadsPerUser", func(t *testing.T) {
+		const limit = 3
+
+		srv, file, users := setup(t, core.DownloadRestrictions{MaxDownloadsPerUser: limit})
+
+		var (
+			wg      sync.WaitGroup
+			mu      sync.Mutex
+			ok      int
+			reached int
+		)
+
+		for i := 0; i < limit*4; i++ {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				err := download(srv, users[1], file)
+
+				mu.Lock()
+				defer mu.Unlock()
+
+				switch {
+				case err == nil:
+					ok++
+				case errors.Is(err, service.ErrFileUserDownloadsLimitReached):
+					reached++
+				default:
+					t.Errorf("unexpected error: %v", err)
+				}
+			}()
+		}
+
+		wg.Wait()
+
+		require.Equal(t, limit, ok)
+		require.Equal(t, limit*3, reached)
+	})
+}
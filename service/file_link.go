@@ -0,0 +1,295 @@
+package service
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/pkg/log"
+	"github.com/bots-house/share-file-bot/pkg/secretid"
+	"github.com/friendsofgo/errors"
+)
+
+const (
+	// FileLinkGraceMax is max duration revoked link still resolves to file.
+	FileLinkGraceMax = 30 * 24 * time.Hour
+
+	// FilePublicIDMinLength and FilePublicIDMaxLength limit length of custom public id.
+	// Max length is limited by column in database, so deep-link with placement
+	// still fits 64 characters allowed by Telegram for start parameter.
+	FilePublicIDMinLength = 3
+	FilePublicIDMaxLength = 50
+
+	// DeepLinkRefPrefix is prefix of start deep-link with ref (ref_<ref>-<public id>).
+	DeepLinkRefPrefix = "ref_"
+)
+
+var (
+	ErrFileLinkRevoked      = errors.New("file link is revoked")
+	ErrFileLinkGraceInvalid = errors.New("grace period of file link is invalid")
+
+	ErrFilePublicIDInvalid  = errors.New("custom public id is invalid")
+	ErrFilePublicIDTaken    = errors.New("custom public id is already taken")
+	ErrFilePublicIDReserved = errors.New("custom public id is reserved")
+)
+
+var (
+	// charset allowed by Telegram in start parameter of deep-link
+	reFilePublicID = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+	// suffix of deep-link with placement, see SplitDeepLinkPlacement
+	reFilePublicIDPlacement = regexp.MustCompile(`-[0-9]+$`)
+)
+
+// ValidatePublicID returns ErrFilePublicIDInvalid if public id can't be used in deep-link.
+func ValidatePublicID(publicID string) error {
+	if len(publicID) < FilePublicIDMinLength || len(publicID) > FilePublicIDMaxLength {
+		return ErrFilePublicIDInvalid
+	}
+
+	if !reFilePublicID.MatchString(publicID) {
+		return ErrFilePublicIDInvalid
+	}
+
+	// such link would be parsed as link with ref or placement
+	if strings.HasPrefix(strings.ToLower(publicID), DeepLinkRefPrefix) ||
+		reFilePublicIDPlacement.MatchString(publicID) {
+		return ErrFilePublicIDInvalid
+	}
+
+	return nil
+}
+
+// RegeneratedLink is result of file link regeneration.
+type RegeneratedLink struct {
+	File *OwnedFile
+
+	// Previous link of file.
+	Revoked *core.RevokedLink
+}
+
+// isPublicIDUsed returns true if public id is used by file, bundle or revoked link.
+func isPublicIDUsed(
+	ctx context.Context,
+	publicID string,
+	fileStore core.FileStore,
+	bundleStore core.BundleStore,
+	linkStore core.RevokedLinkStore,
+) (bool, error) {
+	files, err := fileStore.Query().PublicID(publicID).Count(ctx)
+	if err != nil {
+		return false, errors.Wrap(err, "count files")
+	}
+
+	bundles, err := bundleStore.Query().PublicID(publicID).Count(ctx)
+	if err != nil {
+		return false, errors.Wrap(err, "count bundles")
+	}
+
+	links, err := linkStore.Query().PublicID(publicID).Count(ctx)
+	if err != nil {
+		return false, errors.Wrap(err, "count revoked links")
+	}
+
+	return files+bundles+links > 0, nil
+}
+
+func (srv *File) isPublicIDUsed(ctx context.Context, publicID string) (bool, error) {
+	return isPublicIDUsed(ctx, publicID, srv.File, srv.Bundle, srv.RevokedLink)
+}
+
+// encodePublicID replaces random public id of just added file by encoded id of file,
+// if secret id is set. Long ids are kept random, because they are requested to be unguessable.
+// Random id is also kept, if encoded one is already used (e.g. as custom id of other file).
+func encodePublicID(
+	ctx context.Context,
+	sid secretid.SecretID,
+	fileStore core.FileStore,
+	file *core.File,
+	isUsed func(ctx context.Context, publicID string) (bool, error),
+) error {
+	if sid == nil || secretid.IsLong(file.PublicID) {
+		return nil
+	}
+
+	publicID := sid.Encode(int(file.ID))
+
+	used, err := isUsed(ctx, publicID)
+	if err != nil {
+		return err
+	}
+
+	if used {
+		log.Warn(ctx, "encoded public id is used, keep random one", "file_id", file.ID, "public_id", publicID)
+		return nil
+	}
+
+	file.PublicID = publicID
+
+	if err := fileStore.Update(ctx, file); err != nil {
+		return errors.Wrap(err, "update file")
+	}
+
+	return nil
+}
+
+// findFileByPublicID returns file by public id.
+// Public id encoded from id of file is decoded, so file is found by id.
+// Random, custom and revoked ids are found by public id.
+func (srv *File) findFileByPublicID(ctx context.Context, publicID string) (*core.File, error) {
+	if srv.SecretID != nil {
+		if id, err := srv.SecretID.Decode(publicID); err == nil {
+			file, err := srv.File.Query().ID(core.FileID(id)).One(ctx)
+			if err == nil && file.PublicID == publicID {
+				return file, nil
+			} else if err != nil && !errors.Is(err, core.ErrFileNotFound) {
+				return nil, errors.Wrap(err, "find file by decoded id")
+			}
+		}
+	}
+
+	return srv.File.Query().PublicID(publicID).One(ctx)
+}
+
+// replacePublicID sets public id of file owned by user using next
+// and keeps previous one as revoked link with grace period.
+func (srv *File) replacePublicID(
+	ctx context.Context,
+	user *core.User,
+	fileID core.FileID,
+	grace time.Duration,
+	next func(ctx context.Context, file *core.File) error,
+) (*RegeneratedLink, error) {
+	if grace < 0 || grace > FileLinkGraceMax {
+		return nil, ErrFileLinkGraceInvalid
+	}
+
+	var (
+		file *core.File
+		link *core.RevokedLink
+	)
+
+	if err := srv.Txier(ctx, func(ctx context.Context) error {
+		var err error
+
+		file, err = srv.File.Query().OwnerID(user.ID).ID(fileID).One(ctx)
+		if err != nil {
+			return errors.Wrap(err, "query file")
+		}
+
+		link = core.NewRevokedLink(file, grace)
+
+		if err := next(ctx, file); err != nil {
+			return err
+		}
+
+		if err := srv.RevokedLink.Add(ctx, link); err != nil {
+			return errors.Wrap(err, "add revoked link")
+		}
+
+		if err := srv.File.Update(ctx, file); err != nil {
+			return errors.Wrap(err, "update file")
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	log.Info(ctx, "replace file public id", "file_id", file.ID, "grace", grace)
+
+	owned, err := srv.newOwnedFile(ctx, file)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RegeneratedLink{
+		File:    owned,
+		Revoked: link,
+	}, nil
+}
+
+// RegenerateLink replaces public ID of file owned by user by new random one,
+// which is long if user prefers long IDs, regardless of length of current (maybe custom) ID.
+// Previous link still resolves to file during grace period, and is revoked after it.
+func (srv *File) RegenerateLink(
+	ctx context.Context,
+	user *core.User,
+	fileID core.FileID,
+	grace time.Duration,
+) (*RegeneratedLink, error) {
+	return srv.replacePublicID(ctx, user, fileID, grace, func(ctx context.Context, file *core.File) error {
+		for used := true; used; {
+			file.PublicID = secretid.Generate(user.Settings.LongIDs)
+
+			var err error
+
+			used, err = srv.isPublicIDUsed(ctx, file.PublicID)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// SetPublicID replaces public ID of file owned by user by custom one, like spring_catalog.
+// Previous link still resolves to file during grace period, and is revoked after it.
+func (srv *File) SetPublicID(
+	ctx context.Context,
+	user *core.User,
+	fileID core.FileID,
+	publicID string,
+	grace time.Duration,
+) (*RegeneratedLink, error) {
+	if err := ValidatePublicID(publicID); err != nil {
+		return nil, err
+	}
+
+	_, err := srv.ReservedPublicID.Query().Name(publicID).One(ctx)
+	if err == nil {
+		return nil, ErrFilePublicIDReserved
+	} else if !errors.Is(err, core.ErrReservedPublicIDNotFound) {
+		return nil, errors.Wrap(err, "query reserved public id")
+	}
+
+	return srv.replacePublicID(ctx, user, fileID, grace, func(ctx context.Context, file *core.File) error {
+		if file.PublicID == publicID {
+			return ErrNothingChanged
+		}
+
+		used, err := srv.isPublicIDUsed(ctx, publicID)
+		if err != nil {
+			return err
+		}
+
+		if used {
+			return ErrFilePublicIDTaken
+		}
+
+		file.PublicID = publicID
+
+		return nil
+	})
+}
+
+// getFileByRevokedLink returns file by its previous public ID, if link is in grace period.
+func (srv *File) getFileByRevokedLink(ctx context.Context, publicID string) (*core.File, error) {
+	link, err := srv.RevokedLink.Query().PublicID(publicID).One(ctx)
+	if errors.Is(err, core.ErrRevokedLinkNotFound) {
+		return nil, core.ErrFileNotFound
+	} else if err != nil {
+		return nil, errors.Wrap(err, "query revoked link")
+	}
+
+	if !link.IsActive(time.Now()) {
+		return nil, ErrFileLinkRevoked
+	}
+
+	log.Debug(ctx, "file by revoked link", "file_id", link.FileID, "active_until", link.ActiveUntil)
+
+	return srv.File.Query().ID(link.FileID).One(ctx)
+}
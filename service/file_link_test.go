@@ -0,0 +1,245 @@
+package service_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/service"
+	"github.com/bots-house/share-file-bot/store/memory"
+	"github.com/friendsofgo/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFile_RegenerateLink(t *testing.T) {
+	ctx := context.Background()
+	mem := memory.New()
+
+	srv := &service.File{
+		File:        mem.File(),
+		User:        mem.User(),
+		Download:    mem.Download(),
+		RevokedLink: mem.RevokedLink(),
+		Bundle:      mem.Bundle(),
+		Txier:       mem.Tx,
+	}
+
+	owner := core.NewUser(1, "Owner", "", "", "en")
+	user := core.NewUser(2, "User", "", "", "en")
+
+	for _, u := range []*core.User{owner, user} {
+		require.NoError(t, mem.User().Add(ctx, u))
+	}
+
+	file := core.NewFile("telegram", "caption", core.KindDocument, "text/plain", 100, "file.txt", owner.ID, false, core.Metadata{})
+	require.NoError(t, mem.File().Add(ctx, file))
+
+	first := file.PublicID
+
+	_, err := srv.RegenerateLink(ctx, user, file.ID, time.Hour)
+	require.True(t, errors.Is(err, core.ErrFileNotFound))
+
+	_, err = srv.RegenerateLink(ctx, owner, file.ID, service.FileLinkGraceMax+time.Hour)
+	require.True(t, errors.Is(err, service.ErrFileLinkGraceInvalid))
+
+	// previous link works during grace period
+	result, err := srv.RegenerateLink(ctx, owner, file.ID, time.Hour)
+	require.NoError(t, err)
+	require.NotEqual(t, first, result.File.PublicID)
+	require.Len(t, result.File.PublicID, len(first))
+	require.Equal(t, first, result.Revoked.PublicID)
+
+	second := result.File.PublicID
+
+	for _, publicID := range []string{first, second} {
+		got, err := srv.GetFileByPublicID(ctx, owner, publicID)
+		require.NoError(t, err)
+		require.Equal(t, file.ID, got.OwnedFile.ID)
+	}
+
+	// previous link is revoked right away
+	result, err = srv.RegenerateLink(ctx, owner, file.ID, 0)
+	require.NoError(t, err)
+
+	_, err = srv.GetFileByPublicID(ctx, owner, second)
+	require.True(t, errors.Is(err, service.ErrFileLinkRevoked))
+
+	_, err = srv.GetFileByPublicID(ctx, owner, first)
+	require.NoError(t, err)
+
+	_, err = srv.GetFileByPublicID(ctx, owner, result.File.PublicID)
+	require.NoError(t, err)
+
+	// unknown link is not found, so it can be a bundle
+	_, err = srv.GetFileByPublicID(ctx, owner, "unknown")
+	require.True(t, errors.Is(err, core.ErrFileNotFound))
+}
+
+func TestFile_RegenerateCustomLink(t *testing.T) {
+	ctx := context.Background()
+	mem := memory.New()
+
+	srv := &service.File{
+		File:             mem.File(),
+		User:             mem.User(),
+		Download:         mem.Download(),
+		RevokedLink:      mem.RevokedLink(),
+		Bundle:           mem.Bundle(),
+		ReservedPublicID: mem.ReservedPublicID(),
+		Txier:            mem.Tx,
+	}
+
+	owner := core.NewUser(1, "Owner", "", "", "en")
+	require.NoError(t, mem.User().Add(ctx, owner))
+
+	file := core.NewFile("telegram", "", core.KindDocument, "text/plain", 100, "catalog.pdf", owner.ID, false, core.Metadata{})
+	require.NoError(t, mem.File().Add(ctx, file))
+
+	_, err := srv.SetPublicID(ctx, owner, file.ID, "spring_catalog", 0)
+	require.NoError(t, err)
+
+	// length of custom link doesn't matter
+	result, err := srv.RegenerateLink(ctx, owner, file.ID, 0)
+	require.NoError(t, err)
+	require.Len(t, result.File.PublicID, 5)
+
+	_, err = srv.SetPublicID(ctx, owner, file.ID, "spring_catalog_2021", 0)
+	require.NoError(t, err)
+
+	owner.Settings.LongIDs = true
+
+	result, err = srv.RegenerateLink(ctx, owner, file.ID, 0)
+	require.NoError(t, err)
+	require.Len(t, result.File.PublicID, 50)
+}
+
+func TestValidatePublicID(t *testing.T) {
+	for _, test := range []struct {
+		PublicID string
+		Valid    bool
+	}{
+		{"spring_catalog", true},
+		{"Spring-Catalog_2021", true},
+		{"catalog-v2", true},
+		{"abc", true},
+		{"ab", false},
+		{strings.Repeat("a", service.FilePublicIDMaxLength), true},
+		{strings.Repeat("a", service.FilePublicIDMaxLength+1), false},
+		{"spring catalog", false},
+		{"каталог", false},
+		{"catalog.pdf", false},
+		{"ref_catalog", false},
+		{"REF_catalog", false},
+		{"catalog-2021", false},
+	} {
+		test := test
+
+		t.Run(test.PublicID, func(t *testing.T) {
+			err := service.ValidatePublicID(test.PublicID)
+			if test.Valid {
+				require.NoError(t, err)
+			} else {
+				require.True(t, errors.Is(err, service.ErrFilePublicIDInvalid))
+			}
+		})
+	}
+}
+
+func TestFile_SetPublicID(t *testing.T) {
+	ctx := context.Background()
+	mem := memory.New()
+
+	srv := &service.File{
+		File:             mem.File(),
+		User:             mem.User(),
+		Download:         mem.Download(),
+		RevokedLink:      mem.RevokedLink(),
+		Bundle:           mem.Bundle(),
+		ReservedPublicID: mem.ReservedPublicID(),
+		Txier:            mem.Tx,
+	}
+
+	adminSrv := &service.Admin{
+		User:             mem.User(),
+		ReservedPublicID: mem.ReservedPublicID(),
+		Txier:            mem.Tx,
+	}
+
+	owner := core.NewUser(1, "Owner", "", "", "en")
+	admin := core.NewUser(2, "Admin", "", "", "en")
+	admin.IsAdmin = true
+
+	for _, u := range []*core.User{owner, admin} {
+		require.NoError(t, mem.User().Add(ctx, u))
+	}
+
+	file := core.NewFile("telegram", "", core.KindDocument, "text/plain", 100, "catalog.pdf", owner.ID, false, core.Metadata{})
+	require.NoError(t, mem.File().Add(ctx, file))
+
+	other := core.NewFile("telegram", "", core.KindDocument, "text/plain", 100, "other.pdf", owner.ID, false, core.Metadata{})
+	require.NoError(t, mem.File().Add(ctx, other))
+
+	bundle := core.NewBundle("", []core.FileID{other.ID}, owner.ID, false)
+	require.NoError(t, mem.Bundle().Add(ctx, bundle))
+
+	// blocklist
+	_, err := adminSrv.ReservePublicID(ctx, owner, "support")
+	require.True(t, errors.Is(err, service.ErrUserIsNotAdmin))
+
+	_, err = adminSrv.ReservePublicID(ctx, admin, "support")
+	require.NoError(t, err)
+
+	_, err = adminSrv.ReservePublicID(ctx, admin, "Support")
+	require.True(t, errors.Is(err, service.ErrPublicIDAlreadyReserved))
+
+	reserved, err := adminSrv.ReservedPublicIDs(ctx, admin)
+	require.NoError(t, err)
+	require.Len(t, reserved, 1)
+
+	_, err = srv.SetPublicID(ctx, owner, file.ID, "SUPPORT", time.Hour)
+	require.True(t, errors.Is(err, service.ErrFilePublicIDReserved))
+
+	// invalid, taken by file, bundle or owned by other user
+	_, err = srv.SetPublicID(ctx, owner, file.ID, "ref_catalog", time.Hour)
+	require.True(t, errors.Is(err, service.ErrFilePublicIDInvalid))
+
+	_, err = srv.SetPublicID(ctx, owner, file.ID, other.PublicID, time.Hour)
+	require.True(t, errors.Is(err, service.ErrFilePublicIDTaken))
+
+	_, err = srv.SetPublicID(ctx, owner, file.ID, bundle.PublicID, time.Hour)
+	require.True(t, errors.Is(err, service.ErrFilePublicIDTaken))
+
+	_, err = srv.SetPublicID(ctx, admin, file.ID, "spring_catalog", time.Hour)
+	require.True(t, errors.Is(err, core.ErrFileNotFound))
+
+	// set
+	first := file.PublicID
+
+	result, err := srv.SetPublicID(ctx, owner, file.ID, "spring_catalog", time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, "spring_catalog", result.File.PublicID)
+	require.Equal(t, first, result.Revoked.PublicID)
+
+	_, err = srv.SetPublicID(ctx, owner, file.ID, "spring_catalog", time.Hour)
+	require.True(t, errors.Is(err, service.ErrNothingChanged))
+
+	for _, publicID := range []string{first, "spring_catalog"} {
+		got, err := srv.GetFileByPublicID(ctx, owner, publicID)
+		require.NoError(t, err)
+		require.Equal(t, file.ID, got.OwnedFile.ID)
+	}
+
+	// deep-link with placement
+	publicID, placement := service.SplitDeepLinkPlacement("spring_catalog-10")
+	require.Equal(t, "spring_catalog", publicID)
+	require.Equal(t, core.PlacementID(10), placement)
+
+	// unreserve
+	require.NoError(t, adminSrv.UnreservePublicID(ctx, admin, "support"))
+	require.True(t, errors.Is(adminSrv.UnreservePublicID(ctx, admin, "support"), core.ErrReservedPublicIDNotFound))
+
+	_, err = srv.SetPublicID(ctx, owner, other.ID, "support", 0)
+	require.NoError(t, err)
+}
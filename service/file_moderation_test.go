@@ -0,0 +1,119 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/service"
+	"github.com/bots-house/share-file-bot/store/memory"
+	"github.com/friendsofgo/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestFile_ModerationGuard(t *testing.T) {
+	ctx := context.Background()
+	mem := memory.New()
+	rdb := newRedis(t)
+
+	srv := &service.File{
+		File:        mem.File(),
+		User:        mem.User(),
+		Download:    mem.Download(),
+		RevokedLink: mem.RevokedLink(),
+		Bundle:      mem.Bundle(),
+		Redis:       rdb,
+		Txier:       mem.Tx,
+	}
+
+	bundleSrv := &service.Bundle{
+		Bundle:   mem.Bundle(),
+		File:     mem.File(),
+		User:     mem.User(),
+		Download: mem.Download(),
+		Redis:    rdb,
+		Txier:    mem.Tx,
+	}
+
+	owner := core.NewUser(1, "Owner", "", "", "en")
+	user := core.NewUser(2, "User", "", "", "en")
+
+	for _, u := range []*core.User{owner, user} {
+		require.NoError(t, mem.User().Add(ctx, u))
+	}
+
+	file := core.NewFile("telegram", "", core.KindDocument, "text/plain", 100, "file.txt", owner.ID, false, core.Metadata{})
+	file.Restriction.SetPassword("secret")
+	require.NoError(t, mem.File().Add(ctx, file))
+
+	bundle := core.NewBundle("", []core.FileID{file.ID}, owner.ID, false)
+	require.NoError(t, mem.Bundle().Add(ctx, bundle))
+
+	// user is asked for password before file is moderated
+	result, err := srv.GetFileByPublicID(ctx, user, file.PublicID)
+	require.NoError(t, err)
+	require.NotNil(t, result.PasswordRequest)
+
+	requireFileNotServed := func(t *testing.T, target error) {
+		t.Helper()
+
+		_, err := srv.CheckFilePassword(ctx, user, "secret")
+		require.True(t, errors.Is(err, target), "check file password: %v", err)
+
+		_, err = srv.CheckFileRestrictionsChat(ctx, user, file.ID)
+		require.True(t, errors.Is(err, target), "check file restrictions chat: %v", err)
+
+		_, err = srv.RegisterDownload(ctx, user, file)
+		require.True(t, errors.Is(err, target), "register download: %v", err)
+
+		_, err = srv.GetFileByPublicID(ctx, user, file.PublicID)
+		require.True(t, errors.Is(err, target), "get file by public id: %v", err)
+	}
+
+	t.Run("Blocked", func(t *testing.T) {
+		file.IsBlocked = true
+		require.NoError(t, mem.File().Update(ctx, file))
+
+		requireFileNotServed(t, service.ErrFileBlocked)
+
+		// owner still can manage own file
+		result, err := srv.GetFileByID(ctx, owner, file.ID)
+		require.NoError(t, err)
+		require.NotNil(t, result.OwnedFile)
+
+		file.IsBlocked = false
+		require.NoError(t, mem.File().Update(ctx, file))
+	})
+
+	t.Run("ViolatesCopyright", func(t *testing.T) {
+		file.IsViolatesCopyright = null.BoolFrom(true)
+		require.NoError(t, mem.File().Update(ctx, file))
+
+		requireFileNotServed(t, service.ErrFileViolatesCopyright)
+
+		file.IsViolatesCopyright = null.Bool{}
+		require.NoError(t, mem.File().Update(ctx, file))
+	})
+
+	t.Run("OwnerBanned", func(t *testing.T) {
+		owner.IsBanned = true
+		require.NoError(t, mem.User().Update(ctx, owner))
+
+		requireFileNotServed(t, service.ErrOwnerIsBanned)
+
+		_, err := bundleSrv.CheckBundleRestrictionsChat(ctx, user, bundle.ID)
+		require.True(t, errors.Is(err, service.ErrOwnerIsBanned))
+
+		_, err = bundleSrv.RegisterDownload(ctx, user, bundle)
+		require.True(t, errors.Is(err, service.ErrOwnerIsBanned))
+
+		_, err = bundleSrv.GetBundleByPublicID(ctx, user, bundle.PublicID)
+		require.True(t, errors.Is(err, service.ErrOwnerIsBanned))
+	})
+
+	// nothing was delivered
+	total, err := mem.Download().Query().FileID(file.ID).Count(ctx)
+	require.NoError(t, err)
+	require.Zero(t, total)
+}
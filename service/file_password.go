@@ -0,0 +1,245 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/pkg/log"
+	"github.com/friendsofgo/errors"
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	// FilePasswordMaxAttempts is count of wrong attempts per user and file before lock.
+	FilePasswordMaxAttempts = 5
+
+	// FilePasswordMaxLength is max length of password in runes.
+	FilePasswordMaxLength = 64
+
+	filePasswordAttemptsTTL = time.Hour
+	filePasswordAwaitTTL    = time.Hour
+	filePasswordUnlockTTL   = time.Hour
+)
+
+var (
+	ErrFilePasswordRequired         = errors.New("file password required")
+	ErrFilePasswordNotAwaited       = errors.New("file password is not awaited")
+	ErrFilePasswordAttemptsExceeded = errors.New("too many wrong password attempts")
+	ErrFilePasswordInvalidLength    = errors.New("invalid password length")
+)
+
+// FilePasswordInvalidError is returned when user sends wrong password.
+type FilePasswordInvalidError struct {
+	AttemptsLeft int
+}
+
+func (err *FilePasswordInvalidError) Error() string {
+	return fmt.Sprintf("invalid file password, attempts left: %d", err.AttemptsLeft)
+}
+
+// PasswordRequest means user should send password of file.
+type PasswordRequest struct {
+	FileID core.FileID
+}
+
+func (srv *File) getPasswordAwaitKey(userID core.UserID) string {
+	return fmt.Sprintf("share-file-bot:users:%d:password:await", userID)
+}
+
+func (srv *File) getPasswordSetAwaitKey(userID core.UserID) string {
+	return fmt.Sprintf("share-file-bot:users:%d:password:set", userID)
+}
+
+func (srv *File) getPasswordAttemptsKey(userID core.UserID, fileID core.FileID) string {
+	return fmt.Sprintf("share-file-bot:users:%d:password:%d:attempts", userID, fileID)
+}
+
+func (srv *File) getPasswordUnlockKey(userID core.UserID, fileID core.FileID) string {
+	return fmt.Sprintf("share-file-bot:users:%d:password:%d:unlocked", userID, fileID)
+}
+
+// isPasswordUnlocked returns true if user entered valid password of file recently.
+func (srv *File) isPasswordUnlocked(ctx context.Context, user *core.User, file *core.File) (bool, error) {
+	if !file.Restriction.HasPassword() {
+		return true, nil
+	}
+
+	count, err := srv.Redis.Exists(ctx, srv.getPasswordUnlockKey(user.ID, file.ID)).Result()
+	if err != nil {
+		return false, errors.Wrap(err, "check unlock key")
+	}
+
+	return count > 0, nil
+}
+
+// requestPassword registers user as awaiting for password of file.
+func (srv *File) requestPassword(ctx context.Context, user *core.User, file *core.File) (*DownloadResult, error) {
+	key := srv.getPasswordAwaitKey(user.ID)
+
+	if err := srv.Redis.Set(ctx, key, int(file.ID), filePasswordAwaitTTL).Err(); err != nil {
+		return nil, errors.Wrap(err, "set await key")
+	}
+
+	return &DownloadResult{
+		PasswordRequest: &PasswordRequest{FileID: file.ID},
+	}, nil
+}
+
+// consumePasswordUnlock removes unlock of file, so next download requires password again.
+func (srv *File) consumePasswordUnlock(ctx context.Context, user *core.User, file *core.File) {
+	if !file.Restriction.HasPassword() {
+		return
+	}
+
+	key := srv.getPasswordUnlockKey(user.ID, file.ID)
+
+	if err := srv.Redis.Del(ctx, key).Err(); err != nil {
+		log.Warn(ctx, "can't delete key", "key", key, "err", err)
+	}
+}
+
+// CheckFilePassword checks password of awaited file and continue download.
+func (srv *File) CheckFilePassword(
+	ctx context.Context,
+	user *core.User,
+	pwd string,
+) (*DownloadResult, error) {
+	fileID, err := srv.Redis.Get(ctx, srv.getPasswordAwaitKey(user.ID)).Int()
+	if err == redis.Nil {
+		return nil, ErrFilePasswordNotAwaited
+	} else if err != nil {
+		return nil, errors.Wrap(err, "get await key")
+	}
+
+	file, err := srv.File.Query().ID(core.FileID(fileID)).One(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "query file")
+	}
+
+	if err := srv.checkFileModeration(ctx, file); err != nil {
+		return nil, err
+	}
+
+	attemptsKey := srv.getPasswordAttemptsKey(user.ID, file.ID)
+
+	// count attempt before check, so concurrent attempts can't exceed limit
+	pipe := srv.Redis.TxPipeline()
+	incr := pipe.Incr(ctx, attemptsKey)
+	pipe.Expire(ctx, attemptsKey, filePasswordAttemptsTTL)
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, errors.Wrap(err, "increment attempts")
+	}
+
+	attempt := int(incr.Val())
+
+	if attempt > FilePasswordMaxAttempts {
+		return nil, ErrFilePasswordAttemptsExceeded
+	}
+
+	if !file.Restriction.CheckPassword(pwd) {
+		log.Info(ctx, "invalid file password", "file_id", file.ID, "attempt", attempt)
+
+		return nil, &FilePasswordInvalidError{
+			AttemptsLeft: FilePasswordMaxAttempts - attempt,
+		}
+	}
+
+	pipe = srv.Redis.TxPipeline()
+	pipe.Del(ctx, attemptsKey, srv.getPasswordAwaitKey(user.ID))
+	pipe.Set(ctx, srv.getPasswordUnlockKey(user.ID, file.ID), true, filePasswordUnlockTTL)
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, errors.Wrap(err, "unlock file")
+	}
+
+	placement, err := srv.popPlacement(ctx, user, file)
+	if err != nil {
+		return nil, err
+	}
+
+	return srv.toDownloadResult(ctx, user, file, placement)
+}
+
+// CancelFilePassword stops awaiting of password.
+func (srv *File) CancelFilePassword(ctx context.Context, user *core.User) error {
+	if err := srv.Redis.Del(ctx,
+		srv.getPasswordAwaitKey(user.ID),
+		srv.getPasswordSetAwaitKey(user.ID),
+	).Err(); err != nil {
+		return errors.Wrap(err, "delete await keys")
+	}
+
+	return nil
+}
+
+// StartSetPassword registers owner as awaiting to send new password of file.
+func (srv *File) StartSetPassword(
+	ctx context.Context,
+	user *core.User,
+	fileID core.FileID,
+) (*core.File, error) {
+	file, err := srv.File.Query().OwnerID(user.ID).ID(fileID).One(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "query file")
+	}
+
+	key := srv.getPasswordSetAwaitKey(user.ID)
+
+	if err := srv.Redis.Set(ctx, key, int(file.ID), filePasswordAwaitTTL).Err(); err != nil {
+		return nil, errors.Wrap(err, "set await key")
+	}
+
+	return file, nil
+}
+
+// SetPassword of file awaited by StartSetPassword.
+func (srv *File) SetPassword(
+	ctx context.Context,
+	user *core.User,
+	pwd string,
+) (*core.File, error) {
+	if n := utf8.RuneCountInString(pwd); n == 0 || n > FilePasswordMaxLength {
+		return nil, ErrFilePasswordInvalidLength
+	}
+
+	key := srv.getPasswordSetAwaitKey(user.ID)
+
+	fileID, err := srv.Redis.Get(ctx, key).Int()
+	if err == redis.Nil {
+		return nil, ErrFilePasswordNotAwaited
+	} else if err != nil {
+		return nil, errors.Wrap(err, "get await key")
+	}
+
+	log.Info(ctx, "set file password", "file_id", fileID)
+
+	file, err := srv.updateRestriction(ctx, user, core.FileID(fileID), func(restriction *core.DownloadRestrictions) {
+		restriction.SetPassword(pwd)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := srv.Redis.Del(ctx, key).Err(); err != nil {
+		log.Warn(ctx, "can't delete key", "key", key, "err", err)
+	}
+
+	return file, nil
+}
+
+// DisablePassword of file.
+func (srv *File) DisablePassword(
+	ctx context.Context,
+	user *core.User,
+	fileID core.FileID,
+) (*core.File, error) {
+	log.Info(ctx, "disable file password", "file_id", fileID)
+
+	return srv.updateRestriction(ctx, user, fileID, func(restriction *core.DownloadRestrictions) {
+		restriction.SetPassword("")
+	})
+}
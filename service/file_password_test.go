@@ -0,0 +1,193 @@
+package service_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/service"
+	"github.com/bots-house/share-file-bot/store/memory"
+	"github.com/friendsofgo/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func newPasswordTestFile(t *testing.T) (*service.File, *memory.Memory, *core.User, *core.User, *core.File) {
+	t.Helper()
+
+	ctx := context.Background()
+	mem := memory.New()
+
+	srv := &service.File{
+		File:        mem.File(),
+		User:        mem.User(),
+		Download:    mem.Download(),
+		RevokedLink: mem.RevokedLink(),
+		Bundle:      mem.Bundle(),
+		Redis:       newRedis(t),
+		Txier:       mem.Tx,
+	}
+
+	owner := core.NewUser(1, "Owner", "", "", "en")
+	user := core.NewUser(2, "User", "", "", "en")
+
+	for _, u := range []*core.User{owner, user} {
+		require.NoError(t, mem.User().Add(ctx, u))
+	}
+
+	file := core.NewFile("telegram", "", core.KindDocument, "text/plain", 100, "file.txt", owner.ID, false, core.Metadata{})
+	require.NoError(t, mem.File().Add(ctx, file))
+
+	return srv, mem, owner, user, file
+}
+
+func TestFile_SetPassword(t *testing.T) {
+	ctx := context.Background()
+	srv, _, owner, user, file := newPasswordTestFile(t)
+
+	_, err := srv.SetPassword(ctx, owner, "secret")
+	require.True(t, errors.Is(err, service.ErrFilePasswordNotAwaited))
+
+	_, err = srv.StartSetPassword(ctx, user, file.ID)
+	require.True(t, errors.Is(err, core.ErrFileNotFound))
+
+	_, err = srv.StartSetPassword(ctx, owner, file.ID)
+	require.NoError(t, err)
+
+	_, err = srv.SetPassword(ctx, owner, "")
+	require.True(t, errors.Is(err, service.ErrFilePasswordInvalidLength))
+
+	updated, err := srv.SetPassword(ctx, owner, "secret")
+	require.NoError(t, err)
+	require.True(t, updated.Restriction.HasPassword())
+	require.True(t, updated.Restriction.CheckPassword("secret"))
+
+	// password is awaited only once
+	_, err = srv.SetPassword(ctx, owner, "other")
+	require.True(t, errors.Is(err, service.ErrFilePasswordNotAwaited))
+
+	_, err = srv.DisablePassword(ctx, user, file.ID)
+	require.True(t, errors.Is(err, core.ErrFileNotFound))
+
+	updated, err = srv.DisablePassword(ctx, owner, file.ID)
+	require.NoError(t, err)
+	require.False(t, updated.Restriction.HasPassword())
+}
+
+func TestFile_CheckFilePassword(t *testing.T) {
+	ctx := context.Background()
+	srv, mem, _, user, file := newPasswordTestFile(t)
+
+	file.Restriction.SetPassword("secret")
+	require.NoError(t, mem.File().Update(ctx, file))
+
+	requireDownloads := func(t *testing.T, expected int) {
+		t.Helper()
+
+		total, err := mem.Download().Query().FileID(file.ID).Count(ctx)
+		require.NoError(t, err)
+		require.Equal(t, expected, total)
+	}
+
+	requestPassword := func(t *testing.T) {
+		t.Helper()
+
+		result, err := srv.GetFileByPublicID(ctx, user, file.PublicID)
+		require.NoError(t, err)
+		require.NotNil(t, result.PasswordRequest)
+		require.Equal(t, file.ID, result.PasswordRequest.FileID)
+	}
+
+	t.Run("NotAwaited", func(t *testing.T) {
+		_, err := srv.CheckFilePassword(ctx, user, "secret")
+		require.True(t, errors.Is(err, service.ErrFilePasswordNotAwaited))
+		requireDownloads(t, 0)
+	})
+
+	t.Run("Wrong", func(t *testing.T) {
+		requestPassword(t)
+
+		_, err := srv.CheckFilePassword(ctx, user, "wrong")
+
+		var invalidErr *service.FilePasswordInvalidError
+		require.True(t, errors.As(err, &invalidErr))
+		require.Equal(t, service.FilePasswordMaxAttempts-1, invalidErr.AttemptsLeft)
+		requireDownloads(t, 0)
+	})
+
+	t.Run("Correct", func(t *testing.T) {
+		result, err := srv.CheckFilePassword(ctx, user, "secret")
+		require.NoError(t, err)
+		require.NotNil(t, result.File)
+		requireDownloads(t, 1)
+
+		// unlock is consumed by download
+		_, err = srv.CheckFilePassword(ctx, user, "secret")
+		require.True(t, errors.Is(err, service.ErrFilePasswordNotAwaited))
+		requestPassword(t)
+	})
+
+	t.Run("AttemptsExceeded", func(t *testing.T) {
+		for i := 0; i < service.FilePasswordMaxAttempts; i++ {
+			_, err := srv.CheckFilePassword(ctx, user, "wrong")
+
+			var invalidErr *service.FilePasswordInvalidError
+			require.True(t, errors.As(err, &invalidErr))
+			require.Equal(t, service.FilePasswordMaxAttempts-i-1, invalidErr.AttemptsLeft)
+		}
+
+		// even correct password is rejected
+		_, err := srv.CheckFilePassword(ctx, user, "secret")
+		require.True(t, errors.Is(err, service.ErrFilePasswordAttemptsExceeded))
+		requireDownloads(t, 1)
+	})
+}
+
+func TestFile_CheckFilePasswordConcurrent(t *testing.T) {
+	ctx := context.Background()
+	srv, mem, _, user, file := newPasswordTestFile(t)
+
+	file.Restriction.SetPassword("secret")
+	require.NoError(t, mem.File().Update(ctx, file))
+
+	_, err := srv.GetFileByPublicID(ctx, user, file.PublicID)
+	require.NoError(t, err)
+
+	const guesses = service.FilePasswordMaxAttempts * 3
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		invalid  int
+		exceeded int
+	)
+
+	for i := 0; i < guesses; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			_, err := srv.CheckFilePassword(ctx, user, "wrong")
+
+			var invalidErr *service.FilePasswordInvalidError
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			switch {
+			case errors.As(err, &invalidErr):
+				invalid++
+			case errors.Is(err, service.ErrFilePasswordAttemptsExceeded):
+				exceeded++
+			default:
+				t.Errorf("unexpected error: %v", err)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	require.Equal(t, service.FilePasswordMaxAttempts, invalid)
+	require.Equal(t, guesses-service.FilePasswordMaxAttempts, exceeded)
+}
@@ -0,0 +1,358 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/pkg/log"
+	"github.com/friendsofgo/errors"
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	// FilePlacementsLimit is max count of placements per file.
+	FilePlacementsLimit = 10
+
+	// FilePlacementNameMaxLength is max length of placement name in runes.
+	FilePlacementNameMaxLength = 32
+
+	filePlacementAwaitTTL = time.Hour
+
+	// deepLinkPlacementSep separates public id of file and placement id in deep-link.
+	deepLinkPlacementSep = "-"
+)
+
+var (
+	ErrPlacementInvalidName   = errors.New("invalid placement name")
+	ErrPlacementsLimitReached = errors.New("placements limit reached")
+	ErrPlacementNotAwaited    = errors.New("placement name is not awaited")
+)
+
+// PlacementStats is placement of file with its downloads.
+type PlacementStats struct {
+	// Placement, nil for downloads by link without placement.
+	*core.Placement
+
+	Total  int
+	Unique int
+}
+
+// DeepLinkPayload returns payload of start deep-link for placement of file.
+func DeepLinkPayload(file *core.File, placement *core.Placement) string {
+	if placement == nil {
+		return file.PublicID
+	}
+
+	return file.PublicID + deepLinkPlacementSep + strconv.Itoa(int(placement.ID))
+}
+
+// SplitDeepLinkPlacement splits payload of start deep-link to public id of file and placement.
+// If payload has no placement, zero placement id is returned.
+func SplitDeepLinkPlacement(payload string) (string, core.PlacementID) {
+	idx := strings.LastIndex(payload, deepLinkPlacementSep)
+	if idx == -1 {
+		return payload, 0
+	}
+
+	id, err := strconv.Atoi(payload[idx+len(deepLinkPlacementSep):])
+	if err != nil || id <= 0 {
+		return payload, 0
+	}
+
+	return payload[:idx], core.PlacementID(id)
+}
+
+func (srv *File) getPlacementAwaitKey(userID core.UserID) string {
+	return fmt.Sprintf("share-file-bot:users:%d:placement:await", userID)
+}
+
+func (srv *File) getPlacementKey(userID core.UserID, fileID core.FileID) string {
+	return fmt.Sprintf("share-file-bot:users:%d:placement:%d", userID, fileID)
+}
+
+// rememberPlacement saves placement of deferred download until it's registered.
+// Zero placement resets placement saved by previous request of file.
+func (srv *File) rememberPlacement(ctx context.Context, user *core.User, file *core.File, id core.PlacementID) error {
+	key := srv.getPlacementKey(user.ID, file.ID)
+
+	if id == 0 {
+		if err := srv.Redis.Del(ctx, key).Err(); err != nil {
+			return errors.Wrap(err, "delete placement key")
+		}
+		return nil
+	}
+
+	if err := srv.Redis.Set(ctx, key, int(id), time.Hour).Err(); err != nil {
+		return errors.Wrap(err, "set placement key")
+	}
+
+	return nil
+}
+
+// popPlacement returns placement of deferred download saved by rememberPlacement.
+func (srv *File) popPlacement(ctx context.Context, user *core.User, file *core.File) (core.PlacementID, error) {
+	key := srv.getPlacementKey(user.ID, file.ID)
+
+	id, err := srv.Redis.Get(ctx, key).Int()
+	if err == redis.Nil {
+		return 0, nil
+	} else if err != nil {
+		return 0, errors.Wrap(err, "get placement key")
+	}
+
+	if err := srv.Redis.Del(ctx, key).Err(); err != nil {
+		log.Warn(ctx, "can't delete key", "key", key, "err", err)
+	}
+
+	return core.PlacementID(id), nil
+}
+
+// GetFileByDeepLink returns file by payload of start deep-link,
+// download is attributed to placement from payload if any.
+func (srv *File) GetFileByDeepLink(
+	ctx context.Context,
+	user *core.User,
+	payload string,
+) (*DownloadResult, error) {
+	publicID, placementID := SplitDeepLinkPlacement(payload)
+
+	return srv.getFileByPublicID(ctx, user, publicID, placementID)
+}
+
+// checkPlacement returns id of placement, if it belongs to file, and zero otherwise.
+func (srv *File) checkPlacement(ctx context.Context, file *core.File, id core.PlacementID) (core.PlacementID, error) {
+	if id == 0 {
+		return 0, nil
+	}
+
+	placement, err := srv.Placement.Query().ID(id).FileID(file.ID).One(ctx)
+	if errors.Is(err, core.ErrPlacementNotFound) {
+		log.Debug(ctx, "unknown placement", "file_id", file.ID, "placement_id", id)
+		return 0, nil
+	} else if err != nil {
+		return 0, errors.Wrap(err, "query placement")
+	}
+
+	return placement.ID, nil
+}
+
+func normalizePlacementName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+
+	if n := utf8.RuneCountInString(name); n == 0 || n > FilePlacementNameMaxLength {
+		return "", ErrPlacementInvalidName
+	}
+
+	return name, nil
+}
+
+// AddPlacement creates placement of owned file.
+func (srv *File) AddPlacement(
+	ctx context.Context,
+	user *core.User,
+	fileID core.FileID,
+	name string,
+) (*core.Placement, error) {
+	file, err := srv.File.Query().OwnerID(user.ID).ID(fileID).One(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "query file")
+	}
+
+	return srv.addPlacement(ctx, file, name)
+}
+
+func (srv *File) addPlacement(ctx context.Context, file *core.File, name string) (*core.Placement, error) {
+	name, err := normalizePlacementName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := srv.Placement.Query().FileID(file.ID).Count(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "count placements")
+	}
+
+	if count >= FilePlacementsLimit {
+		return nil, ErrPlacementsLimitReached
+	}
+
+	placement := core.NewPlacement(file.ID, name)
+
+	log.Info(ctx, "add placement", "file_id", file.ID, "name", name)
+	if err := srv.Placement.Add(ctx, placement); err != nil {
+		return nil, errors.Wrap(err, "add placement")
+	}
+
+	return placement, nil
+}
+
+// StartAddPlacement registers owner as awaiting to send name of new placement of file.
+func (srv *File) StartAddPlacement(
+	ctx context.Context,
+	user *core.User,
+	fileID core.FileID,
+) (*core.File, error) {
+	file, err := srv.File.Query().OwnerID(user.ID).ID(fileID).One(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "query file")
+	}
+
+	count, err := srv.Placement.Query().FileID(file.ID).Count(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "count placements")
+	}
+
+	if count >= FilePlacementsLimit {
+		return nil, ErrPlacementsLimitReached
+	}
+
+	key := srv.getPlacementAwaitKey(user.ID)
+
+	if err := srv.Redis.Set(ctx, key, int(file.ID), filePlacementAwaitTTL).Err(); err != nil {
+		return nil, errors.Wrap(err, "set await key")
+	}
+
+	return file, nil
+}
+
+// AddAwaitedPlacement creates placement of file awaited by StartAddPlacement.
+func (srv *File) AddAwaitedPlacement(
+	ctx context.Context,
+	user *core.User,
+	name string,
+) (*core.File, *core.Placement, error) {
+	key := srv.getPlacementAwaitKey(user.ID)
+
+	fileID, err := srv.Redis.Get(ctx, key).Int()
+	if err == redis.Nil {
+		return nil, nil, ErrPlacementNotAwaited
+	} else if err != nil {
+		return nil, nil, errors.Wrap(err, "get await key")
+	}
+
+	file, err := srv.File.Query().OwnerID(user.ID).ID(core.FileID(fileID)).One(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "query file")
+	}
+
+	placement, err := srv.addPlacement(ctx, file, name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := srv.Redis.Del(ctx, key).Err(); err != nil {
+		log.Warn(ctx, "can't delete key", "key", key, "err", err)
+	}
+
+	return file, placement, nil
+}
+
+// CancelAddPlacement stops awaiting of placement name.
+func (srv *File) CancelAddPlacement(ctx context.Context, user *core.User) error {
+	if err := srv.Redis.Del(ctx, srv.getPlacementAwaitKey(user.ID)).Err(); err != nil {
+		return errors.Wrap(err, "delete await key")
+	}
+
+	return nil
+}
+
+// DeletePlacement of owned file. Downloads made by placement link are kept as direct.
+func (srv *File) DeletePlacement(
+	ctx context.Context,
+	user *core.User,
+	fileID core.FileID,
+	id core.PlacementID,
+) error {
+	file, err := srv.File.Query().OwnerID(user.ID).ID(fileID).One(ctx)
+	if err != nil {
+		return errors.Wrap(err, "query file")
+	}
+
+	log.Info(ctx, "delete placement", "file_id", file.ID, "placement_id", id)
+
+	count, err := srv.Placement.Query().FileID(file.ID).ID(id).Delete(ctx)
+	if err != nil {
+		return errors.Wrap(err, "delete placement")
+	}
+
+	if count == 0 {
+		return core.ErrPlacementNotFound
+	}
+
+	return nil
+}
+
+// FilePlacements is placements of file with downloads.
+type FilePlacements struct {
+	File *core.File
+
+	// Downloads by link without placement.
+	Direct *PlacementStats
+
+	Items []*PlacementStats
+}
+
+// GetPlacements returns placements of owned file with downloads.
+func (srv *File) GetPlacements(
+	ctx context.Context,
+	user *core.User,
+	fileID core.FileID,
+) (*FilePlacements, error) {
+	file, err := srv.File.Query().OwnerID(user.ID).ID(fileID).One(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "query file")
+	}
+
+	direct, items, err := srv.getPlacementStats(ctx, file)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FilePlacements{
+		File:   file,
+		Direct: direct,
+		Items:  items,
+	}, nil
+}
+
+// getPlacementStats returns downloads without placement and by each placement of file.
+func (srv *File) getPlacementStats(ctx context.Context, file *core.File) (*PlacementStats, []*PlacementStats, error) {
+	placements, err := srv.Placement.Query().FileID(file.ID).All(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "query placements")
+	}
+
+	stats, err := srv.Download.GetFilePlacementStats(ctx, file.ID)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "get placement stats")
+	}
+
+	byID := make(map[core.PlacementID]*core.PlacementDownloadStats, len(stats))
+	for _, item := range stats {
+		byID[item.PlacementID] = item
+	}
+
+	direct := &PlacementStats{}
+	if item, ok := byID[0]; ok {
+		direct.Total = item.Total
+		direct.Unique = item.Unique
+	}
+
+	items := make([]*PlacementStats, len(placements))
+
+	for i, placement := range placements {
+		items[i] = &PlacementStats{Placement: placement}
+
+		if item, ok := byID[placement.ID]; ok {
+			items[i].Total = item.Total
+			items[i].Unique = item.Unique
+		}
+	}
+
+	return direct, items, nil
+}
@@ -0,0 +1,133 @@
+package service_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/service"
+	"github.com/bots-house/share-file-bot/store/memory"
+	"github.com/friendsofgo/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitDeepLinkPlacement(t *testing.T) {
+	for _, test := range []struct {
+		Payload   string
+		PublicID  string
+		Placement core.PlacementID
+	}{
+		{"HndVA", "HndVA", 0},
+		{"HndVA-12", "HndVA", 12},
+		{"HndVA-", "HndVA-", 0},
+		{"HndVA-x", "HndVA-x", 0},
+		{"HndVA-0", "HndVA-0", 0},
+	} {
+		publicID, placement := service.SplitDeepLinkPlacement(test.Payload)
+		require.Equal(t, test.PublicID, publicID, test.Payload)
+		require.Equal(t, test.Placement, placement, test.Payload)
+	}
+}
+
+func TestFile_Placements(t *testing.T) {
+	ctx := context.Background()
+	mem := memory.New()
+
+	srv := &service.File{
+		File:      mem.File(),
+		User:      mem.User(),
+		Download:  mem.Download(),
+		Placement: mem.Placement(),
+		Txier:     mem.Tx,
+	}
+
+	owner := core.NewUser(1, "Owner", "", "", "en")
+	user := core.NewUser(2, "User", "", "", "en")
+
+	for _, u := range []*core.User{owner, user} {
+		require.NoError(t, mem.User().Add(ctx, u))
+	}
+
+	file := core.NewFile("telegram", "", core.KindDocument, "text/plain", 100, "file.txt", owner.ID, false, core.Metadata{})
+	require.NoError(t, mem.File().Add(ctx, file))
+
+	_, err := srv.AddPlacement(ctx, owner, file.ID, "  ")
+	require.True(t, errors.Is(err, service.ErrPlacementInvalidName))
+
+	_, err = srv.AddPlacement(ctx, owner, file.ID, strings.Repeat("a", service.FilePlacementNameMaxLength+1))
+	require.True(t, errors.Is(err, service.ErrPlacementInvalidName))
+
+	_, err = srv.AddPlacement(ctx, user, file.ID, "reddit")
+	require.True(t, errors.Is(err, core.ErrFileNotFound))
+
+	reddit, err := srv.AddPlacement(ctx, owner, file.ID, " reddit ")
+	require.NoError(t, err)
+	require.Equal(t, "reddit", reddit.Name)
+
+	channel, err := srv.AddPlacement(ctx, owner, file.ID, "channel-A")
+	require.NoError(t, err)
+
+	// download by placement link is attributed to placement
+	result, err := srv.GetFileByDeepLink(ctx, user, service.DeepLinkPayload(file, reddit))
+	require.NoError(t, err)
+	require.NotNil(t, result.File)
+
+	// placement of other file or unknown placement is ignored
+	_, err = srv.GetFileByDeepLink(ctx, user, file.PublicID+"-100")
+	require.NoError(t, err)
+
+	_, err = srv.GetFileByDeepLink(ctx, user, service.DeepLinkPayload(file, nil))
+	require.NoError(t, err)
+
+	placements, err := srv.GetPlacements(ctx, owner, file.ID)
+	require.NoError(t, err)
+	require.Equal(t, 2, placements.Direct.Total)
+	require.Len(t, placements.Items, 2)
+	require.Equal(t, reddit.ID, placements.Items[0].ID)
+	require.Equal(t, 1, placements.Items[0].Total)
+	require.Equal(t, channel.ID, placements.Items[1].ID)
+	require.Zero(t, placements.Items[1].Total)
+
+	stats, err := srv.GetDownloadStats(ctx, owner, file.ID)
+	require.NoError(t, err)
+	require.Len(t, stats.Placements, 3)
+	require.Nil(t, stats.Placements[0].Placement)
+
+	err = srv.DeletePlacement(ctx, user, file.ID, reddit.ID)
+	require.True(t, errors.Is(err, core.ErrFileNotFound))
+
+	require.NoError(t, srv.DeletePlacement(ctx, owner, file.ID, reddit.ID))
+
+	err = srv.DeletePlacement(ctx, owner, file.ID, reddit.ID)
+	require.True(t, errors.Is(err, core.ErrPlacementNotFound))
+
+	placements, err = srv.GetPlacements(ctx, owner, file.ID)
+	require.NoError(t, err)
+	require.Equal(t, 3, placements.Direct.Total)
+	require.Len(t, placements.Items, 1)
+}
+
+func TestFile_PlacementsLimit(t *testing.T) {
+	ctx := context.Background()
+	mem := memory.New()
+
+	srv := &service.File{
+		File:      mem.File(),
+		Placement: mem.Placement(),
+	}
+
+	owner := core.NewUser(1, "Owner", "", "", "en")
+	require.NoError(t, mem.User().Add(ctx, owner))
+
+	file := core.NewFile("telegram", "", core.KindDocument, "text/plain", 100, "file.txt", owner.ID, false, core.Metadata{})
+	require.NoError(t, mem.File().Add(ctx, file))
+
+	for i := 0; i < service.FilePlacementsLimit; i++ {
+		_, err := srv.AddPlacement(ctx, owner, file.ID, "place")
+		require.NoError(t, err)
+	}
+
+	_, err := srv.AddPlacement(ctx, owner, file.ID, "place")
+	require.True(t, errors.Is(err, service.ErrPlacementsLimitReached))
+}
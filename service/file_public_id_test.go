@@ -0,0 +1,126 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/pkg/secretid"
+	"github.com/bots-house/share-file-bot/service"
+	"github.com/bots-house/share-file-bot/store/memory"
+	"github.com/friendsofgo/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFile_PublicIDStrategy(t *testing.T) {
+	for _, strategy := range []string{secretid.StrategyNanoID, secretid.StrategyHashIDs} {
+		strategy := strategy
+
+		t.Run(strategy, func(t *testing.T) {
+			ctx := context.Background()
+			mem := memory.New()
+
+			sid, err := secretid.Parse(strategy, "salt")
+			require.NoError(t, err)
+
+			srv := &service.File{
+				File:        mem.File(),
+				User:        mem.User(),
+				Download:    mem.Download(),
+				RevokedLink: mem.RevokedLink(),
+				Bundle:      mem.Bundle(),
+				Txier:       mem.Tx,
+				SecretID:    sid,
+
+				IsUsersCanUploadFiles: true,
+			}
+
+			owner := core.NewUser(1, "Owner", "", "", "en")
+			require.NoError(t, mem.User().Add(ctx, owner))
+
+			in := &service.InputFile{
+				FileID:   "telegram",
+				Kind:     core.KindDocument,
+				Name:     "file.txt",
+				Size:     100,
+				Metadata: core.Metadata{},
+			}
+
+			getFile := func(publicID string) (*service.OwnedFile, error) {
+				t.Helper()
+
+				result, err := srv.GetFileByPublicID(ctx, owner, publicID)
+				if err != nil {
+					return nil, err
+				}
+
+				return result.OwnedFile, nil
+			}
+
+			// file uploaded before strategy was changed
+			legacy := core.NewFile("telegram", "", core.KindDocument, "", 100, "legacy.txt", owner.ID, false, core.Metadata{})
+			require.NoError(t, mem.File().Add(ctx, legacy))
+
+			file, err := srv.AddFile(ctx, owner, in)
+			require.NoError(t, err)
+
+			if sid != nil {
+				require.Equal(t, sid.Encode(int(file.ID)), file.PublicID)
+			} else {
+				require.Len(t, file.PublicID, 5)
+			}
+
+			for _, item := range []*core.File{legacy, file.File} {
+				found, err := getFile(item.PublicID)
+				require.NoError(t, err)
+				require.Equal(t, item.ID, found.ID)
+			}
+
+			// long ids are random with any strategy
+			owner.Settings.LongIDs = true
+
+			long, err := srv.AddFile(ctx, owner, in)
+			require.NoError(t, err)
+			require.True(t, secretid.IsLong(long.PublicID))
+
+			found, err := getFile(long.PublicID)
+			require.NoError(t, err)
+			require.Equal(t, long.ID, found.ID)
+
+			owner.Settings.LongIDs = false
+
+			// previous id is revoked, even if it can be decoded to id of file
+			previous := file.PublicID
+
+			_, err = srv.RegenerateLink(ctx, owner, file.ID, 0)
+			require.NoError(t, err)
+
+			_, err = getFile(previous)
+			require.True(t, errors.Is(err, service.ErrFileLinkRevoked))
+
+			_, err = getFile("unknown")
+			require.True(t, errors.Is(err, core.ErrFileNotFound))
+
+			if sid == nil {
+				return
+			}
+
+			// random id is kept, if encoded one is used by other file
+			next := core.FileID(long.ID + 1)
+
+			legacy.PublicID = sid.Encode(int(next))
+			require.NoError(t, mem.File().Update(ctx, legacy))
+
+			collided, err := srv.AddFile(ctx, owner, in)
+			require.NoError(t, err)
+			require.Equal(t, next, collided.ID)
+			require.NotEqual(t, legacy.PublicID, collided.PublicID)
+
+			for _, item := range []*core.File{legacy, collided.File} {
+				found, err := getFile(item.PublicID)
+				require.NoError(t, err)
+				require.Equal(t, item.ID, found.ID)
+			}
+		})
+	}
+}
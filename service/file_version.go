@@ -0,0 +1,194 @@
+package service
+
+import (
+	"context"
+
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/pkg/log"
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+)
+
+// FileVersionsShowLimit is max number of previous versions returned to owner.
+const FileVersionsShowLimit = 10
+
+// FileVersionStats is previous version of file with downloads made when it was current.
+type FileVersionStats struct {
+	*core.FileVersion
+
+	Total  int
+	Unique int
+}
+
+// FileVersions is versions of owned file.
+type FileVersions struct {
+	File *core.File
+
+	// Downloads of current version.
+	Current *FileVersionStats
+
+	// Previous versions from newest to oldest, at most FileVersionsShowLimit.
+	Items []*FileVersionStats
+
+	// Total count of previous versions.
+	Count int
+}
+
+// replaceFileContent archives current content of file owned by user
+// and replaces it by content returned by getContent.
+func (srv *File) replaceFileContent(
+	ctx context.Context,
+	user *core.User,
+	fileID core.FileID,
+	getContent func(ctx context.Context, file *core.File) (*core.FileVersion, error),
+) (*core.File, error) {
+	var file *core.File
+
+	if err := srv.Txier(ctx, func(ctx context.Context) error {
+		var err error
+
+		file, err = srv.File.Query().OwnerID(user.ID).ID(fileID).One(ctx)
+		if err != nil {
+			return errors.Wrap(err, "query file")
+		}
+
+		content, err := getContent(ctx, file)
+		if err != nil {
+			return err
+		}
+
+		if err := srv.FileVersion.Add(ctx, core.NewFileVersion(file)); err != nil {
+			return errors.Wrap(err, "add version")
+		}
+
+		file.SetContent(content)
+
+		if err := srv.File.Update(ctx, file); err != nil {
+			return errors.Wrap(err, "update file")
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return file, nil
+}
+
+// ReplaceFile uploads new version of file owned by user.
+// Public ID, caption, restrictions and stats of file are kept.
+func (srv *File) ReplaceFile(
+	ctx context.Context,
+	user *core.User,
+	fileID core.FileID,
+	in *InputFile,
+) (*OwnedFile, error) {
+	file, err := srv.replaceFileContent(ctx, user, fileID, func(ctx context.Context, file *core.File) (*core.FileVersion, error) {
+		return &core.FileVersion{
+			TelegramID: in.FileID,
+			Kind:       in.Kind,
+			MIMEType:   null.NewString(in.MIMEType, in.MIMEType != ""),
+			Name:       in.Name,
+			Size:       in.Size,
+			Metadata:   in.Metadata,
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info(ctx, "replace file",
+		"file_id", file.ID,
+		"version", file.Version,
+		"name", in.Name,
+		"size", in.Size,
+		"kind", in.Kind.String(),
+	)
+
+	return srv.newOwnedFile(ctx, file)
+}
+
+// RestoreFileVersion makes content of previous version current.
+// Restored content becomes new version, so history is kept.
+func (srv *File) RestoreFileVersion(
+	ctx context.Context,
+	user *core.User,
+	fileID core.FileID,
+	versionID core.FileVersionID,
+) (*OwnedFile, error) {
+	var restored int
+
+	file, err := srv.replaceFileContent(ctx, user, fileID, func(ctx context.Context, file *core.File) (*core.FileVersion, error) {
+		version, err := srv.FileVersion.Query().ID(versionID).FileID(file.ID).One(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "query version")
+		}
+
+		restored = version.Version
+
+		return version, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info(ctx, "restore file version", "file_id", file.ID, "restored", restored, "version", file.Version)
+
+	return srv.newOwnedFile(ctx, file)
+}
+
+// GetFileVersions returns previous versions of owned file with downloads.
+func (srv *File) GetFileVersions(
+	ctx context.Context,
+	user *core.User,
+	fileID core.FileID,
+) (*FileVersions, error) {
+	file, err := srv.File.Query().OwnerID(user.ID).ID(fileID).One(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "query file")
+	}
+
+	versions, err := srv.FileVersion.Query().FileID(file.ID).Limit(FileVersionsShowLimit).All(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "query versions")
+	}
+
+	count, err := srv.FileVersion.Query().FileID(file.ID).Count(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "count versions")
+	}
+
+	stats, err := srv.Download.GetFileVersionStats(ctx, file.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "get version stats")
+	}
+
+	byVersion := make(map[int]*core.FileVersionDownloadStats, len(stats))
+	for _, item := range stats {
+		byVersion[item.Version] = item
+	}
+
+	newStats := func(version *core.FileVersion) *FileVersionStats {
+		result := &FileVersionStats{FileVersion: version}
+
+		if item, ok := byVersion[version.Version]; ok {
+			result.Total = item.Total
+			result.Unique = item.Unique
+		}
+
+		return result
+	}
+
+	result := &FileVersions{
+		File:    file,
+		Current: newStats(&core.FileVersion{FileID: file.ID, Version: file.Version}),
+		Items:   make([]*FileVersionStats, len(versions)),
+		Count:   count,
+	}
+
+	for i, version := range versions {
+		result.Items[i] = newStats(version)
+	}
+
+	return result, nil
+}
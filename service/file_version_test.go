@@ -0,0 +1,96 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/service"
+	"github.com/bots-house/share-file-bot/store/memory"
+	"github.com/friendsofgo/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFile_Versions(t *testing.T) {
+	ctx := context.Background()
+	mem := memory.New()
+
+	srv := &service.File{
+		File:        mem.File(),
+		User:        mem.User(),
+		Download:    mem.Download(),
+		FileVersion: mem.FileVersion(),
+		Txier:       mem.Tx,
+	}
+
+	owner := core.NewUser(1, "Owner", "", "", "en")
+	user := core.NewUser(2, "User", "", "", "en")
+
+	for _, u := range []*core.User{owner, user} {
+		require.NoError(t, mem.User().Add(ctx, u))
+	}
+
+	file := core.NewFile("telegram-v1", "caption", core.KindDocument, "text/plain", 100, "v1.txt", owner.ID, false, core.Metadata{})
+	file.Restriction.MaxDownloads = 10
+	require.NoError(t, mem.File().Add(ctx, file))
+
+	require.NoError(t, mem.Download().Add(ctx, core.NewFileDownload(file, user.ID)))
+
+	v2 := &service.InputFile{
+		FileID:   "telegram-v2",
+		Kind:     core.KindPhoto,
+		Size:     200,
+		Caption:  "ignored",
+		Metadata: core.Metadata{},
+	}
+
+	_, err := srv.ReplaceFile(ctx, user, file.ID, v2)
+	require.True(t, errors.Is(err, core.ErrFileNotFound))
+
+	replaced, err := srv.ReplaceFile(ctx, owner, file.ID, v2)
+	require.NoError(t, err)
+	require.Equal(t, 2, replaced.Version)
+	require.Equal(t, "telegram-v2", replaced.TelegramID)
+	require.Equal(t, core.KindPhoto, replaced.Kind)
+	require.False(t, replaced.MIMEType.Valid)
+
+	// public id, caption, restrictions and stats are kept
+	require.Equal(t, file.PublicID, replaced.PublicID)
+	require.Equal(t, "caption", replaced.Caption.String)
+	require.Equal(t, 10, replaced.Restriction.MaxDownloads)
+	require.Equal(t, 1, replaced.Stats.Total)
+
+	require.NoError(t, mem.Download().Add(ctx, core.NewFileDownload(replaced.File, user.ID)))
+	require.NoError(t, mem.Download().Add(ctx, core.NewFileDownload(replaced.File, owner.ID)))
+
+	versions, err := srv.GetFileVersions(ctx, owner, file.ID)
+	require.NoError(t, err)
+	require.Equal(t, 1, versions.Count)
+	require.Equal(t, 2, versions.Current.Version)
+	require.Equal(t, 2, versions.Current.Total)
+	require.Len(t, versions.Items, 1)
+	require.Equal(t, "telegram-v1", versions.Items[0].TelegramID)
+	require.Equal(t, 1, versions.Items[0].Version)
+	require.Equal(t, 1, versions.Items[0].Total)
+
+	_, err = srv.GetFileVersions(ctx, user, file.ID)
+	require.True(t, errors.Is(err, core.ErrFileNotFound))
+
+	// restore
+	_, err = srv.RestoreFileVersion(ctx, owner, file.ID, versions.Items[0].ID+100)
+	require.True(t, errors.Is(err, core.ErrFileVersionNotFound))
+
+	restored, err := srv.RestoreFileVersion(ctx, owner, file.ID, versions.Items[0].ID)
+	require.NoError(t, err)
+	require.Equal(t, 3, restored.Version)
+	require.Equal(t, "telegram-v1", restored.TelegramID)
+	require.Equal(t, "v1.txt", restored.Name)
+	require.Equal(t, "text/plain", restored.MIMEType.String)
+
+	versions, err = srv.GetFileVersions(ctx, owner, file.ID)
+	require.NoError(t, err)
+	require.Equal(t, 2, versions.Count)
+	require.Equal(t, 2, versions.Items[0].Version)
+	require.Equal(t, "telegram-v2", versions.Items[0].TelegramID)
+	require.Zero(t, versions.Current.Total)
+}
@@ -0,0 +1,400 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/store"
+	"github.com/friendsofgo/errors"
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	// ReportReasonMaxLength is max length of report reason in runes.
+	ReportReasonMaxLength = 500
+
+	// ReportAppealMaxLength is max length of appeal in runes.
+	ReportAppealMaxLength = 1000
+
+	reportAwaitTTL = time.Hour
+)
+
+var (
+	ErrReportOwnFile            = errors.New("can't report own file")
+	ErrReportAlreadyExists      = errors.New("user already reported file")
+	ErrReportNotAwaited         = errors.New("report is not awaited")
+	ErrReportAppealNotAwaited   = errors.New("report appeal is not awaited")
+	ErrReportTextInvalidLength  = errors.New("invalid length of report text")
+	ErrReportAppealNotFileOwner = errors.New("only owner of file can appeal")
+	ErrReportQueueEmpty         = errors.New("report queue is empty")
+	ErrReportFileAlreadyBlocked = errors.New("file is already blocked")
+)
+
+// Report implements copyright takedown workflow.
+//
+// Any user can report file, admins approve or reject reports from queue.
+// Approved report blocks file, owner of file can appeal it once.
+type Report struct {
+	Report core.ReportStore
+	File   core.FileStore
+	User   core.UserStore
+	Redis  redis.UniversalClient
+	Txier  store.Txier
+}
+
+// FullReport is report with related entities.
+type FullReport struct {
+	*core.Report
+
+	File  *core.File
+	Owner *core.User
+
+	// Reporter is nil if user was deleted.
+	Reporter *core.User
+}
+
+// ReportQueue is first open report and count of open reports.
+type ReportQueue struct {
+	Report *FullReport
+	Total  int
+}
+
+func (srv *Report) getReportAwaitKey(userID core.UserID) string {
+	return fmt.Sprintf("share-file-bot:users:%d:report:await", userID)
+}
+
+func (srv *Report) getAppealAwaitKey(userID core.UserID) string {
+	return fmt.Sprintf("share-file-bot:users:%d:report:appeal", userID)
+}
+
+func (srv *Report) isAdmin(user *core.User) error {
+	if !user.IsAdmin {
+		return ErrUserIsNotAdmin
+	}
+
+	return nil
+}
+
+func (srv *Report) getFull(ctx context.Context, report *core.Report) (*FullReport, error) {
+	file, err := srv.File.Query().ID(report.FileID).One(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "get file")
+	}
+
+	owner, err := srv.User.Find(ctx, file.OwnerID)
+	if err != nil {
+		return nil, errors.Wrap(err, "get owner")
+	}
+
+	result := &FullReport{
+		Report: report,
+		File:   file,
+		Owner:  owner,
+	}
+
+	if report.ReporterID != 0 {
+		reporter, err := srv.User.Find(ctx, report.ReporterID)
+		if err != nil && !errors.Is(err, core.ErrUserNotFound) {
+			return nil, errors.Wrap(err, "get reporter")
+		}
+		result.Reporter = reporter
+	}
+
+	return result, nil
+}
+
+// popAwaited returns id stored by key and deletes key.
+func (srv *Report) popAwaited(ctx context.Context, key string) (int, bool, error) {
+	val, err := srv.Redis.Get(ctx, key).Result()
+	if err == redis.Nil {
+		return 0, false, nil
+	} else if err != nil {
+		return 0, false, errors.Wrap(err, "get await key")
+	}
+
+	id, err := strconv.Atoi(val)
+	if err != nil {
+		return 0, false, errors.Wrap(err, "parse await key")
+	}
+
+	if err := srv.Redis.Del(ctx, key).Err(); err != nil {
+		return 0, false, errors.Wrap(err, "del await key")
+	}
+
+	return id, true, nil
+}
+
+func checkReportTextLength(text string, max int) error {
+	if n := utf8.RuneCountInString(text); n == 0 || n > max {
+		return ErrReportTextInvalidLength
+	}
+
+	return nil
+}
+
+// RequestReport registers user as awaiting for reason of file report.
+func (srv *Report) RequestReport(ctx context.Context, user *core.User, fileID core.FileID) error {
+	file, err := srv.File.Query().ID(fileID).One(ctx)
+	if err != nil {
+		return errors.Wrap(err, "get file")
+	}
+
+	if file.OwnerID == user.ID {
+		return ErrReportOwnFile
+	}
+
+	if file.IsViolatesCopyright.Bool {
+		return ErrReportFileAlreadyBlocked
+	}
+
+	count, err := srv.Report.Query().
+		FileID(file.ID).
+		ReporterID(user.ID).
+		Status(core.ReportStatusPending).
+		Count(ctx)
+	if err != nil {
+		return errors.Wrap(err, "count pending reports")
+	}
+
+	if count > 0 {
+		return ErrReportAlreadyExists
+	}
+
+	key := srv.getReportAwaitKey(user.ID)
+
+	if err := srv.Redis.Set(ctx, key, int(file.ID), reportAwaitTTL).Err(); err != nil {
+		return errors.Wrap(err, "set await key")
+	}
+
+	return nil
+}
+
+// CreateReport creates report of awaited file with reason.
+func (srv *Report) CreateReport(ctx context.Context, user *core.User, reason string) (*core.Report, error) {
+	if err := checkReportTextLength(reason, ReportReasonMaxLength); err != nil {
+		return nil, err
+	}
+
+	id, ok, err := srv.popAwaited(ctx, srv.getReportAwaitKey(user.ID))
+	if err != nil {
+		return nil, errors.Wrap(err, "pop awaited file")
+	} else if !ok {
+		return nil, ErrReportNotAwaited
+	}
+
+	report := core.NewReport(core.FileID(id), user.ID, reason)
+
+	if err := srv.Report.Add(ctx, report); err != nil {
+		return nil, errors.Wrap(err, "add report")
+	}
+
+	return report, nil
+}
+
+// RequestAppeal registers owner of file as awaiting for text of appeal.
+func (srv *Report) RequestAppeal(ctx context.Context, user *core.User, id core.ReportID) error {
+	report, err := srv.Report.Query().ID(id).One(ctx)
+	if err != nil {
+		return errors.Wrap(err, "get report")
+	}
+
+	file, err := srv.File.Query().ID(report.FileID).One(ctx)
+	if err != nil {
+		return errors.Wrap(err, "get file")
+	}
+
+	if file.OwnerID != user.ID {
+		return ErrReportAppealNotFileOwner
+	}
+
+	if report.Status != core.ReportStatusApproved {
+		return core.ErrReportCantBeAppealed
+	}
+
+	key := srv.getAppealAwaitKey(user.ID)
+
+	if err := srv.Redis.Set(ctx, key, int(report.ID), reportAwaitTTL).Err(); err != nil {
+		return errors.Wrap(err, "set await key")
+	}
+
+	return nil
+}
+
+// CreateAppeal appeals awaited report.
+func (srv *Report) CreateAppeal(ctx context.Context, user *core.User, text string) (*core.Report, error) {
+	if err := checkReportTextLength(text, ReportAppealMaxLength); err != nil {
+		return nil, err
+	}
+
+	id, ok, err := srv.popAwaited(ctx, srv.getAppealAwaitKey(user.ID))
+	if err != nil {
+		return nil, errors.Wrap(err, "pop awaited report")
+	} else if !ok {
+		return nil, ErrReportAppealNotAwaited
+	}
+
+	var report *core.Report
+
+	if err := srv.Txier(ctx, func(ctx context.Context) error {
+		report, err = srv.Report.Query().ID(core.ReportID(id)).One(ctx)
+		if err != nil {
+			return errors.Wrap(err, "get report")
+		}
+
+		if err := report.MakeAppeal(text); err != nil {
+			return err
+		}
+
+		return srv.Report.Update(ctx, report)
+	}); err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// CancelAwait cancels waiting for reason or appeal.
+func (srv *Report) CancelAwait(ctx context.Context, user *core.User) error {
+	err := srv.Redis.Del(ctx,
+		srv.getReportAwaitKey(user.ID),
+		srv.getAppealAwaitKey(user.ID),
+	).Err()
+	if err != nil {
+		return errors.Wrap(err, "del await keys")
+	}
+
+	return nil
+}
+
+// OpenReportsCount returns count of reports waiting for admin decision.
+func (srv *Report) OpenReportsCount(ctx context.Context, admin *core.User) (int, error) {
+	if err := srv.isAdmin(admin); err != nil {
+		return 0, err
+	}
+
+	return srv.Report.Query().
+		Status(core.ReportStatusPending, core.ReportStatusAppealed).
+		Count(ctx)
+}
+
+// GetQueue returns oldest open report.
+func (srv *Report) GetQueue(ctx context.Context, admin *core.User) (*ReportQueue, error) {
+	total, err := srv.OpenReportsCount(ctx, admin)
+	if err != nil {
+		return nil, err
+	}
+
+	if total == 0 {
+		return nil, ErrReportQueueEmpty
+	}
+
+	report, err := srv.Report.Query().
+		Status(core.ReportStatusPending, core.ReportStatusAppealed).
+		One(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "get first open report")
+	}
+
+	full, err := srv.getFull(ctx, report)
+	if err != nil {
+		return nil, errors.Wrap(err, "get full report")
+	}
+
+	return &ReportQueue{
+		Report: full,
+		Total:  total,
+	}, nil
+}
+
+// GetReport returns report by id for admin.
+func (srv *Report) GetReport(ctx context.Context, admin *core.User, id core.ReportID) (*FullReport, error) {
+	if err := srv.isAdmin(admin); err != nil {
+		return nil, err
+	}
+
+	report, err := srv.Report.Query().ID(id).One(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "get report")
+	}
+
+	return srv.getFull(ctx, report)
+}
+
+// Resolve approves or rejects open report or appeal.
+// File is blocked when report is approved and unblocked when appeal is approved.
+func (srv *Report) Resolve(ctx context.Context, admin *core.User, id core.ReportID, approve bool) (*FullReport, error) {
+	if err := srv.isAdmin(admin); err != nil {
+		return nil, err
+	}
+
+	var result *FullReport
+
+	err := srv.Txier(ctx, func(ctx context.Context) error {
+		report, err := srv.Report.Query().ID(id).One(ctx)
+		if err != nil {
+			return errors.Wrap(err, "get report")
+		}
+
+		switch report.Status {
+		case core.ReportStatusPending:
+			err = report.Resolve(admin.ID, approve)
+		case core.ReportStatusAppealed:
+			err = report.ResolveAppeal(admin.ID, approve)
+		default:
+			err = core.ErrReportAlreadyResolved
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if err := srv.Report.Update(ctx, report); err != nil {
+			return errors.Wrap(err, "update report")
+		}
+
+		result, err = srv.getFull(ctx, report)
+		if err != nil {
+			return errors.Wrap(err, "get full report")
+		}
+
+		if err := srv.updateFileBlock(ctx, result.File); err != nil {
+			return errors.Wrap(err, "update file block")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// updateFileBlock blocks file if any of its reports blocks it.
+func (srv *Report) updateFileBlock(ctx context.Context, file *core.File) error {
+	blocking, err := srv.Report.Query().
+		FileID(file.ID).
+		Status(
+			core.ReportStatusApproved,
+			core.ReportStatusAppealed,
+			core.ReportStatusAppealRejected,
+		).
+		Count(ctx)
+	if err != nil {
+		return errors.Wrap(err, "count blocking reports")
+	}
+
+	isBlocked := blocking > 0
+
+	if file.IsViolatesCopyright.Bool == isBlocked {
+		return nil
+	}
+
+	file.IsViolatesCopyright.SetValid(isBlocked)
+
+	return srv.File.Update(ctx, file)
+}
@@ -0,0 +1,96 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/service"
+	"github.com/bots-house/share-file-bot/store/memory"
+	"github.com/friendsofgo/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReport_Resolve(t *testing.T) {
+	ctx := context.Background()
+	mem := memory.New()
+
+	srv := &service.Report{
+		Report: mem.Report(),
+		File:   mem.File(),
+		User:   mem.User(),
+		Txier:  mem.Tx,
+	}
+
+	owner := core.NewUser(1, "Owner", "", "", "en")
+	reporter := core.NewUser(2, "Reporter", "", "", "en")
+	admin := core.NewUser(3, "Admin", "", "", "en")
+	admin.IsAdmin = true
+
+	for _, user := range []*core.User{owner, reporter, admin} {
+		require.NoError(t, mem.User().Add(ctx, user))
+	}
+
+	file := core.NewFile("telegram", "", core.KindDocument, "text/plain", 100, "file.txt", owner.ID, false, core.Metadata{})
+	require.NoError(t, mem.File().Add(ctx, file))
+
+	isBlocked := func() bool {
+		t.Helper()
+
+		found, err := mem.File().Query().ID(file.ID).One(ctx)
+		require.NoError(t, err)
+
+		return found.IsViolatesCopyright.Bool
+	}
+
+	first := core.NewReport(file.ID, reporter.ID, "first")
+	second := core.NewReport(file.ID, reporter.ID, "second")
+	require.NoError(t, mem.Report().Add(ctx, first))
+	require.NoError(t, mem.Report().Add(ctx, second))
+
+	_, err := srv.Resolve(ctx, reporter, first.ID, true)
+	require.True(t, errors.Is(err, service.ErrUserIsNotAdmin))
+
+	queue, err := srv.GetQueue(ctx, admin)
+	require.NoError(t, err)
+	require.Equal(t, 2, queue.Total)
+	require.Equal(t, first.ID, queue.Report.ID)
+	require.Equal(t, owner.ID, queue.Report.Owner.ID)
+	require.Equal(t, reporter.ID, queue.Report.Reporter.ID)
+
+	// rejected report doesn't block file
+	result, err := srv.Resolve(ctx, admin, first.ID, false)
+	require.NoError(t, err)
+	require.Equal(t, core.ReportStatusRejected, result.Status)
+	require.Equal(t, admin.ID, result.ResolvedBy)
+	require.True(t, result.ResolvedAt.Valid)
+	require.False(t, isBlocked())
+
+	_, err = srv.Resolve(ctx, admin, first.ID, true)
+	require.True(t, errors.Is(err, core.ErrReportAlreadyResolved))
+
+	// approved report blocks file
+	result, err = srv.Resolve(ctx, admin, second.ID, true)
+	require.NoError(t, err)
+	require.Equal(t, core.ReportStatusApproved, result.Status)
+	require.True(t, isBlocked())
+
+	_, err = srv.GetQueue(ctx, admin)
+	require.True(t, errors.Is(err, service.ErrReportQueueEmpty))
+
+	// appeal keeps file blocked until decision
+	require.NoError(t, result.MakeAppeal("appeal"))
+	require.NoError(t, mem.Report().Update(ctx, result.Report))
+	require.True(t, isBlocked())
+
+	queue, err = srv.GetQueue(ctx, admin)
+	require.NoError(t, err)
+	require.Equal(t, second.ID, queue.Report.ID)
+
+	// approved appeal unblocks file
+	result, err = srv.Resolve(ctx, admin, second.ID, true)
+	require.NoError(t, err)
+	require.Equal(t, core.ReportStatusAppealApproved, result.Status)
+	require.True(t, result.AppealResolvedAt.Valid)
+	require.False(t, isBlocked())
+}
@@ -0,0 +1,121 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/bots-house/share-file-bot/core"
+)
+
+type AuditEntryStore struct {
+	mem *Memory
+}
+
+func cloneAuditEntry(entry *core.AuditEntry) *core.AuditEntry {
+	result := *entry
+	return &result
+}
+
+func (store *AuditEntryStore) Add(ctx context.Context, entry *core.AuditEntry) error {
+	return store.mem.update(ctx, func(d *data) error {
+		d.lastAuditID++
+		entry.ID = core.AuditEntryID(d.lastAuditID)
+
+		d.audit = append(d.audit, cloneAuditEntry(entry))
+
+		return nil
+	})
+}
+
+func (store *AuditEntryStore) Query() core.AuditEntryStoreQuery {
+	return &auditEntryStoreQuery{store: store}
+}
+
+type auditEntryStoreQuery struct {
+	store   *AuditEntryStore
+	filters []func(entry *core.AuditEntry) bool
+	limit   int
+}
+
+func (aesq *auditEntryStoreQuery) filter(fn func(entry *core.AuditEntry) bool) core.AuditEntryStoreQuery {
+	aesq.filters = append(aesq.filters, fn)
+	return aesq
+}
+
+func (aesq *auditEntryStoreQuery) AdminID(id core.UserID) core.AuditEntryStoreQuery {
+	return aesq.filter(func(entry *core.AuditEntry) bool {
+		return entry.AdminID == id
+	})
+}
+
+func (aesq *auditEntryStoreQuery) UserID(id core.UserID) core.AuditEntryStoreQuery {
+	return aesq.filter(func(entry *core.AuditEntry) bool {
+		return entry.UserID == id
+	})
+}
+
+func (aesq *auditEntryStoreQuery) FileID(id core.FileID) core.AuditEntryStoreQuery {
+	return aesq.filter(func(entry *core.AuditEntry) bool {
+		return entry.FileID == id
+	})
+}
+
+func (aesq *auditEntryStoreQuery) Limit(n int) core.AuditEntryStoreQuery {
+	aesq.limit = n
+	return aesq
+}
+
+func (aesq *auditEntryStoreQuery) match(entry *core.AuditEntry) bool {
+	for _, filter := range aesq.filters {
+		if !filter(entry) {
+			return false
+		}
+	}
+	return true
+}
+
+// find returns matched entries from newest to oldest.
+func (aesq *auditEntryStoreQuery) find(d *data) []*core.AuditEntry {
+	result := []*core.AuditEntry{}
+
+	for i := len(d.audit) - 1; i >= 0; i-- {
+		if aesq.match(d.audit[i]) {
+			result = append(result, d.audit[i])
+		}
+	}
+
+	return result
+}
+
+func (aesq *auditEntryStoreQuery) All(ctx context.Context) ([]*core.AuditEntry, error) {
+	var result []*core.AuditEntry
+
+	if err := aesq.store.mem.view(ctx, func(d *data) error {
+		entries := aesq.find(d)
+
+		if aesq.limit > 0 && len(entries) > aesq.limit {
+			entries = entries[:aesq.limit]
+		}
+
+		result = make([]*core.AuditEntry, len(entries))
+		for i, entry := range entries {
+			result[i] = cloneAuditEntry(entry)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (aesq *auditEntryStoreQuery) Count(ctx context.Context) (int, error) {
+	var count int
+
+	err := aesq.store.mem.view(ctx, func(d *data) error {
+		count = len(aesq.find(d))
+		return nil
+	})
+
+	return count, err
+}
@@ -0,0 +1,234 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/friendsofgo/errors"
+)
+
+var errBroadcastDeliveryAlreadyExists = errors.New("broadcast delivery already exists")
+
+type BroadcastStore struct {
+	mem *Memory
+}
+
+func cloneBroadcast(broadcast *core.Broadcast) *core.Broadcast {
+	result := *broadcast
+	result.Buttons = append([]core.BroadcastButton{}, broadcast.Buttons...)
+	return &result
+}
+
+func (store *BroadcastStore) Add(ctx context.Context, broadcast *core.Broadcast) error {
+	return store.mem.update(ctx, func(d *data) error {
+		d.lastBroadcastID++
+		broadcast.ID = core.BroadcastID(d.lastBroadcastID)
+
+		d.broadcasts[broadcast.ID] = cloneBroadcast(broadcast)
+
+		return nil
+	})
+}
+
+func (store *BroadcastStore) Update(ctx context.Context, broadcast *core.Broadcast) error {
+	return store.mem.update(ctx, func(d *data) error {
+		if _, ok := d.broadcasts[broadcast.ID]; !ok {
+			return core.ErrBroadcastNotFound
+		}
+
+		d.broadcasts[broadcast.ID] = cloneBroadcast(broadcast)
+
+		return nil
+	})
+}
+
+func (store *BroadcastStore) Query() core.BroadcastStoreQuery {
+	return &broadcastStoreQuery{store: store}
+}
+
+type broadcastStoreQuery struct {
+	store   *BroadcastStore
+	filters []func(broadcast *core.Broadcast) bool
+	limit   int
+}
+
+func (bsq *broadcastStoreQuery) filter(fn func(broadcast *core.Broadcast) bool) core.BroadcastStoreQuery {
+	bsq.filters = append(bsq.filters, fn)
+	return bsq
+}
+
+func (bsq *broadcastStoreQuery) ID(id core.BroadcastID) core.BroadcastStoreQuery {
+	return bsq.filter(func(broadcast *core.Broadcast) bool {
+		return broadcast.ID == id
+	})
+}
+
+func (bsq *broadcastStoreQuery) Status(statuses ...core.BroadcastStatus) core.BroadcastStoreQuery {
+	return bsq.filter(func(broadcast *core.Broadcast) bool {
+		for _, status := range statuses {
+			if broadcast.Status == status {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+func (bsq *broadcastStoreQuery) Limit(n int) core.BroadcastStoreQuery {
+	bsq.limit = n
+	return bsq
+}
+
+// find returns matched broadcasts from newest to oldest.
+func (bsq *broadcastStoreQuery) find(d *data) []*core.Broadcast {
+	result := []*core.Broadcast{}
+
+	for _, broadcast := range d.broadcasts {
+		if bsq.match(broadcast) {
+			result = append(result, broadcast)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ID > result[j].ID
+	})
+
+	return result
+}
+
+func (bsq *broadcastStoreQuery) match(broadcast *core.Broadcast) bool {
+	for _, filter := range bsq.filters {
+		if !filter(broadcast) {
+			return false
+		}
+	}
+	return true
+}
+
+func (bsq *broadcastStoreQuery) One(ctx context.Context) (*core.Broadcast, error) {
+	var result *core.Broadcast
+
+	if err := bsq.store.mem.view(ctx, func(d *data) error {
+		broadcasts := bsq.find(d)
+		if len(broadcasts) == 0 {
+			return core.ErrBroadcastNotFound
+		}
+
+		result = cloneBroadcast(broadcasts[0])
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (bsq *broadcastStoreQuery) All(ctx context.Context) ([]*core.Broadcast, error) {
+	var result []*core.Broadcast
+
+	if err := bsq.store.mem.view(ctx, func(d *data) error {
+		broadcasts := bsq.find(d)
+
+		if bsq.limit > 0 && len(broadcasts) > bsq.limit {
+			broadcasts = broadcasts[:bsq.limit]
+		}
+
+		result = make([]*core.Broadcast, len(broadcasts))
+		for i, broadcast := range broadcasts {
+			result[i] = cloneBroadcast(broadcast)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (bsq *broadcastStoreQuery) Count(ctx context.Context) (int, error) {
+	var count int
+
+	err := bsq.store.mem.view(ctx, func(d *data) error {
+		count = len(bsq.find(d))
+		return nil
+	})
+
+	return count, err
+}
+
+type BroadcastDeliveryStore struct {
+	mem *Memory
+}
+
+func cloneBroadcastDelivery(delivery *core.BroadcastDelivery) *core.BroadcastDelivery {
+	result := *delivery
+	return &result
+}
+
+func (store *BroadcastDeliveryStore) Add(ctx context.Context, delivery *core.BroadcastDelivery) error {
+	return store.mem.update(ctx, func(d *data) error {
+		for _, item := range d.broadcastDeliveries {
+			if item.BroadcastID == delivery.BroadcastID && item.UserID == delivery.UserID {
+				return errBroadcastDeliveryAlreadyExists
+			}
+		}
+
+		d.broadcastDeliveries = append(d.broadcastDeliveries, cloneBroadcastDelivery(delivery))
+
+		return nil
+	})
+}
+
+func (store *BroadcastDeliveryStore) Query() core.BroadcastDeliveryStoreQuery {
+	return &broadcastDeliveryStoreQuery{store: store}
+}
+
+type broadcastDeliveryStoreQuery struct {
+	store   *BroadcastDeliveryStore
+	filters []func(delivery *core.BroadcastDelivery) bool
+}
+
+func (bdsq *broadcastDeliveryStoreQuery) filter(fn func(delivery *core.BroadcastDelivery) bool) core.BroadcastDeliveryStoreQuery {
+	bdsq.filters = append(bdsq.filters, fn)
+	return bdsq
+}
+
+func (bdsq *broadcastDeliveryStoreQuery) BroadcastID(id core.BroadcastID) core.BroadcastDeliveryStoreQuery {
+	return bdsq.filter(func(delivery *core.BroadcastDelivery) bool {
+		return delivery.BroadcastID == id
+	})
+}
+
+func (bdsq *broadcastDeliveryStoreQuery) UserID(id core.UserID) core.BroadcastDeliveryStoreQuery {
+	return bdsq.filter(func(delivery *core.BroadcastDelivery) bool {
+		return delivery.UserID == id
+	})
+}
+
+func (bdsq *broadcastDeliveryStoreQuery) Status(status core.BroadcastDeliveryStatus) core.BroadcastDeliveryStoreQuery {
+	return bdsq.filter(func(delivery *core.BroadcastDelivery) bool {
+		return delivery.Status == status
+	})
+}
+
+func (bdsq *broadcastDeliveryStoreQuery) Count(ctx context.Context) (int, error) {
+	var count int
+
+	err := bdsq.store.mem.view(ctx, func(d *data) error {
+	deliveries:
+		for _, delivery := range d.broadcastDeliveries {
+			for _, filter := range bdsq.filters {
+				if !filter(delivery) {
+					continue deliveries
+				}
+			}
+			count++
+		}
+		return nil
+	})
+
+	return count, err
+}
@@ -0,0 +1,183 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"github.com/bots-house/share-file-bot/core"
+)
+
+type BundleStore struct {
+	mem *Memory
+}
+
+func cloneBundle(bundle *core.Bundle) *core.Bundle {
+	result := *bundle
+
+	result.Restriction.ChatIDs = cloneChatIDs(bundle.Restriction.ChatIDs)
+
+	if bundle.FileIDs != nil {
+		result.FileIDs = append([]core.FileID{}, bundle.FileIDs...)
+	}
+
+	return &result
+}
+
+func (store *BundleStore) Add(ctx context.Context, bundle *core.Bundle) error {
+	return store.mem.update(ctx, func(d *data) error {
+		// public id of bundle and file share one namespace in deep links
+		for isPublicIDUsed(d, bundle.PublicID) {
+			bundle.RegenPublicID()
+		}
+
+		d.lastBundleID++
+		bundle.ID = core.BundleID(d.lastBundleID)
+
+		d.bundles[bundle.ID] = cloneBundle(bundle)
+
+		return nil
+	})
+}
+
+func (store *BundleStore) Update(ctx context.Context, bundle *core.Bundle) error {
+	return store.mem.update(ctx, func(d *data) error {
+		if _, ok := d.bundles[bundle.ID]; !ok {
+			return core.ErrBundleNotFound
+		}
+
+		d.bundles[bundle.ID] = cloneBundle(bundle)
+
+		return nil
+	})
+}
+
+func (store *BundleStore) Query() core.BundleStoreQuery {
+	return &bundleStoreQuery{store: store}
+}
+
+type bundleStoreQuery struct {
+	store   *BundleStore
+	filters []func(bundle *core.Bundle) bool
+}
+
+func (bsq *bundleStoreQuery) ID(id core.BundleID) core.BundleStoreQuery {
+	bsq.filters = append(bsq.filters, func(bundle *core.Bundle) bool {
+		return bundle.ID == id
+	})
+	return bsq
+}
+
+func (bsq *bundleStoreQuery) OwnerID(id core.UserID) core.BundleStoreQuery {
+	bsq.filters = append(bsq.filters, func(bundle *core.Bundle) bool {
+		return bundle.OwnerID == id
+	})
+	return bsq
+}
+
+func (bsq *bundleStoreQuery) PublicID(ids ...string) core.BundleStoreQuery {
+	bsq.filters = append(bsq.filters, func(bundle *core.Bundle) bool {
+		for _, id := range ids {
+			if bundle.PublicID == id {
+				return true
+			}
+		}
+		return false
+	})
+	return bsq
+}
+
+// find returns matched bundles ordered by id.
+func (bsq *bundleStoreQuery) find(d *data) []*core.Bundle {
+	result := []*core.Bundle{}
+
+	for _, bundle := range d.bundles {
+		if bsq.match(bundle) {
+			result = append(result, bundle)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ID < result[j].ID
+	})
+
+	return result
+}
+
+func (bsq *bundleStoreQuery) match(bundle *core.Bundle) bool {
+	for _, filter := range bsq.filters {
+		if !filter(bundle) {
+			return false
+		}
+	}
+	return true
+}
+
+func (bsq *bundleStoreQuery) One(ctx context.Context) (*core.Bundle, error) {
+	var result *core.Bundle
+
+	if err := bsq.store.mem.view(ctx, func(d *data) error {
+		bundles := bsq.find(d)
+		if len(bundles) == 0 {
+			return core.ErrBundleNotFound
+		}
+
+		result = cloneBundle(bundles[0])
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (bsq *bundleStoreQuery) All(ctx context.Context) ([]*core.Bundle, error) {
+	var result []*core.Bundle
+
+	if err := bsq.store.mem.view(ctx, func(d *data) error {
+		bundles := bsq.find(d)
+
+		result = make([]*core.Bundle, len(bundles))
+		for i, bundle := range bundles {
+			result[i] = cloneBundle(bundle)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (bsq *bundleStoreQuery) Delete(ctx context.Context) error {
+	return bsq.store.mem.update(ctx, func(d *data) error {
+		bundles := bsq.find(d)
+		if len(bundles) == 0 {
+			return core.ErrBundleNotFound
+		}
+
+		for _, bundle := range bundles {
+			delete(d.bundles, bundle.ID)
+
+			for _, dwn := range d.downloads {
+				if dwn.BundleID == bundle.ID {
+					dwn.BundleID = core.ZeroBundleID
+				}
+			}
+		}
+
+		return nil
+	})
+}
+
+func (bsq *bundleStoreQuery) Count(ctx context.Context) (int, error) {
+	var count int
+
+	err := bsq.store.mem.view(ctx, func(d *data) error {
+		count = len(bsq.find(d))
+		return nil
+	})
+
+	return count, err
+}
@@ -0,0 +1,145 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/friendsofgo/errors"
+)
+
+var errCampaignAlreadyExists = errors.New("campaign with same ref already exists")
+
+type CampaignStore struct {
+	mem *Memory
+}
+
+func cloneCampaign(campaign *core.Campaign) *core.Campaign {
+	result := *campaign
+	return &result
+}
+
+func (store *CampaignStore) Add(ctx context.Context, campaign *core.Campaign) error {
+	return store.mem.update(ctx, func(d *data) error {
+		for _, item := range d.campaigns {
+			if item.Ref == campaign.Ref {
+				return errCampaignAlreadyExists
+			}
+		}
+
+		d.lastCampaignID++
+		campaign.ID = core.CampaignID(d.lastCampaignID)
+
+		d.campaigns[campaign.ID] = cloneCampaign(campaign)
+
+		return nil
+	})
+}
+
+func (store *CampaignStore) Query() core.CampaignStoreQuery {
+	return &campaignStoreQuery{store: store}
+}
+
+type campaignStoreQuery struct {
+	store   *CampaignStore
+	filters []func(campaign *core.Campaign) bool
+}
+
+func (csq *campaignStoreQuery) filter(fn func(campaign *core.Campaign) bool) core.CampaignStoreQuery {
+	csq.filters = append(csq.filters, fn)
+	return csq
+}
+
+func (csq *campaignStoreQuery) ID(id core.CampaignID) core.CampaignStoreQuery {
+	return csq.filter(func(campaign *core.Campaign) bool {
+		return campaign.ID == id
+	})
+}
+
+func (csq *campaignStoreQuery) Ref(ref string) core.CampaignStoreQuery {
+	return csq.filter(func(campaign *core.Campaign) bool {
+		return campaign.Ref == ref
+	})
+}
+
+// find returns matched campaigns from newest to oldest.
+func (csq *campaignStoreQuery) find(d *data) []*core.Campaign {
+	result := []*core.Campaign{}
+
+	for _, campaign := range d.campaigns {
+		if csq.match(campaign) {
+			result = append(result, campaign)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ID > result[j].ID
+	})
+
+	return result
+}
+
+func (csq *campaignStoreQuery) match(campaign *core.Campaign) bool {
+	for _, filter := range csq.filters {
+		if !filter(campaign) {
+			return false
+		}
+	}
+	return true
+}
+
+func (csq *campaignStoreQuery) One(ctx context.Context) (*core.Campaign, error) {
+	var result *core.Campaign
+
+	if err := csq.store.mem.view(ctx, func(d *data) error {
+		campaigns := csq.find(d)
+		if len(campaigns) == 0 {
+			return core.ErrCampaignNotFound
+		}
+
+		result = cloneCampaign(campaigns[0])
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (csq *campaignStoreQuery) All(ctx context.Context) ([]*core.Campaign, error) {
+	var result []*core.Campaign
+
+	if err := csq.store.mem.view(ctx, func(d *data) error {
+		campaigns := csq.find(d)
+
+		result = make([]*core.Campaign, len(campaigns))
+		for i, campaign := range campaigns {
+			result[i] = cloneCampaign(campaign)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (csq *campaignStoreQuery) Delete(ctx context.Context) (int, error) {
+	var count int
+
+	err := csq.store.mem.update(ctx, func(d *data) error {
+		campaigns := csq.find(d)
+
+		for _, campaign := range campaigns {
+			delete(d.campaigns, campaign.ID)
+		}
+
+		count = len(campaigns)
+
+		return nil
+	})
+
+	return count, err
+}
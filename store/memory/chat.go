@@ -0,0 +1,194 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/service"
+)
+
+type ChatStore struct {
+	mem *Memory
+}
+
+func cloneChat(chat *core.Chat) *core.Chat {
+	result := *chat
+	return &result
+}
+
+// Add chat to store.
+func (store *ChatStore) Add(ctx context.Context, chat *core.Chat) error {
+	return store.mem.update(ctx, func(d *data) error {
+		for _, v := range d.chats {
+			if v.OwnerID == chat.OwnerID && v.TelegramID == chat.TelegramID {
+				return service.ErrChatAlreadyConnected
+			}
+		}
+
+		d.lastChatID++
+		chat.ID = core.ChatID(d.lastChatID)
+
+		d.chats[chat.ID] = cloneChat(chat)
+
+		return nil
+	})
+}
+
+// Update chat in store.
+func (store *ChatStore) Update(ctx context.Context, chat *core.Chat) error {
+	return store.mem.update(ctx, func(d *data) error {
+		if _, ok := d.chats[chat.ID]; !ok {
+			return core.ErrChatNotFound
+		}
+
+		d.chats[chat.ID] = cloneChat(chat)
+
+		return nil
+	})
+}
+
+func (store *ChatStore) Query() core.ChatStoreQuery {
+	return &chatStoreQuery{store: store}
+}
+
+type chatStoreQuery struct {
+	store   *ChatStore
+	filters []func(chat *core.Chat) bool
+}
+
+func (csq *chatStoreQuery) ID(ids ...core.ChatID) core.ChatStoreQuery {
+	csq.filters = append(csq.filters, func(chat *core.Chat) bool {
+		for _, id := range ids {
+			if chat.ID == id {
+				return true
+			}
+		}
+		return false
+	})
+	return csq
+}
+
+func (csq *chatStoreQuery) TelegramID(id int64) core.ChatStoreQuery {
+	csq.filters = append(csq.filters, func(chat *core.Chat) bool {
+		return chat.TelegramID == id
+	})
+	return csq
+}
+
+func (csq *chatStoreQuery) OwnerID(id core.UserID) core.ChatStoreQuery {
+	csq.filters = append(csq.filters, func(chat *core.Chat) bool {
+		return chat.OwnerID == id
+	})
+	return csq
+}
+
+// find returns matched chats ordered by id.
+func (csq *chatStoreQuery) find(d *data) []*core.Chat {
+	result := []*core.Chat{}
+
+	for _, chat := range d.chats {
+		if matchChat(csq.filters, chat) {
+			result = append(result, chat)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ID < result[j].ID
+	})
+
+	return result
+}
+
+func matchChat(filters []func(chat *core.Chat) bool, chat *core.Chat) bool {
+	for _, filter := range filters {
+		if !filter(chat) {
+			return false
+		}
+	}
+	return true
+}
+
+func (csq *chatStoreQuery) Delete(ctx context.Context) (int, error) {
+	var count int
+
+	err := csq.store.mem.update(ctx, func(d *data) error {
+		chats := csq.find(d)
+
+		for _, chat := range chats {
+			delete(d.chats, chat.ID)
+
+			// restrictions by chat are deleted by cascade
+			for _, file := range d.files {
+				if file.Restriction.HasChat(chat.ID) {
+					file.Restriction.ToggleChat(chat.ID)
+				}
+			}
+
+			for _, bundle := range d.bundles {
+				if bundle.Restriction.HasChat(chat.ID) {
+					bundle.Restriction.ToggleChat(chat.ID)
+				}
+			}
+
+			for _, dwn := range d.downloads {
+				dwn.ChatIDs = removeChatID(dwn.ChatIDs, chat.ID)
+			}
+		}
+
+		count = len(chats)
+
+		return nil
+	})
+
+	return count, err
+}
+
+func (csq *chatStoreQuery) One(ctx context.Context) (*core.Chat, error) {
+	var result *core.Chat
+
+	if err := csq.store.mem.view(ctx, func(d *data) error {
+		chats := csq.find(d)
+		if len(chats) == 0 {
+			return core.ErrChatNotFound
+		}
+
+		result = cloneChat(chats[0])
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (csq *chatStoreQuery) All(ctx context.Context) ([]*core.Chat, error) {
+	var result []*core.Chat
+
+	if err := csq.store.mem.view(ctx, func(d *data) error {
+		chats := csq.find(d)
+
+		result = make([]*core.Chat, len(chats))
+		for i, chat := range chats {
+			result[i] = cloneChat(chat)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (csq *chatStoreQuery) Count(ctx context.Context) (int, error) {
+	var count int
+
+	err := csq.store.mem.view(ctx, func(d *data) error {
+		count = len(csq.find(d))
+		return nil
+	})
+
+	return count, err
+}
@@ -0,0 +1,391 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"github.com/bots-house/share-file-bot/core"
+)
+
+type DownloadStore struct {
+	mem *Memory
+}
+
+func cloneDownload(dwn *core.Download) *core.Download {
+	result := *dwn
+	result.ChatIDs = cloneChatIDs(dwn.ChatIDs)
+	return &result
+}
+
+func (store *DownloadStore) Add(ctx context.Context, dwn *core.Download) error {
+	return store.mem.update(ctx, func(d *data) error {
+		d.lastDownloadID++
+		dwn.ID = core.DownloadID(d.lastDownloadID)
+
+		d.downloads = append(d.downloads, cloneDownload(dwn))
+
+		return nil
+	})
+}
+
+// filterDownloads returns downloads matched by fn in order of id.
+func filterDownloads(d *data, fn func(dwn *core.Download) bool) []*core.Download {
+	result := []*core.Download{}
+
+	for _, dwn := range d.downloads {
+		if fn(dwn) {
+			result = append(result, dwn)
+		}
+	}
+
+	return result
+}
+
+// isChatDownload returns true if download required subscription to chat.
+func isChatDownload(dwn *core.Download, id core.ChatID) bool {
+	for _, v := range dwn.ChatIDs {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
+
+func (store *DownloadStore) GetFileStats(ctx context.Context, id core.FileID) (*core.FileDownloadStats, error) {
+	result := &core.FileDownloadStats{}
+
+	err := store.mem.view(ctx, func(d *data) error {
+		users := map[core.UserID]struct{}{}
+
+		for _, dwn := range filterDownloads(d, func(dwn *core.Download) bool {
+			return dwn.FileID == id
+		}) {
+			if dwn.UserID != 0 {
+				result.Total++
+				users[dwn.UserID] = struct{}{}
+			}
+
+			if dwn.NewSubscription.Valid {
+				if dwn.NewSubscription.Bool {
+					result.NewSubscription++
+				} else {
+					result.WithSubscription++
+				}
+			}
+		}
+
+		result.Unique = len(users)
+
+		return nil
+	})
+
+	return result, err
+}
+
+func (store *DownloadStore) GetFilePlacementStats(ctx context.Context, id core.FileID) ([]*core.PlacementDownloadStats, error) {
+	result := []*core.PlacementDownloadStats{}
+
+	err := store.mem.view(ctx, func(d *data) error {
+		stats := map[core.PlacementID]*core.PlacementDownloadStats{}
+		users := map[core.PlacementID]map[core.UserID]struct{}{}
+
+		for _, dwn := range filterDownloads(d, func(dwn *core.Download) bool {
+			return dwn.FileID == id
+		}) {
+			item, ok := stats[dwn.PlacementID]
+			if !ok {
+				item = &core.PlacementDownloadStats{PlacementID: dwn.PlacementID}
+				stats[dwn.PlacementID] = item
+				users[dwn.PlacementID] = map[core.UserID]struct{}{}
+				result = append(result, item)
+			}
+
+			if dwn.UserID != 0 {
+				item.Total++
+				users[dwn.PlacementID][dwn.UserID] = struct{}{}
+			}
+		}
+
+		for _, item := range result {
+			item.Unique = len(users[item.PlacementID])
+		}
+
+		sort.Slice(result, func(i, j int) bool {
+			return result[i].PlacementID < result[j].PlacementID
+		})
+
+		return nil
+	})
+
+	return result, err
+}
+
+func (store *DownloadStore) GetFileVersionStats(ctx context.Context, id core.FileID) ([]*core.FileVersionDownloadStats, error) {
+	result := []*core.FileVersionDownloadStats{}
+
+	err := store.mem.view(ctx, func(d *data) error {
+		stats := map[int]*core.FileVersionDownloadStats{}
+		users := map[int]map[core.UserID]struct{}{}
+
+		for _, dwn := range filterDownloads(d, func(dwn *core.Download) bool {
+			return dwn.FileID == id
+		}) {
+			item, ok := stats[dwn.FileVersion]
+			if !ok {
+				item = &core.FileVersionDownloadStats{Version: dwn.FileVersion}
+				stats[dwn.FileVersion] = item
+				users[dwn.FileVersion] = map[core.UserID]struct{}{}
+				result = append(result, item)
+			}
+
+			if dwn.UserID != 0 {
+				item.Total++
+				users[dwn.FileVersion][dwn.UserID] = struct{}{}
+			}
+		}
+
+		for _, item := range result {
+			item.Unique = len(users[item.Version])
+		}
+
+		sort.Slice(result, func(i, j int) bool {
+			return result[i].Version < result[j].Version
+		})
+
+		return nil
+	})
+
+	return result, err
+}
+
+func (store *DownloadStore) GetBundleStats(ctx context.Context, id core.BundleID) (*core.FileDownloadStats, error) {
+	result := &core.FileDownloadStats{}
+
+	// every bundle download is stored as row per member file with same time,
+	// so one download of bundle is unique pair of user and time.
+	type bundleDownload struct {
+		UserID core.UserID
+		At     int64
+	}
+
+	err := store.mem.view(ctx, func(d *data) error {
+		all := map[bundleDownload]struct{}{}
+		newSub := map[bundleDownload]struct{}{}
+		withSub := map[bundleDownload]struct{}{}
+		users := map[core.UserID]struct{}{}
+
+		for _, dwn := range filterDownloads(d, func(dwn *core.Download) bool {
+			return dwn.BundleID == id
+		}) {
+			key := bundleDownload{UserID: dwn.UserID, At: dwn.At.UnixNano()}
+
+			all[key] = struct{}{}
+
+			if dwn.UserID != 0 {
+				users[dwn.UserID] = struct{}{}
+			}
+
+			if dwn.NewSubscription.Valid {
+				if dwn.NewSubscription.Bool {
+					newSub[key] = struct{}{}
+				} else {
+					withSub[key] = struct{}{}
+				}
+			}
+		}
+
+		result.Total = len(all)
+		result.Unique = len(users)
+		result.NewSubscription = len(newSub)
+		result.WithSubscription = len(withSub)
+
+		return nil
+	})
+
+	return result, err
+}
+
+func (store *DownloadStore) GetChatStats(ctx context.Context, id core.ChatID) (*core.ChatDownloadStats, error) {
+	result := &core.ChatDownloadStats{}
+
+	err := store.mem.view(ctx, func(d *data) error {
+		for _, dwn := range filterDownloads(d, func(dwn *core.Download) bool {
+			return isChatDownload(dwn, id)
+		}) {
+			if dwn.NewSubscription.Valid {
+				if dwn.NewSubscription.Bool {
+					result.NewSubscription++
+				} else {
+					result.WithSubscription++
+				}
+			}
+		}
+
+		return nil
+	})
+
+	return result, err
+}
+
+// getSeries returns buckets of downloads matched by fn.
+func (store *DownloadStore) getSeries(
+	ctx context.Context,
+	series *core.DownloadSeriesQuery,
+	fn func(d *data, dwn *core.Download) bool,
+) ([]*core.DownloadSeriesPoint, error) {
+	result := []*core.DownloadSeriesPoint{}
+
+	err := store.mem.view(ctx, func(d *data) error {
+		for at := series.From; at.Before(series.To); at = at.Add(series.Step) {
+			point := &core.DownloadSeriesPoint{At: at}
+			end := at.Add(series.Step)
+			users := map[core.UserID]struct{}{}
+
+			for _, dwn := range d.downloads {
+				if dwn.At.Before(at) || !dwn.At.Before(end) || !fn(d, dwn) {
+					continue
+				}
+
+				point.Total++
+
+				if dwn.UserID != 0 {
+					users[dwn.UserID] = struct{}{}
+				}
+
+				if dwn.NewSubscription.Valid && dwn.NewSubscription.Bool {
+					point.NewSubscription++
+				}
+			}
+
+			point.Unique = len(users)
+
+			result = append(result, point)
+		}
+
+		return nil
+	})
+
+	return result, err
+}
+
+func (store *DownloadStore) GetFileSeries(
+	ctx context.Context,
+	id core.FileID,
+	series *core.DownloadSeriesQuery,
+) ([]*core.DownloadSeriesPoint, error) {
+	return store.getSeries(ctx, series, func(d *data, dwn *core.Download) bool {
+		return dwn.FileID == id
+	})
+}
+
+func (store *DownloadStore) GetChatSeries(
+	ctx context.Context,
+	id core.ChatID,
+	series *core.DownloadSeriesQuery,
+) ([]*core.DownloadSeriesPoint, error) {
+	return store.getSeries(ctx, series, func(d *data, dwn *core.Download) bool {
+		return isChatDownload(dwn, id)
+	})
+}
+
+// getLog returns page of downloads matched by fn.
+func (store *DownloadStore) getLog(
+	ctx context.Context,
+	after core.DownloadID,
+	limit int,
+	fn func(d *data, dwn *core.Download) bool,
+) ([]*core.DownloadLogEntry, error) {
+	result := make([]*core.DownloadLogEntry, 0, limit)
+
+	err := store.mem.view(ctx, func(d *data) error {
+		for _, dwn := range d.downloads {
+			if len(result) == limit {
+				break
+			}
+
+			if dwn.ID <= after || !fn(d, dwn) {
+				continue
+			}
+
+			entry := &core.DownloadLogEntry{
+				ID:              dwn.ID,
+				UserID:          dwn.UserID,
+				At:              dwn.At,
+				NewSubscription: dwn.NewSubscription,
+			}
+
+			if user, ok := d.users[dwn.UserID]; ok {
+				entry.Username = user.Username
+			}
+
+			result = append(result, entry)
+		}
+
+		return nil
+	})
+
+	return result, err
+}
+
+func (store *DownloadStore) GetFileLog(
+	ctx context.Context,
+	id core.FileID,
+	after core.DownloadID,
+	limit int,
+) ([]*core.DownloadLogEntry, error) {
+	return store.getLog(ctx, after, limit, func(d *data, dwn *core.Download) bool {
+		return dwn.FileID == id
+	})
+}
+
+func (store *DownloadStore) GetChatLog(
+	ctx context.Context,
+	id core.ChatID,
+	after core.DownloadID,
+	limit int,
+) ([]*core.DownloadLogEntry, error) {
+	return store.getLog(ctx, after, limit, func(d *data, dwn *core.Download) bool {
+		return isChatDownload(dwn, id)
+	})
+}
+
+func (store *DownloadStore) Query() core.DownloadStoreQuery {
+	return &downloadStoreQuery{store: store}
+}
+
+type downloadStoreQuery struct {
+	store   *DownloadStore
+	filters []func(dwn *core.Download) bool
+}
+
+func (dsq *downloadStoreQuery) FileID(id core.FileID) core.DownloadStoreQuery {
+	dsq.filters = append(dsq.filters, func(dwn *core.Download) bool {
+		return dwn.FileID == id
+	})
+	return dsq
+}
+
+func (dsq *downloadStoreQuery) UserID(id core.UserID) core.DownloadStoreQuery {
+	dsq.filters = append(dsq.filters, func(dwn *core.Download) bool {
+		return dwn.UserID == id
+	})
+	return dsq
+}
+
+func (dsq *downloadStoreQuery) Count(ctx context.Context) (int, error) {
+	var count int
+
+	err := dsq.store.mem.view(ctx, func(d *data) error {
+		count = len(filterDownloads(d, func(dwn *core.Download) bool {
+			for _, filter := range dsq.filters {
+				if !filter(dwn) {
+					return false
+				}
+			}
+			return true
+		}))
+		return nil
+	})
+
+	return count, err
+}
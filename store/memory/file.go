@@ -0,0 +1,409 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/pkg/log"
+)
+
+type FileStore struct {
+	mem *Memory
+}
+
+func cloneFile(file *core.File) *core.File {
+	result := *file
+
+	result.Restriction.ChatIDs = cloneChatIDs(file.Restriction.ChatIDs)
+
+	if file.CaptionEntities != nil {
+		result.CaptionEntities = append([]core.TextEntity{}, file.CaptionEntities...)
+	}
+
+	result.Metadata = cloneMetadata(file.Metadata)
+
+	return &result
+}
+
+func cloneMetadata(metadata core.Metadata) core.Metadata {
+	result := metadata
+
+	if metadata.Audio != nil {
+		audio := *metadata.Audio
+		result.Audio = &audio
+	}
+
+	if metadata.Sticker != nil {
+		sticker := *metadata.Sticker
+		result.Sticker = &sticker
+	}
+
+	if metadata.VideoNote != nil {
+		videoNote := *metadata.VideoNote
+		result.VideoNote = &videoNote
+	}
+
+	if metadata.Contact != nil {
+		contact := *metadata.Contact
+		result.Contact = &contact
+	}
+
+	if metadata.Location != nil {
+		location := *metadata.Location
+		result.Location = &location
+	}
+
+	return result
+}
+
+func cloneChatIDs(ids []core.ChatID) []core.ChatID {
+	if ids == nil {
+		return nil
+	}
+	return append([]core.ChatID{}, ids...)
+}
+
+func isPublicIDUsed(d *data, publicID string) bool {
+	for _, file := range d.files {
+		if file.PublicID == publicID {
+			return true
+		}
+	}
+
+	for _, bundle := range d.bundles {
+		if bundle.PublicID == publicID {
+			return true
+		}
+	}
+
+	if _, ok := d.revokedLinks[publicID]; ok {
+		return true
+	}
+
+	return false
+}
+
+func (store *FileStore) Add(ctx context.Context, file *core.File) error {
+	return store.mem.update(ctx, func(d *data) error {
+		for isPublicIDUsed(d, file.PublicID) {
+			currID := file.PublicID
+			file.RegenPublicID()
+			log.Warn(ctx, "collison when insert doc", "curr_id", currID, "next_id", file.PublicID)
+		}
+
+		d.lastFileID++
+		file.ID = core.FileID(d.lastFileID)
+
+		d.files[file.ID] = cloneFile(file)
+
+		return nil
+	})
+}
+
+func (store *FileStore) Update(ctx context.Context, file *core.File) error {
+	return store.mem.update(ctx, func(d *data) error {
+		if _, ok := d.files[file.ID]; !ok {
+			return core.ErrFileNotFound
+		}
+
+		d.files[file.ID] = cloneFile(file)
+
+		return nil
+	})
+}
+
+func (store *FileStore) Query() core.FileStoreQuery {
+	return &fileStoreQuery{store: store}
+}
+
+type fileStoreQuery struct {
+	store   *FileStore
+	filters []func(file *core.File) bool
+
+	// order and pagination is not applicable to count and delete
+	order  core.FileOrder
+	offset int
+	limit  int
+}
+
+func (fsq *fileStoreQuery) filter(fn func(file *core.File) bool) core.FileStoreQuery {
+	fsq.filters = append(fsq.filters, fn)
+	return fsq
+}
+
+func (fsq *fileStoreQuery) ID(ids ...core.FileID) core.FileStoreQuery {
+	return fsq.filter(func(file *core.File) bool {
+		for _, id := range ids {
+			if file.ID == id {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+func (fsq *fileStoreQuery) OwnerID(id core.UserID) core.FileStoreQuery {
+	return fsq.filter(func(file *core.File) bool {
+		return file.OwnerID == id
+	})
+}
+
+func (fsq *fileStoreQuery) PublicID(ids ...string) core.FileStoreQuery {
+	return fsq.filter(func(file *core.File) bool {
+		for _, id := range ids {
+			if file.PublicID == id {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+func (fsq *fileStoreQuery) RestrictionChatID(id core.ChatID) core.FileStoreQuery {
+	return fsq.filter(func(file *core.File) bool {
+		return file.Restriction.HasChat(id)
+	})
+}
+
+func (fsq *fileStoreQuery) Kind(kinds ...core.Kind) core.FileStoreQuery {
+	return fsq.filter(func(file *core.File) bool {
+		for _, kind := range kinds {
+			if file.Kind == kind {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+func (fsq *fileStoreQuery) Search(text string) core.FileStoreQuery {
+	text = strings.ToLower(text)
+
+	return fsq.filter(func(file *core.File) bool {
+		return strings.Contains(strings.ToLower(file.Name), text) ||
+			(file.Caption.Valid && strings.Contains(strings.ToLower(file.Caption.String), text))
+	})
+}
+
+func (fsq *fileStoreQuery) NotModerated() core.FileStoreQuery {
+	return fsq.filter(func(file *core.File) bool {
+		return !file.IsBlocked && !(file.IsViolatesCopyright.Valid && file.IsViolatesCopyright.Bool)
+	})
+}
+
+func (fsq *fileStoreQuery) OrderBy(order core.FileOrder) core.FileStoreQuery {
+	fsq.order = order
+	return fsq
+}
+
+func (fsq *fileStoreQuery) Offset(n int) core.FileStoreQuery {
+	fsq.offset = n
+	return fsq
+}
+
+func (fsq *fileStoreQuery) Limit(n int) core.FileStoreQuery {
+	fsq.limit = n
+	return fsq
+}
+
+// ForUpdate does nothing, because transaction holds lock of whole memory.
+func (fsq *fileStoreQuery) ForUpdate() core.FileStoreQuery {
+	return fsq
+}
+
+// find returns matched files ordered by id.
+func (fsq *fileStoreQuery) find(d *data) []*core.File {
+	result := []*core.File{}
+
+	for _, file := range d.files {
+		if fsq.match(file) {
+			result = append(result, file)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ID < result[j].ID
+	})
+
+	return result
+}
+
+func (fsq *fileStoreQuery) match(file *core.File) bool {
+	for _, filter := range fsq.filters {
+		if !filter(file) {
+			return false
+		}
+	}
+	return true
+}
+
+// list returns matched files with applied order and pagination.
+func (fsq *fileStoreQuery) list(d *data) []*core.File {
+	files := fsq.find(d)
+
+	switch fsq.order {
+	case core.FileOrderDownloads:
+		downloads := make(map[core.FileID]int, len(files))
+		for _, dwn := range d.downloads {
+			downloads[dwn.FileID]++
+		}
+
+		sort.SliceStable(files, func(i, j int) bool {
+			a, b := files[i], files[j]
+			if downloads[a.ID] != downloads[b.ID] {
+				return downloads[a.ID] > downloads[b.ID]
+			}
+			return a.ID > b.ID
+		})
+	default:
+		sort.SliceStable(files, func(i, j int) bool {
+			a, b := files[i], files[j]
+			if !a.CreatedAt.Equal(b.CreatedAt) {
+				return a.CreatedAt.After(b.CreatedAt)
+			}
+			return a.ID > b.ID
+		})
+	}
+
+	if fsq.offset >= len(files) {
+		return []*core.File{}
+	}
+
+	files = files[fsq.offset:]
+
+	if fsq.limit > 0 && fsq.limit < len(files) {
+		files = files[:fsq.limit]
+	}
+
+	return files
+}
+
+func (fsq *fileStoreQuery) One(ctx context.Context) (*core.File, error) {
+	var result *core.File
+
+	if err := fsq.store.mem.view(ctx, func(d *data) error {
+		files := fsq.list(d)
+		if len(files) == 0 {
+			return core.ErrFileNotFound
+		}
+
+		result = cloneFile(files[0])
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (fsq *fileStoreQuery) All(ctx context.Context) ([]*core.File, error) {
+	var result []*core.File
+
+	if err := fsq.store.mem.view(ctx, func(d *data) error {
+		files := fsq.list(d)
+
+		result = make([]*core.File, len(files))
+		for i, file := range files {
+			result[i] = cloneFile(file)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (fsq *fileStoreQuery) Delete(ctx context.Context) error {
+	return fsq.store.mem.update(ctx, func(d *data) error {
+		files := fsq.find(d)
+		if len(files) == 0 {
+			return core.ErrFileNotFound
+		}
+
+		for _, file := range files {
+			delete(d.files, file.ID)
+
+			// downloads keep history without file
+			for _, dwn := range d.downloads {
+				if dwn.FileID == file.ID {
+					dwn.FileID = 0
+				}
+			}
+
+			// bundle members are deleted by cascade
+			for _, bundle := range d.bundles {
+				bundle.FileIDs = removeFileID(bundle.FileIDs, file.ID)
+			}
+
+			// reports are deleted by cascade
+			for id, report := range d.reports {
+				if report.FileID == file.ID {
+					delete(d.reports, id)
+				}
+			}
+
+			// placements are deleted by cascade
+			for id, placement := range d.placements {
+				if placement.FileID == file.ID {
+					deletePlacement(d, id)
+				}
+			}
+
+			// versions are deleted by cascade
+			for id, version := range d.fileVersions {
+				if version.FileID == file.ID {
+					delete(d.fileVersions, id)
+				}
+			}
+
+			// revoked links are deleted by cascade
+			for id, link := range d.revokedLinks {
+				if link.FileID == file.ID {
+					delete(d.revokedLinks, id)
+				}
+			}
+		}
+
+		return nil
+	})
+}
+
+func (fsq *fileStoreQuery) Count(ctx context.Context) (int, error) {
+	var count int
+
+	err := fsq.store.mem.view(ctx, func(d *data) error {
+		count = len(fsq.find(d))
+		return nil
+	})
+
+	return count, err
+}
+
+func removeChatID(ids []core.ChatID, id core.ChatID) []core.ChatID {
+	result := ids[:0]
+
+	for _, v := range ids {
+		if v != id {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
+
+func removeFileID(ids []core.FileID, id core.FileID) []core.FileID {
+	result := ids[:0]
+
+	for _, v := range ids {
+		if v != id {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
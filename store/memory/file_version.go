@@ -0,0 +1,140 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"github.com/bots-house/share-file-bot/core"
+)
+
+type FileVersionStore struct {
+	mem *Memory
+}
+
+func cloneFileVersion(version *core.FileVersion) *core.FileVersion {
+	result := *version
+	result.Metadata = cloneMetadata(version.Metadata)
+	return &result
+}
+
+func (store *FileVersionStore) Add(ctx context.Context, version *core.FileVersion) error {
+	return store.mem.update(ctx, func(d *data) error {
+		d.lastFileVersionID++
+		version.ID = core.FileVersionID(d.lastFileVersionID)
+
+		d.fileVersions[version.ID] = cloneFileVersion(version)
+
+		return nil
+	})
+}
+
+func (store *FileVersionStore) Query() core.FileVersionStoreQuery {
+	return &fileVersionStoreQuery{store: store}
+}
+
+type fileVersionStoreQuery struct {
+	store   *FileVersionStore
+	filters []func(version *core.FileVersion) bool
+	limit   int
+}
+
+func (fvsq *fileVersionStoreQuery) filter(fn func(version *core.FileVersion) bool) core.FileVersionStoreQuery {
+	fvsq.filters = append(fvsq.filters, fn)
+	return fvsq
+}
+
+func (fvsq *fileVersionStoreQuery) ID(id core.FileVersionID) core.FileVersionStoreQuery {
+	return fvsq.filter(func(version *core.FileVersion) bool {
+		return version.ID == id
+	})
+}
+
+func (fvsq *fileVersionStoreQuery) FileID(id core.FileID) core.FileVersionStoreQuery {
+	return fvsq.filter(func(version *core.FileVersion) bool {
+		return version.FileID == id
+	})
+}
+
+func (fvsq *fileVersionStoreQuery) Limit(n int) core.FileVersionStoreQuery {
+	fvsq.limit = n
+	return fvsq
+}
+
+// find returns matched versions from newest to oldest.
+func (fvsq *fileVersionStoreQuery) find(d *data) []*core.FileVersion {
+	result := []*core.FileVersion{}
+
+	for _, version := range d.fileVersions {
+		if fvsq.match(version) {
+			result = append(result, version)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ID > result[j].ID
+	})
+
+	return result
+}
+
+func (fvsq *fileVersionStoreQuery) match(version *core.FileVersion) bool {
+	for _, filter := range fvsq.filters {
+		if !filter(version) {
+			return false
+		}
+	}
+	return true
+}
+
+func (fvsq *fileVersionStoreQuery) One(ctx context.Context) (*core.FileVersion, error) {
+	var result *core.FileVersion
+
+	if err := fvsq.store.mem.view(ctx, func(d *data) error {
+		versions := fvsq.find(d)
+		if len(versions) == 0 {
+			return core.ErrFileVersionNotFound
+		}
+
+		result = cloneFileVersion(versions[0])
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (fvsq *fileVersionStoreQuery) All(ctx context.Context) ([]*core.FileVersion, error) {
+	var result []*core.FileVersion
+
+	if err := fvsq.store.mem.view(ctx, func(d *data) error {
+		versions := fvsq.find(d)
+
+		if fvsq.limit > 0 && len(versions) > fvsq.limit {
+			versions = versions[:fvsq.limit]
+		}
+
+		result = make([]*core.FileVersion, len(versions))
+		for i, version := range versions {
+			result[i] = cloneFileVersion(version)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (fvsq *fileVersionStoreQuery) Count(ctx context.Context) (int, error) {
+	var count int
+
+	err := fvsq.store.mem.view(ctx, func(d *data) error {
+		count = len(fvsq.find(d))
+		return nil
+	})
+
+	return count, err
+}
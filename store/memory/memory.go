@@ -0,0 +1,342 @@
+// Package memory implements store.Store in memory.
+// It's used in tests and for local run of bot without database.
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/store"
+)
+
+// data contains all entities of store.
+type data struct {
+	users               map[core.UserID]*core.User
+	files               map[core.FileID]*core.File
+	chats               map[core.ChatID]*core.Chat
+	bundles             map[core.BundleID]*core.Bundle
+	reports             map[core.ReportID]*core.Report
+	downloads           []*core.Download
+	audit               []*core.AuditEntry
+	broadcasts          map[core.BroadcastID]*core.Broadcast
+	broadcastDeliveries []*core.BroadcastDelivery
+	campaigns           map[core.CampaignID]*core.Campaign
+	placements          map[core.PlacementID]*core.Placement
+	fileVersions        map[core.FileVersionID]*core.FileVersion
+	revokedLinks        map[string]*core.RevokedLink
+	reservedPublicIDs   map[string]*core.ReservedPublicID
+
+	// last used ids, like sequences in database
+	lastFileID      int
+	lastChatID      int
+	lastBundleID    int
+	lastReportID    int
+	lastDownloadID  int
+	lastAuditID     int
+	lastBroadcastID int
+	lastCampaignID  int
+	lastPlacementID int
+
+	lastFileVersionID int
+}
+
+func newData() *data {
+	return &data{
+		users:      map[core.UserID]*core.User{},
+		files:      map[core.FileID]*core.File{},
+		chats:      map[core.ChatID]*core.Chat{},
+		bundles:    map[core.BundleID]*core.Bundle{},
+		reports:    map[core.ReportID]*core.Report{},
+		broadcasts: map[core.BroadcastID]*core.Broadcast{},
+		campaigns:  map[core.CampaignID]*core.Campaign{},
+		placements: map[core.PlacementID]*core.Placement{},
+
+		fileVersions: map[core.FileVersionID]*core.FileVersion{},
+		revokedLinks: map[string]*core.RevokedLink{},
+
+		reservedPublicIDs: map[string]*core.ReservedPublicID{},
+	}
+}
+
+// clone returns deep copy of data, it's used only by explicit tx.
+func (d *data) clone() *data {
+	result := &data{
+		users:               make(map[core.UserID]*core.User, len(d.users)),
+		files:               make(map[core.FileID]*core.File, len(d.files)),
+		chats:               make(map[core.ChatID]*core.Chat, len(d.chats)),
+		bundles:             make(map[core.BundleID]*core.Bundle, len(d.bundles)),
+		reports:             make(map[core.ReportID]*core.Report, len(d.reports)),
+		downloads:           make([]*core.Download, len(d.downloads)),
+		audit:               make([]*core.AuditEntry, len(d.audit)),
+		broadcasts:          make(map[core.BroadcastID]*core.Broadcast, len(d.broadcasts)),
+		broadcastDeliveries: make([]*core.BroadcastDelivery, len(d.broadcastDeliveries)),
+		campaigns:           make(map[core.CampaignID]*core.Campaign, len(d.campaigns)),
+		placements:          make(map[core.PlacementID]*core.Placement, len(d.placements)),
+		fileVersions:        make(map[core.FileVersionID]*core.FileVersion, len(d.fileVersions)),
+		revokedLinks:        make(map[string]*core.RevokedLink, len(d.revokedLinks)),
+		reservedPublicIDs:   make(map[string]*core.ReservedPublicID, len(d.reservedPublicIDs)),
+
+		lastFileID:      d.lastFileID,
+		lastChatID:      d.lastChatID,
+		lastBundleID:    d.lastBundleID,
+		lastReportID:    d.lastReportID,
+		lastDownloadID:  d.lastDownloadID,
+		lastAuditID:     d.lastAuditID,
+		lastBroadcastID: d.lastBroadcastID,
+		lastCampaignID:  d.lastCampaignID,
+		lastPlacementID: d.lastPlacementID,
+
+		lastFileVersionID: d.lastFileVersionID,
+	}
+
+	for id, user := range d.users {
+		result.users[id] = cloneUser(user)
+	}
+
+	for id, file := range d.files {
+		result.files[id] = cloneFile(file)
+	}
+
+	for id, chat := range d.chats {
+		result.chats[id] = cloneChat(chat)
+	}
+
+	for id, bundle := range d.bundles {
+		result.bundles[id] = cloneBundle(bundle)
+	}
+
+	for id, report := range d.reports {
+		result.reports[id] = cloneReport(report)
+	}
+
+	for i, dwn := range d.downloads {
+		result.downloads[i] = cloneDownload(dwn)
+	}
+
+	for i, entry := range d.audit {
+		result.audit[i] = cloneAuditEntry(entry)
+	}
+
+	for id, broadcast := range d.broadcasts {
+		result.broadcasts[id] = cloneBroadcast(broadcast)
+	}
+
+	for i, delivery := range d.broadcastDeliveries {
+		result.broadcastDeliveries[i] = cloneBroadcastDelivery(delivery)
+	}
+
+	for id, campaign := range d.campaigns {
+		result.campaigns[id] = cloneCampaign(campaign)
+	}
+
+	for id, placement := range d.placements {
+		result.placements[id] = clonePlacement(placement)
+	}
+
+	for id, version := range d.fileVersions {
+		result.fileVersions[id] = cloneFileVersion(version)
+	}
+
+	for id, link := range d.revokedLinks {
+		result.revokedLinks[id] = cloneRevokedLink(link)
+	}
+
+	for name, reserved := range d.reservedPublicIDs {
+		result.reservedPublicIDs[name] = cloneReservedPublicID(reserved)
+	}
+
+	return result
+}
+
+type ctxKey string
+
+const ctxKeyTx = ctxKey("tx")
+
+// tx holds copy of data, which replaces store data on commit.
+type tx struct {
+	mu   sync.Mutex
+	data *data
+}
+
+func getTx(ctx context.Context) *tx {
+	v := ctx.Value(ctxKeyTx)
+	if v != nil {
+		return v.(*tx)
+	}
+	return nil
+}
+
+func withTx(ctx context.Context, t *tx) context.Context {
+	return context.WithValue(ctx, ctxKeyTx, t)
+}
+
+// Memory is in-memory store with transaction support.
+type Memory struct {
+	mu   sync.RWMutex
+	data *data
+
+	user              *UserStore
+	file              *FileStore
+	download          *DownloadStore
+	chat              *ChatStore
+	bundle            *BundleStore
+	report            *ReportStore
+	audit             *AuditEntryStore
+	broadcast         *BroadcastStore
+	broadcastDelivery *BroadcastDeliveryStore
+	campaign          *CampaignStore
+	placement         *PlacementStore
+	fileVersion       *FileVersionStore
+	revokedLink       *RevokedLinkStore
+	reservedPublicID  *ReservedPublicIDStore
+}
+
+var _ store.Store = &Memory{}
+
+// New creates empty in-memory store.
+func New() *Memory {
+	mem := &Memory{
+		data: newData(),
+	}
+
+	mem.user = &UserStore{mem}
+	mem.file = &FileStore{mem}
+	mem.download = &DownloadStore{mem}
+	mem.chat = &ChatStore{mem}
+	mem.bundle = &BundleStore{mem}
+	mem.report = &ReportStore{mem}
+	mem.audit = &AuditEntryStore{mem}
+	mem.broadcast = &BroadcastStore{mem}
+	mem.broadcastDelivery = &BroadcastDeliveryStore{mem}
+	mem.campaign = &CampaignStore{mem}
+	mem.placement = &PlacementStore{mem}
+	mem.fileVersion = &FileVersionStore{mem}
+	mem.revokedLink = &RevokedLinkStore{mem}
+	mem.reservedPublicID = &ReservedPublicIDStore{mem}
+
+	return mem
+}
+
+func (mem *Memory) User() core.UserStore {
+	return mem.user
+}
+
+func (mem *Memory) File() core.FileStore {
+	return mem.file
+}
+
+func (mem *Memory) Download() core.DownloadStore {
+	return mem.download
+}
+
+func (mem *Memory) Chat() core.ChatStore {
+	return mem.chat
+}
+
+func (mem *Memory) Bundle() core.BundleStore {
+	return mem.bundle
+}
+
+func (mem *Memory) Report() core.ReportStore {
+	return mem.report
+}
+
+func (mem *Memory) Audit() core.AuditEntryStore {
+	return mem.audit
+}
+
+func (mem *Memory) Broadcast() core.BroadcastStore {
+	return mem.broadcast
+}
+
+func (mem *Memory) BroadcastDelivery() core.BroadcastDeliveryStore {
+	return mem.broadcastDelivery
+}
+
+func (mem *Memory) Campaign() core.CampaignStore {
+	return mem.campaign
+}
+
+func (mem *Memory) Placement() core.PlacementStore {
+	return mem.placement
+}
+
+func (mem *Memory) FileVersion() core.FileVersionStore {
+	return mem.fileVersion
+}
+
+func (mem *Memory) RevokedLink() core.RevokedLinkStore {
+	return mem.revokedLink
+}
+
+func (mem *Memory) ReservedPublicID() core.ReservedPublicIDStore {
+	return mem.reservedPublicID
+}
+
+// Migrator returns no-op migrator, memory store has no schema.
+func (mem *Memory) Migrator() store.Migrator {
+	return migrator{}
+}
+
+// Tx run code in transaction.
+// Transactions are serialized and block other queries until commit or rollback,
+// so queries inside tx should use context of tx.
+func (mem *Memory) Tx(ctx context.Context, txFunc store.TxFunc) error {
+	if getTx(ctx) != nil {
+		return txFunc(ctx)
+	}
+
+	mem.mu.Lock()
+	defer mem.mu.Unlock()
+
+	t := &tx{data: mem.data.clone()}
+
+	if err := txFunc(withTx(ctx, t)); err != nil {
+		return err
+	}
+
+	mem.data = t.data
+
+	return nil
+}
+
+// view calls fn with data for read.
+func (mem *Memory) view(ctx context.Context, fn func(d *data) error) error {
+	if t := getTx(ctx); t != nil {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		return fn(t.data)
+	}
+
+	mem.mu.RLock()
+	defer mem.mu.RUnlock()
+
+	return fn(mem.data)
+}
+
+// update calls fn with data for write.
+// Inside tx fn changes copy of tx, otherwise it changes store data in place,
+// so fn should check everything before first change and not fail after it.
+func (mem *Memory) update(ctx context.Context, fn func(d *data) error) error {
+	if t := getTx(ctx); t != nil {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		return fn(t.data)
+	}
+
+	mem.mu.Lock()
+	defer mem.mu.Unlock()
+
+	return fn(mem.data)
+}
+
+type migrator struct{}
+
+func (migrator) Up(ctx context.Context) error {
+	return nil
+}
+
+func (migrator) Down(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,15 @@
+package memory_test
+
+import (
+	"testing"
+
+	"github.com/bots-house/share-file-bot/store"
+	"github.com/bots-house/share-file-bot/store/memory"
+	"github.com/bots-house/share-file-bot/store/storetest"
+)
+
+func TestMemory_Conformance(t *testing.T) {
+	storetest.Run(t, func(t *testing.T) store.Store {
+		return memory.New()
+	})
+}
@@ -0,0 +1,158 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"github.com/bots-house/share-file-bot/core"
+)
+
+type PlacementStore struct {
+	mem *Memory
+}
+
+func clonePlacement(placement *core.Placement) *core.Placement {
+	result := *placement
+	return &result
+}
+
+func (store *PlacementStore) Add(ctx context.Context, placement *core.Placement) error {
+	return store.mem.update(ctx, func(d *data) error {
+		d.lastPlacementID++
+		placement.ID = core.PlacementID(d.lastPlacementID)
+
+		d.placements[placement.ID] = clonePlacement(placement)
+
+		return nil
+	})
+}
+
+func (store *PlacementStore) Query() core.PlacementStoreQuery {
+	return &placementStoreQuery{store: store}
+}
+
+type placementStoreQuery struct {
+	store   *PlacementStore
+	filters []func(placement *core.Placement) bool
+}
+
+func (psq *placementStoreQuery) filter(fn func(placement *core.Placement) bool) core.PlacementStoreQuery {
+	psq.filters = append(psq.filters, fn)
+	return psq
+}
+
+func (psq *placementStoreQuery) ID(id core.PlacementID) core.PlacementStoreQuery {
+	return psq.filter(func(placement *core.Placement) bool {
+		return placement.ID == id
+	})
+}
+
+func (psq *placementStoreQuery) FileID(id core.FileID) core.PlacementStoreQuery {
+	return psq.filter(func(placement *core.Placement) bool {
+		return placement.FileID == id
+	})
+}
+
+// find returns matched placements in order of id.
+func (psq *placementStoreQuery) find(d *data) []*core.Placement {
+	result := []*core.Placement{}
+
+	for _, placement := range d.placements {
+		if psq.match(placement) {
+			result = append(result, placement)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ID < result[j].ID
+	})
+
+	return result
+}
+
+func (psq *placementStoreQuery) match(placement *core.Placement) bool {
+	for _, filter := range psq.filters {
+		if !filter(placement) {
+			return false
+		}
+	}
+	return true
+}
+
+func (psq *placementStoreQuery) One(ctx context.Context) (*core.Placement, error) {
+	var result *core.Placement
+
+	if err := psq.store.mem.view(ctx, func(d *data) error {
+		placements := psq.find(d)
+		if len(placements) == 0 {
+			return core.ErrPlacementNotFound
+		}
+
+		result = clonePlacement(placements[0])
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (psq *placementStoreQuery) All(ctx context.Context) ([]*core.Placement, error) {
+	var result []*core.Placement
+
+	if err := psq.store.mem.view(ctx, func(d *data) error {
+		placements := psq.find(d)
+
+		result = make([]*core.Placement, len(placements))
+		for i, placement := range placements {
+			result[i] = clonePlacement(placement)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (psq *placementStoreQuery) Count(ctx context.Context) (int, error) {
+	var count int
+
+	err := psq.store.mem.view(ctx, func(d *data) error {
+		count = len(psq.find(d))
+		return nil
+	})
+
+	return count, err
+}
+
+func (psq *placementStoreQuery) Delete(ctx context.Context) (int, error) {
+	var count int
+
+	err := psq.store.mem.update(ctx, func(d *data) error {
+		placements := psq.find(d)
+
+		for _, placement := range placements {
+			deletePlacement(d, placement.ID)
+		}
+
+		count = len(placements)
+
+		return nil
+	})
+
+	return count, err
+}
+
+// deletePlacement removes placement and keeps its downloads without placement.
+func deletePlacement(d *data, id core.PlacementID) {
+	delete(d.placements, id)
+
+	for _, dwn := range d.downloads {
+		if dwn.PlacementID == id {
+			dwn.PlacementID = 0
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"github.com/bots-house/share-file-bot/core"
+)
+
+type ReportStore struct {
+	mem *Memory
+}
+
+func cloneReport(report *core.Report) *core.Report {
+	result := *report
+	return &result
+}
+
+func (store *ReportStore) Add(ctx context.Context, report *core.Report) error {
+	return store.mem.update(ctx, func(d *data) error {
+		d.lastReportID++
+		report.ID = core.ReportID(d.lastReportID)
+
+		d.reports[report.ID] = cloneReport(report)
+
+		return nil
+	})
+}
+
+func (store *ReportStore) Update(ctx context.Context, report *core.Report) error {
+	return store.mem.update(ctx, func(d *data) error {
+		if _, ok := d.reports[report.ID]; !ok {
+			return core.ErrReportNotFound
+		}
+
+		d.reports[report.ID] = cloneReport(report)
+
+		return nil
+	})
+}
+
+func (store *ReportStore) Query() core.ReportStoreQuery {
+	return &reportStoreQuery{store: store}
+}
+
+type reportStoreQuery struct {
+	store   *ReportStore
+	filters []func(report *core.Report) bool
+	limit   int
+}
+
+func (rsq *reportStoreQuery) filter(fn func(report *core.Report) bool) core.ReportStoreQuery {
+	rsq.filters = append(rsq.filters, fn)
+	return rsq
+}
+
+func (rsq *reportStoreQuery) ID(id core.ReportID) core.ReportStoreQuery {
+	return rsq.filter(func(report *core.Report) bool {
+		return report.ID == id
+	})
+}
+
+func (rsq *reportStoreQuery) FileID(id core.FileID) core.ReportStoreQuery {
+	return rsq.filter(func(report *core.Report) bool {
+		return report.FileID == id
+	})
+}
+
+func (rsq *reportStoreQuery) ReporterID(id core.UserID) core.ReportStoreQuery {
+	return rsq.filter(func(report *core.Report) bool {
+		return report.ReporterID == id
+	})
+}
+
+func (rsq *reportStoreQuery) Status(statuses ...core.ReportStatus) core.ReportStoreQuery {
+	return rsq.filter(func(report *core.Report) bool {
+		for _, status := range statuses {
+			if report.Status == status {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+func (rsq *reportStoreQuery) Limit(n int) core.ReportStoreQuery {
+	rsq.limit = n
+	return rsq
+}
+
+// find returns matched reports ordered by id.
+func (rsq *reportStoreQuery) find(d *data) []*core.Report {
+	result := []*core.Report{}
+
+	for _, report := range d.reports {
+		if rsq.match(report) {
+			result = append(result, report)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ID < result[j].ID
+	})
+
+	return result
+}
+
+func (rsq *reportStoreQuery) match(report *core.Report) bool {
+	for _, filter := range rsq.filters {
+		if !filter(report) {
+			return false
+		}
+	}
+	return true
+}
+
+func (rsq *reportStoreQuery) One(ctx context.Context) (*core.Report, error) {
+	var result *core.Report
+
+	if err := rsq.store.mem.view(ctx, func(d *data) error {
+		reports := rsq.find(d)
+		if len(reports) == 0 {
+			return core.ErrReportNotFound
+		}
+
+		result = cloneReport(reports[0])
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (rsq *reportStoreQuery) All(ctx context.Context) ([]*core.Report, error) {
+	var result []*core.Report
+
+	if err := rsq.store.mem.view(ctx, func(d *data) error {
+		reports := rsq.find(d)
+
+		if rsq.limit > 0 && len(reports) > rsq.limit {
+			reports = reports[:rsq.limit]
+		}
+
+		result = make([]*core.Report, len(reports))
+		for i, report := range reports {
+			result[i] = cloneReport(report)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (rsq *reportStoreQuery) Count(ctx context.Context) (int, error) {
+	var count int
+
+	err := rsq.store.mem.view(ctx, func(d *data) error {
+		count = len(rsq.find(d))
+		return nil
+	})
+
+	return count, err
+}
@@ -0,0 +1,137 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/friendsofgo/errors"
+)
+
+var errReservedPublicIDAlreadyExists = errors.New("reserved public id already exists")
+
+type ReservedPublicIDStore struct {
+	mem *Memory
+}
+
+func cloneReservedPublicID(reserved *core.ReservedPublicID) *core.ReservedPublicID {
+	result := *reserved
+	return &result
+}
+
+func (store *ReservedPublicIDStore) Add(ctx context.Context, reserved *core.ReservedPublicID) error {
+	return store.mem.update(ctx, func(d *data) error {
+		if _, ok := d.reservedPublicIDs[reserved.Name]; ok {
+			return errReservedPublicIDAlreadyExists
+		}
+
+		d.reservedPublicIDs[reserved.Name] = cloneReservedPublicID(reserved)
+
+		return nil
+	})
+}
+
+func (store *ReservedPublicIDStore) Query() core.ReservedPublicIDStoreQuery {
+	return &reservedPublicIDStoreQuery{store: store}
+}
+
+type reservedPublicIDStoreQuery struct {
+	store   *ReservedPublicIDStore
+	filters []func(reserved *core.ReservedPublicID) bool
+}
+
+func (rsq *reservedPublicIDStoreQuery) filter(fn func(reserved *core.ReservedPublicID) bool) core.ReservedPublicIDStoreQuery {
+	rsq.filters = append(rsq.filters, fn)
+	return rsq
+}
+
+func (rsq *reservedPublicIDStoreQuery) Name(name string) core.ReservedPublicIDStoreQuery {
+	name = strings.ToLower(name)
+
+	return rsq.filter(func(reserved *core.ReservedPublicID) bool {
+		return reserved.Name == name
+	})
+}
+
+// find returns matched names ordered alphabetically.
+func (rsq *reservedPublicIDStoreQuery) find(d *data) []*core.ReservedPublicID {
+	result := []*core.ReservedPublicID{}
+
+	for _, reserved := range d.reservedPublicIDs {
+		if rsq.match(reserved) {
+			result = append(result, reserved)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+
+	return result
+}
+
+func (rsq *reservedPublicIDStoreQuery) match(reserved *core.ReservedPublicID) bool {
+	for _, filter := range rsq.filters {
+		if !filter(reserved) {
+			return false
+		}
+	}
+	return true
+}
+
+func (rsq *reservedPublicIDStoreQuery) One(ctx context.Context) (*core.ReservedPublicID, error) {
+	var result *core.ReservedPublicID
+
+	if err := rsq.store.mem.view(ctx, func(d *data) error {
+		items := rsq.find(d)
+		if len(items) == 0 {
+			return core.ErrReservedPublicIDNotFound
+		}
+
+		result = cloneReservedPublicID(items[0])
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (rsq *reservedPublicIDStoreQuery) All(ctx context.Context) ([]*core.ReservedPublicID, error) {
+	var result []*core.ReservedPublicID
+
+	if err := rsq.store.mem.view(ctx, func(d *data) error {
+		items := rsq.find(d)
+
+		result = make([]*core.ReservedPublicID, len(items))
+		for i, reserved := range items {
+			result[i] = cloneReservedPublicID(reserved)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (rsq *reservedPublicIDStoreQuery) Delete(ctx context.Context) (int, error) {
+	var count int
+
+	err := rsq.store.mem.update(ctx, func(d *data) error {
+		items := rsq.find(d)
+
+		for _, reserved := range items {
+			delete(d.reservedPublicIDs, reserved.Name)
+		}
+
+		count = len(items)
+
+		return nil
+	})
+
+	return count, err
+}
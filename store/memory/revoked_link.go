@@ -0,0 +1,100 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/bots-house/share-file-bot/core"
+)
+
+type RevokedLinkStore struct {
+	mem *Memory
+}
+
+func cloneRevokedLink(link *core.RevokedLink) *core.RevokedLink {
+	result := *link
+	return &result
+}
+
+func (store *RevokedLinkStore) Add(ctx context.Context, link *core.RevokedLink) error {
+	return store.mem.update(ctx, func(d *data) error {
+		d.revokedLinks[link.PublicID] = cloneRevokedLink(link)
+		return nil
+	})
+}
+
+func (store *RevokedLinkStore) Query() core.RevokedLinkStoreQuery {
+	return &revokedLinkStoreQuery{store: store}
+}
+
+type revokedLinkStoreQuery struct {
+	store   *RevokedLinkStore
+	filters []func(link *core.RevokedLink) bool
+}
+
+func (rlsq *revokedLinkStoreQuery) filter(fn func(link *core.RevokedLink) bool) core.RevokedLinkStoreQuery {
+	rlsq.filters = append(rlsq.filters, fn)
+	return rlsq
+}
+
+func (rlsq *revokedLinkStoreQuery) PublicID(id string) core.RevokedLinkStoreQuery {
+	return rlsq.filter(func(link *core.RevokedLink) bool {
+		return link.PublicID == id
+	})
+}
+
+func (rlsq *revokedLinkStoreQuery) FileID(id core.FileID) core.RevokedLinkStoreQuery {
+	return rlsq.filter(func(link *core.RevokedLink) bool {
+		return link.FileID == id
+	})
+}
+
+func (rlsq *revokedLinkStoreQuery) find(d *data) []*core.RevokedLink {
+	result := []*core.RevokedLink{}
+
+	for _, link := range d.revokedLinks {
+		if rlsq.match(link) {
+			result = append(result, link)
+		}
+	}
+
+	return result
+}
+
+func (rlsq *revokedLinkStoreQuery) match(link *core.RevokedLink) bool {
+	for _, filter := range rlsq.filters {
+		if !filter(link) {
+			return false
+		}
+	}
+	return true
+}
+
+func (rlsq *revokedLinkStoreQuery) One(ctx context.Context) (*core.RevokedLink, error) {
+	var result *core.RevokedLink
+
+	if err := rlsq.store.mem.view(ctx, func(d *data) error {
+		links := rlsq.find(d)
+		if len(links) == 0 {
+			return core.ErrRevokedLinkNotFound
+		}
+
+		result = cloneRevokedLink(links[0])
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (rlsq *revokedLinkStoreQuery) Count(ctx context.Context) (int, error) {
+	var count int
+
+	err := rlsq.store.mem.view(ctx, func(d *data) error {
+		count = len(rlsq.find(d))
+		return nil
+	})
+
+	return count, err
+}
@@ -0,0 +1,260 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/friendsofgo/errors"
+)
+
+var errUserAlreadyExists = errors.New("user already exists")
+
+type UserStore struct {
+	mem *Memory
+}
+
+func cloneUser(user *core.User) *core.User {
+	result := *user
+	return &result
+}
+
+func (store *UserStore) Add(ctx context.Context, user *core.User) error {
+	return store.mem.update(ctx, func(d *data) error {
+		if _, ok := d.users[user.ID]; ok {
+			return errUserAlreadyExists
+		}
+
+		d.users[user.ID] = cloneUser(user)
+
+		return nil
+	})
+}
+
+func (store *UserStore) Find(ctx context.Context, id core.UserID) (*core.User, error) {
+	var result *core.User
+
+	if err := store.mem.view(ctx, func(d *data) error {
+		user, ok := d.users[id]
+		if !ok {
+			return core.ErrUserNotFound
+		}
+
+		result = cloneUser(user)
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (store *UserStore) Update(ctx context.Context, user *core.User) error {
+	return store.mem.update(ctx, func(d *data) error {
+		if _, ok := d.users[user.ID]; !ok {
+			return core.ErrUserNotFound
+		}
+
+		d.users[user.ID] = cloneUser(user)
+
+		return nil
+	})
+}
+
+func (store *UserStore) RefStats(ctx context.Context, from, to time.Time) (core.UserRefStats, error) {
+	result := core.UserRefStats{}
+
+	err := store.mem.view(ctx, func(d *data) error {
+		index := map[string]int{}
+		refs := map[core.UserID]int{}
+
+		for _, user := range d.users {
+			if !from.IsZero() && user.JoinedAt.Before(from) {
+				continue
+			}
+
+			if !to.IsZero() && !user.JoinedAt.Before(to) {
+				continue
+			}
+
+			// null and empty refs are different groups
+			key := "null"
+			if user.Ref.Valid {
+				key = "ref:" + user.Ref.String
+			}
+
+			i, ok := index[key]
+			if !ok {
+				i = len(result)
+				index[key] = i
+				result = append(result, core.UserRefStatsItem{Ref: user.Ref})
+			}
+
+			result[i].Count++
+			refs[user.ID] = i
+		}
+
+		for _, dwn := range d.downloads {
+			if !from.IsZero() && dwn.At.Before(from) {
+				continue
+			}
+
+			if !to.IsZero() && !dwn.At.Before(to) {
+				continue
+			}
+
+			if i, ok := refs[dwn.UserID]; ok {
+				result[i].Downloads++
+			}
+		}
+
+		return nil
+	})
+
+	return result, err
+}
+
+func (store *UserStore) CountActive(ctx context.Context, since time.Time) (int, error) {
+	var count int
+
+	err := store.mem.view(ctx, func(d *data) error {
+		active := map[core.UserID]bool{}
+
+		for _, user := range d.users {
+			if user.LastSeenAt.Valid && !user.LastSeenAt.Time.Before(since) {
+				active[user.ID] = true
+			}
+		}
+
+		for _, dwn := range d.downloads {
+			if _, ok := d.users[dwn.UserID]; ok && !dwn.At.Before(since) {
+				active[dwn.UserID] = true
+			}
+		}
+
+		count = len(active)
+
+		return nil
+	})
+
+	return count, err
+}
+
+func (store *UserStore) Query() core.UserStoreQuery {
+	return &userStoreQuery{store: store}
+}
+
+type userStoreQuery struct {
+	store   *UserStore
+	filters []func(user *core.User) bool
+	limit   int
+}
+
+func (usq *userStoreQuery) filter(fn func(user *core.User) bool) core.UserStoreQuery {
+	usq.filters = append(usq.filters, fn)
+	return usq
+}
+
+func (usq *userStoreQuery) Ref(ref string) core.UserStoreQuery {
+	return usq.filter(func(user *core.User) bool {
+		return user.Ref.Valid && user.Ref.String == ref
+	})
+}
+
+func (usq *userStoreQuery) Language(lang string) core.UserStoreQuery {
+	return usq.filter(func(user *core.User) bool {
+		return strings.HasPrefix(user.GetLanguage(), lang)
+	})
+}
+
+func (usq *userStoreQuery) JoinedAfter(t time.Time) core.UserStoreQuery {
+	return usq.filter(func(user *core.User) bool {
+		return user.JoinedAt.After(t)
+	})
+}
+
+func (usq *userStoreQuery) IDGreaterThan(id core.UserID) core.UserStoreQuery {
+	return usq.filter(func(user *core.User) bool {
+		return user.ID > id
+	})
+}
+
+func (usq *userStoreQuery) NotBlockedBot() core.UserStoreQuery {
+	return usq.filter(func(user *core.User) bool {
+		return !user.BotBlockedAt.Valid
+	})
+}
+
+func (usq *userStoreQuery) BlockedBot() core.UserStoreQuery {
+	return usq.filter(func(user *core.User) bool {
+		return user.BotBlockedAt.Valid
+	})
+}
+
+func (usq *userStoreQuery) Limit(n int) core.UserStoreQuery {
+	usq.limit = n
+	return usq
+}
+
+// find returns matched users ordered by id.
+func (usq *userStoreQuery) find(d *data) []*core.User {
+	result := []*core.User{}
+
+	for _, user := range d.users {
+		if usq.match(user) {
+			result = append(result, user)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ID < result[j].ID
+	})
+
+	return result
+}
+
+func (usq *userStoreQuery) match(user *core.User) bool {
+	for _, filter := range usq.filters {
+		if !filter(user) {
+			return false
+		}
+	}
+	return true
+}
+
+func (usq *userStoreQuery) All(ctx context.Context) ([]*core.User, error) {
+	var result []*core.User
+
+	if err := usq.store.mem.view(ctx, func(d *data) error {
+		users := usq.find(d)
+
+		if usq.limit > 0 && len(users) > usq.limit {
+			users = users[:usq.limit]
+		}
+
+		result = make([]*core.User, len(users))
+		for i, user := range users {
+			result[i] = cloneUser(user)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (usq *userStoreQuery) Count(ctx context.Context) (int, error) {
+	var count int
+
+	err := usq.store.mem.view(ctx, func(d *data) error {
+		count = len(usq.find(d))
+		return nil
+	})
+
+	return count, err
+}
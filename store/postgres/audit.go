@@ -0,0 +1,128 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/store/postgres/dal"
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type AuditEntryStore struct {
+	BaseStore
+}
+
+func (store *AuditEntryStore) toRow(entry *core.AuditEntry) *dal.AuditLog {
+	return &dal.AuditLog{
+		ID:        int(entry.ID),
+		AdminID:   int(entry.AdminID),
+		Action:    entry.Action.String(),
+		UserID:    null.NewInt(int(entry.UserID), entry.UserID != 0),
+		FileID:    null.NewInt(int(entry.FileID), entry.FileID != 0),
+		CreatedAt: entry.CreatedAt,
+	}
+}
+
+func (store *AuditEntryStore) fromRow(row *dal.AuditLog) (*core.AuditEntry, error) {
+	action, err := core.ParseAuditAction(row.Action)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse audit action")
+	}
+
+	return &core.AuditEntry{
+		ID:        core.AuditEntryID(row.ID),
+		AdminID:   core.UserID(row.AdminID),
+		Action:    action,
+		UserID:    core.UserID(row.UserID.Int),
+		FileID:    core.FileID(row.FileID.Int),
+		CreatedAt: row.CreatedAt,
+	}, nil
+}
+
+func (store *AuditEntryStore) Add(ctx context.Context, entry *core.AuditEntry) error {
+	row := store.toRow(entry)
+
+	if err := store.insertOne(ctx, row); err != nil {
+		return errors.Wrap(err, "insert query")
+	}
+
+	result, err := store.fromRow(row)
+	if err != nil {
+		return errors.Wrap(err, "from row")
+	}
+
+	*entry = *result
+
+	return nil
+}
+
+func (store *AuditEntryStore) Query() core.AuditEntryStoreQuery {
+	return &auditEntryStoreQuery{store: store}
+}
+
+type auditEntryStoreQuery struct {
+	mods []qm.QueryMod
+
+	// mods applied only to All
+	listMods []qm.QueryMod
+
+	store *AuditEntryStore
+}
+
+func (aesq *auditEntryStoreQuery) AdminID(id core.UserID) core.AuditEntryStoreQuery {
+	aesq.mods = append(aesq.mods, dal.AuditLogWhere.AdminID.EQ(int(id)))
+	return aesq
+}
+
+func (aesq *auditEntryStoreQuery) UserID(id core.UserID) core.AuditEntryStoreQuery {
+	aesq.mods = append(aesq.mods, dal.AuditLogWhere.UserID.EQ(null.IntFrom(int(id))))
+	return aesq
+}
+
+func (aesq *auditEntryStoreQuery) FileID(id core.FileID) core.AuditEntryStoreQuery {
+	aesq.mods = append(aesq.mods, dal.AuditLogWhere.FileID.EQ(null.IntFrom(int(id))))
+	return aesq
+}
+
+func (aesq *auditEntryStoreQuery) Limit(n int) core.AuditEntryStoreQuery {
+	aesq.listMods = append(aesq.listMods, qm.Limit(n))
+	return aesq
+}
+
+func (aesq *auditEntryStoreQuery) getListMods() []qm.QueryMod {
+	mods := make([]qm.QueryMod, 0, len(aesq.mods)+len(aesq.listMods)+1)
+	mods = append(mods, aesq.mods...)
+	mods = append(mods, qm.OrderBy(dal.AuditLogColumns.ID+" desc"))
+	return append(mods, aesq.listMods...)
+}
+
+func (aesq *auditEntryStoreQuery) All(ctx context.Context) ([]*core.AuditEntry, error) {
+	rows, err := dal.AuditLogs(aesq.getListMods()...).All(ctx, aesq.store.getExecutor(ctx))
+	if err != nil {
+		return nil, errors.Wrap(err, "select query")
+	}
+
+	result := make([]*core.AuditEntry, len(rows))
+
+	for i, row := range rows {
+		entry, err := aesq.store.fromRow(row)
+		if err != nil {
+			return nil, errors.Wrapf(err, "from row #%d", i)
+		}
+
+		result[i] = entry
+	}
+
+	return result, nil
+}
+
+func (aesq *auditEntryStoreQuery) Count(ctx context.Context) (int, error) {
+	count, err := dal.AuditLogs(aesq.mods...).Count(ctx, aesq.store.getExecutor(ctx))
+	if err != nil {
+		return 0, errors.Wrap(err, "count query")
+	}
+
+	return int(count), nil
+}
@@ -0,0 +1,242 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/store/postgres/dal"
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type BroadcastStore struct {
+	BaseStore
+}
+
+func (store *BroadcastStore) toRow(broadcast *core.Broadcast) (*dal.Broadcast, error) {
+	buttons, err := json.Marshal(broadcast.Buttons)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal buttons")
+	}
+
+	return &dal.Broadcast{
+		ID:                 int(broadcast.ID),
+		AuthorID:           int(broadcast.AuthorID),
+		FromChatID:         broadcast.FromChatID,
+		MessageID:          broadcast.MessageID,
+		Buttons:            string(buttons),
+		SegmentRef:         broadcast.Segment.Ref,
+		SegmentLanguage:    broadcast.Segment.Language,
+		SegmentJoinedAfter: broadcast.Segment.JoinedAfter,
+		Status:             broadcast.Status.String(),
+		LastUserID:         int(broadcast.LastUserID),
+		Total:              broadcast.Total,
+		Delivered:          broadcast.Delivered,
+		Blocked:            broadcast.Blocked,
+		Failed:             broadcast.Failed,
+		CreatedAt:          broadcast.CreatedAt,
+		StartedAt:          broadcast.StartedAt,
+		FinishedAt:         broadcast.FinishedAt,
+	}, nil
+}
+
+func (store *BroadcastStore) fromRow(row *dal.Broadcast) (*core.Broadcast, error) {
+	status, err := core.ParseBroadcastStatus(row.Status)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse broadcast status")
+	}
+
+	buttons := []core.BroadcastButton{}
+
+	if err := json.Unmarshal([]byte(row.Buttons), &buttons); err != nil {
+		return nil, errors.Wrap(err, "unmarshal buttons")
+	}
+
+	return &core.Broadcast{
+		ID:         core.BroadcastID(row.ID),
+		AuthorID:   core.UserID(row.AuthorID),
+		FromChatID: row.FromChatID,
+		MessageID:  row.MessageID,
+		Buttons:    buttons,
+		Segment: core.BroadcastSegment{
+			Ref:         row.SegmentRef,
+			Language:    row.SegmentLanguage,
+			JoinedAfter: row.SegmentJoinedAfter,
+		},
+		Status:     status,
+		LastUserID: core.UserID(row.LastUserID),
+		Total:      row.Total,
+		Delivered:  row.Delivered,
+		Blocked:    row.Blocked,
+		Failed:     row.Failed,
+		CreatedAt:  row.CreatedAt,
+		StartedAt:  row.StartedAt,
+		FinishedAt: row.FinishedAt,
+	}, nil
+}
+
+func (store *BroadcastStore) Add(ctx context.Context, broadcast *core.Broadcast) error {
+	row, err := store.toRow(broadcast)
+	if err != nil {
+		return errors.Wrap(err, "to row")
+	}
+
+	if err := store.insertOne(ctx, row); err != nil {
+		return errors.Wrap(err, "insert query")
+	}
+
+	result, err := store.fromRow(row)
+	if err != nil {
+		return errors.Wrap(err, "from row")
+	}
+
+	*broadcast = *result
+
+	return nil
+}
+
+func (store *BroadcastStore) Update(ctx context.Context, broadcast *core.Broadcast) error {
+	row, err := store.toRow(broadcast)
+	if err != nil {
+		return errors.Wrap(err, "to row")
+	}
+
+	return store.updateOne(ctx, row, core.ErrBroadcastNotFound)
+}
+
+func (store *BroadcastStore) Query() core.BroadcastStoreQuery {
+	return &broadcastStoreQuery{store: store}
+}
+
+type broadcastStoreQuery struct {
+	mods []qm.QueryMod
+
+	// mods applied only to One and All
+	listMods []qm.QueryMod
+
+	store *BroadcastStore
+}
+
+func (bsq *broadcastStoreQuery) ID(id core.BroadcastID) core.BroadcastStoreQuery {
+	bsq.mods = append(bsq.mods, dal.BroadcastWhere.ID.EQ(int(id)))
+	return bsq
+}
+
+func (bsq *broadcastStoreQuery) Status(statuses ...core.BroadcastStatus) core.BroadcastStoreQuery {
+	values := make([]string, len(statuses))
+	for i, status := range statuses {
+		values[i] = status.String()
+	}
+
+	bsq.mods = append(bsq.mods, dal.BroadcastWhere.Status.IN(values))
+	return bsq
+}
+
+func (bsq *broadcastStoreQuery) Limit(n int) core.BroadcastStoreQuery {
+	bsq.listMods = append(bsq.listMods, qm.Limit(n))
+	return bsq
+}
+
+func (bsq *broadcastStoreQuery) getListMods() []qm.QueryMod {
+	mods := make([]qm.QueryMod, 0, len(bsq.mods)+len(bsq.listMods)+1)
+	mods = append(mods, bsq.mods...)
+	mods = append(mods, qm.OrderBy(dal.BroadcastColumns.ID+" desc"))
+	return append(mods, bsq.listMods...)
+}
+
+func (bsq *broadcastStoreQuery) One(ctx context.Context) (*core.Broadcast, error) {
+	row, err := dal.Broadcasts(bsq.getListMods()...).One(ctx, bsq.store.getExecutor(ctx))
+	if err == sql.ErrNoRows {
+		return nil, core.ErrBroadcastNotFound
+	} else if err != nil {
+		return nil, errors.Wrap(err, "select query")
+	}
+
+	return bsq.store.fromRow(row)
+}
+
+func (bsq *broadcastStoreQuery) All(ctx context.Context) ([]*core.Broadcast, error) {
+	rows, err := dal.Broadcasts(bsq.getListMods()...).All(ctx, bsq.store.getExecutor(ctx))
+	if err != nil {
+		return nil, errors.Wrap(err, "select query")
+	}
+
+	result := make([]*core.Broadcast, len(rows))
+
+	for i, row := range rows {
+		broadcast, err := bsq.store.fromRow(row)
+		if err != nil {
+			return nil, errors.Wrapf(err, "from row #%d", i)
+		}
+
+		result[i] = broadcast
+	}
+
+	return result, nil
+}
+
+func (bsq *broadcastStoreQuery) Count(ctx context.Context) (int, error) {
+	count, err := dal.Broadcasts(bsq.mods...).Count(ctx, bsq.store.getExecutor(ctx))
+	if err != nil {
+		return 0, errors.Wrap(err, "count query")
+	}
+
+	return int(count), nil
+}
+
+type BroadcastDeliveryStore struct {
+	BaseStore
+}
+
+func (store *BroadcastDeliveryStore) toRow(delivery *core.BroadcastDelivery) *dal.BroadcastDelivery {
+	return &dal.BroadcastDelivery{
+		BroadcastID: int(delivery.BroadcastID),
+		UserID:      int(delivery.UserID),
+		Status:      delivery.Status.String(),
+		Error:       delivery.Error,
+		CreatedAt:   delivery.CreatedAt,
+	}
+}
+
+func (store *BroadcastDeliveryStore) Add(ctx context.Context, delivery *core.BroadcastDelivery) error {
+	if err := store.insertOne(ctx, store.toRow(delivery)); err != nil {
+		return errors.Wrap(err, "insert query")
+	}
+
+	return nil
+}
+
+func (store *BroadcastDeliveryStore) Query() core.BroadcastDeliveryStoreQuery {
+	return &broadcastDeliveryStoreQuery{store: store}
+}
+
+type broadcastDeliveryStoreQuery struct {
+	mods  []qm.QueryMod
+	store *BroadcastDeliveryStore
+}
+
+func (bdsq *broadcastDeliveryStoreQuery) BroadcastID(id core.BroadcastID) core.BroadcastDeliveryStoreQuery {
+	bdsq.mods = append(bdsq.mods, dal.BroadcastDeliveryWhere.BroadcastID.EQ(int(id)))
+	return bdsq
+}
+
+func (bdsq *broadcastDeliveryStoreQuery) UserID(id core.UserID) core.BroadcastDeliveryStoreQuery {
+	bdsq.mods = append(bdsq.mods, dal.BroadcastDeliveryWhere.UserID.EQ(int(id)))
+	return bdsq
+}
+
+func (bdsq *broadcastDeliveryStoreQuery) Status(status core.BroadcastDeliveryStatus) core.BroadcastDeliveryStoreQuery {
+	bdsq.mods = append(bdsq.mods, dal.BroadcastDeliveryWhere.Status.EQ(status.String()))
+	return bdsq
+}
+
+func (bdsq *broadcastDeliveryStoreQuery) Count(ctx context.Context) (int, error) {
+	count, err := dal.BroadcastDeliveries(bdsq.mods...).Count(ctx, bdsq.store.getExecutor(ctx))
+	if err != nil {
+		return 0, errors.Wrap(err, "count query")
+	}
+
+	return int(count), nil
+}
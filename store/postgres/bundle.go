@@ -0,0 +1,330 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/pkg/log"
+	"github.com/bots-house/share-file-bot/store/postgres/dal"
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type BundleStore struct {
+	BaseStore
+}
+
+func (store *BundleStore) toRow(bundle *core.Bundle) *dal.Bundle {
+	return &dal.Bundle{
+		ID:                     int(bundle.ID),
+		PublicID:               bundle.PublicID,
+		Caption:                bundle.Caption,
+		RestrictionsChatPolicy: bundle.Restriction.ChatPolicy.String(),
+		OwnerID:                int(bundle.OwnerID),
+		CreatedAt:              bundle.CreatedAt,
+	}
+}
+
+func (store *BundleStore) fromRow(row *dal.Bundle, fileIDs []core.FileID, chatIDs []core.ChatID) (*core.Bundle, error) {
+	policy, err := core.ParseChatPolicy(row.RestrictionsChatPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.Bundle{
+		ID:       core.BundleID(row.ID),
+		PublicID: row.PublicID,
+		Caption:  row.Caption,
+		Restriction: core.ChatRestrictions{
+			ChatIDs:    chatIDs,
+			ChatPolicy: policy,
+		},
+		FileIDs:   fileIDs,
+		OwnerID:   core.UserID(row.OwnerID),
+		CreatedAt: row.CreatedAt,
+	}, nil
+}
+
+func (store *BundleStore) fromRows(ctx context.Context, rows []*dal.Bundle) ([]*core.Bundle, error) {
+	if len(rows) == 0 {
+		return []*core.Bundle{}, nil
+	}
+
+	ids := make([]int, len(rows))
+	for i, row := range rows {
+		ids[i] = row.ID
+	}
+
+	members, err := store.getFileIDs(ctx, ids...)
+	if err != nil {
+		return nil, errors.Wrap(err, "get bundle files")
+	}
+
+	chats, err := store.getRestrictionChatIDs(ctx, ids...)
+	if err != nil {
+		return nil, errors.Wrap(err, "get restriction chats")
+	}
+
+	result := make([]*core.Bundle, len(rows))
+
+	for i, row := range rows {
+		id := core.BundleID(row.ID)
+
+		bundle, err := store.fromRow(row, members[id], chats[id])
+		if err != nil {
+			return nil, errors.Wrapf(err, "from row #%d", i)
+		}
+
+		result[i] = bundle
+	}
+
+	return result, nil
+}
+
+// getFileIDs returns ordered file ids of each bundle.
+func (store *BundleStore) getFileIDs(ctx context.Context, ids ...int) (map[core.BundleID][]core.FileID, error) {
+	rows, err := dal.BundleFiles(
+		dal.BundleFileWhere.BundleID.IN(ids),
+		qm.OrderBy(dal.BundleFileColumns.BundleID+", "+dal.BundleFileColumns.Position),
+	).All(ctx, store.getExecutor(ctx))
+	if err != nil {
+		return nil, errors.Wrap(err, "select query")
+	}
+
+	result := make(map[core.BundleID][]core.FileID, len(ids))
+
+	for _, row := range rows {
+		id := core.BundleID(row.BundleID)
+		result[id] = append(result[id], core.FileID(row.FileID))
+	}
+
+	return result, nil
+}
+
+// getRestrictionChatIDs returns ordered restriction chat ids of each bundle.
+func (store *BundleStore) getRestrictionChatIDs(ctx context.Context, ids ...int) (map[core.BundleID][]core.ChatID, error) {
+	rows, err := dal.BundleRestrictionChats(
+		dal.BundleRestrictionChatWhere.BundleID.IN(ids),
+		qm.OrderBy(dal.BundleRestrictionChatColumns.BundleID+", "+dal.BundleRestrictionChatColumns.Position),
+	).All(ctx, store.getExecutor(ctx))
+	if err != nil {
+		return nil, errors.Wrap(err, "select query")
+	}
+
+	result := make(map[core.BundleID][]core.ChatID, len(ids))
+
+	for _, row := range rows {
+		id := core.BundleID(row.BundleID)
+		result[id] = append(result[id], core.ChatID(row.ChatID))
+	}
+
+	return result, nil
+}
+
+func (store *BundleStore) Add(ctx context.Context, bundle *core.Bundle) error {
+	return store.Txier(ctx, func(ctx context.Context) error {
+		for {
+			// public id of bundle and file share one namespace in deep links
+			used, err := dal.Files(
+				dal.FileWhere.PublicID.EQ(bundle.PublicID),
+			).Exists(ctx, store.getExecutor(ctx))
+			if err != nil {
+				return errors.Wrap(err, "check file public id")
+			}
+
+			if used {
+				bundle.RegenPublicID()
+				continue
+			}
+
+			if err := store.add(ctx, bundle); err != nil {
+				if isBundlePublicIDCollisionErr(err) {
+					currID := bundle.PublicID
+					bundle.RegenPublicID()
+					log.Warn(ctx, "collison when insert bundle", "curr_id", currID, "next_id", bundle.PublicID)
+					continue
+				}
+				return err
+			}
+
+			return nil
+		}
+	})
+}
+
+func (store *BundleStore) add(ctx context.Context, bundle *core.Bundle) error {
+	row := store.toRow(bundle)
+
+	// insert of bundle with collision aborts transaction, so do it in savepoint.
+	if _, err := store.getExecutor(ctx).ExecContext(ctx, "savepoint bundle_insert"); err != nil {
+		return errors.Wrap(err, "create savepoint")
+	}
+
+	if err := store.insertOne(ctx, row); err != nil {
+		if _, err := store.getExecutor(ctx).ExecContext(ctx, "rollback to savepoint bundle_insert"); err != nil {
+			return errors.Wrap(err, "rollback to savepoint")
+		}
+		return errors.Wrap(err, "insert query")
+	}
+
+	if err := store.insertFiles(ctx, row.ID, bundle.FileIDs); err != nil {
+		return errors.Wrap(err, "insert files")
+	}
+
+	if err := store.insertRestrictionChats(ctx, row.ID, bundle.Restriction.ChatIDs); err != nil {
+		return errors.Wrap(err, "insert restriction chats")
+	}
+
+	newBundle, err := store.fromRow(row, bundle.FileIDs, bundle.Restriction.ChatIDs)
+	if err != nil {
+		return errors.Wrap(err, "from row")
+	}
+
+	*bundle = *newBundle
+
+	return nil
+}
+
+func (store *BundleStore) insertFiles(ctx context.Context, id int, fileIDs []core.FileID) error {
+	for i, fileID := range fileIDs {
+		row := &dal.BundleFile{
+			BundleID: id,
+			FileID:   int(fileID),
+			Position: i,
+		}
+
+		if err := store.insertOne(ctx, row); err != nil {
+			return errors.Wrapf(err, "insert file #%d", i)
+		}
+	}
+
+	return nil
+}
+
+func (store *BundleStore) insertRestrictionChats(ctx context.Context, id int, chatIDs []core.ChatID) error {
+	for i, chatID := range chatIDs {
+		row := &dal.BundleRestrictionChat{
+			BundleID: id,
+			ChatID:   int(chatID),
+			Position: i,
+		}
+
+		if err := store.insertOne(ctx, row); err != nil {
+			return errors.Wrapf(err, "insert chat #%d", i)
+		}
+	}
+
+	return nil
+}
+
+func (store *BundleStore) Update(ctx context.Context, bundle *core.Bundle) error {
+	return store.Txier(ctx, func(ctx context.Context) error {
+		row := store.toRow(bundle)
+
+		if err := store.updateOne(ctx, row, core.ErrBundleNotFound); err != nil {
+			return errors.Wrap(err, "update one")
+		}
+
+		if _, err := dal.BundleFiles(
+			dal.BundleFileWhere.BundleID.EQ(row.ID),
+		).DeleteAll(ctx, store.getExecutor(ctx)); err != nil {
+			return errors.Wrap(err, "delete files")
+		}
+
+		if err := store.insertFiles(ctx, row.ID, bundle.FileIDs); err != nil {
+			return errors.Wrap(err, "insert files")
+		}
+
+		if _, err := dal.BundleRestrictionChats(
+			dal.BundleRestrictionChatWhere.BundleID.EQ(row.ID),
+		).DeleteAll(ctx, store.getExecutor(ctx)); err != nil {
+			return errors.Wrap(err, "delete restriction chats")
+		}
+
+		if err := store.insertRestrictionChats(ctx, row.ID, bundle.Restriction.ChatIDs); err != nil {
+			return errors.Wrap(err, "insert restriction chats")
+		}
+
+		return nil
+	})
+}
+
+func (store *BundleStore) Query() core.BundleStoreQuery {
+	return &bundleStoreQuery{store: store}
+}
+
+type bundleStoreQuery struct {
+	mods  []qm.QueryMod
+	store *BundleStore
+}
+
+func (bsq *bundleStoreQuery) ID(id core.BundleID) core.BundleStoreQuery {
+	bsq.mods = append(bsq.mods, dal.BundleWhere.ID.EQ(int(id)))
+	return bsq
+}
+
+func (bsq *bundleStoreQuery) OwnerID(id core.UserID) core.BundleStoreQuery {
+	bsq.mods = append(bsq.mods, dal.BundleWhere.OwnerID.EQ(int(id)))
+	return bsq
+}
+
+func (bsq *bundleStoreQuery) PublicID(ids ...string) core.BundleStoreQuery {
+	bsq.mods = append(bsq.mods, dal.BundleWhere.PublicID.IN(ids))
+	return bsq
+}
+
+func (bsq *bundleStoreQuery) One(ctx context.Context) (*core.Bundle, error) {
+	executor := bsq.store.getExecutor(ctx)
+
+	row, err := dal.Bundles(bsq.mods...).One(ctx, executor)
+	if err == sql.ErrNoRows {
+		return nil, core.ErrBundleNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	bundles, err := bsq.store.fromRows(ctx, []*dal.Bundle{row})
+	if err != nil {
+		return nil, err
+	}
+
+	return bundles[0], nil
+}
+
+func (bsq *bundleStoreQuery) All(ctx context.Context) ([]*core.Bundle, error) {
+	executor := bsq.store.getExecutor(ctx)
+	rows, err := dal.Bundles(bsq.mods...).All(ctx, executor)
+	if err != nil {
+		return nil, err
+	}
+	return bsq.store.fromRows(ctx, rows)
+}
+
+func (bsq *bundleStoreQuery) Delete(ctx context.Context) error {
+	executor := bsq.store.getExecutor(ctx)
+	count, err := dal.
+		Bundles(bsq.mods...).
+		DeleteAll(ctx, executor)
+	if err != nil {
+		return errors.Wrap(err, "delete query")
+	}
+	if count == 0 {
+		return core.ErrBundleNotFound
+	}
+	return nil
+}
+
+func (bsq *bundleStoreQuery) Count(ctx context.Context) (int, error) {
+	executor := bsq.store.getExecutor(ctx)
+
+	count, err := dal.
+		Bundles(bsq.mods...).
+		Count(ctx, executor)
+
+	if err != nil {
+		return 0, errors.Wrap(err, "count query")
+	}
+
+	return int(count), nil
+}
@@ -0,0 +1,92 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/stretchr/testify/require"
+)
+
+func newFakeFileInStore(t *testing.T, pg *Postgres, owner *core.User, name string) *core.File {
+	t.Helper()
+
+	file := core.NewFile(
+		"telegram-"+name,
+		"",
+		core.KindDocument,
+		"text/plain",
+		100,
+		name,
+		owner.ID,
+		false,
+		core.Metadata{},
+	)
+
+	if err := pg.File().Add(context.Background(), file); err != nil {
+		t.Fatalf("can't add fake file to store: %v", err)
+	}
+
+	return file
+}
+
+func TestBundleStore_Add(t *testing.T) {
+	ctx, pg := newPostgres(t)
+	store := pg.Bundle()
+
+	user := newFakeUserInStore(t, pg)
+
+	first := newFakeFileInStore(t, pg, user, "first.txt")
+	second := newFakeFileInStore(t, pg, user, "second.txt")
+
+	bundle := core.NewBundle(
+		"test",
+		[]core.FileID{second.ID, first.ID},
+		user.ID,
+		false,
+	)
+
+	err := store.Add(ctx, bundle)
+	require.NoError(t, err)
+	require.NotZero(t, bundle.ID, "bundle id should be not zero")
+
+	found, err := store.Query().PublicID(bundle.PublicID).One(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []core.FileID{second.ID, first.ID}, found.FileIDs, "order of files should be kept")
+}
+
+func TestBundleStore_Update(t *testing.T) {
+	ctx, pg := newPostgres(t)
+	store := pg.Bundle()
+
+	user := newFakeUserInStore(t, pg)
+
+	first := newFakeFileInStore(t, pg, user, "first.txt")
+	second := newFakeFileInStore(t, pg, user, "second.txt")
+
+	bundle := core.NewBundle(
+		"test",
+		[]core.FileID{first.ID},
+		user.ID,
+		false,
+	)
+
+	require.NoError(t, store.Add(ctx, bundle))
+
+	bundle.FileIDs = []core.FileID{second.ID, first.ID}
+
+	require.NoError(t, store.Update(ctx, bundle))
+
+	found, err := store.Query().ID(bundle.ID).One(ctx)
+	require.NoError(t, err)
+	require.Equal(t, bundle.FileIDs, found.FileIDs)
+}
+
+func TestBundleStore_Query(t *testing.T) {
+	t.Run("NotFound", func(t *testing.T) {
+		ctx, pg := newPostgres(t)
+
+		_, err := pg.Bundle().Query().ID(1).One(ctx)
+		require.Equal(t, core.ErrBundleNotFound, err)
+	})
+}
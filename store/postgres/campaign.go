@@ -0,0 +1,107 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/store/postgres/dal"
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type CampaignStore struct {
+	BaseStore
+}
+
+func (store *CampaignStore) toRow(campaign *core.Campaign) *dal.Campaign {
+	return &dal.Campaign{
+		ID:        int(campaign.ID),
+		Ref:       campaign.Ref,
+		Name:      campaign.Name,
+		Cost:      campaign.Cost,
+		CreatedAt: campaign.CreatedAt,
+	}
+}
+
+func (store *CampaignStore) fromRow(row *dal.Campaign) *core.Campaign {
+	return &core.Campaign{
+		ID:        core.CampaignID(row.ID),
+		Ref:       row.Ref,
+		Name:      row.Name,
+		Cost:      row.Cost,
+		CreatedAt: row.CreatedAt,
+	}
+}
+
+func (store *CampaignStore) Add(ctx context.Context, campaign *core.Campaign) error {
+	row := store.toRow(campaign)
+
+	if err := store.insertOne(ctx, row); err != nil {
+		return errors.Wrap(err, "insert query")
+	}
+
+	*campaign = *store.fromRow(row)
+
+	return nil
+}
+
+func (store *CampaignStore) Query() core.CampaignStoreQuery {
+	return &campaignStoreQuery{store: store}
+}
+
+type campaignStoreQuery struct {
+	mods  []qm.QueryMod
+	store *CampaignStore
+}
+
+func (csq *campaignStoreQuery) ID(id core.CampaignID) core.CampaignStoreQuery {
+	csq.mods = append(csq.mods, dal.CampaignWhere.ID.EQ(int(id)))
+	return csq
+}
+
+func (csq *campaignStoreQuery) Ref(ref string) core.CampaignStoreQuery {
+	csq.mods = append(csq.mods, dal.CampaignWhere.Ref.EQ(ref))
+	return csq
+}
+
+func (csq *campaignStoreQuery) getListMods() []qm.QueryMod {
+	mods := make([]qm.QueryMod, 0, len(csq.mods)+1)
+	mods = append(mods, csq.mods...)
+	return append(mods, qm.OrderBy(dal.CampaignColumns.ID+" desc"))
+}
+
+func (csq *campaignStoreQuery) One(ctx context.Context) (*core.Campaign, error) {
+	row, err := dal.Campaigns(csq.getListMods()...).One(ctx, csq.store.getExecutor(ctx))
+	if err == sql.ErrNoRows {
+		return nil, core.ErrCampaignNotFound
+	} else if err != nil {
+		return nil, errors.Wrap(err, "select query")
+	}
+
+	return csq.store.fromRow(row), nil
+}
+
+func (csq *campaignStoreQuery) All(ctx context.Context) ([]*core.Campaign, error) {
+	rows, err := dal.Campaigns(csq.getListMods()...).All(ctx, csq.store.getExecutor(ctx))
+	if err != nil {
+		return nil, errors.Wrap(err, "select query")
+	}
+
+	result := make([]*core.Campaign, len(rows))
+
+	for i, row := range rows {
+		result[i] = csq.store.fromRow(row)
+	}
+
+	return result, nil
+}
+
+func (csq *campaignStoreQuery) Delete(ctx context.Context) (int, error) {
+	count, err := dal.Campaigns(csq.mods...).DeleteAll(ctx, csq.store.getExecutor(ctx))
+	if err != nil {
+		return 0, errors.Wrap(err, "delete query")
+	}
+
+	return int(count), nil
+}
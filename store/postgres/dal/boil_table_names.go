@@ -4,13 +4,41 @@
 package dal
 
 var TableNames = struct {
-	Chat     string
-	Download string
-	File     string
-	User     string
+	AuditLog              string
+	Broadcast             string
+	BroadcastDelivery     string
+	Bundle                string
+	BundleFile            string
+	BundleRestrictionChat string
+	Campaign              string
+	Chat                  string
+	Download              string
+	DownloadChat          string
+	File                  string
+	FileRestrictionChat   string
+	FileRevokedLink       string
+	FileVersion           string
+	Placement             string
+	Report                string
+	ReservedPublicID      string
+	User                  string
 }{
-	Chat:     "chat",
-	Download: "download",
-	File:     "file",
-	User:     "user",
+	AuditLog:              "audit_log",
+	Broadcast:             "broadcast",
+	BroadcastDelivery:     "broadcast_delivery",
+	Bundle:                "bundle",
+	BundleFile:            "bundle_file",
+	BundleRestrictionChat: "bundle_restriction_chat",
+	Campaign:              "campaign",
+	Chat:                  "chat",
+	Download:              "download",
+	DownloadChat:          "download_chat",
+	File:                  "file",
+	FileRestrictionChat:   "file_restriction_chat",
+	FileRevokedLink:       "file_revoked_link",
+	FileVersion:           "file_version",
+	Placement:             "placement",
+	Report:                "report",
+	ReservedPublicID:      "reserved_public_id",
+	User:                  "user",
 }
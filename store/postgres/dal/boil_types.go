@@ -51,6 +51,35 @@ func makeCacheKey(cols boil.Columns, nzDefaults []string) string {
 	return str
 }
 
+// Enum values for audit_action
+const (
+	AuditActionFileBlock   = "FileBlock"
+	AuditActionFileUnblock = "FileUnblock"
+	AuditActionUserBan     = "UserBan"
+	AuditActionUserUnban   = "UserUnban"
+)
+
+// Enum values for broadcast_status
+const (
+	BroadcastStatusDraft    = "Draft"
+	BroadcastStatusRunning  = "Running"
+	BroadcastStatusDone     = "Done"
+	BroadcastStatusCanceled = "Canceled"
+)
+
+// Enum values for broadcast_delivery_status
+const (
+	BroadcastDeliveryStatusDelivered = "Delivered"
+	BroadcastDeliveryStatusBlocked   = "Blocked"
+	BroadcastDeliveryStatusFailed    = "Failed"
+)
+
+// Enum values for chat_policy
+const (
+	ChatPolicyAll = "All"
+	ChatPolicyAny = "Any"
+)
+
 // Enum values for chat_type
 const (
 	ChatTypeGroup      = "Group"
@@ -58,6 +87,12 @@ const (
 	ChatTypeChannel    = "Channel"
 )
 
+// Enum values for download_source
+const (
+	DownloadSourceLink   = "Link"
+	DownloadSourceInline = "Inline"
+)
+
 // Enum values for file_kind
 const (
 	FileKindDocument  = "Document"
@@ -68,4 +103,16 @@ const (
 	FileKindVideoNote = "VideoNote"
 	FileKindVoice     = "Voice"
 	FileKindPhoto     = "Photo"
+	FileKindContact   = "Contact"
+	FileKindLocation  = "Location"
+)
+
+// Enum values for report_status
+const (
+	ReportStatusPending        = "Pending"
+	ReportStatusApproved       = "Approved"
+	ReportStatusRejected       = "Rejected"
+	ReportStatusAppealed       = "Appealed"
+	ReportStatusAppealApproved = "AppealApproved"
+	ReportStatusAppealRejected = "AppealRejected"
 )
@@ -56,119 +56,6 @@ var ChatColumns = struct {
 
 // Generated where
 
-type whereHelperint struct{ field string }
-
-func (w whereHelperint) EQ(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperint) NEQ(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperint) LT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperint) LTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperint) GT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperint) GTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperint) IN(slice []int) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperint) NIN(slice []int) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
-type whereHelperint64 struct{ field string }
-
-func (w whereHelperint64) EQ(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperint64) NEQ(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperint64) LT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperint64) LTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperint64) GT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperint64) GTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperint64) IN(slice []int64) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperint64) NIN(slice []int64) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
-type whereHelperstring struct{ field string }
-
-func (w whereHelperstring) EQ(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperstring) NEQ(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperstring) LT(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperstring) LTE(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperstring) GT(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperstring) GTE(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperstring) IN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
-type whereHelpertime_Time struct{ field string }
-
-func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.EQ, x)
-}
-func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.NEQ, x)
-}
-func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
-type whereHelpernull_Time struct{ field string }
-
-func (w whereHelpernull_Time) EQ(x null.Time) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
-}
-func (w whereHelpernull_Time) NEQ(x null.Time) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
-}
-func (w whereHelpernull_Time) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_Time) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
-func (w whereHelpernull_Time) LT(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpernull_Time) LTE(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpernull_Time) GT(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpernull_Time) GTE(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
 var ChatWhere = struct {
 	ID         whereHelperint
 	TelegramID whereHelperint64
@@ -189,17 +76,23 @@ var ChatWhere = struct {
 
 // ChatRels is where relationship names are stored.
 var ChatRels = struct {
-	Owner                 string
-	RestrictionsChatFiles string
+	Owner                  string
+	BundleRestrictionChats string
+	Downloads              string
+	FileRestrictionChats   string
 }{
-	Owner:                 "Owner",
-	RestrictionsChatFiles: "RestrictionsChatFiles",
+	Owner:                  "Owner",
+	BundleRestrictionChats: "BundleRestrictionChats",
+	Downloads:              "Downloads",
+	FileRestrictionChats:   "FileRestrictionChats",
 }
 
 // chatR is where relationships are stored.
 type chatR struct {
-	Owner                 *User     `boil:"Owner" json:"Owner" toml:"Owner" yaml:"Owner"`
-	RestrictionsChatFiles FileSlice `boil:"RestrictionsChatFiles" json:"RestrictionsChatFiles" toml:"RestrictionsChatFiles" yaml:"RestrictionsChatFiles"`
+	Owner                  *User                      `boil:"Owner" json:"Owner" toml:"Owner" yaml:"Owner"`
+	BundleRestrictionChats BundleRestrictionChatSlice `boil:"BundleRestrictionChats" json:"BundleRestrictionChats" toml:"BundleRestrictionChats" yaml:"BundleRestrictionChats"`
+	Downloads              DownloadSlice              `boil:"Downloads" json:"Downloads" toml:"Downloads" yaml:"Downloads"`
+	FileRestrictionChats   FileRestrictionChatSlice   `boil:"FileRestrictionChats" json:"FileRestrictionChats" toml:"FileRestrictionChats" yaml:"FileRestrictionChats"`
 }
 
 // NewStruct creates a new relationship struct
@@ -322,22 +215,65 @@ func (o *Chat) Owner(mods ...qm.QueryMod) userQuery {
 	return query
 }
 
-// RestrictionsChatFiles retrieves all the file's Files with an executor via restrictions_chat_id column.
-func (o *Chat) RestrictionsChatFiles(mods ...qm.QueryMod) fileQuery {
+// BundleRestrictionChats retrieves all the bundle_restriction_chat's BundleRestrictionChats with an executor.
+func (o *Chat) BundleRestrictionChats(mods ...qm.QueryMod) bundleRestrictionChatQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"file\".\"restrictions_chat_id\"=?", o.ID),
+		qm.Where("\"bundle_restriction_chat\".\"chat_id\"=?", o.ID),
 	)
 
-	query := Files(queryMods...)
-	queries.SetFrom(query.Query, "\"file\"")
+	query := BundleRestrictionChats(queryMods...)
+	queries.SetFrom(query.Query, "\"bundle_restriction_chat\"")
 
 	if len(queries.GetSelect(query.Query)) == 0 {
-		queries.SetSelect(query.Query, []string{"\"file\".*"})
+		queries.SetSelect(query.Query, []string{"\"bundle_restriction_chat\".*"})
+	}
+
+	return query
+}
+
+// Downloads retrieves all the download's Downloads with an executor.
+func (o *Chat) Downloads(mods ...qm.QueryMod) downloadQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.InnerJoin("\"download_chat\" on \"download\".\"id\" = \"download_chat\".\"download_id\""),
+		qm.Where("\"download_chat\".\"chat_id\"=?", o.ID),
+	)
+
+	query := Downloads(queryMods...)
+	queries.SetFrom(query.Query, "\"download\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"download\".*"})
+	}
+
+	return query
+}
+
+// FileRestrictionChats retrieves all the file_restriction_chat's FileRestrictionChats with an executor.
+func (o *Chat) FileRestrictionChats(mods ...qm.QueryMod) fileRestrictionChatQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"file_restriction_chat\".\"chat_id\"=?", o.ID),
+	)
+
+	query := FileRestrictionChats(queryMods...)
+	queries.SetFrom(query.Query, "\"file_restriction_chat\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"file_restriction_chat\".*"})
 	}
 
 	return query
@@ -439,9 +375,208 @@ func (chatL) LoadOwner(ctx context.Context, e boil.ContextExecutor, singular boo
 	return nil
 }
 
-// LoadRestrictionsChatFiles allows an eager lookup of values, cached into the
+// LoadBundleRestrictionChats allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (chatL) LoadBundleRestrictionChats(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChat interface{}, mods queries.Applicator) error {
+	var slice []*Chat
+	var object *Chat
+
+	if singular {
+		object = maybeChat.(*Chat)
+	} else {
+		slice = *maybeChat.(*[]*Chat)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &chatR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &chatR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`bundle_restriction_chat`),
+		qm.WhereIn(`bundle_restriction_chat.chat_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load bundle_restriction_chat")
+	}
+
+	var resultSlice []*BundleRestrictionChat
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice bundle_restriction_chat")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on bundle_restriction_chat")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for bundle_restriction_chat")
+	}
+
+	if singular {
+		object.R.BundleRestrictionChats = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &bundleRestrictionChatR{}
+			}
+			foreign.R.Chat = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ChatID {
+				local.R.BundleRestrictionChats = append(local.R.BundleRestrictionChats, foreign)
+				if foreign.R == nil {
+					foreign.R = &bundleRestrictionChatR{}
+				}
+				foreign.R.Chat = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadDownloads allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (chatL) LoadDownloads(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChat interface{}, mods queries.Applicator) error {
+	var slice []*Chat
+	var object *Chat
+
+	if singular {
+		object = maybeChat.(*Chat)
+	} else {
+		slice = *maybeChat.(*[]*Chat)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &chatR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &chatR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.Select("\"download\".id, \"download\".file_id, \"download\".user_id, \"download\".at, \"download\".new_subscription, \"download\".bundle_id, \"download\".source, \"download\".placement_id, \"download\".file_version, \"a\".\"chat_id\""),
+		qm.From("\"download\""),
+		qm.InnerJoin("\"download_chat\" as \"a\" on \"download\".\"id\" = \"a\".\"download_id\""),
+		qm.WhereIn("\"a\".\"chat_id\" in ?", args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load download")
+	}
+
+	var resultSlice []*Download
+
+	var localJoinCols []int
+	for results.Next() {
+		one := new(Download)
+		var localJoinCol int
+
+		err = results.Scan(&one.ID, &one.FileID, &one.UserID, &one.At, &one.NewSubscription, &one.BundleID, &one.Source, &one.PlacementID, &one.FileVersion, &localJoinCol)
+		if err != nil {
+			return errors.Wrap(err, "failed to scan eager loaded results for download")
+		}
+		if err = results.Err(); err != nil {
+			return errors.Wrap(err, "failed to plebian-bind eager loaded slice download")
+		}
+
+		resultSlice = append(resultSlice, one)
+		localJoinCols = append(localJoinCols, localJoinCol)
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on download")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for download")
+	}
+
+	if singular {
+		object.R.Downloads = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &downloadR{}
+			}
+			foreign.R.Chats = append(foreign.R.Chats, object)
+		}
+		return nil
+	}
+
+	for i, foreign := range resultSlice {
+		localJoinCol := localJoinCols[i]
+		for _, local := range slice {
+			if local.ID == localJoinCol {
+				local.R.Downloads = append(local.R.Downloads, foreign)
+				if foreign.R == nil {
+					foreign.R = &downloadR{}
+				}
+				foreign.R.Chats = append(foreign.R.Chats, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadFileRestrictionChats allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (chatL) LoadRestrictionsChatFiles(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChat interface{}, mods queries.Applicator) error {
+func (chatL) LoadFileRestrictionChats(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChat interface{}, mods queries.Applicator) error {
 	var slice []*Chat
 	var object *Chat
 
@@ -465,7 +600,7 @@ func (chatL) LoadRestrictionsChatFiles(ctx context.Context, e boil.ContextExecut
 			}
 
 			for _, a := range args {
-				if queries.Equal(a, obj.ID) {
+				if a == obj.ID {
 					continue Outer
 				}
 			}
@@ -479,8 +614,8 @@ func (chatL) LoadRestrictionsChatFiles(ctx context.Context, e boil.ContextExecut
 	}
 
 	query := NewQuery(
-		qm.From(`file`),
-		qm.WhereIn(`file.restrictions_chat_id in ?`, args...),
+		qm.From(`file_restriction_chat`),
+		qm.WhereIn(`file_restriction_chat.chat_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -488,40 +623,40 @@ func (chatL) LoadRestrictionsChatFiles(ctx context.Context, e boil.ContextExecut
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load file")
+		return errors.Wrap(err, "failed to eager load file_restriction_chat")
 	}
 
-	var resultSlice []*File
+	var resultSlice []*FileRestrictionChat
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice file")
+		return errors.Wrap(err, "failed to bind eager loaded slice file_restriction_chat")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on file")
+		return errors.Wrap(err, "failed to close results in eager load on file_restriction_chat")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for file")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for file_restriction_chat")
 	}
 
 	if singular {
-		object.R.RestrictionsChatFiles = resultSlice
+		object.R.FileRestrictionChats = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &fileR{}
+				foreign.R = &fileRestrictionChatR{}
 			}
-			foreign.R.RestrictionsChat = object
+			foreign.R.Chat = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if queries.Equal(local.ID, foreign.RestrictionsChatID) {
-				local.R.RestrictionsChatFiles = append(local.R.RestrictionsChatFiles, foreign)
+			if local.ID == foreign.ChatID {
+				local.R.FileRestrictionChats = append(local.R.FileRestrictionChats, foreign)
 				if foreign.R == nil {
-					foreign.R = &fileR{}
+					foreign.R = &fileRestrictionChatR{}
 				}
-				foreign.R.RestrictionsChat = local
+				foreign.R.Chat = local
 				break
 			}
 		}
@@ -577,25 +712,25 @@ func (o *Chat) SetOwner(ctx context.Context, exec boil.ContextExecutor, insert b
 	return nil
 }
 
-// AddRestrictionsChatFiles adds the given related objects to the existing relationships
+// AddBundleRestrictionChats adds the given related objects to the existing relationships
 // of the chat, optionally inserting them as new records.
-// Appends related to o.R.RestrictionsChatFiles.
-// Sets related.R.RestrictionsChat appropriately.
-func (o *Chat) AddRestrictionsChatFiles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*File) error {
+// Appends related to o.R.BundleRestrictionChats.
+// Sets related.R.Chat appropriately.
+func (o *Chat) AddBundleRestrictionChats(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*BundleRestrictionChat) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			queries.Assign(&rel.RestrictionsChatID, o.ID)
+			rel.ChatID = o.ID
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"file\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"restrictions_chat_id"}),
-				strmangle.WhereClause("\"", "\"", 2, filePrimaryKeyColumns),
+				"UPDATE \"bundle_restriction_chat\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"chat_id"}),
+				strmangle.WhereClause("\"", "\"", 2, bundleRestrictionChatPrimaryKeyColumns),
 			)
-			values := []interface{}{o.ID, rel.ID}
+			values := []interface{}{o.ID, rel.BundleID, rel.ChatID}
 
 			if boil.IsDebug(ctx) {
 				writer := boil.DebugWriterFrom(ctx)
@@ -606,38 +741,86 @@ func (o *Chat) AddRestrictionsChatFiles(ctx context.Context, exec boil.ContextEx
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			queries.Assign(&rel.RestrictionsChatID, o.ID)
+			rel.ChatID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &chatR{
+			BundleRestrictionChats: related,
+		}
+	} else {
+		o.R.BundleRestrictionChats = append(o.R.BundleRestrictionChats, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &bundleRestrictionChatR{
+				Chat: o,
+			}
+		} else {
+			rel.R.Chat = o
+		}
+	}
+	return nil
+}
+
+// AddDownloads adds the given related objects to the existing relationships
+// of the chat, optionally inserting them as new records.
+// Appends related to o.R.Downloads.
+// Sets related.R.Chats appropriately.
+func (o *Chat) AddDownloads(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Download) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
 		}
 	}
 
+	for _, rel := range related {
+		query := "insert into \"download_chat\" (\"chat_id\", \"download_id\") values ($1, $2)"
+		values := []interface{}{o.ID, rel.ID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, query)
+			fmt.Fprintln(writer, values)
+		}
+		_, err = exec.ExecContext(ctx, query, values...)
+		if err != nil {
+			return errors.Wrap(err, "failed to insert into join table")
+		}
+	}
 	if o.R == nil {
 		o.R = &chatR{
-			RestrictionsChatFiles: related,
+			Downloads: related,
 		}
 	} else {
-		o.R.RestrictionsChatFiles = append(o.R.RestrictionsChatFiles, related...)
+		o.R.Downloads = append(o.R.Downloads, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &fileR{
-				RestrictionsChat: o,
+			rel.R = &downloadR{
+				Chats: ChatSlice{o},
 			}
 		} else {
-			rel.R.RestrictionsChat = o
+			rel.R.Chats = append(rel.R.Chats, o)
 		}
 	}
 	return nil
 }
 
-// SetRestrictionsChatFiles removes all previously related items of the
+// SetDownloads removes all previously related items of the
 // chat replacing them completely with the passed
 // in related items, optionally inserting them as new records.
-// Sets o.R.RestrictionsChat's RestrictionsChatFiles accordingly.
-// Replaces o.R.RestrictionsChatFiles with related.
-// Sets related.R.RestrictionsChat's RestrictionsChatFiles accordingly.
-func (o *Chat) SetRestrictionsChatFiles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*File) error {
-	query := "update \"file\" set \"restrictions_chat_id\" = null where \"restrictions_chat_id\" = $1"
+// Sets o.R.Chats's Downloads accordingly.
+// Replaces o.R.Downloads with related.
+// Sets related.R.Chats's Downloads accordingly.
+func (o *Chat) SetDownloads(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Download) error {
+	query := "delete from \"download_chat\" where \"chat_id\" = $1"
 	values := []interface{}{o.ID}
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
@@ -649,50 +832,52 @@ func (o *Chat) SetRestrictionsChatFiles(ctx context.Context, exec boil.ContextEx
 		return errors.Wrap(err, "failed to remove relationships before set")
 	}
 
+	removeDownloadsFromChatsSlice(o, related)
 	if o.R != nil {
-		for _, rel := range o.R.RestrictionsChatFiles {
-			queries.SetScanner(&rel.RestrictionsChatID, nil)
-			if rel.R == nil {
-				continue
-			}
-
-			rel.R.RestrictionsChat = nil
-		}
-
-		o.R.RestrictionsChatFiles = nil
+		o.R.Downloads = nil
 	}
-	return o.AddRestrictionsChatFiles(ctx, exec, insert, related...)
+	return o.AddDownloads(ctx, exec, insert, related...)
 }
 
-// RemoveRestrictionsChatFiles relationships from objects passed in.
-// Removes related items from R.RestrictionsChatFiles (uses pointer comparison, removal does not keep order)
-// Sets related.R.RestrictionsChat.
-func (o *Chat) RemoveRestrictionsChatFiles(ctx context.Context, exec boil.ContextExecutor, related ...*File) error {
+// RemoveDownloads relationships from objects passed in.
+// Removes related items from R.Downloads (uses pointer comparison, removal does not keep order)
+// Sets related.R.Chats.
+func (o *Chat) RemoveDownloads(ctx context.Context, exec boil.ContextExecutor, related ...*Download) error {
 	var err error
+	query := fmt.Sprintf(
+		"delete from \"download_chat\" where \"chat_id\" = $1 and \"download_id\" in (%s)",
+		strmangle.Placeholders(dialect.UseIndexPlaceholders, len(related), 2, 1),
+	)
+	values := []interface{}{o.ID}
 	for _, rel := range related {
-		queries.SetScanner(&rel.RestrictionsChatID, nil)
-		if rel.R != nil {
-			rel.R.RestrictionsChat = nil
-		}
-		if _, err = rel.Update(ctx, exec, boil.Whitelist("restrictions_chat_id")); err != nil {
-			return err
-		}
+		values = append(values, rel.ID)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
 	}
+	_, err = exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+	removeDownloadsFromChatsSlice(o, related)
 	if o.R == nil {
 		return nil
 	}
 
 	for _, rel := range related {
-		for i, ri := range o.R.RestrictionsChatFiles {
+		for i, ri := range o.R.Downloads {
 			if rel != ri {
 				continue
 			}
 
-			ln := len(o.R.RestrictionsChatFiles)
+			ln := len(o.R.Downloads)
 			if ln > 1 && i < ln-1 {
-				o.R.RestrictionsChatFiles[i] = o.R.RestrictionsChatFiles[ln-1]
+				o.R.Downloads[i] = o.R.Downloads[ln-1]
 			}
-			o.R.RestrictionsChatFiles = o.R.RestrictionsChatFiles[:ln-1]
+			o.R.Downloads = o.R.Downloads[:ln-1]
 			break
 		}
 	}
@@ -700,6 +885,79 @@ func (o *Chat) RemoveRestrictionsChatFiles(ctx context.Context, exec boil.Contex
 	return nil
 }
 
+func removeDownloadsFromChatsSlice(o *Chat, related []*Download) {
+	for _, rel := range related {
+		if rel.R == nil {
+			continue
+		}
+		for i, ri := range rel.R.Chats {
+			if o.ID != ri.ID {
+				continue
+			}
+
+			ln := len(rel.R.Chats)
+			if ln > 1 && i < ln-1 {
+				rel.R.Chats[i] = rel.R.Chats[ln-1]
+			}
+			rel.R.Chats = rel.R.Chats[:ln-1]
+			break
+		}
+	}
+}
+
+// AddFileRestrictionChats adds the given related objects to the existing relationships
+// of the chat, optionally inserting them as new records.
+// Appends related to o.R.FileRestrictionChats.
+// Sets related.R.Chat appropriately.
+func (o *Chat) AddFileRestrictionChats(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*FileRestrictionChat) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ChatID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"file_restriction_chat\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"chat_id"}),
+				strmangle.WhereClause("\"", "\"", 2, fileRestrictionChatPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.FileID, rel.ChatID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ChatID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &chatR{
+			FileRestrictionChats: related,
+		}
+	} else {
+		o.R.FileRestrictionChats = append(o.R.FileRestrictionChats, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &fileRestrictionChatR{
+				Chat: o,
+			}
+		} else {
+			rel.R.Chat = o
+		}
+	}
+	return nil
+}
+
 // Chats retrieves all the records using an executor.
 func Chats(mods ...qm.QueryMod) chatQuery {
 	mods = append(mods, qm.From("\"chat\""))
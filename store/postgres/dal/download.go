@@ -29,6 +29,10 @@ type Download struct {
 	UserID          null.Int  `boil:"user_id" json:"user_id,omitempty" toml:"user_id" yaml:"user_id,omitempty"`
 	At              time.Time `boil:"at" json:"at" toml:"at" yaml:"at"`
 	NewSubscription null.Bool `boil:"new_subscription" json:"new_subscription,omitempty" toml:"new_subscription" yaml:"new_subscription,omitempty"`
+	BundleID        null.Int  `boil:"bundle_id" json:"bundle_id,omitempty" toml:"bundle_id" yaml:"bundle_id,omitempty"`
+	Source          string    `boil:"source" json:"source" toml:"source" yaml:"source"`
+	PlacementID     null.Int  `boil:"placement_id" json:"placement_id,omitempty" toml:"placement_id" yaml:"placement_id,omitempty"`
+	FileVersion     null.Int  `boil:"file_version" json:"file_version,omitempty" toml:"file_version" yaml:"file_version,omitempty"`
 
 	R *downloadR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L downloadL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -40,39 +44,24 @@ var DownloadColumns = struct {
 	UserID          string
 	At              string
 	NewSubscription string
+	BundleID        string
+	Source          string
+	PlacementID     string
+	FileVersion     string
 }{
 	ID:              "id",
 	FileID:          "file_id",
 	UserID:          "user_id",
 	At:              "at",
 	NewSubscription: "new_subscription",
+	BundleID:        "bundle_id",
+	Source:          "source",
+	PlacementID:     "placement_id",
+	FileVersion:     "file_version",
 }
 
 // Generated where
 
-type whereHelpernull_Int struct{ field string }
-
-func (w whereHelpernull_Int) EQ(x null.Int) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
-}
-func (w whereHelpernull_Int) NEQ(x null.Int) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
-}
-func (w whereHelpernull_Int) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_Int) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
-func (w whereHelpernull_Int) LT(x null.Int) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpernull_Int) LTE(x null.Int) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpernull_Int) GT(x null.Int) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpernull_Int) GTE(x null.Int) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
 type whereHelpernull_Bool struct{ field string }
 
 func (w whereHelpernull_Bool) EQ(x null.Bool) qm.QueryMod {
@@ -102,27 +91,44 @@ var DownloadWhere = struct {
 	UserID          whereHelpernull_Int
 	At              whereHelpertime_Time
 	NewSubscription whereHelpernull_Bool
+	BundleID        whereHelpernull_Int
+	Source          whereHelperstring
+	PlacementID     whereHelpernull_Int
+	FileVersion     whereHelpernull_Int
 }{
 	ID:              whereHelperint{field: "\"download\".\"id\""},
 	FileID:          whereHelpernull_Int{field: "\"download\".\"file_id\""},
 	UserID:          whereHelpernull_Int{field: "\"download\".\"user_id\""},
 	At:              whereHelpertime_Time{field: "\"download\".\"at\""},
 	NewSubscription: whereHelpernull_Bool{field: "\"download\".\"new_subscription\""},
+	BundleID:        whereHelpernull_Int{field: "\"download\".\"bundle_id\""},
+	Source:          whereHelperstring{field: "\"download\".\"source\""},
+	PlacementID:     whereHelpernull_Int{field: "\"download\".\"placement_id\""},
+	FileVersion:     whereHelpernull_Int{field: "\"download\".\"file_version\""},
 }
 
 // DownloadRels is where relationship names are stored.
 var DownloadRels = struct {
-	File string
-	User string
+	Bundle    string
+	File      string
+	Placement string
+	User      string
+	Chats     string
 }{
-	File: "File",
-	User: "User",
+	Bundle:    "Bundle",
+	File:      "File",
+	Placement: "Placement",
+	User:      "User",
+	Chats:     "Chats",
 }
 
 // downloadR is where relationships are stored.
 type downloadR struct {
-	File *File `boil:"File" json:"File" toml:"File" yaml:"File"`
-	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+	Bundle    *Bundle    `boil:"Bundle" json:"Bundle" toml:"Bundle" yaml:"Bundle"`
+	File      *File      `boil:"File" json:"File" toml:"File" yaml:"File"`
+	Placement *Placement `boil:"Placement" json:"Placement" toml:"Placement" yaml:"Placement"`
+	User      *User      `boil:"User" json:"User" toml:"User" yaml:"User"`
+	Chats     ChatSlice  `boil:"Chats" json:"Chats" toml:"Chats" yaml:"Chats"`
 }
 
 // NewStruct creates a new relationship struct
@@ -134,9 +140,9 @@ func (*downloadR) NewStruct() *downloadR {
 type downloadL struct{}
 
 var (
-	downloadAllColumns            = []string{"id", "file_id", "user_id", "at", "new_subscription"}
-	downloadColumnsWithoutDefault = []string{"file_id", "user_id", "at", "new_subscription"}
-	downloadColumnsWithDefault    = []string{"id"}
+	downloadAllColumns            = []string{"id", "file_id", "user_id", "at", "new_subscription", "bundle_id", "source", "placement_id", "file_version"}
+	downloadColumnsWithoutDefault = []string{"file_id", "user_id", "at", "new_subscription", "bundle_id", "placement_id", "file_version"}
+	downloadColumnsWithDefault    = []string{"id", "source"}
 	downloadPrimaryKeyColumns     = []string{"id"}
 )
 
@@ -231,6 +237,20 @@ func (q downloadQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (b
 	return count > 0, nil
 }
 
+// Bundle pointed to by the foreign key.
+func (o *Download) Bundle(mods ...qm.QueryMod) bundleQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.BundleID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Bundles(queryMods...)
+	queries.SetFrom(query.Query, "\"bundle\"")
+
+	return query
+}
+
 // File pointed to by the foreign key.
 func (o *Download) File(mods ...qm.QueryMod) fileQuery {
 	queryMods := []qm.QueryMod{
@@ -245,6 +265,20 @@ func (o *Download) File(mods ...qm.QueryMod) fileQuery {
 	return query
 }
 
+// Placement pointed to by the foreign key.
+func (o *Download) Placement(mods ...qm.QueryMod) placementQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.PlacementID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Placements(queryMods...)
+	queries.SetFrom(query.Query, "\"placement\"")
+
+	return query
+}
+
 // User pointed to by the foreign key.
 func (o *Download) User(mods ...qm.QueryMod) userQuery {
 	queryMods := []qm.QueryMod{
@@ -259,6 +293,128 @@ func (o *Download) User(mods ...qm.QueryMod) userQuery {
 	return query
 }
 
+// Chats retrieves all the chat's Chats with an executor.
+func (o *Download) Chats(mods ...qm.QueryMod) chatQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.InnerJoin("\"download_chat\" on \"chat\".\"id\" = \"download_chat\".\"chat_id\""),
+		qm.Where("\"download_chat\".\"download_id\"=?", o.ID),
+	)
+
+	query := Chats(queryMods...)
+	queries.SetFrom(query.Query, "\"chat\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"chat\".*"})
+	}
+
+	return query
+}
+
+// LoadBundle allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (downloadL) LoadBundle(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDownload interface{}, mods queries.Applicator) error {
+	var slice []*Download
+	var object *Download
+
+	if singular {
+		object = maybeDownload.(*Download)
+	} else {
+		slice = *maybeDownload.(*[]*Download)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &downloadR{}
+		}
+		if !queries.IsNil(object.BundleID) {
+			args = append(args, object.BundleID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &downloadR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.BundleID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.BundleID) {
+				args = append(args, obj.BundleID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`bundle`),
+		qm.WhereIn(`bundle.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Bundle")
+	}
+
+	var resultSlice []*Bundle
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Bundle")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for bundle")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for bundle")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Bundle = foreign
+		if foreign.R == nil {
+			foreign.R = &bundleR{}
+		}
+		foreign.R.Downloads = append(foreign.R.Downloads, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.BundleID, foreign.ID) {
+				local.R.Bundle = foreign
+				if foreign.R == nil {
+					foreign.R = &bundleR{}
+				}
+				foreign.R.Downloads = append(foreign.R.Downloads, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadFile allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (downloadL) LoadFile(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDownload interface{}, mods queries.Applicator) error {
@@ -359,6 +515,106 @@ func (downloadL) LoadFile(ctx context.Context, e boil.ContextExecutor, singular
 	return nil
 }
 
+// LoadPlacement allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (downloadL) LoadPlacement(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDownload interface{}, mods queries.Applicator) error {
+	var slice []*Download
+	var object *Download
+
+	if singular {
+		object = maybeDownload.(*Download)
+	} else {
+		slice = *maybeDownload.(*[]*Download)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &downloadR{}
+		}
+		if !queries.IsNil(object.PlacementID) {
+			args = append(args, object.PlacementID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &downloadR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.PlacementID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.PlacementID) {
+				args = append(args, obj.PlacementID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`placement`),
+		qm.WhereIn(`placement.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Placement")
+	}
+
+	var resultSlice []*Placement
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Placement")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for placement")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for placement")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Placement = foreign
+		if foreign.R == nil {
+			foreign.R = &placementR{}
+		}
+		foreign.R.Downloads = append(foreign.R.Downloads, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.PlacementID, foreign.ID) {
+				local.R.Placement = foreign
+				if foreign.R == nil {
+					foreign.R = &placementR{}
+				}
+				foreign.R.Downloads = append(foreign.R.Downloads, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadUser allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (downloadL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDownload interface{}, mods queries.Applicator) error {
@@ -459,6 +715,194 @@ func (downloadL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular
 	return nil
 }
 
+// LoadChats allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (downloadL) LoadChats(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDownload interface{}, mods queries.Applicator) error {
+	var slice []*Download
+	var object *Download
+
+	if singular {
+		object = maybeDownload.(*Download)
+	} else {
+		slice = *maybeDownload.(*[]*Download)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &downloadR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &downloadR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.Select("\"chat\".id, \"chat\".telegram_id, \"chat\".title, \"chat\".type, \"chat\".owner_id, \"chat\".linked_at, \"chat\".updated_at, \"a\".\"download_id\""),
+		qm.From("\"chat\""),
+		qm.InnerJoin("\"download_chat\" as \"a\" on \"chat\".\"id\" = \"a\".\"chat_id\""),
+		qm.WhereIn("\"a\".\"download_id\" in ?", args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load chat")
+	}
+
+	var resultSlice []*Chat
+
+	var localJoinCols []int
+	for results.Next() {
+		one := new(Chat)
+		var localJoinCol int
+
+		err = results.Scan(&one.ID, &one.TelegramID, &one.Title, &one.Type, &one.OwnerID, &one.LinkedAt, &one.UpdatedAt, &localJoinCol)
+		if err != nil {
+			return errors.Wrap(err, "failed to scan eager loaded results for chat")
+		}
+		if err = results.Err(); err != nil {
+			return errors.Wrap(err, "failed to plebian-bind eager loaded slice chat")
+		}
+
+		resultSlice = append(resultSlice, one)
+		localJoinCols = append(localJoinCols, localJoinCol)
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on chat")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chat")
+	}
+
+	if singular {
+		object.R.Chats = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &chatR{}
+			}
+			foreign.R.Downloads = append(foreign.R.Downloads, object)
+		}
+		return nil
+	}
+
+	for i, foreign := range resultSlice {
+		localJoinCol := localJoinCols[i]
+		for _, local := range slice {
+			if local.ID == localJoinCol {
+				local.R.Chats = append(local.R.Chats, foreign)
+				if foreign.R == nil {
+					foreign.R = &chatR{}
+				}
+				foreign.R.Downloads = append(foreign.R.Downloads, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetBundle of the download to the related item.
+// Sets o.R.Bundle to related.
+// Adds o to related.R.Downloads.
+func (o *Download) SetBundle(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Bundle) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"download\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"bundle_id"}),
+		strmangle.WhereClause("\"", "\"", 2, downloadPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.BundleID, related.ID)
+	if o.R == nil {
+		o.R = &downloadR{
+			Bundle: related,
+		}
+	} else {
+		o.R.Bundle = related
+	}
+
+	if related.R == nil {
+		related.R = &bundleR{
+			Downloads: DownloadSlice{o},
+		}
+	} else {
+		related.R.Downloads = append(related.R.Downloads, o)
+	}
+
+	return nil
+}
+
+// RemoveBundle relationship.
+// Sets o.R.Bundle to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *Download) RemoveBundle(ctx context.Context, exec boil.ContextExecutor, related *Bundle) error {
+	var err error
+
+	queries.SetScanner(&o.BundleID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("bundle_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Bundle = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.Downloads {
+		if queries.Equal(o.BundleID, ri.BundleID) {
+			continue
+		}
+
+		ln := len(related.R.Downloads)
+		if ln > 1 && i < ln-1 {
+			related.R.Downloads[i] = related.R.Downloads[ln-1]
+		}
+		related.R.Downloads = related.R.Downloads[:ln-1]
+		break
+	}
+	return nil
+}
+
 // SetFile of the download to the related item.
 // Sets o.R.File to related.
 // Adds o to related.R.Downloads.
@@ -539,6 +983,86 @@ func (o *Download) RemoveFile(ctx context.Context, exec boil.ContextExecutor, re
 	return nil
 }
 
+// SetPlacement of the download to the related item.
+// Sets o.R.Placement to related.
+// Adds o to related.R.Downloads.
+func (o *Download) SetPlacement(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Placement) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"download\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"placement_id"}),
+		strmangle.WhereClause("\"", "\"", 2, downloadPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.PlacementID, related.ID)
+	if o.R == nil {
+		o.R = &downloadR{
+			Placement: related,
+		}
+	} else {
+		o.R.Placement = related
+	}
+
+	if related.R == nil {
+		related.R = &placementR{
+			Downloads: DownloadSlice{o},
+		}
+	} else {
+		related.R.Downloads = append(related.R.Downloads, o)
+	}
+
+	return nil
+}
+
+// RemovePlacement relationship.
+// Sets o.R.Placement to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *Download) RemovePlacement(ctx context.Context, exec boil.ContextExecutor, related *Placement) error {
+	var err error
+
+	queries.SetScanner(&o.PlacementID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("placement_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Placement = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.Downloads {
+		if queries.Equal(o.PlacementID, ri.PlacementID) {
+			continue
+		}
+
+		ln := len(related.R.Downloads)
+		if ln > 1 && i < ln-1 {
+			related.R.Downloads[i] = related.R.Downloads[ln-1]
+		}
+		related.R.Downloads = related.R.Downloads[:ln-1]
+		break
+	}
+	return nil
+}
+
 // SetUser of the download to the related item.
 // Sets o.R.User to related.
 // Adds o to related.R.Downloads.
@@ -619,6 +1143,146 @@ func (o *Download) RemoveUser(ctx context.Context, exec boil.ContextExecutor, re
 	return nil
 }
 
+// AddChats adds the given related objects to the existing relationships
+// of the download, optionally inserting them as new records.
+// Appends related to o.R.Chats.
+// Sets related.R.Downloads appropriately.
+func (o *Download) AddChats(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Chat) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		}
+	}
+
+	for _, rel := range related {
+		query := "insert into \"download_chat\" (\"download_id\", \"chat_id\") values ($1, $2)"
+		values := []interface{}{o.ID, rel.ID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, query)
+			fmt.Fprintln(writer, values)
+		}
+		_, err = exec.ExecContext(ctx, query, values...)
+		if err != nil {
+			return errors.Wrap(err, "failed to insert into join table")
+		}
+	}
+	if o.R == nil {
+		o.R = &downloadR{
+			Chats: related,
+		}
+	} else {
+		o.R.Chats = append(o.R.Chats, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &chatR{
+				Downloads: DownloadSlice{o},
+			}
+		} else {
+			rel.R.Downloads = append(rel.R.Downloads, o)
+		}
+	}
+	return nil
+}
+
+// SetChats removes all previously related items of the
+// download replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Downloads's Chats accordingly.
+// Replaces o.R.Chats with related.
+// Sets related.R.Downloads's Chats accordingly.
+func (o *Download) SetChats(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Chat) error {
+	query := "delete from \"download_chat\" where \"download_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	removeChatsFromDownloadsSlice(o, related)
+	if o.R != nil {
+		o.R.Chats = nil
+	}
+	return o.AddChats(ctx, exec, insert, related...)
+}
+
+// RemoveChats relationships from objects passed in.
+// Removes related items from R.Chats (uses pointer comparison, removal does not keep order)
+// Sets related.R.Downloads.
+func (o *Download) RemoveChats(ctx context.Context, exec boil.ContextExecutor, related ...*Chat) error {
+	var err error
+	query := fmt.Sprintf(
+		"delete from \"download_chat\" where \"download_id\" = $1 and \"chat_id\" in (%s)",
+		strmangle.Placeholders(dialect.UseIndexPlaceholders, len(related), 2, 1),
+	)
+	values := []interface{}{o.ID}
+	for _, rel := range related {
+		values = append(values, rel.ID)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err = exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+	removeChatsFromDownloadsSlice(o, related)
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Chats {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Chats)
+			if ln > 1 && i < ln-1 {
+				o.R.Chats[i] = o.R.Chats[ln-1]
+			}
+			o.R.Chats = o.R.Chats[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+func removeChatsFromDownloadsSlice(o *Download, related []*Chat) {
+	for _, rel := range related {
+		if rel.R == nil {
+			continue
+		}
+		for i, ri := range rel.R.Downloads {
+			if o.ID != ri.ID {
+				continue
+			}
+
+			ln := len(rel.R.Downloads)
+			if ln > 1 && i < ln-1 {
+				rel.R.Downloads[i] = rel.R.Downloads[ln-1]
+			}
+			rel.R.Downloads = rel.R.Downloads[:ln-1]
+			break
+		}
+	}
+}
+
 // Downloads retrieves all the records using an executor.
 func Downloads(mods ...qm.QueryMod) downloadQuery {
 	mods = append(mods, qm.From("\"download\""))
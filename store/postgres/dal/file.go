@@ -24,130 +24,171 @@ import (
 
 // File is an object representing the database table.
 type File struct {
-	ID                  int         `boil:"id" json:"id" toml:"id" yaml:"id"`
-	FileID              string      `boil:"file_id" json:"file_id" toml:"file_id" yaml:"file_id"`
-	Caption             null.String `boil:"caption" json:"caption,omitempty" toml:"caption" yaml:"caption,omitempty"`
-	MimeType            null.String `boil:"mime_type" json:"mime_type,omitempty" toml:"mime_type" yaml:"mime_type,omitempty"`
-	Size                int         `boil:"size" json:"size" toml:"size" yaml:"size"`
-	Name                string      `boil:"name" json:"name" toml:"name" yaml:"name"`
-	OwnerID             int         `boil:"owner_id" json:"owner_id" toml:"owner_id" yaml:"owner_id"`
-	CreatedAt           time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	PublicID            string      `boil:"public_id" json:"public_id" toml:"public_id" yaml:"public_id"`
-	Kind                string      `boil:"kind" json:"kind" toml:"kind" yaml:"kind"`
-	Metadata            string      `boil:"metadata" json:"metadata" toml:"metadata" yaml:"metadata"`
-	RestrictionsChatID  null.Int    `boil:"restrictions_chat_id" json:"restrictions_chat_id,omitempty" toml:"restrictions_chat_id" yaml:"restrictions_chat_id,omitempty"`
-	IsViolatesCopyright null.Bool   `boil:"is_violates_copyright" json:"is_violates_copyright,omitempty" toml:"is_violates_copyright" yaml:"is_violates_copyright,omitempty"`
-	LinkedPostURI       null.String `boil:"linked_post_uri" json:"linked_post_uri,omitempty" toml:"linked_post_uri" yaml:"linked_post_uri,omitempty"`
+	ID                              int         `boil:"id" json:"id" toml:"id" yaml:"id"`
+	FileID                          null.String `boil:"file_id" json:"file_id,omitempty" toml:"file_id" yaml:"file_id,omitempty"`
+	Caption                         null.String `boil:"caption" json:"caption,omitempty" toml:"caption" yaml:"caption,omitempty"`
+	MimeType                        null.String `boil:"mime_type" json:"mime_type,omitempty" toml:"mime_type" yaml:"mime_type,omitempty"`
+	Size                            int         `boil:"size" json:"size" toml:"size" yaml:"size"`
+	Name                            string      `boil:"name" json:"name" toml:"name" yaml:"name"`
+	OwnerID                         int         `boil:"owner_id" json:"owner_id" toml:"owner_id" yaml:"owner_id"`
+	CreatedAt                       time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	PublicID                        string      `boil:"public_id" json:"public_id" toml:"public_id" yaml:"public_id"`
+	Kind                            string      `boil:"kind" json:"kind" toml:"kind" yaml:"kind"`
+	Metadata                        string      `boil:"metadata" json:"metadata" toml:"metadata" yaml:"metadata"`
+	IsViolatesCopyright             null.Bool   `boil:"is_violates_copyright" json:"is_violates_copyright,omitempty" toml:"is_violates_copyright" yaml:"is_violates_copyright,omitempty"`
+	LinkedPostURI                   null.String `boil:"linked_post_uri" json:"linked_post_uri,omitempty" toml:"linked_post_uri" yaml:"linked_post_uri,omitempty"`
+	RestrictionsMaxDownloads        null.Int    `boil:"restrictions_max_downloads" json:"restrictions_max_downloads,omitempty" toml:"restrictions_max_downloads" yaml:"restrictions_max_downloads,omitempty"`
+	RestrictionsMaxDownloadsPerUser null.Int    `boil:"restrictions_max_downloads_per_user" json:"restrictions_max_downloads_per_user,omitempty" toml:"restrictions_max_downloads_per_user" yaml:"restrictions_max_downloads_per_user,omitempty"`
+	RestrictionsExpiresAt           null.Time   `boil:"restrictions_expires_at" json:"restrictions_expires_at,omitempty" toml:"restrictions_expires_at" yaml:"restrictions_expires_at,omitempty"`
+	RestrictionsAvailableFrom       null.Time   `boil:"restrictions_available_from" json:"restrictions_available_from,omitempty" toml:"restrictions_available_from" yaml:"restrictions_available_from,omitempty"`
+	RestrictionsPasswordHash        null.String `boil:"restrictions_password_hash" json:"restrictions_password_hash,omitempty" toml:"restrictions_password_hash" yaml:"restrictions_password_hash,omitempty"`
+	RestrictionsChatPolicy          string      `boil:"restrictions_chat_policy" json:"restrictions_chat_policy" toml:"restrictions_chat_policy" yaml:"restrictions_chat_policy"`
+	IsBlocked                       bool        `boil:"is_blocked" json:"is_blocked" toml:"is_blocked" yaml:"is_blocked"`
+	CaptionEntities                 string      `boil:"caption_entities" json:"caption_entities" toml:"caption_entities" yaml:"caption_entities"`
+	Version                         int         `boil:"version" json:"version" toml:"version" yaml:"version"`
 
 	R *fileR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L fileL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var FileColumns = struct {
-	ID                  string
-	FileID              string
-	Caption             string
-	MimeType            string
-	Size                string
-	Name                string
-	OwnerID             string
-	CreatedAt           string
-	PublicID            string
-	Kind                string
-	Metadata            string
-	RestrictionsChatID  string
-	IsViolatesCopyright string
-	LinkedPostURI       string
+	ID                              string
+	FileID                          string
+	Caption                         string
+	MimeType                        string
+	Size                            string
+	Name                            string
+	OwnerID                         string
+	CreatedAt                       string
+	PublicID                        string
+	Kind                            string
+	Metadata                        string
+	IsViolatesCopyright             string
+	LinkedPostURI                   string
+	RestrictionsMaxDownloads        string
+	RestrictionsMaxDownloadsPerUser string
+	RestrictionsExpiresAt           string
+	RestrictionsAvailableFrom       string
+	RestrictionsPasswordHash        string
+	RestrictionsChatPolicy          string
+	IsBlocked                       string
+	CaptionEntities                 string
+	Version                         string
 }{
-	ID:                  "id",
-	FileID:              "file_id",
-	Caption:             "caption",
-	MimeType:            "mime_type",
-	Size:                "size",
-	Name:                "name",
-	OwnerID:             "owner_id",
-	CreatedAt:           "created_at",
-	PublicID:            "public_id",
-	Kind:                "kind",
-	Metadata:            "metadata",
-	RestrictionsChatID:  "restrictions_chat_id",
-	IsViolatesCopyright: "is_violates_copyright",
-	LinkedPostURI:       "linked_post_uri",
+	ID:                              "id",
+	FileID:                          "file_id",
+	Caption:                         "caption",
+	MimeType:                        "mime_type",
+	Size:                            "size",
+	Name:                            "name",
+	OwnerID:                         "owner_id",
+	CreatedAt:                       "created_at",
+	PublicID:                        "public_id",
+	Kind:                            "kind",
+	Metadata:                        "metadata",
+	IsViolatesCopyright:             "is_violates_copyright",
+	LinkedPostURI:                   "linked_post_uri",
+	RestrictionsMaxDownloads:        "restrictions_max_downloads",
+	RestrictionsMaxDownloadsPerUser: "restrictions_max_downloads_per_user",
+	RestrictionsExpiresAt:           "restrictions_expires_at",
+	RestrictionsAvailableFrom:       "restrictions_available_from",
+	RestrictionsPasswordHash:        "restrictions_password_hash",
+	RestrictionsChatPolicy:          "restrictions_chat_policy",
+	IsBlocked:                       "is_blocked",
+	CaptionEntities:                 "caption_entities",
+	Version:                         "version",
 }
 
 // Generated where
 
-type whereHelpernull_String struct{ field string }
+type whereHelperbool struct{ field string }
 
-func (w whereHelpernull_String) EQ(x null.String) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
-}
-func (w whereHelpernull_String) NEQ(x null.String) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
-}
-func (w whereHelpernull_String) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
-func (w whereHelpernull_String) LT(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpernull_String) LTE(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpernull_String) GT(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpernull_String) GTE(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
+func (w whereHelperbool) EQ(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperbool) NEQ(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperbool) LT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperbool) LTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
 
 var FileWhere = struct {
-	ID                  whereHelperint
-	FileID              whereHelperstring
-	Caption             whereHelpernull_String
-	MimeType            whereHelpernull_String
-	Size                whereHelperint
-	Name                whereHelperstring
-	OwnerID             whereHelperint
-	CreatedAt           whereHelpertime_Time
-	PublicID            whereHelperstring
-	Kind                whereHelperstring
-	Metadata            whereHelperstring
-	RestrictionsChatID  whereHelpernull_Int
-	IsViolatesCopyright whereHelpernull_Bool
-	LinkedPostURI       whereHelpernull_String
+	ID                              whereHelperint
+	FileID                          whereHelpernull_String
+	Caption                         whereHelpernull_String
+	MimeType                        whereHelpernull_String
+	Size                            whereHelperint
+	Name                            whereHelperstring
+	OwnerID                         whereHelperint
+	CreatedAt                       whereHelpertime_Time
+	PublicID                        whereHelperstring
+	Kind                            whereHelperstring
+	Metadata                        whereHelperstring
+	IsViolatesCopyright             whereHelpernull_Bool
+	LinkedPostURI                   whereHelpernull_String
+	RestrictionsMaxDownloads        whereHelpernull_Int
+	RestrictionsMaxDownloadsPerUser whereHelpernull_Int
+	RestrictionsExpiresAt           whereHelpernull_Time
+	RestrictionsAvailableFrom       whereHelpernull_Time
+	RestrictionsPasswordHash        whereHelpernull_String
+	RestrictionsChatPolicy          whereHelperstring
+	IsBlocked                       whereHelperbool
+	CaptionEntities                 whereHelperstring
+	Version                         whereHelperint
 }{
-	ID:                  whereHelperint{field: "\"file\".\"id\""},
-	FileID:              whereHelperstring{field: "\"file\".\"file_id\""},
-	Caption:             whereHelpernull_String{field: "\"file\".\"caption\""},
-	MimeType:            whereHelpernull_String{field: "\"file\".\"mime_type\""},
-	Size:                whereHelperint{field: "\"file\".\"size\""},
-	Name:                whereHelperstring{field: "\"file\".\"name\""},
-	OwnerID:             whereHelperint{field: "\"file\".\"owner_id\""},
-	CreatedAt:           whereHelpertime_Time{field: "\"file\".\"created_at\""},
-	PublicID:            whereHelperstring{field: "\"file\".\"public_id\""},
-	Kind:                whereHelperstring{field: "\"file\".\"kind\""},
-	Metadata:            whereHelperstring{field: "\"file\".\"metadata\""},
-	RestrictionsChatID:  whereHelpernull_Int{field: "\"file\".\"restrictions_chat_id\""},
-	IsViolatesCopyright: whereHelpernull_Bool{field: "\"file\".\"is_violates_copyright\""},
-	LinkedPostURI:       whereHelpernull_String{field: "\"file\".\"linked_post_uri\""},
+	ID:                              whereHelperint{field: "\"file\".\"id\""},
+	FileID:                          whereHelpernull_String{field: "\"file\".\"file_id\""},
+	Caption:                         whereHelpernull_String{field: "\"file\".\"caption\""},
+	MimeType:                        whereHelpernull_String{field: "\"file\".\"mime_type\""},
+	Size:                            whereHelperint{field: "\"file\".\"size\""},
+	Name:                            whereHelperstring{field: "\"file\".\"name\""},
+	OwnerID:                         whereHelperint{field: "\"file\".\"owner_id\""},
+	CreatedAt:                       whereHelpertime_Time{field: "\"file\".\"created_at\""},
+	PublicID:                        whereHelperstring{field: "\"file\".\"public_id\""},
+	Kind:                            whereHelperstring{field: "\"file\".\"kind\""},
+	Metadata:                        whereHelperstring{field: "\"file\".\"metadata\""},
+	IsViolatesCopyright:             whereHelpernull_Bool{field: "\"file\".\"is_violates_copyright\""},
+	LinkedPostURI:                   whereHelpernull_String{field: "\"file\".\"linked_post_uri\""},
+	RestrictionsMaxDownloads:        whereHelpernull_Int{field: "\"file\".\"restrictions_max_downloads\""},
+	RestrictionsMaxDownloadsPerUser: whereHelpernull_Int{field: "\"file\".\"restrictions_max_downloads_per_user\""},
+	RestrictionsExpiresAt:           whereHelpernull_Time{field: "\"file\".\"restrictions_expires_at\""},
+	RestrictionsAvailableFrom:       whereHelpernull_Time{field: "\"file\".\"restrictions_available_from\""},
+	RestrictionsPasswordHash:        whereHelpernull_String{field: "\"file\".\"restrictions_password_hash\""},
+	RestrictionsChatPolicy:          whereHelperstring{field: "\"file\".\"restrictions_chat_policy\""},
+	IsBlocked:                       whereHelperbool{field: "\"file\".\"is_blocked\""},
+	CaptionEntities:                 whereHelperstring{field: "\"file\".\"caption_entities\""},
+	Version:                         whereHelperint{field: "\"file\".\"version\""},
 }
 
 // FileRels is where relationship names are stored.
 var FileRels = struct {
-	Owner            string
-	RestrictionsChat string
-	Downloads        string
+	Owner                string
+	BundleFiles          string
+	Downloads            string
+	FileRestrictionChats string
+	FileRevokedLinks     string
+	FileVersions         string
+	Placements           string
+	Reports              string
 }{
-	Owner:            "Owner",
-	RestrictionsChat: "RestrictionsChat",
-	Downloads:        "Downloads",
+	Owner:                "Owner",
+	BundleFiles:          "BundleFiles",
+	Downloads:            "Downloads",
+	FileRestrictionChats: "FileRestrictionChats",
+	FileRevokedLinks:     "FileRevokedLinks",
+	FileVersions:         "FileVersions",
+	Placements:           "Placements",
+	Reports:              "Reports",
 }
 
 // fileR is where relationships are stored.
 type fileR struct {
-	Owner            *User         `boil:"Owner" json:"Owner" toml:"Owner" yaml:"Owner"`
-	RestrictionsChat *Chat         `boil:"RestrictionsChat" json:"RestrictionsChat" toml:"RestrictionsChat" yaml:"RestrictionsChat"`
-	Downloads        DownloadSlice `boil:"Downloads" json:"Downloads" toml:"Downloads" yaml:"Downloads"`
+	Owner                *User                    `boil:"Owner" json:"Owner" toml:"Owner" yaml:"Owner"`
+	BundleFiles          BundleFileSlice          `boil:"BundleFiles" json:"BundleFiles" toml:"BundleFiles" yaml:"BundleFiles"`
+	Downloads            DownloadSlice            `boil:"Downloads" json:"Downloads" toml:"Downloads" yaml:"Downloads"`
+	FileRestrictionChats FileRestrictionChatSlice `boil:"FileRestrictionChats" json:"FileRestrictionChats" toml:"FileRestrictionChats" yaml:"FileRestrictionChats"`
+	FileRevokedLinks     FileRevokedLinkSlice     `boil:"FileRevokedLinks" json:"FileRevokedLinks" toml:"FileRevokedLinks" yaml:"FileRevokedLinks"`
+	FileVersions         FileVersionSlice         `boil:"FileVersions" json:"FileVersions" toml:"FileVersions" yaml:"FileVersions"`
+	Placements           PlacementSlice           `boil:"Placements" json:"Placements" toml:"Placements" yaml:"Placements"`
+	Reports              ReportSlice              `boil:"Reports" json:"Reports" toml:"Reports" yaml:"Reports"`
 }
 
 // NewStruct creates a new relationship struct
@@ -159,9 +200,9 @@ func (*fileR) NewStruct() *fileR {
 type fileL struct{}
 
 var (
-	fileAllColumns            = []string{"id", "file_id", "caption", "mime_type", "size", "name", "owner_id", "created_at", "public_id", "kind", "metadata", "restrictions_chat_id", "is_violates_copyright", "linked_post_uri"}
-	fileColumnsWithoutDefault = []string{"file_id", "caption", "mime_type", "size", "name", "owner_id", "created_at", "public_id", "kind", "restrictions_chat_id", "is_violates_copyright", "linked_post_uri"}
-	fileColumnsWithDefault    = []string{"id", "metadata"}
+	fileAllColumns            = []string{"id", "file_id", "caption", "mime_type", "size", "name", "owner_id", "created_at", "public_id", "kind", "metadata", "is_violates_copyright", "linked_post_uri", "restrictions_max_downloads", "restrictions_max_downloads_per_user", "restrictions_expires_at", "restrictions_available_from", "restrictions_password_hash", "restrictions_chat_policy", "is_blocked", "caption_entities", "version"}
+	fileColumnsWithoutDefault = []string{"file_id", "caption", "mime_type", "size", "name", "owner_id", "created_at", "public_id", "kind", "is_violates_copyright", "linked_post_uri", "restrictions_max_downloads", "restrictions_max_downloads_per_user", "restrictions_expires_at", "restrictions_available_from", "restrictions_password_hash"}
+	fileColumnsWithDefault    = []string{"id", "metadata", "restrictions_chat_policy", "is_blocked", "caption_entities", "version"}
 	filePrimaryKeyColumns     = []string{"id"}
 )
 
@@ -270,16 +311,23 @@ func (o *File) Owner(mods ...qm.QueryMod) userQuery {
 	return query
 }
 
-// RestrictionsChat pointed to by the foreign key.
-func (o *File) RestrictionsChat(mods ...qm.QueryMod) chatQuery {
-	queryMods := []qm.QueryMod{
-		qm.Where("\"id\" = ?", o.RestrictionsChatID),
+// BundleFiles retrieves all the bundle_file's BundleFiles with an executor.
+func (o *File) BundleFiles(mods ...qm.QueryMod) bundleFileQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
 	}
 
-	queryMods = append(queryMods, mods...)
+	queryMods = append(queryMods,
+		qm.Where("\"bundle_file\".\"file_id\"=?", o.ID),
+	)
+
+	query := BundleFiles(queryMods...)
+	queries.SetFrom(query.Query, "\"bundle_file\"")
 
-	query := Chats(queryMods...)
-	queries.SetFrom(query.Query, "\"chat\"")
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"bundle_file\".*"})
+	}
 
 	return query
 }
@@ -305,6 +353,111 @@ func (o *File) Downloads(mods ...qm.QueryMod) downloadQuery {
 	return query
 }
 
+// FileRestrictionChats retrieves all the file_restriction_chat's FileRestrictionChats with an executor.
+func (o *File) FileRestrictionChats(mods ...qm.QueryMod) fileRestrictionChatQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"file_restriction_chat\".\"file_id\"=?", o.ID),
+	)
+
+	query := FileRestrictionChats(queryMods...)
+	queries.SetFrom(query.Query, "\"file_restriction_chat\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"file_restriction_chat\".*"})
+	}
+
+	return query
+}
+
+// FileRevokedLinks retrieves all the file_revoked_link's FileRevokedLinks with an executor.
+func (o *File) FileRevokedLinks(mods ...qm.QueryMod) fileRevokedLinkQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"file_revoked_link\".\"file_id\"=?", o.ID),
+	)
+
+	query := FileRevokedLinks(queryMods...)
+	queries.SetFrom(query.Query, "\"file_revoked_link\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"file_revoked_link\".*"})
+	}
+
+	return query
+}
+
+// FileVersions retrieves all the file_version's FileVersions with an executor.
+func (o *File) FileVersions(mods ...qm.QueryMod) fileVersionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"file_version\".\"file_id\"=?", o.ID),
+	)
+
+	query := FileVersions(queryMods...)
+	queries.SetFrom(query.Query, "\"file_version\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"file_version\".*"})
+	}
+
+	return query
+}
+
+// Placements retrieves all the placement's Placements with an executor.
+func (o *File) Placements(mods ...qm.QueryMod) placementQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"placement\".\"file_id\"=?", o.ID),
+	)
+
+	query := Placements(queryMods...)
+	queries.SetFrom(query.Query, "\"placement\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"placement\".*"})
+	}
+
+	return query
+}
+
+// Reports retrieves all the report's Reports with an executor.
+func (o *File) Reports(mods ...qm.QueryMod) reportQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"report\".\"file_id\"=?", o.ID),
+	)
+
+	query := Reports(queryMods...)
+	queries.SetFrom(query.Query, "\"report\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"report\".*"})
+	}
+
+	return query
+}
+
 // LoadOwner allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (fileL) LoadOwner(ctx context.Context, e boil.ContextExecutor, singular bool, maybeFile interface{}, mods queries.Applicator) error {
@@ -401,9 +554,9 @@ func (fileL) LoadOwner(ctx context.Context, e boil.ContextExecutor, singular boo
 	return nil
 }
 
-// LoadRestrictionsChat allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for an N-1 relationship.
-func (fileL) LoadRestrictionsChat(ctx context.Context, e boil.ContextExecutor, singular bool, maybeFile interface{}, mods queries.Applicator) error {
+// LoadBundleFiles allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (fileL) LoadBundleFiles(ctx context.Context, e boil.ContextExecutor, singular bool, maybeFile interface{}, mods queries.Applicator) error {
 	var slice []*File
 	var object *File
 
@@ -418,10 +571,7 @@ func (fileL) LoadRestrictionsChat(ctx context.Context, e boil.ContextExecutor, s
 		if object.R == nil {
 			object.R = &fileR{}
 		}
-		if !queries.IsNil(object.RestrictionsChatID) {
-			args = append(args, object.RestrictionsChatID)
-		}
-
+		args = append(args, object.ID)
 	} else {
 	Outer:
 		for _, obj := range slice {
@@ -430,15 +580,12 @@ func (fileL) LoadRestrictionsChat(ctx context.Context, e boil.ContextExecutor, s
 			}
 
 			for _, a := range args {
-				if queries.Equal(a, obj.RestrictionsChatID) {
+				if a == obj.ID {
 					continue Outer
 				}
 			}
 
-			if !queries.IsNil(obj.RestrictionsChatID) {
-				args = append(args, obj.RestrictionsChatID)
-			}
-
+			args = append(args, obj.ID)
 		}
 	}
 
@@ -447,8 +594,8 @@ func (fileL) LoadRestrictionsChat(ctx context.Context, e boil.ContextExecutor, s
 	}
 
 	query := NewQuery(
-		qm.From(`chat`),
-		qm.WhereIn(`chat.id in ?`, args...),
+		qm.From(`bundle_file`),
+		qm.WhereIn(`bundle_file.file_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -456,43 +603,40 @@ func (fileL) LoadRestrictionsChat(ctx context.Context, e boil.ContextExecutor, s
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load Chat")
+		return errors.Wrap(err, "failed to eager load bundle_file")
 	}
 
-	var resultSlice []*Chat
+	var resultSlice []*BundleFile
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice Chat")
+		return errors.Wrap(err, "failed to bind eager loaded slice bundle_file")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results of eager load for chat")
+		return errors.Wrap(err, "failed to close results in eager load on bundle_file")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chat")
-	}
-
-	if len(resultSlice) == 0 {
-		return nil
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for bundle_file")
 	}
 
 	if singular {
-		foreign := resultSlice[0]
-		object.R.RestrictionsChat = foreign
-		if foreign.R == nil {
-			foreign.R = &chatR{}
+		object.R.BundleFiles = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &bundleFileR{}
+			}
+			foreign.R.File = object
 		}
-		foreign.R.RestrictionsChatFiles = append(foreign.R.RestrictionsChatFiles, object)
 		return nil
 	}
 
-	for _, local := range slice {
-		for _, foreign := range resultSlice {
-			if queries.Equal(local.RestrictionsChatID, foreign.ID) {
-				local.R.RestrictionsChat = foreign
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.FileID {
+				local.R.BundleFiles = append(local.R.BundleFiles, foreign)
 				if foreign.R == nil {
-					foreign.R = &chatR{}
+					foreign.R = &bundleFileR{}
 				}
-				foreign.R.RestrictionsChatFiles = append(foreign.R.RestrictionsChatFiles, local)
+				foreign.R.File = local
 				break
 			}
 		}
@@ -592,132 +736,560 @@ func (fileL) LoadDownloads(ctx context.Context, e boil.ContextExecutor, singular
 	return nil
 }
 
-// SetOwner of the file to the related item.
-// Sets o.R.Owner to related.
-// Adds o to related.R.OwnerFiles.
-func (o *File) SetOwner(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
-	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
-		}
-	}
-
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"file\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 1, []string{"owner_id"}),
-		strmangle.WhereClause("\"", "\"", 2, filePrimaryKeyColumns),
-	)
-	values := []interface{}{related.ID, o.ID}
-
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, updateQuery)
-		fmt.Fprintln(writer, values)
-	}
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
-	}
+// LoadFileRestrictionChats allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (fileL) LoadFileRestrictionChats(ctx context.Context, e boil.ContextExecutor, singular bool, maybeFile interface{}, mods queries.Applicator) error {
+	var slice []*File
+	var object *File
 
-	o.OwnerID = related.ID
-	if o.R == nil {
-		o.R = &fileR{
-			Owner: related,
-		}
+	if singular {
+		object = maybeFile.(*File)
 	} else {
-		o.R.Owner = related
+		slice = *maybeFile.(*[]*File)
 	}
 
-	if related.R == nil {
-		related.R = &userR{
-			OwnerFiles: FileSlice{o},
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &fileR{}
 		}
+		args = append(args, object.ID)
 	} else {
-		related.R.OwnerFiles = append(related.R.OwnerFiles, o)
-	}
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &fileR{}
+			}
 
-	return nil
-}
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
 
-// SetRestrictionsChat of the file to the related item.
-// Sets o.R.RestrictionsChat to related.
-// Adds o to related.R.RestrictionsChatFiles.
-func (o *File) SetRestrictionsChat(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Chat) error {
-	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
+			args = append(args, obj.ID)
 		}
 	}
 
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"file\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 1, []string{"restrictions_chat_id"}),
-		strmangle.WhereClause("\"", "\"", 2, filePrimaryKeyColumns),
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`file_restriction_chat`),
+		qm.WhereIn(`file_restriction_chat.file_id in ?`, args...),
 	)
-	values := []interface{}{related.ID, o.ID}
+	if mods != nil {
+		mods.Apply(query)
+	}
 
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, updateQuery)
-		fmt.Fprintln(writer, values)
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load file_restriction_chat")
 	}
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+
+	var resultSlice []*FileRestrictionChat
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice file_restriction_chat")
 	}
 
-	queries.Assign(&o.RestrictionsChatID, related.ID)
-	if o.R == nil {
-		o.R = &fileR{
-			RestrictionsChat: related,
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on file_restriction_chat")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for file_restriction_chat")
+	}
+
+	if singular {
+		object.R.FileRestrictionChats = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &fileRestrictionChatR{}
+			}
+			foreign.R.File = object
 		}
-	} else {
-		o.R.RestrictionsChat = related
+		return nil
 	}
 
-	if related.R == nil {
-		related.R = &chatR{
-			RestrictionsChatFiles: FileSlice{o},
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.FileID {
+				local.R.FileRestrictionChats = append(local.R.FileRestrictionChats, foreign)
+				if foreign.R == nil {
+					foreign.R = &fileRestrictionChatR{}
+				}
+				foreign.R.File = local
+				break
+			}
 		}
-	} else {
-		related.R.RestrictionsChatFiles = append(related.R.RestrictionsChatFiles, o)
 	}
 
 	return nil
 }
 
-// RemoveRestrictionsChat relationship.
-// Sets o.R.RestrictionsChat to nil.
-// Removes o from all passed in related items' relationships struct (Optional).
-func (o *File) RemoveRestrictionsChat(ctx context.Context, exec boil.ContextExecutor, related *Chat) error {
-	var err error
-
-	queries.SetScanner(&o.RestrictionsChatID, nil)
-	if _, err = o.Update(ctx, exec, boil.Whitelist("restrictions_chat_id")); err != nil {
-		return errors.Wrap(err, "failed to update local table")
-	}
+// LoadFileRevokedLinks allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (fileL) LoadFileRevokedLinks(ctx context.Context, e boil.ContextExecutor, singular bool, maybeFile interface{}, mods queries.Applicator) error {
+	var slice []*File
+	var object *File
 
-	if o.R != nil {
-		o.R.RestrictionsChat = nil
-	}
-	if related == nil || related.R == nil {
-		return nil
+	if singular {
+		object = maybeFile.(*File)
+	} else {
+		slice = *maybeFile.(*[]*File)
 	}
 
-	for i, ri := range related.R.RestrictionsChatFiles {
-		if queries.Equal(o.RestrictionsChatID, ri.RestrictionsChatID) {
-			continue
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &fileR{}
 		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &fileR{}
+			}
 
-		ln := len(related.R.RestrictionsChatFiles)
-		if ln > 1 && i < ln-1 {
-			related.R.RestrictionsChatFiles[i] = related.R.RestrictionsChatFiles[ln-1]
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
 		}
-		related.R.RestrictionsChatFiles = related.R.RestrictionsChatFiles[:ln-1]
-		break
 	}
-	return nil
-}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`file_revoked_link`),
+		qm.WhereIn(`file_revoked_link.file_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load file_revoked_link")
+	}
+
+	var resultSlice []*FileRevokedLink
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice file_revoked_link")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on file_revoked_link")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for file_revoked_link")
+	}
+
+	if singular {
+		object.R.FileRevokedLinks = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &fileRevokedLinkR{}
+			}
+			foreign.R.File = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.FileID {
+				local.R.FileRevokedLinks = append(local.R.FileRevokedLinks, foreign)
+				if foreign.R == nil {
+					foreign.R = &fileRevokedLinkR{}
+				}
+				foreign.R.File = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadFileVersions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (fileL) LoadFileVersions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeFile interface{}, mods queries.Applicator) error {
+	var slice []*File
+	var object *File
+
+	if singular {
+		object = maybeFile.(*File)
+	} else {
+		slice = *maybeFile.(*[]*File)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &fileR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &fileR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`file_version`),
+		qm.WhereIn(`file_version.file_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load file_version")
+	}
+
+	var resultSlice []*FileVersion
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice file_version")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on file_version")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for file_version")
+	}
+
+	if singular {
+		object.R.FileVersions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &fileVersionR{}
+			}
+			foreign.R.File = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.FileID {
+				local.R.FileVersions = append(local.R.FileVersions, foreign)
+				if foreign.R == nil {
+					foreign.R = &fileVersionR{}
+				}
+				foreign.R.File = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPlacements allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (fileL) LoadPlacements(ctx context.Context, e boil.ContextExecutor, singular bool, maybeFile interface{}, mods queries.Applicator) error {
+	var slice []*File
+	var object *File
+
+	if singular {
+		object = maybeFile.(*File)
+	} else {
+		slice = *maybeFile.(*[]*File)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &fileR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &fileR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`placement`),
+		qm.WhereIn(`placement.file_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load placement")
+	}
+
+	var resultSlice []*Placement
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice placement")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on placement")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for placement")
+	}
+
+	if singular {
+		object.R.Placements = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &placementR{}
+			}
+			foreign.R.File = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.FileID {
+				local.R.Placements = append(local.R.Placements, foreign)
+				if foreign.R == nil {
+					foreign.R = &placementR{}
+				}
+				foreign.R.File = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadReports allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (fileL) LoadReports(ctx context.Context, e boil.ContextExecutor, singular bool, maybeFile interface{}, mods queries.Applicator) error {
+	var slice []*File
+	var object *File
+
+	if singular {
+		object = maybeFile.(*File)
+	} else {
+		slice = *maybeFile.(*[]*File)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &fileR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &fileR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`report`),
+		qm.WhereIn(`report.file_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load report")
+	}
+
+	var resultSlice []*Report
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice report")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on report")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for report")
+	}
+
+	if singular {
+		object.R.Reports = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &reportR{}
+			}
+			foreign.R.File = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.FileID {
+				local.R.Reports = append(local.R.Reports, foreign)
+				if foreign.R == nil {
+					foreign.R = &reportR{}
+				}
+				foreign.R.File = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetOwner of the file to the related item.
+// Sets o.R.Owner to related.
+// Adds o to related.R.OwnerFiles.
+func (o *File) SetOwner(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"file\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"owner_id"}),
+		strmangle.WhereClause("\"", "\"", 2, filePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.OwnerID = related.ID
+	if o.R == nil {
+		o.R = &fileR{
+			Owner: related,
+		}
+	} else {
+		o.R.Owner = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			OwnerFiles: FileSlice{o},
+		}
+	} else {
+		related.R.OwnerFiles = append(related.R.OwnerFiles, o)
+	}
+
+	return nil
+}
+
+// AddBundleFiles adds the given related objects to the existing relationships
+// of the file, optionally inserting them as new records.
+// Appends related to o.R.BundleFiles.
+// Sets related.R.File appropriately.
+func (o *File) AddBundleFiles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*BundleFile) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.FileID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"bundle_file\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"file_id"}),
+				strmangle.WhereClause("\"", "\"", 2, bundleFilePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.BundleID, rel.FileID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.FileID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &fileR{
+			BundleFiles: related,
+		}
+	} else {
+		o.R.BundleFiles = append(o.R.BundleFiles, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &bundleFileR{
+				File: o,
+			}
+		} else {
+			rel.R.File = o
+		}
+	}
+	return nil
+}
 
 // AddDownloads adds the given related objects to the existing relationships
 // of the file, optionally inserting them as new records.
@@ -842,6 +1414,271 @@ func (o *File) RemoveDownloads(ctx context.Context, exec boil.ContextExecutor, r
 	return nil
 }
 
+// AddFileRestrictionChats adds the given related objects to the existing relationships
+// of the file, optionally inserting them as new records.
+// Appends related to o.R.FileRestrictionChats.
+// Sets related.R.File appropriately.
+func (o *File) AddFileRestrictionChats(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*FileRestrictionChat) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.FileID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"file_restriction_chat\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"file_id"}),
+				strmangle.WhereClause("\"", "\"", 2, fileRestrictionChatPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.FileID, rel.ChatID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.FileID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &fileR{
+			FileRestrictionChats: related,
+		}
+	} else {
+		o.R.FileRestrictionChats = append(o.R.FileRestrictionChats, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &fileRestrictionChatR{
+				File: o,
+			}
+		} else {
+			rel.R.File = o
+		}
+	}
+	return nil
+}
+
+// AddFileRevokedLinks adds the given related objects to the existing relationships
+// of the file, optionally inserting them as new records.
+// Appends related to o.R.FileRevokedLinks.
+// Sets related.R.File appropriately.
+func (o *File) AddFileRevokedLinks(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*FileRevokedLink) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.FileID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"file_revoked_link\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"file_id"}),
+				strmangle.WhereClause("\"", "\"", 2, fileRevokedLinkPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.PublicID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.FileID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &fileR{
+			FileRevokedLinks: related,
+		}
+	} else {
+		o.R.FileRevokedLinks = append(o.R.FileRevokedLinks, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &fileRevokedLinkR{
+				File: o,
+			}
+		} else {
+			rel.R.File = o
+		}
+	}
+	return nil
+}
+
+// AddFileVersions adds the given related objects to the existing relationships
+// of the file, optionally inserting them as new records.
+// Appends related to o.R.FileVersions.
+// Sets related.R.File appropriately.
+func (o *File) AddFileVersions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*FileVersion) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.FileID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"file_version\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"file_id"}),
+				strmangle.WhereClause("\"", "\"", 2, fileVersionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.FileID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &fileR{
+			FileVersions: related,
+		}
+	} else {
+		o.R.FileVersions = append(o.R.FileVersions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &fileVersionR{
+				File: o,
+			}
+		} else {
+			rel.R.File = o
+		}
+	}
+	return nil
+}
+
+// AddPlacements adds the given related objects to the existing relationships
+// of the file, optionally inserting them as new records.
+// Appends related to o.R.Placements.
+// Sets related.R.File appropriately.
+func (o *File) AddPlacements(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Placement) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.FileID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"placement\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"file_id"}),
+				strmangle.WhereClause("\"", "\"", 2, placementPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.FileID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &fileR{
+			Placements: related,
+		}
+	} else {
+		o.R.Placements = append(o.R.Placements, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &placementR{
+				File: o,
+			}
+		} else {
+			rel.R.File = o
+		}
+	}
+	return nil
+}
+
+// AddReports adds the given related objects to the existing relationships
+// of the file, optionally inserting them as new records.
+// Appends related to o.R.Reports.
+// Sets related.R.File appropriately.
+func (o *File) AddReports(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Report) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.FileID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"report\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"file_id"}),
+				strmangle.WhereClause("\"", "\"", 2, reportPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.FileID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &fileR{
+			Reports: related,
+		}
+	} else {
+		o.R.Reports = append(o.R.Reports, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &reportR{
+				File: o,
+			}
+		} else {
+			rel.R.File = o
+		}
+	}
+	return nil
+}
+
 // Files retrieves all the records using an executor.
 func Files(mods ...qm.QueryMod) fileQuery {
 	mods = append(mods, qm.From("\"file\""))
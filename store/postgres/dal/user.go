@@ -24,96 +24,131 @@ import (
 
 // User is an object representing the database table.
 type User struct {
-	ID           int         `boil:"id" json:"id" toml:"id" yaml:"id"`
-	FirstName    string      `boil:"first_name" json:"first_name" toml:"first_name" yaml:"first_name"`
-	LastName     null.String `boil:"last_name" json:"last_name,omitempty" toml:"last_name" yaml:"last_name,omitempty"`
-	Username     null.String `boil:"username" json:"username,omitempty" toml:"username" yaml:"username,omitempty"`
-	LanguageCode string      `boil:"language_code" json:"language_code" toml:"language_code" yaml:"language_code"`
-	IsAdmin      bool        `boil:"is_admin" json:"is_admin" toml:"is_admin" yaml:"is_admin"`
-	JoinedAt     time.Time   `boil:"joined_at" json:"joined_at" toml:"joined_at" yaml:"joined_at"`
-	UpdatedAt    null.Time   `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
-	Settings     string      `boil:"settings" json:"settings" toml:"settings" yaml:"settings"`
-	Ref          null.String `boil:"ref" json:"ref,omitempty" toml:"ref" yaml:"ref,omitempty"`
+	ID             int         `boil:"id" json:"id" toml:"id" yaml:"id"`
+	FirstName      string      `boil:"first_name" json:"first_name" toml:"first_name" yaml:"first_name"`
+	LastName       null.String `boil:"last_name" json:"last_name,omitempty" toml:"last_name" yaml:"last_name,omitempty"`
+	Username       null.String `boil:"username" json:"username,omitempty" toml:"username" yaml:"username,omitempty"`
+	LanguageCode   string      `boil:"language_code" json:"language_code" toml:"language_code" yaml:"language_code"`
+	IsAdmin        bool        `boil:"is_admin" json:"is_admin" toml:"is_admin" yaml:"is_admin"`
+	JoinedAt       time.Time   `boil:"joined_at" json:"joined_at" toml:"joined_at" yaml:"joined_at"`
+	UpdatedAt      null.Time   `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+	Settings       string      `boil:"settings" json:"settings" toml:"settings" yaml:"settings"`
+	Ref            null.String `boil:"ref" json:"ref,omitempty" toml:"ref" yaml:"ref,omitempty"`
+	IsBanned       bool        `boil:"is_banned" json:"is_banned" toml:"is_banned" yaml:"is_banned"`
+	BotBlockedAt   null.Time   `boil:"bot_blocked_at" json:"bot_blocked_at,omitempty" toml:"bot_blocked_at" yaml:"bot_blocked_at,omitempty"`
+	BotUnblockedAt null.Time   `boil:"bot_unblocked_at" json:"bot_unblocked_at,omitempty" toml:"bot_unblocked_at" yaml:"bot_unblocked_at,omitempty"`
+	LastSeenAt     null.Time   `boil:"last_seen_at" json:"last_seen_at,omitempty" toml:"last_seen_at" yaml:"last_seen_at,omitempty"`
 
 	R *userR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L userL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var UserColumns = struct {
-	ID           string
-	FirstName    string
-	LastName     string
-	Username     string
-	LanguageCode string
-	IsAdmin      string
-	JoinedAt     string
-	UpdatedAt    string
-	Settings     string
-	Ref          string
+	ID             string
+	FirstName      string
+	LastName       string
+	Username       string
+	LanguageCode   string
+	IsAdmin        string
+	JoinedAt       string
+	UpdatedAt      string
+	Settings       string
+	Ref            string
+	IsBanned       string
+	BotBlockedAt   string
+	BotUnblockedAt string
+	LastSeenAt     string
 }{
-	ID:           "id",
-	FirstName:    "first_name",
-	LastName:     "last_name",
-	Username:     "username",
-	LanguageCode: "language_code",
-	IsAdmin:      "is_admin",
-	JoinedAt:     "joined_at",
-	UpdatedAt:    "updated_at",
-	Settings:     "settings",
-	Ref:          "ref",
+	ID:             "id",
+	FirstName:      "first_name",
+	LastName:       "last_name",
+	Username:       "username",
+	LanguageCode:   "language_code",
+	IsAdmin:        "is_admin",
+	JoinedAt:       "joined_at",
+	UpdatedAt:      "updated_at",
+	Settings:       "settings",
+	Ref:            "ref",
+	IsBanned:       "is_banned",
+	BotBlockedAt:   "bot_blocked_at",
+	BotUnblockedAt: "bot_unblocked_at",
+	LastSeenAt:     "last_seen_at",
 }
 
 // Generated where
 
-type whereHelperbool struct{ field string }
-
-func (w whereHelperbool) EQ(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperbool) NEQ(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperbool) LT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperbool) LTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-
 var UserWhere = struct {
-	ID           whereHelperint
-	FirstName    whereHelperstring
-	LastName     whereHelpernull_String
-	Username     whereHelpernull_String
-	LanguageCode whereHelperstring
-	IsAdmin      whereHelperbool
-	JoinedAt     whereHelpertime_Time
-	UpdatedAt    whereHelpernull_Time
-	Settings     whereHelperstring
-	Ref          whereHelpernull_String
+	ID             whereHelperint
+	FirstName      whereHelperstring
+	LastName       whereHelpernull_String
+	Username       whereHelpernull_String
+	LanguageCode   whereHelperstring
+	IsAdmin        whereHelperbool
+	JoinedAt       whereHelpertime_Time
+	UpdatedAt      whereHelpernull_Time
+	Settings       whereHelperstring
+	Ref            whereHelpernull_String
+	IsBanned       whereHelperbool
+	BotBlockedAt   whereHelpernull_Time
+	BotUnblockedAt whereHelpernull_Time
+	LastSeenAt     whereHelpernull_Time
 }{
-	ID:           whereHelperint{field: "\"user\".\"id\""},
-	FirstName:    whereHelperstring{field: "\"user\".\"first_name\""},
-	LastName:     whereHelpernull_String{field: "\"user\".\"last_name\""},
-	Username:     whereHelpernull_String{field: "\"user\".\"username\""},
-	LanguageCode: whereHelperstring{field: "\"user\".\"language_code\""},
-	IsAdmin:      whereHelperbool{field: "\"user\".\"is_admin\""},
-	JoinedAt:     whereHelpertime_Time{field: "\"user\".\"joined_at\""},
-	UpdatedAt:    whereHelpernull_Time{field: "\"user\".\"updated_at\""},
-	Settings:     whereHelperstring{field: "\"user\".\"settings\""},
-	Ref:          whereHelpernull_String{field: "\"user\".\"ref\""},
+	ID:             whereHelperint{field: "\"user\".\"id\""},
+	FirstName:      whereHelperstring{field: "\"user\".\"first_name\""},
+	LastName:       whereHelpernull_String{field: "\"user\".\"last_name\""},
+	Username:       whereHelpernull_String{field: "\"user\".\"username\""},
+	LanguageCode:   whereHelperstring{field: "\"user\".\"language_code\""},
+	IsAdmin:        whereHelperbool{field: "\"user\".\"is_admin\""},
+	JoinedAt:       whereHelpertime_Time{field: "\"user\".\"joined_at\""},
+	UpdatedAt:      whereHelpernull_Time{field: "\"user\".\"updated_at\""},
+	Settings:       whereHelperstring{field: "\"user\".\"settings\""},
+	Ref:            whereHelpernull_String{field: "\"user\".\"ref\""},
+	IsBanned:       whereHelperbool{field: "\"user\".\"is_banned\""},
+	BotBlockedAt:   whereHelpernull_Time{field: "\"user\".\"bot_blocked_at\""},
+	BotUnblockedAt: whereHelpernull_Time{field: "\"user\".\"bot_unblocked_at\""},
+	LastSeenAt:     whereHelpernull_Time{field: "\"user\".\"last_seen_at\""},
 }
 
 // UserRels is where relationship names are stored.
 var UserRels = struct {
-	OwnerChats string
-	Downloads  string
-	OwnerFiles string
+	AdminAuditLogs          string
+	AuthorBroadcasts        string
+	BroadcastDeliveries     string
+	OwnerBundles            string
+	OwnerChats              string
+	Downloads               string
+	OwnerFiles              string
+	ReporterReports         string
+	ResolvedByReports       string
+	AppealResolvedByReports string
+	AdminReservedPublicIds  string
 }{
-	OwnerChats: "OwnerChats",
-	Downloads:  "Downloads",
-	OwnerFiles: "OwnerFiles",
+	AdminAuditLogs:          "AdminAuditLogs",
+	AuthorBroadcasts:        "AuthorBroadcasts",
+	BroadcastDeliveries:     "BroadcastDeliveries",
+	OwnerBundles:            "OwnerBundles",
+	OwnerChats:              "OwnerChats",
+	Downloads:               "Downloads",
+	OwnerFiles:              "OwnerFiles",
+	ReporterReports:         "ReporterReports",
+	ResolvedByReports:       "ResolvedByReports",
+	AppealResolvedByReports: "AppealResolvedByReports",
+	AdminReservedPublicIds:  "AdminReservedPublicIds",
 }
 
 // userR is where relationships are stored.
 type userR struct {
-	OwnerChats ChatSlice     `boil:"OwnerChats" json:"OwnerChats" toml:"OwnerChats" yaml:"OwnerChats"`
-	Downloads  DownloadSlice `boil:"Downloads" json:"Downloads" toml:"Downloads" yaml:"Downloads"`
-	OwnerFiles FileSlice     `boil:"OwnerFiles" json:"OwnerFiles" toml:"OwnerFiles" yaml:"OwnerFiles"`
+	AdminAuditLogs          AuditLogSlice          `boil:"AdminAuditLogs" json:"AdminAuditLogs" toml:"AdminAuditLogs" yaml:"AdminAuditLogs"`
+	AuthorBroadcasts        BroadcastSlice         `boil:"AuthorBroadcasts" json:"AuthorBroadcasts" toml:"AuthorBroadcasts" yaml:"AuthorBroadcasts"`
+	BroadcastDeliveries     BroadcastDeliverySlice `boil:"BroadcastDeliveries" json:"BroadcastDeliveries" toml:"BroadcastDeliveries" yaml:"BroadcastDeliveries"`
+	OwnerBundles            BundleSlice            `boil:"OwnerBundles" json:"OwnerBundles" toml:"OwnerBundles" yaml:"OwnerBundles"`
+	OwnerChats              ChatSlice              `boil:"OwnerChats" json:"OwnerChats" toml:"OwnerChats" yaml:"OwnerChats"`
+	Downloads               DownloadSlice          `boil:"Downloads" json:"Downloads" toml:"Downloads" yaml:"Downloads"`
+	OwnerFiles              FileSlice              `boil:"OwnerFiles" json:"OwnerFiles" toml:"OwnerFiles" yaml:"OwnerFiles"`
+	ReporterReports         ReportSlice            `boil:"ReporterReports" json:"ReporterReports" toml:"ReporterReports" yaml:"ReporterReports"`
+	ResolvedByReports       ReportSlice            `boil:"ResolvedByReports" json:"ResolvedByReports" toml:"ResolvedByReports" yaml:"ResolvedByReports"`
+	AppealResolvedByReports ReportSlice            `boil:"AppealResolvedByReports" json:"AppealResolvedByReports" toml:"AppealResolvedByReports" yaml:"AppealResolvedByReports"`
+	AdminReservedPublicIds  ReservedPublicIDSlice  `boil:"AdminReservedPublicIds" json:"AdminReservedPublicIds" toml:"AdminReservedPublicIds" yaml:"AdminReservedPublicIds"`
 }
 
 // NewStruct creates a new relationship struct
@@ -125,9 +160,9 @@ func (*userR) NewStruct() *userR {
 type userL struct{}
 
 var (
-	userAllColumns            = []string{"id", "first_name", "last_name", "username", "language_code", "is_admin", "joined_at", "updated_at", "settings", "ref"}
-	userColumnsWithoutDefault = []string{"id", "first_name", "last_name", "username", "language_code", "is_admin", "joined_at", "updated_at", "ref"}
-	userColumnsWithDefault    = []string{"settings"}
+	userAllColumns            = []string{"id", "first_name", "last_name", "username", "language_code", "is_admin", "joined_at", "updated_at", "settings", "ref", "is_banned", "bot_blocked_at", "bot_unblocked_at", "last_seen_at"}
+	userColumnsWithoutDefault = []string{"id", "first_name", "last_name", "username", "language_code", "is_admin", "joined_at", "updated_at", "ref", "bot_blocked_at", "bot_unblocked_at", "last_seen_at"}
+	userColumnsWithDefault    = []string{"settings", "is_banned"}
 	userPrimaryKeyColumns     = []string{"id"}
 )
 
@@ -222,6 +257,90 @@ func (q userQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool,
 	return count > 0, nil
 }
 
+// AdminAuditLogs retrieves all the audit_log's AuditLogs with an executor via admin_id column.
+func (o *User) AdminAuditLogs(mods ...qm.QueryMod) auditLogQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"audit_log\".\"admin_id\"=?", o.ID),
+	)
+
+	query := AuditLogs(queryMods...)
+	queries.SetFrom(query.Query, "\"audit_log\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"audit_log\".*"})
+	}
+
+	return query
+}
+
+// AuthorBroadcasts retrieves all the broadcast's Broadcasts with an executor via author_id column.
+func (o *User) AuthorBroadcasts(mods ...qm.QueryMod) broadcastQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"broadcast\".\"author_id\"=?", o.ID),
+	)
+
+	query := Broadcasts(queryMods...)
+	queries.SetFrom(query.Query, "\"broadcast\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"broadcast\".*"})
+	}
+
+	return query
+}
+
+// BroadcastDeliveries retrieves all the broadcast_delivery's BroadcastDeliveries with an executor.
+func (o *User) BroadcastDeliveries(mods ...qm.QueryMod) broadcastDeliveryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"broadcast_delivery\".\"user_id\"=?", o.ID),
+	)
+
+	query := BroadcastDeliveries(queryMods...)
+	queries.SetFrom(query.Query, "\"broadcast_delivery\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"broadcast_delivery\".*"})
+	}
+
+	return query
+}
+
+// OwnerBundles retrieves all the bundle's Bundles with an executor via owner_id column.
+func (o *User) OwnerBundles(mods ...qm.QueryMod) bundleQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"bundle\".\"owner_id\"=?", o.ID),
+	)
+
+	query := Bundles(queryMods...)
+	queries.SetFrom(query.Query, "\"bundle\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"bundle\".*"})
+	}
+
+	return query
+}
+
 // OwnerChats retrieves all the chat's Chats with an executor via owner_id column.
 func (o *User) OwnerChats(mods ...qm.QueryMod) chatQuery {
 	var queryMods []qm.QueryMod
@@ -285,9 +404,93 @@ func (o *User) OwnerFiles(mods ...qm.QueryMod) fileQuery {
 	return query
 }
 
-// LoadOwnerChats allows an eager lookup of values, cached into the
+// ReporterReports retrieves all the report's Reports with an executor via reporter_id column.
+func (o *User) ReporterReports(mods ...qm.QueryMod) reportQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"report\".\"reporter_id\"=?", o.ID),
+	)
+
+	query := Reports(queryMods...)
+	queries.SetFrom(query.Query, "\"report\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"report\".*"})
+	}
+
+	return query
+}
+
+// ResolvedByReports retrieves all the report's Reports with an executor via resolved_by column.
+func (o *User) ResolvedByReports(mods ...qm.QueryMod) reportQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"report\".\"resolved_by\"=?", o.ID),
+	)
+
+	query := Reports(queryMods...)
+	queries.SetFrom(query.Query, "\"report\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"report\".*"})
+	}
+
+	return query
+}
+
+// AppealResolvedByReports retrieves all the report's Reports with an executor via appeal_resolved_by column.
+func (o *User) AppealResolvedByReports(mods ...qm.QueryMod) reportQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"report\".\"appeal_resolved_by\"=?", o.ID),
+	)
+
+	query := Reports(queryMods...)
+	queries.SetFrom(query.Query, "\"report\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"report\".*"})
+	}
+
+	return query
+}
+
+// AdminReservedPublicIds retrieves all the reserved_public_id's ReservedPublicIds with an executor via admin_id column.
+func (o *User) AdminReservedPublicIds(mods ...qm.QueryMod) reservedPublicIDQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"reserved_public_id\".\"admin_id\"=?", o.ID),
+	)
+
+	query := ReservedPublicIds(queryMods...)
+	queries.SetFrom(query.Query, "\"reserved_public_id\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"reserved_public_id\".*"})
+	}
+
+	return query
+}
+
+// LoadAdminAuditLogs allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadOwnerChats(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadAdminAuditLogs(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -325,8 +528,8 @@ func (userL) LoadOwnerChats(ctx context.Context, e boil.ContextExecutor, singula
 	}
 
 	query := NewQuery(
-		qm.From(`chat`),
-		qm.WhereIn(`chat.owner_id in ?`, args...),
+		qm.From(`audit_log`),
+		qm.WhereIn(`audit_log.admin_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -334,40 +537,40 @@ func (userL) LoadOwnerChats(ctx context.Context, e boil.ContextExecutor, singula
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load chat")
+		return errors.Wrap(err, "failed to eager load audit_log")
 	}
 
-	var resultSlice []*Chat
+	var resultSlice []*AuditLog
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice chat")
+		return errors.Wrap(err, "failed to bind eager loaded slice audit_log")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on chat")
+		return errors.Wrap(err, "failed to close results in eager load on audit_log")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chat")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for audit_log")
 	}
 
 	if singular {
-		object.R.OwnerChats = resultSlice
+		object.R.AdminAuditLogs = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &chatR{}
+				foreign.R = &auditLogR{}
 			}
-			foreign.R.Owner = object
+			foreign.R.Admin = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.OwnerID {
-				local.R.OwnerChats = append(local.R.OwnerChats, foreign)
+			if local.ID == foreign.AdminID {
+				local.R.AdminAuditLogs = append(local.R.AdminAuditLogs, foreign)
 				if foreign.R == nil {
-					foreign.R = &chatR{}
+					foreign.R = &auditLogR{}
 				}
-				foreign.R.Owner = local
+				foreign.R.Admin = local
 				break
 			}
 		}
@@ -376,9 +579,9 @@ func (userL) LoadOwnerChats(ctx context.Context, e boil.ContextExecutor, singula
 	return nil
 }
 
-// LoadDownloads allows an eager lookup of values, cached into the
+// LoadAuthorBroadcasts allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadDownloads(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadAuthorBroadcasts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -402,7 +605,7 @@ func (userL) LoadDownloads(ctx context.Context, e boil.ContextExecutor, singular
 			}
 
 			for _, a := range args {
-				if queries.Equal(a, obj.ID) {
+				if a == obj.ID {
 					continue Outer
 				}
 			}
@@ -416,8 +619,8 @@ func (userL) LoadDownloads(ctx context.Context, e boil.ContextExecutor, singular
 	}
 
 	query := NewQuery(
-		qm.From(`download`),
-		qm.WhereIn(`download.user_id in ?`, args...),
+		qm.From(`broadcast`),
+		qm.WhereIn(`broadcast.author_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -425,40 +628,40 @@ func (userL) LoadDownloads(ctx context.Context, e boil.ContextExecutor, singular
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load download")
+		return errors.Wrap(err, "failed to eager load broadcast")
 	}
 
-	var resultSlice []*Download
+	var resultSlice []*Broadcast
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice download")
+		return errors.Wrap(err, "failed to bind eager loaded slice broadcast")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on download")
+		return errors.Wrap(err, "failed to close results in eager load on broadcast")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for download")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for broadcast")
 	}
 
 	if singular {
-		object.R.Downloads = resultSlice
+		object.R.AuthorBroadcasts = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &downloadR{}
+				foreign.R = &broadcastR{}
 			}
-			foreign.R.User = object
+			foreign.R.Author = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if queries.Equal(local.ID, foreign.UserID) {
-				local.R.Downloads = append(local.R.Downloads, foreign)
+			if local.ID == foreign.AuthorID {
+				local.R.AuthorBroadcasts = append(local.R.AuthorBroadcasts, foreign)
 				if foreign.R == nil {
-					foreign.R = &downloadR{}
+					foreign.R = &broadcastR{}
 				}
-				foreign.R.User = local
+				foreign.R.Author = local
 				break
 			}
 		}
@@ -467,9 +670,9 @@ func (userL) LoadDownloads(ctx context.Context, e boil.ContextExecutor, singular
 	return nil
 }
 
-// LoadOwnerFiles allows an eager lookup of values, cached into the
+// LoadBroadcastDeliveries allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadOwnerFiles(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadBroadcastDeliveries(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -507,8 +710,8 @@ func (userL) LoadOwnerFiles(ctx context.Context, e boil.ContextExecutor, singula
 	}
 
 	query := NewQuery(
-		qm.From(`file`),
-		qm.WhereIn(`file.owner_id in ?`, args...),
+		qm.From(`broadcast_delivery`),
+		qm.WhereIn(`broadcast_delivery.user_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -516,40 +719,40 @@ func (userL) LoadOwnerFiles(ctx context.Context, e boil.ContextExecutor, singula
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load file")
+		return errors.Wrap(err, "failed to eager load broadcast_delivery")
 	}
 
-	var resultSlice []*File
+	var resultSlice []*BroadcastDelivery
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice file")
+		return errors.Wrap(err, "failed to bind eager loaded slice broadcast_delivery")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on file")
+		return errors.Wrap(err, "failed to close results in eager load on broadcast_delivery")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for file")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for broadcast_delivery")
 	}
 
 	if singular {
-		object.R.OwnerFiles = resultSlice
+		object.R.BroadcastDeliveries = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &fileR{}
+				foreign.R = &broadcastDeliveryR{}
 			}
-			foreign.R.Owner = object
+			foreign.R.User = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.OwnerID {
-				local.R.OwnerFiles = append(local.R.OwnerFiles, foreign)
+			if local.ID == foreign.UserID {
+				local.R.BroadcastDeliveries = append(local.R.BroadcastDeliveries, foreign)
 				if foreign.R == nil {
-					foreign.R = &fileR{}
+					foreign.R = &broadcastDeliveryR{}
 				}
-				foreign.R.Owner = local
+				foreign.R.User = local
 				break
 			}
 		}
@@ -558,76 +761,1192 @@ func (userL) LoadOwnerFiles(ctx context.Context, e boil.ContextExecutor, singula
 	return nil
 }
 
-// AddOwnerChats adds the given related objects to the existing relationships
-// of the user, optionally inserting them as new records.
-// Appends related to o.R.OwnerChats.
-// Sets related.R.Owner appropriately.
-func (o *User) AddOwnerChats(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Chat) error {
-	var err error
-	for _, rel := range related {
-		if insert {
-			rel.OwnerID = o.ID
-			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
-				return errors.Wrap(err, "failed to insert into foreign table")
-			}
-		} else {
-			updateQuery := fmt.Sprintf(
-				"UPDATE \"chat\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"owner_id"}),
-				strmangle.WhereClause("\"", "\"", 2, chatPrimaryKeyColumns),
-			)
-			values := []interface{}{o.ID, rel.ID}
+// LoadOwnerBundles allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadOwnerBundles(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
 
-			if boil.IsDebug(ctx) {
-				writer := boil.DebugWriterFrom(ctx)
-				fmt.Fprintln(writer, updateQuery)
-				fmt.Fprintln(writer, values)
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
 			}
-			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-				return errors.Wrap(err, "failed to update foreign table")
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
 			}
 
-			rel.OwnerID = o.ID
+			args = append(args, obj.ID)
 		}
 	}
 
-	if o.R == nil {
-		o.R = &userR{
-			OwnerChats: related,
-		}
-	} else {
-		o.R.OwnerChats = append(o.R.OwnerChats, related...)
+	if len(args) == 0 {
+		return nil
 	}
 
-	for _, rel := range related {
-		if rel.R == nil {
-			rel.R = &chatR{
-				Owner: o,
-			}
-		} else {
-			rel.R.Owner = o
-		}
+	query := NewQuery(
+		qm.From(`bundle`),
+		qm.WhereIn(`bundle.owner_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
 	}
-	return nil
-}
 
-// AddDownloads adds the given related objects to the existing relationships
-// of the user, optionally inserting them as new records.
-// Appends related to o.R.Downloads.
-// Sets related.R.User appropriately.
-func (o *User) AddDownloads(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Download) error {
-	var err error
-	for _, rel := range related {
-		if insert {
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load bundle")
+	}
+
+	var resultSlice []*Bundle
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice bundle")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on bundle")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for bundle")
+	}
+
+	if singular {
+		object.R.OwnerBundles = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &bundleR{}
+			}
+			foreign.R.Owner = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.OwnerID {
+				local.R.OwnerBundles = append(local.R.OwnerBundles, foreign)
+				if foreign.R == nil {
+					foreign.R = &bundleR{}
+				}
+				foreign.R.Owner = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadOwnerChats allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadOwnerChats(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`chat`),
+		qm.WhereIn(`chat.owner_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load chat")
+	}
+
+	var resultSlice []*Chat
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice chat")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on chat")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chat")
+	}
+
+	if singular {
+		object.R.OwnerChats = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &chatR{}
+			}
+			foreign.R.Owner = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.OwnerID {
+				local.R.OwnerChats = append(local.R.OwnerChats, foreign)
+				if foreign.R == nil {
+					foreign.R = &chatR{}
+				}
+				foreign.R.Owner = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadDownloads allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadDownloads(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`download`),
+		qm.WhereIn(`download.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load download")
+	}
+
+	var resultSlice []*Download
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice download")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on download")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for download")
+	}
+
+	if singular {
+		object.R.Downloads = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &downloadR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.UserID) {
+				local.R.Downloads = append(local.R.Downloads, foreign)
+				if foreign.R == nil {
+					foreign.R = &downloadR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadOwnerFiles allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadOwnerFiles(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`file`),
+		qm.WhereIn(`file.owner_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load file")
+	}
+
+	var resultSlice []*File
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice file")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on file")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for file")
+	}
+
+	if singular {
+		object.R.OwnerFiles = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &fileR{}
+			}
+			foreign.R.Owner = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.OwnerID {
+				local.R.OwnerFiles = append(local.R.OwnerFiles, foreign)
+				if foreign.R == nil {
+					foreign.R = &fileR{}
+				}
+				foreign.R.Owner = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadReporterReports allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadReporterReports(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`report`),
+		qm.WhereIn(`report.reporter_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load report")
+	}
+
+	var resultSlice []*Report
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice report")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on report")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for report")
+	}
+
+	if singular {
+		object.R.ReporterReports = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &reportR{}
+			}
+			foreign.R.Reporter = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.ReporterID) {
+				local.R.ReporterReports = append(local.R.ReporterReports, foreign)
+				if foreign.R == nil {
+					foreign.R = &reportR{}
+				}
+				foreign.R.Reporter = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadResolvedByReports allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadResolvedByReports(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`report`),
+		qm.WhereIn(`report.resolved_by in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load report")
+	}
+
+	var resultSlice []*Report
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice report")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on report")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for report")
+	}
+
+	if singular {
+		object.R.ResolvedByReports = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &reportR{}
+			}
+			foreign.R.ResolvedByUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.ResolvedBy) {
+				local.R.ResolvedByReports = append(local.R.ResolvedByReports, foreign)
+				if foreign.R == nil {
+					foreign.R = &reportR{}
+				}
+				foreign.R.ResolvedByUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAppealResolvedByReports allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadAppealResolvedByReports(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`report`),
+		qm.WhereIn(`report.appeal_resolved_by in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load report")
+	}
+
+	var resultSlice []*Report
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice report")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on report")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for report")
+	}
+
+	if singular {
+		object.R.AppealResolvedByReports = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &reportR{}
+			}
+			foreign.R.AppealResolvedByUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.AppealResolvedBy) {
+				local.R.AppealResolvedByReports = append(local.R.AppealResolvedByReports, foreign)
+				if foreign.R == nil {
+					foreign.R = &reportR{}
+				}
+				foreign.R.AppealResolvedByUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAdminReservedPublicIds allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadAdminReservedPublicIds(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`reserved_public_id`),
+		qm.WhereIn(`reserved_public_id.admin_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load reserved_public_id")
+	}
+
+	var resultSlice []*ReservedPublicID
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice reserved_public_id")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on reserved_public_id")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for reserved_public_id")
+	}
+
+	if singular {
+		object.R.AdminReservedPublicIds = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &reservedPublicIDR{}
+			}
+			foreign.R.Admin = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.AdminID {
+				local.R.AdminReservedPublicIds = append(local.R.AdminReservedPublicIds, foreign)
+				if foreign.R == nil {
+					foreign.R = &reservedPublicIDR{}
+				}
+				foreign.R.Admin = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddAdminAuditLogs adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.AdminAuditLogs.
+// Sets related.R.Admin appropriately.
+func (o *User) AddAdminAuditLogs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AuditLog) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.AdminID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"audit_log\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"admin_id"}),
+				strmangle.WhereClause("\"", "\"", 2, auditLogPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.AdminID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			AdminAuditLogs: related,
+		}
+	} else {
+		o.R.AdminAuditLogs = append(o.R.AdminAuditLogs, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &auditLogR{
+				Admin: o,
+			}
+		} else {
+			rel.R.Admin = o
+		}
+	}
+	return nil
+}
+
+// AddAuthorBroadcasts adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.AuthorBroadcasts.
+// Sets related.R.Author appropriately.
+func (o *User) AddAuthorBroadcasts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Broadcast) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.AuthorID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"broadcast\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"author_id"}),
+				strmangle.WhereClause("\"", "\"", 2, broadcastPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.AuthorID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			AuthorBroadcasts: related,
+		}
+	} else {
+		o.R.AuthorBroadcasts = append(o.R.AuthorBroadcasts, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &broadcastR{
+				Author: o,
+			}
+		} else {
+			rel.R.Author = o
+		}
+	}
+	return nil
+}
+
+// AddBroadcastDeliveries adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.BroadcastDeliveries.
+// Sets related.R.User appropriately.
+func (o *User) AddBroadcastDeliveries(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*BroadcastDelivery) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"broadcast_delivery\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, broadcastDeliveryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.BroadcastID, rel.UserID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			BroadcastDeliveries: related,
+		}
+	} else {
+		o.R.BroadcastDeliveries = append(o.R.BroadcastDeliveries, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &broadcastDeliveryR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddOwnerBundles adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.OwnerBundles.
+// Sets related.R.Owner appropriately.
+func (o *User) AddOwnerBundles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Bundle) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.OwnerID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"bundle\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"owner_id"}),
+				strmangle.WhereClause("\"", "\"", 2, bundlePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.OwnerID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			OwnerBundles: related,
+		}
+	} else {
+		o.R.OwnerBundles = append(o.R.OwnerBundles, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &bundleR{
+				Owner: o,
+			}
+		} else {
+			rel.R.Owner = o
+		}
+	}
+	return nil
+}
+
+// AddOwnerChats adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.OwnerChats.
+// Sets related.R.Owner appropriately.
+func (o *User) AddOwnerChats(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Chat) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.OwnerID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"chat\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"owner_id"}),
+				strmangle.WhereClause("\"", "\"", 2, chatPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.OwnerID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			OwnerChats: related,
+		}
+	} else {
+		o.R.OwnerChats = append(o.R.OwnerChats, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &chatR{
+				Owner: o,
+			}
+		} else {
+			rel.R.Owner = o
+		}
+	}
+	return nil
+}
+
+// AddDownloads adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.Downloads.
+// Sets related.R.User appropriately.
+func (o *User) AddDownloads(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Download) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.UserID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"download\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, downloadPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
 			queries.Assign(&rel.UserID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			Downloads: related,
+		}
+	} else {
+		o.R.Downloads = append(o.R.Downloads, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &downloadR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// SetDownloads removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.User's Downloads accordingly.
+// Replaces o.R.Downloads with related.
+// Sets related.R.User's Downloads accordingly.
+func (o *User) SetDownloads(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Download) error {
+	query := "update \"download\" set \"user_id\" = null where \"user_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.Downloads {
+			queries.SetScanner(&rel.UserID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.User = nil
+		}
+
+		o.R.Downloads = nil
+	}
+	return o.AddDownloads(ctx, exec, insert, related...)
+}
+
+// RemoveDownloads relationships from objects passed in.
+// Removes related items from R.Downloads (uses pointer comparison, removal does not keep order)
+// Sets related.R.User.
+func (o *User) RemoveDownloads(ctx context.Context, exec boil.ContextExecutor, related ...*Download) error {
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.UserID, nil)
+		if rel.R != nil {
+			rel.R.User = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("user_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Downloads {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Downloads)
+			if ln > 1 && i < ln-1 {
+				o.R.Downloads[i] = o.R.Downloads[ln-1]
+			}
+			o.R.Downloads = o.R.Downloads[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddOwnerFiles adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.OwnerFiles.
+// Sets related.R.Owner appropriately.
+func (o *User) AddOwnerFiles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*File) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.OwnerID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"file\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"owner_id"}),
+				strmangle.WhereClause("\"", "\"", 2, filePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.OwnerID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			OwnerFiles: related,
+		}
+	} else {
+		o.R.OwnerFiles = append(o.R.OwnerFiles, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &fileR{
+				Owner: o,
+			}
+		} else {
+			rel.R.Owner = o
+		}
+	}
+	return nil
+}
+
+// AddReporterReports adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ReporterReports.
+// Sets related.R.Reporter appropriately.
+func (o *User) AddReporterReports(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Report) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.ReporterID, o.ID)
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"download\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
-				strmangle.WhereClause("\"", "\"", 2, downloadPrimaryKeyColumns),
+				"UPDATE \"report\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"reporter_id"}),
+				strmangle.WhereClause("\"", "\"", 2, reportPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -640,38 +1959,38 @@ func (o *User) AddDownloads(ctx context.Context, exec boil.ContextExecutor, inse
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			queries.Assign(&rel.UserID, o.ID)
+			queries.Assign(&rel.ReporterID, o.ID)
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			Downloads: related,
+			ReporterReports: related,
 		}
 	} else {
-		o.R.Downloads = append(o.R.Downloads, related...)
+		o.R.ReporterReports = append(o.R.ReporterReports, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &downloadR{
-				User: o,
+			rel.R = &reportR{
+				Reporter: o,
 			}
 		} else {
-			rel.R.User = o
+			rel.R.Reporter = o
 		}
 	}
 	return nil
 }
 
-// SetDownloads removes all previously related items of the
+// SetReporterReports removes all previously related items of the
 // user replacing them completely with the passed
 // in related items, optionally inserting them as new records.
-// Sets o.R.User's Downloads accordingly.
-// Replaces o.R.Downloads with related.
-// Sets related.R.User's Downloads accordingly.
-func (o *User) SetDownloads(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Download) error {
-	query := "update \"download\" set \"user_id\" = null where \"user_id\" = $1"
+// Sets o.R.Reporter's ReporterReports accordingly.
+// Replaces o.R.ReporterReports with related.
+// Sets related.R.Reporter's ReporterReports accordingly.
+func (o *User) SetReporterReports(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Report) error {
+	query := "update \"report\" set \"reporter_id\" = null where \"reporter_id\" = $1"
 	values := []interface{}{o.ID}
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
@@ -684,31 +2003,31 @@ func (o *User) SetDownloads(ctx context.Context, exec boil.ContextExecutor, inse
 	}
 
 	if o.R != nil {
-		for _, rel := range o.R.Downloads {
-			queries.SetScanner(&rel.UserID, nil)
+		for _, rel := range o.R.ReporterReports {
+			queries.SetScanner(&rel.ReporterID, nil)
 			if rel.R == nil {
 				continue
 			}
 
-			rel.R.User = nil
+			rel.R.Reporter = nil
 		}
 
-		o.R.Downloads = nil
+		o.R.ReporterReports = nil
 	}
-	return o.AddDownloads(ctx, exec, insert, related...)
+	return o.AddReporterReports(ctx, exec, insert, related...)
 }
 
-// RemoveDownloads relationships from objects passed in.
-// Removes related items from R.Downloads (uses pointer comparison, removal does not keep order)
-// Sets related.R.User.
-func (o *User) RemoveDownloads(ctx context.Context, exec boil.ContextExecutor, related ...*Download) error {
+// RemoveReporterReports relationships from objects passed in.
+// Removes related items from R.ReporterReports (uses pointer comparison, removal does not keep order)
+// Sets related.R.Reporter.
+func (o *User) RemoveReporterReports(ctx context.Context, exec boil.ContextExecutor, related ...*Report) error {
 	var err error
 	for _, rel := range related {
-		queries.SetScanner(&rel.UserID, nil)
+		queries.SetScanner(&rel.ReporterID, nil)
 		if rel.R != nil {
-			rel.R.User = nil
+			rel.R.Reporter = nil
 		}
-		if _, err = rel.Update(ctx, exec, boil.Whitelist("user_id")); err != nil {
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("reporter_id")); err != nil {
 			return err
 		}
 	}
@@ -717,16 +2036,16 @@ func (o *User) RemoveDownloads(ctx context.Context, exec boil.ContextExecutor, r
 	}
 
 	for _, rel := range related {
-		for i, ri := range o.R.Downloads {
+		for i, ri := range o.R.ReporterReports {
 			if rel != ri {
 				continue
 			}
 
-			ln := len(o.R.Downloads)
+			ln := len(o.R.ReporterReports)
 			if ln > 1 && i < ln-1 {
-				o.R.Downloads[i] = o.R.Downloads[ln-1]
+				o.R.ReporterReports[i] = o.R.ReporterReports[ln-1]
 			}
-			o.R.Downloads = o.R.Downloads[:ln-1]
+			o.R.ReporterReports = o.R.ReporterReports[:ln-1]
 			break
 		}
 	}
@@ -734,23 +2053,23 @@ func (o *User) RemoveDownloads(ctx context.Context, exec boil.ContextExecutor, r
 	return nil
 }
 
-// AddOwnerFiles adds the given related objects to the existing relationships
+// AddResolvedByReports adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.OwnerFiles.
-// Sets related.R.Owner appropriately.
-func (o *User) AddOwnerFiles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*File) error {
+// Appends related to o.R.ResolvedByReports.
+// Sets related.R.ResolvedByUser appropriately.
+func (o *User) AddResolvedByReports(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Report) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			rel.OwnerID = o.ID
+			queries.Assign(&rel.ResolvedBy, o.ID)
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"file\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"owner_id"}),
-				strmangle.WhereClause("\"", "\"", 2, filePrimaryKeyColumns),
+				"UPDATE \"report\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"resolved_by"}),
+				strmangle.WhereClause("\"", "\"", 2, reportPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -763,25 +2082,271 @@ func (o *User) AddOwnerFiles(ctx context.Context, exec boil.ContextExecutor, ins
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.OwnerID = o.ID
+			queries.Assign(&rel.ResolvedBy, o.ID)
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			OwnerFiles: related,
+			ResolvedByReports: related,
 		}
 	} else {
-		o.R.OwnerFiles = append(o.R.OwnerFiles, related...)
+		o.R.ResolvedByReports = append(o.R.ResolvedByReports, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &fileR{
-				Owner: o,
+			rel.R = &reportR{
+				ResolvedByUser: o,
 			}
 		} else {
-			rel.R.Owner = o
+			rel.R.ResolvedByUser = o
+		}
+	}
+	return nil
+}
+
+// SetResolvedByReports removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.ResolvedByUser's ResolvedByReports accordingly.
+// Replaces o.R.ResolvedByReports with related.
+// Sets related.R.ResolvedByUser's ResolvedByReports accordingly.
+func (o *User) SetResolvedByReports(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Report) error {
+	query := "update \"report\" set \"resolved_by\" = null where \"resolved_by\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ResolvedByReports {
+			queries.SetScanner(&rel.ResolvedBy, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.ResolvedByUser = nil
+		}
+
+		o.R.ResolvedByReports = nil
+	}
+	return o.AddResolvedByReports(ctx, exec, insert, related...)
+}
+
+// RemoveResolvedByReports relationships from objects passed in.
+// Removes related items from R.ResolvedByReports (uses pointer comparison, removal does not keep order)
+// Sets related.R.ResolvedByUser.
+func (o *User) RemoveResolvedByReports(ctx context.Context, exec boil.ContextExecutor, related ...*Report) error {
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.ResolvedBy, nil)
+		if rel.R != nil {
+			rel.R.ResolvedByUser = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("resolved_by")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.ResolvedByReports {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ResolvedByReports)
+			if ln > 1 && i < ln-1 {
+				o.R.ResolvedByReports[i] = o.R.ResolvedByReports[ln-1]
+			}
+			o.R.ResolvedByReports = o.R.ResolvedByReports[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddAppealResolvedByReports adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.AppealResolvedByReports.
+// Sets related.R.AppealResolvedByUser appropriately.
+func (o *User) AddAppealResolvedByReports(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Report) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.AppealResolvedBy, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"report\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"appeal_resolved_by"}),
+				strmangle.WhereClause("\"", "\"", 2, reportPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.AppealResolvedBy, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			AppealResolvedByReports: related,
+		}
+	} else {
+		o.R.AppealResolvedByReports = append(o.R.AppealResolvedByReports, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &reportR{
+				AppealResolvedByUser: o,
+			}
+		} else {
+			rel.R.AppealResolvedByUser = o
+		}
+	}
+	return nil
+}
+
+// SetAppealResolvedByReports removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.AppealResolvedByUser's AppealResolvedByReports accordingly.
+// Replaces o.R.AppealResolvedByReports with related.
+// Sets related.R.AppealResolvedByUser's AppealResolvedByReports accordingly.
+func (o *User) SetAppealResolvedByReports(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Report) error {
+	query := "update \"report\" set \"appeal_resolved_by\" = null where \"appeal_resolved_by\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.AppealResolvedByReports {
+			queries.SetScanner(&rel.AppealResolvedBy, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.AppealResolvedByUser = nil
+		}
+
+		o.R.AppealResolvedByReports = nil
+	}
+	return o.AddAppealResolvedByReports(ctx, exec, insert, related...)
+}
+
+// RemoveAppealResolvedByReports relationships from objects passed in.
+// Removes related items from R.AppealResolvedByReports (uses pointer comparison, removal does not keep order)
+// Sets related.R.AppealResolvedByUser.
+func (o *User) RemoveAppealResolvedByReports(ctx context.Context, exec boil.ContextExecutor, related ...*Report) error {
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.AppealResolvedBy, nil)
+		if rel.R != nil {
+			rel.R.AppealResolvedByUser = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("appeal_resolved_by")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.AppealResolvedByReports {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.AppealResolvedByReports)
+			if ln > 1 && i < ln-1 {
+				o.R.AppealResolvedByReports[i] = o.R.AppealResolvedByReports[ln-1]
+			}
+			o.R.AppealResolvedByReports = o.R.AppealResolvedByReports[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddAdminReservedPublicIds adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.AdminReservedPublicIds.
+// Sets related.R.Admin appropriately.
+func (o *User) AddAdminReservedPublicIds(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ReservedPublicID) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.AdminID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"reserved_public_id\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"admin_id"}),
+				strmangle.WhereClause("\"", "\"", 2, reservedPublicIDPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.Name}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.AdminID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			AdminReservedPublicIds: related,
+		}
+	} else {
+		o.R.AdminReservedPublicIds = append(o.R.AdminReservedPublicIds, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &reservedPublicIDR{
+				Admin: o,
+			}
+		} else {
+			rel.R.Admin = o
 		}
 	}
 	return nil
@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"context"
+	"time"
 
 	"github.com/bots-house/share-file-bot/core"
 	"github.com/bots-house/share-file-bot/store/postgres/dal"
@@ -19,27 +20,64 @@ func (store *DownloadStore) toRow(dwn *core.Download) *dal.Download {
 		ID:              int(dwn.ID),
 		UserID:          null.NewInt(int(dwn.UserID), dwn.UserID != 0),
 		FileID:          null.NewInt(int(dwn.FileID), dwn.FileID != 0),
+		BundleID:        null.NewInt(int(dwn.BundleID), dwn.BundleID != core.ZeroBundleID),
+		PlacementID:     null.NewInt(int(dwn.PlacementID), dwn.PlacementID != 0),
+		FileVersion:     null.NewInt(dwn.FileVersion, dwn.FileVersion != 0),
 		NewSubscription: dwn.NewSubscription,
+		Source:          dwn.Source.String(),
 		At:              dwn.At,
 	}
 }
 
-func (store *DownloadStore) fromRow(row *dal.Download) *core.Download {
+func (store *DownloadStore) fromRow(row *dal.Download, chatIDs []core.ChatID) (*core.Download, error) {
+	source, err := core.ParseDownloadSource(row.Source)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse download source")
+	}
+
 	return &core.Download{
 		ID:              core.DownloadID(row.ID),
 		UserID:          core.UserID(row.UserID.Int),
 		FileID:          core.FileID(row.FileID.Int),
+		BundleID:        core.BundleID(row.BundleID.Int),
+		PlacementID:     core.PlacementID(row.PlacementID.Int),
+		FileVersion:     row.FileVersion.Int,
 		NewSubscription: row.NewSubscription,
+		ChatIDs:         chatIDs,
+		Source:          source,
 		At:              row.At,
-	}
+	}, nil
 }
 
 func (store *DownloadStore) Add(ctx context.Context, dwn *core.Download) error {
 	row := store.toRow(dwn)
-	if err := store.insertOne(ctx, row); err != nil {
-		return errors.Wrap(err, "insert query")
+
+	if err := store.Txier(ctx, func(ctx context.Context) error {
+		if err := store.insertOne(ctx, row); err != nil {
+			return errors.Wrap(err, "insert query")
+		}
+
+		chats := make([]*dal.Chat, len(dwn.ChatIDs))
+		for i, id := range dwn.ChatIDs {
+			chats[i] = &dal.Chat{ID: int(id)}
+		}
+
+		if err := row.AddChats(ctx, store.getExecutor(ctx), false, chats...); err != nil {
+			return errors.Wrap(err, "insert chats")
+		}
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	result, err := store.fromRow(row, dwn.ChatIDs)
+	if err != nil {
+		return errors.Wrap(err, "from row")
 	}
-	*dwn = *store.fromRow(row)
+
+	*dwn = *result
+
 	return nil
 }
 
@@ -84,6 +122,37 @@ func (store *DownloadStore) GetFileStats(ctx context.Context, id core.FileID) (*
 	return result, nil
 }
 
+func (store *DownloadStore) GetBundleStats(ctx context.Context, id core.BundleID) (*core.FileDownloadStats, error) {
+	// every bundle download is stored as row per member file with same time,
+	// so one download of bundle is unique pair of user and time.
+	const query = `
+	select
+		count(distinct (user_id, at)) as total,
+		count(distinct user_id) as unique,
+		count(distinct (user_id, at)) filter (where new_subscription = true) as new_subscription,
+		count(distinct (user_id, at)) filter (where new_subscription = false) as with_subscription
+	from
+		download
+	where
+		bundle_id = $1
+    `
+
+	result := &core.FileDownloadStats{}
+
+	executor := store.getExecutor(ctx)
+
+	if err := executor.QueryRowContext(ctx, query, id).Scan(
+		&result.Total,
+		&result.Unique,
+		&result.NewSubscription,
+		&result.WithSubscription,
+	); err != nil {
+		return nil, errors.Wrap(err, "count downloads query")
+	}
+
+	return result, nil
+}
+
 func (store *DownloadStore) GetChatStats(ctx context.Context, id core.ChatID) (*core.ChatDownloadStats, error) {
 	const query = `
 		select
@@ -92,9 +161,9 @@ func (store *DownloadStore) GetChatStats(ctx context.Context, id core.ChatID) (*
 		from
 			download
 		inner join
-			file on download.file_id = file.id
+			download_chat on download_chat.download_id = download.id
 		where
-			file.restrictions_chat_id = $1
+			download_chat.chat_id = $1
     `
 
 	result := &core.ChatDownloadStats{}
@@ -117,6 +186,304 @@ func (store *DownloadStore) GetChatStats(ctx context.Context, id core.ChatID) (*
 	return result, nil
 }
 
+func (store *DownloadStore) GetFilePlacementStats(ctx context.Context, id core.FileID) ([]*core.PlacementDownloadStats, error) {
+	const query = `
+		select
+			coalesce(placement_id, 0) as placement_id,
+			count(user_id) as total,
+			count(distinct user_id) as unique
+		from
+			download
+		where
+			file_id = $1
+		group by
+			coalesce(placement_id, 0)
+		order by
+			placement_id
+	`
+
+	rows, err := store.getExecutor(ctx).QueryContext(ctx, query, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "query rows")
+	}
+	defer rows.Close()
+
+	result := []*core.PlacementDownloadStats{}
+
+	for rows.Next() {
+		item := &core.PlacementDownloadStats{}
+
+		if err := rows.Scan(
+			&item.PlacementID,
+			&item.Total,
+			&item.Unique,
+		); err != nil {
+			return nil, errors.Wrap(err, "scan row")
+		}
+
+		result = append(result, item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "rows error")
+	}
+
+	return result, nil
+}
+
+func (store *DownloadStore) GetFileVersionStats(ctx context.Context, id core.FileID) ([]*core.FileVersionDownloadStats, error) {
+	const query = `
+		select
+			coalesce(file_version, 0) as file_version,
+			count(user_id) as total,
+			count(distinct user_id) as unique
+		from
+			download
+		where
+			file_id = $1
+		group by
+			coalesce(file_version, 0)
+		order by
+			file_version
+	`
+
+	rows, err := store.getExecutor(ctx).QueryContext(ctx, query, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "query rows")
+	}
+	defer rows.Close()
+
+	result := []*core.FileVersionDownloadStats{}
+
+	for rows.Next() {
+		item := &core.FileVersionDownloadStats{}
+
+		if err := rows.Scan(
+			&item.Version,
+			&item.Total,
+			&item.Unique,
+		); err != nil {
+			return nil, errors.Wrap(err, "scan row")
+		}
+
+		result = append(result, item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "rows error")
+	}
+
+	return result, nil
+}
+
+// querySeries executes query of downloads series.
+// Query should accept id as $1, window as $2 and $3 and step in seconds as $4.
+func (store *DownloadStore) querySeries(
+	ctx context.Context,
+	query string,
+	id int,
+	series *core.DownloadSeriesQuery,
+) ([]*core.DownloadSeriesPoint, error) {
+	executor := store.getExecutor(ctx)
+
+	rows, err := executor.QueryContext(ctx, query,
+		id,
+		series.From,
+		series.To,
+		int(series.Step/time.Second),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "series query")
+	}
+	defer rows.Close()
+
+	result := []*core.DownloadSeriesPoint{}
+
+	for rows.Next() {
+		point := &core.DownloadSeriesPoint{}
+
+		if err := rows.Scan(
+			&point.At,
+			&point.Total,
+			&point.Unique,
+			&point.NewSubscription,
+		); err != nil {
+			return nil, errors.Wrap(err, "scan series row")
+		}
+
+		result = append(result, point)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "iterate series rows")
+	}
+
+	return result, nil
+}
+
+func (store *DownloadStore) GetFileSeries(
+	ctx context.Context,
+	id core.FileID,
+	series *core.DownloadSeriesQuery,
+) ([]*core.DownloadSeriesPoint, error) {
+	const query = `
+	select
+		bucket.at,
+		count(download.id) as total,
+		count(distinct download.user_id) as unique,
+		count(download.id) filter (where download.new_subscription = true) as new_subscription
+	from
+		generate_series($2::timestamptz, $3::timestamptz - interval '1 microsecond', $4::integer * interval '1 second') as bucket(at)
+	left join
+		download on download.file_id = $1 and
+		download.at >= bucket.at and
+		download.at < bucket.at + $4::integer * interval '1 second'
+	group by
+		bucket.at
+	order by
+		bucket.at
+    `
+
+	return store.querySeries(ctx, query, int(id), series)
+}
+
+func (store *DownloadStore) GetChatSeries(
+	ctx context.Context,
+	id core.ChatID,
+	series *core.DownloadSeriesQuery,
+) ([]*core.DownloadSeriesPoint, error) {
+	const query = `
+	select
+		bucket.at,
+		count(download.id) as total,
+		count(distinct download.user_id) as unique,
+		count(download.id) filter (where download.new_subscription = true) as new_subscription
+	from
+		generate_series($2::timestamptz, $3::timestamptz - interval '1 microsecond', $4::integer * interval '1 second') as bucket(at)
+	left join (
+		select
+			download.*
+		from
+			download
+		inner join
+			download_chat on download_chat.download_id = download.id
+		where
+			download_chat.chat_id = $1
+	) as download on
+		download.at >= bucket.at and
+		download.at < bucket.at + $4::integer * interval '1 second'
+	group by
+		bucket.at
+	order by
+		bucket.at
+    `
+
+	return store.querySeries(ctx, query, int(id), series)
+}
+
+// queryLog executes query of downloads log.
+// Query should accept id as $1, cursor as $2 and limit as $3.
+func (store *DownloadStore) queryLog(
+	ctx context.Context,
+	query string,
+	id int,
+	after core.DownloadID,
+	limit int,
+) ([]*core.DownloadLogEntry, error) {
+	executor := store.getExecutor(ctx)
+
+	rows, err := executor.QueryContext(ctx, query, id, int(after), limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "log query")
+	}
+	defer rows.Close()
+
+	result := make([]*core.DownloadLogEntry, 0, limit)
+
+	for rows.Next() {
+		entry := &core.DownloadLogEntry{}
+		userID := null.Int{}
+
+		if err := rows.Scan(
+			&entry.ID,
+			&userID,
+			&entry.Username,
+			&entry.At,
+			&entry.NewSubscription,
+		); err != nil {
+			return nil, errors.Wrap(err, "scan log row")
+		}
+
+		entry.UserID = core.UserID(userID.Int)
+
+		result = append(result, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "iterate log rows")
+	}
+
+	return result, nil
+}
+
+func (store *DownloadStore) GetFileLog(
+	ctx context.Context,
+	id core.FileID,
+	after core.DownloadID,
+	limit int,
+) ([]*core.DownloadLogEntry, error) {
+	const query = `
+	select
+		download.id,
+		download.user_id,
+		"user".username,
+		download.at,
+		download.new_subscription
+	from
+		download
+	left join
+		"user" on "user".id = download.user_id
+	where
+		download.file_id = $1 and
+		download.id > $2
+	order by
+		download.id
+	limit $3
+    `
+
+	return store.queryLog(ctx, query, int(id), after, limit)
+}
+
+func (store *DownloadStore) GetChatLog(
+	ctx context.Context,
+	id core.ChatID,
+	after core.DownloadID,
+	limit int,
+) ([]*core.DownloadLogEntry, error) {
+	const query = `
+	select
+		download.id,
+		download.user_id,
+		"user".username,
+		download.at,
+		download.new_subscription
+	from
+		download
+	inner join
+		download_chat on download_chat.download_id = download.id
+	left join
+		"user" on "user".id = download.user_id
+	where
+		download_chat.chat_id = $1 and
+		download.id > $2
+	order by
+		download.id
+	limit $3
+    `
+
+	return store.queryLog(ctx, query, int(id), after, limit)
+}
+
 type downloadStoreQuery struct {
 	mods  []qm.QueryMod
 	store *DownloadStore
@@ -127,6 +494,11 @@ func (dsq *downloadStoreQuery) FileID(id core.FileID) core.DownloadStoreQuery {
 	return dsq
 }
 
+func (dsq *downloadStoreQuery) UserID(id core.UserID) core.DownloadStoreQuery {
+	dsq.mods = append(dsq.mods, dal.DownloadWhere.UserID.EQ(null.IntFrom(int(id))))
+	return dsq
+}
+
 func (dsq *downloadStoreQuery) Count(ctx context.Context) (int, error) {
 	count, err := dal.
 		Downloads(dsq.mods...).
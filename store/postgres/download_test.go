@@ -0,0 +1,88 @@
+package postgres
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDownloadStore_GetFileSeries(t *testing.T) {
+	ctx, pg := newPostgres(t)
+	store := pg.Download()
+
+	user := newFakeUserInStore(t, pg)
+	chat := newFakeChatInStore(t, pg, user, -1001)
+
+	file := newFakeFileInStore(t, pg, user, "file.txt")
+	file.Restriction.ToggleChat(chat.ID)
+	require.NoError(t, pg.File().Update(ctx, file))
+
+	from := time.Date(2020, time.November, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, at := range []time.Time{
+		from.Add(time.Hour),
+		from.Add(2 * time.Hour),
+		from.Add(50 * time.Hour),
+	} {
+		dwn := core.NewDownload(file.ID, user.ID)
+		dwn.At = at
+		dwn.SetNewSubscription(true)
+		require.NoError(t, store.Add(ctx, dwn))
+	}
+
+	query := &core.DownloadSeriesQuery{
+		From: from,
+		To:   from.Add(3 * 24 * time.Hour),
+		Step: 24 * time.Hour,
+	}
+
+	series, err := store.GetFileSeries(ctx, file.ID, query)
+	require.NoError(t, err)
+	require.Len(t, series, 3)
+
+	require.True(t, from.Equal(series[0].At))
+	require.Equal(t, 2, series[0].Total)
+	require.Equal(t, 1, series[0].Unique)
+	require.Equal(t, 2, series[0].NewSubscription)
+
+	require.Zero(t, series[1].Total)
+	require.Equal(t, 1, series[2].Total)
+
+	series, err = store.GetChatSeries(ctx, chat.ID, query)
+	require.NoError(t, err)
+	require.Len(t, series, 3)
+	require.Equal(t, 2, series[0].Total)
+	require.Equal(t, 1, series[2].Total)
+}
+
+func TestDownloadStore_GetFileLog(t *testing.T) {
+	ctx, pg := newPostgres(t)
+	store := pg.Download()
+
+	user := newFakeUserInStore(t, pg)
+	chat := newFakeChatInStore(t, pg, user, -1001)
+
+	file := newFakeFileInStore(t, pg, user, "file.txt")
+	file.Restriction.ToggleChat(chat.ID)
+	require.NoError(t, pg.File().Update(ctx, file))
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, store.Add(ctx, core.NewDownload(file.ID, user.ID)))
+	}
+
+	page, err := store.GetFileLog(ctx, file.ID, 0, 2)
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+	require.Equal(t, user.ID, page[0].UserID)
+	require.Equal(t, user.Username, page[0].Username)
+
+	page, err = store.GetFileLog(ctx, file.ID, page[1].ID, 2)
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+
+	page, err = store.GetChatLog(ctx, chat.ID, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, page, 3)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"strings"
 
 	"github.com/bots-house/share-file-bot/core"
 	"github.com/bots-house/share-file-bot/pkg/log"
@@ -23,70 +24,160 @@ func (store *FileStore) toRow(file *core.File) (*dal.File, error) {
 		return nil, errors.Wrap(err, "unmarshal metadata")
 	}
 
+	entities := file.CaptionEntities
+	if entities == nil {
+		entities = []core.TextEntity{}
+	}
+
+	captionEntities, err := json.Marshal(entities)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal caption entities")
+	}
+
 	return &dal.File{
-		ID:                  int(file.ID),
-		FileID:              file.TelegramID,
-		PublicID:            file.PublicID,
-		Caption:             file.Caption,
-		MimeType:            file.MIMEType,
-		Kind:                file.Kind.String(),
-		RestrictionsChatID:  null.NewInt(int(file.Restriction.ChatID), file.Restriction.ChatID != 0),
-		Metadata:            string(metadata),
-		Size:                file.Size,
-		Name:                file.Name,
-		IsViolatesCopyright: file.IsViolatesCopyright,
-		OwnerID:             int(file.OwnerID),
-		LinkedPostURI:       file.LinkedPostURI,
-		CreatedAt:           file.CreatedAt,
+		ID:                              int(file.ID),
+		FileID:                          null.NewString(file.TelegramID, file.TelegramID != ""),
+		PublicID:                        file.PublicID,
+		Caption:                         file.Caption,
+		CaptionEntities:                 string(captionEntities),
+		MimeType:                        file.MIMEType,
+		Kind:                            file.Kind.String(),
+		RestrictionsChatPolicy:          file.Restriction.ChatPolicy.String(),
+		RestrictionsMaxDownloads:        null.NewInt(file.Restriction.MaxDownloads, file.Restriction.HasMaxDownloads()),
+		RestrictionsMaxDownloadsPerUser: null.NewInt(file.Restriction.MaxDownloadsPerUser, file.Restriction.HasMaxDownloadsPerUser()),
+		RestrictionsExpiresAt:           file.Restriction.ExpiresAt,
+		RestrictionsAvailableFrom:       file.Restriction.AvailableFrom,
+		RestrictionsPasswordHash:        null.NewString(file.Restriction.PasswordHash, file.Restriction.HasPassword()),
+		Metadata:                        string(metadata),
+		Size:                            file.Size,
+		Name:                            file.Name,
+		IsViolatesCopyright:             file.IsViolatesCopyright,
+		IsBlocked:                       file.IsBlocked,
+		OwnerID:                         int(file.OwnerID),
+		LinkedPostURI:                   file.LinkedPostURI,
+		Version:                         file.Version,
+		CreatedAt:                       file.CreatedAt,
 	}, nil
 }
 
-func (store *FileStore) fromRows(rows []*dal.File) ([]*core.File, error) {
+func (store *FileStore) fromRows(ctx context.Context, rows []*dal.File) ([]*core.File, error) {
+	if len(rows) == 0 {
+		return []*core.File{}, nil
+	}
+
+	ids := make([]int, len(rows))
+	for i, row := range rows {
+		ids[i] = row.ID
+	}
+
+	chats, err := store.getRestrictionChatIDs(ctx, ids...)
+	if err != nil {
+		return nil, errors.Wrap(err, "get restriction chats")
+	}
+
 	result := make([]*core.File, len(rows))
 
 	for i, row := range rows {
-		chat, err := store.fromRow(row)
+		file, err := store.fromRow(row, chats[core.FileID(row.ID)])
 		if err != nil {
 			return nil, errors.Wrapf(err, "from row #%d", i)
 		}
-		result[i] = chat
+		result[i] = file
 	}
 
 	return result, nil
 }
 
-func (store *FileStore) fromRow(row *dal.File) (*core.File, error) {
+func (store *FileStore) fromRow(row *dal.File, chatIDs []core.ChatID) (*core.File, error) {
 	kind, err := core.ParseKind(row.Kind)
 	if err != nil {
 		return nil, err
 	}
 
+	policy, err := core.ParseChatPolicy(row.RestrictionsChatPolicy)
+	if err != nil {
+		return nil, err
+	}
+
 	var metadata core.Metadata
 
 	if err := json.Unmarshal([]byte(row.Metadata), &metadata); err != nil {
 		return nil, errors.Wrap(err, "unmarshal metadata")
 	}
 
+	var captionEntities []core.TextEntity
+
+	if err := json.Unmarshal([]byte(row.CaptionEntities), &captionEntities); err != nil {
+		return nil, errors.Wrap(err, "unmarshal caption entities")
+	}
+
 	return &core.File{
 		ID:         core.FileID(row.ID),
-		TelegramID: row.FileID,
+		TelegramID: row.FileID.String,
 		PublicID:   row.PublicID,
 		Caption:    row.Caption,
 		Kind:       kind,
 		Metadata:   metadata,
 		MIMEType:   row.MimeType,
 		Restriction: core.DownloadRestrictions{
-			ChatID: core.ChatID(row.RestrictionsChatID.Int),
+			ChatRestrictions: core.ChatRestrictions{
+				ChatIDs:    chatIDs,
+				ChatPolicy: policy,
+			},
+			MaxDownloads:        row.RestrictionsMaxDownloads.Int,
+			MaxDownloadsPerUser: row.RestrictionsMaxDownloadsPerUser.Int,
+			ExpiresAt:           row.RestrictionsExpiresAt,
+			AvailableFrom:       row.RestrictionsAvailableFrom,
+			PasswordHash:        row.RestrictionsPasswordHash.String,
 		},
 		Size:                row.Size,
 		Name:                row.Name,
 		OwnerID:             core.UserID(row.OwnerID),
 		IsViolatesCopyright: row.IsViolatesCopyright,
+		IsBlocked:           row.IsBlocked,
 		LinkedPostURI:       row.LinkedPostURI,
+		CaptionEntities:     captionEntities,
+		Version:             row.Version,
 		CreatedAt:           row.CreatedAt,
 	}, nil
 }
 
+// getRestrictionChatIDs returns ordered restriction chat ids of each file.
+func (store *FileStore) getRestrictionChatIDs(ctx context.Context, ids ...int) (map[core.FileID][]core.ChatID, error) {
+	rows, err := dal.FileRestrictionChats(
+		dal.FileRestrictionChatWhere.FileID.IN(ids),
+		qm.OrderBy(dal.FileRestrictionChatColumns.FileID+", "+dal.FileRestrictionChatColumns.Position),
+	).All(ctx, store.getExecutor(ctx))
+	if err != nil {
+		return nil, errors.Wrap(err, "select query")
+	}
+
+	result := make(map[core.FileID][]core.ChatID, len(ids))
+
+	for _, row := range rows {
+		id := core.FileID(row.FileID)
+		result[id] = append(result[id], core.ChatID(row.ChatID))
+	}
+
+	return result, nil
+}
+
+func (store *FileStore) insertRestrictionChats(ctx context.Context, id int, chatIDs []core.ChatID) error {
+	for i, chatID := range chatIDs {
+		row := &dal.FileRestrictionChat{
+			FileID:   id,
+			ChatID:   int(chatID),
+			Position: i,
+		}
+
+		if err := store.insertOne(ctx, row); err != nil {
+			return errors.Wrapf(err, "insert chat #%d", i)
+		}
+	}
+
+	return nil
+}
+
 func (store *FileStore) Add(ctx context.Context, file *core.File) error {
 	for {
 		if err := store.add(ctx, file); err != nil {
@@ -114,7 +205,11 @@ func (store *FileStore) add(ctx context.Context, file *core.File) error {
 		return errors.Wrap(err, "insert query")
 	}
 
-	newFile, err := store.fromRow(row)
+	if err := store.insertRestrictionChats(ctx, row.ID, file.Restriction.ChatIDs); err != nil {
+		return errors.Wrap(err, "insert restriction chats")
+	}
+
+	newFile, err := store.fromRow(row, file.Restriction.ChatIDs)
 	if err != nil {
 		return errors.Wrap(err, "from row")
 	}
@@ -130,11 +225,23 @@ func (store *FileStore) Update(ctx context.Context, file *core.File) error {
 		return errors.Wrap(err, "to row")
 	}
 
-	if err := store.updateOne(ctx, row, core.ErrFileNotFound); err != nil {
-		return errors.Wrap(err, "update one")
-	}
+	return store.Txier(ctx, func(ctx context.Context) error {
+		if err := store.updateOne(ctx, row, core.ErrFileNotFound); err != nil {
+			return errors.Wrap(err, "update one")
+		}
 
-	return nil
+		if _, err := dal.FileRestrictionChats(
+			dal.FileRestrictionChatWhere.FileID.EQ(row.ID),
+		).DeleteAll(ctx, store.getExecutor(ctx)); err != nil {
+			return errors.Wrap(err, "delete restriction chats")
+		}
+
+		if err := store.insertRestrictionChats(ctx, row.ID, file.Restriction.ChatIDs); err != nil {
+			return errors.Wrap(err, "insert restriction chats")
+		}
+
+		return nil
+	})
 }
 
 func (store *FileStore) Query() core.FileStoreQuery {
@@ -144,16 +251,81 @@ func (store *FileStore) Query() core.FileStoreQuery {
 type fileStoreQuery struct {
 	mods  []qm.QueryMod
 	store *FileStore
+
+	// order and pagination is not applicable to count and delete
+	listMods []qm.QueryMod
 }
 
-func (fsq *fileStoreQuery) ID(id core.FileID) core.FileStoreQuery {
-	fsq.mods = append(fsq.mods, dal.FileWhere.ID.EQ(int(id)))
+func (fsq *fileStoreQuery) ID(ids ...core.FileID) core.FileStoreQuery {
+	idsInt := make([]int, len(ids))
+	for i, v := range ids {
+		idsInt[i] = int(v)
+	}
+
+	fsq.mods = append(fsq.mods, dal.FileWhere.ID.IN(idsInt))
 	return fsq
 }
 
 func (fsq *fileStoreQuery) RestrictionChatID(id core.ChatID) core.FileStoreQuery {
-	v := null.NewInt(int(id), id != core.ZeroChatID)
-	fsq.mods = append(fsq.mods, dal.FileWhere.RestrictionsChatID.EQ(v))
+	fsq.mods = append(fsq.mods, qm.Where(
+		"exists (select 1 from file_restriction_chat where file_restriction_chat.file_id = file.id and file_restriction_chat.chat_id = ?)",
+		int(id),
+	))
+	return fsq
+}
+
+func (fsq *fileStoreQuery) Kind(kinds ...core.Kind) core.FileStoreQuery {
+	values := make([]string, len(kinds))
+	for i, kind := range kinds {
+		values[i] = kind.String()
+	}
+
+	fsq.mods = append(fsq.mods, dal.FileWhere.Kind.IN(values))
+	return fsq
+}
+
+func (fsq *fileStoreQuery) Search(text string) core.FileStoreQuery {
+	pattern := "%" + escapeLike(text) + "%"
+
+	fsq.mods = append(fsq.mods, qm.Where(
+		"(file.name ilike ? or file.caption ilike ?)",
+		pattern,
+		pattern,
+	))
+	return fsq
+}
+
+func (fsq *fileStoreQuery) NotModerated() core.FileStoreQuery {
+	fsq.mods = append(fsq.mods, qm.Where(
+		"(file.is_blocked = false and file.is_violates_copyright is not true)",
+	))
+	return fsq
+}
+
+func (fsq *fileStoreQuery) OrderBy(order core.FileOrder) core.FileStoreQuery {
+	switch order {
+	case core.FileOrderDownloads:
+		fsq.listMods = append(fsq.listMods, qm.OrderBy(
+			"(select count(*) from download where download.file_id = file.id) desc, file.id desc",
+		))
+	default:
+		fsq.listMods = append(fsq.listMods, qm.OrderBy("file.created_at desc, file.id desc"))
+	}
+	return fsq
+}
+
+func (fsq *fileStoreQuery) Offset(n int) core.FileStoreQuery {
+	fsq.listMods = append(fsq.listMods, qm.Offset(n))
+	return fsq
+}
+
+func (fsq *fileStoreQuery) Limit(n int) core.FileStoreQuery {
+	fsq.listMods = append(fsq.listMods, qm.Limit(n))
+	return fsq
+}
+
+func (fsq *fileStoreQuery) ForUpdate() core.FileStoreQuery {
+	fsq.listMods = append(fsq.listMods, qm.For("update"))
 	return fsq
 }
 
@@ -167,26 +339,37 @@ func (fsq *fileStoreQuery) OwnerID(id core.UserID) core.FileStoreQuery {
 	return fsq
 }
 
+func (fsq *fileStoreQuery) getListMods() []qm.QueryMod {
+	mods := make([]qm.QueryMod, 0, len(fsq.mods)+len(fsq.listMods))
+	mods = append(mods, fsq.mods...)
+	return append(mods, fsq.listMods...)
+}
+
 func (fsq *fileStoreQuery) One(ctx context.Context) (*core.File, error) {
 	executor := fsq.store.getExecutor(ctx)
 
-	file, err := dal.Files(fsq.mods...).One(ctx, executor)
+	file, err := dal.Files(fsq.getListMods()...).One(ctx, executor)
 	if err == sql.ErrNoRows {
 		return nil, core.ErrFileNotFound
 	} else if err != nil {
 		return nil, err
 	}
 
-	return fsq.store.fromRow(file)
+	chats, err := fsq.store.getRestrictionChatIDs(ctx, file.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "get restriction chats")
+	}
+
+	return fsq.store.fromRow(file, chats[core.FileID(file.ID)])
 }
 
 func (fsq *fileStoreQuery) All(ctx context.Context) ([]*core.File, error) {
 	executor := fsq.store.getExecutor(ctx)
-	rows, err := dal.Files(fsq.mods...).All(ctx, executor)
+	rows, err := dal.Files(fsq.getListMods()...).All(ctx, executor)
 	if err != nil {
 		return nil, err
 	}
-	return fsq.store.fromRows(rows)
+	return fsq.store.fromRows(ctx, rows)
 }
 
 func (fsq *fileStoreQuery) Delete(ctx context.Context) error {
@@ -216,3 +399,10 @@ func (fsq *fileStoreQuery) Count(ctx context.Context) (int, error) {
 
 	return int(count), nil
 }
+
+var likeReplacer = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// escapeLike escapes special chars of like pattern.
+func escapeLike(v string) string {
+	return likeReplacer.Replace(v)
+}
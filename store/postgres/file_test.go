@@ -0,0 +1,139 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/stretchr/testify/require"
+)
+
+func newFakeChatInStore(t *testing.T, pg *Postgres, owner *core.User, telegramID int64) *core.Chat {
+	t.Helper()
+
+	chat := core.NewChat(telegramID, "test", core.ChatTypeChannel, owner.ID)
+
+	if err := pg.Chat().Add(context.Background(), chat); err != nil {
+		t.Fatalf("can't add fake chat to store: %v", err)
+	}
+
+	return chat
+}
+
+func TestFileStore_RestrictionChats(t *testing.T) {
+	ctx, pg := newPostgres(t)
+	store := pg.File()
+
+	user := newFakeUserInStore(t, pg)
+
+	first := newFakeChatInStore(t, pg, user, -1001)
+	second := newFakeChatInStore(t, pg, user, -1002)
+
+	file := newFakeFileInStore(t, pg, user, "file.txt")
+	require.Empty(t, file.Restriction.ChatIDs)
+
+	file.Restriction.ToggleChat(second.ID)
+	file.Restriction.ToggleChat(first.ID)
+	file.Restriction.ChatPolicy = core.ChatPolicyAny
+
+	require.NoError(t, store.Update(ctx, file))
+
+	found, err := store.Query().ID(file.ID).One(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []core.ChatID{second.ID, first.ID}, found.Restriction.ChatIDs)
+	require.Equal(t, core.ChatPolicyAny, found.Restriction.ChatPolicy)
+
+	count, err := store.Query().RestrictionChatID(first.ID).Count(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	// remove chat from restriction
+	found.Restriction.ToggleChat(second.ID)
+	require.NoError(t, store.Update(ctx, found))
+
+	count, err = store.Query().RestrictionChatID(second.ID).Count(ctx)
+	require.NoError(t, err)
+	require.Zero(t, count)
+
+	all, err := store.Query().OwnerID(user.ID).All(ctx)
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+	require.Equal(t, []core.ChatID{first.ID}, all[0].Restriction.ChatIDs)
+}
+
+func TestFileStore_QueryLibrary(t *testing.T) {
+	ctx, pg := newPostgres(t)
+	store := pg.File()
+
+	user := newFakeUserInStore(t, pg)
+
+	report := newFakeFileInStore(t, pg, user, "Report 100%.pdf")
+	notes := newFakeFileInStore(t, pg, user, "notes.txt")
+	photo := newFakeFileInStore(t, pg, user, "photo.jpg")
+
+	photo.Kind = core.KindPhoto
+	require.NoError(t, store.Update(ctx, photo))
+
+	// notes is most downloaded
+	require.NoError(t, pg.Download().Add(ctx, core.NewDownload(notes.ID, user.ID)))
+	require.NoError(t, pg.Download().Add(ctx, core.NewDownload(notes.ID, user.ID)))
+	require.NoError(t, pg.Download().Add(ctx, core.NewDownload(report.ID, user.ID)))
+
+	ids := func(files []*core.File) []core.FileID {
+		result := make([]core.FileID, len(files))
+		for i, file := range files {
+			result[i] = file.ID
+		}
+		return result
+	}
+
+	t.Run("Kind", func(t *testing.T) {
+		files, err := store.Query().OwnerID(user.ID).Kind(core.KindPhoto).All(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []core.FileID{photo.ID}, ids(files))
+	})
+
+	t.Run("Search", func(t *testing.T) {
+		files, err := store.Query().OwnerID(user.ID).Search("REPORT").All(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []core.FileID{report.ID}, ids(files))
+
+		// like wildcards are escaped
+		count, err := store.Query().OwnerID(user.ID).Search("0%.").Count(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+
+		count, err = store.Query().OwnerID(user.ID).Search("%").Count(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+	})
+
+	t.Run("OrderByCreatedAt", func(t *testing.T) {
+		files, err := store.Query().OwnerID(user.ID).OrderBy(core.FileOrderCreatedAt).All(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []core.FileID{photo.ID, notes.ID, report.ID}, ids(files))
+	})
+
+	t.Run("OrderByDownloads", func(t *testing.T) {
+		files, err := store.Query().OwnerID(user.ID).OrderBy(core.FileOrderDownloads).All(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []core.FileID{notes.ID, report.ID, photo.ID}, ids(files))
+	})
+
+	t.Run("Page", func(t *testing.T) {
+		query := store.Query().
+			OwnerID(user.ID).
+			OrderBy(core.FileOrderCreatedAt).
+			Offset(1).
+			Limit(1)
+
+		files, err := query.All(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []core.FileID{notes.ID}, ids(files))
+
+		// pagination is not applied to count
+		count, err := query.Count(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 3, count)
+	})
+}
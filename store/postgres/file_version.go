@@ -0,0 +1,159 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/store/postgres/dal"
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type FileVersionStore struct {
+	BaseStore
+}
+
+func (store *FileVersionStore) toRow(version *core.FileVersion) (*dal.FileVersion, error) {
+	metadata, err := json.Marshal(version.Metadata)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal metadata")
+	}
+
+	return &dal.FileVersion{
+		ID:         int(version.ID),
+		FileID:     int(version.FileID),
+		Version:    version.Version,
+		TelegramID: null.NewString(version.TelegramID, version.TelegramID != ""),
+		Kind:       version.Kind.String(),
+		MimeType:   version.MIMEType,
+		Name:       version.Name,
+		Size:       version.Size,
+		Metadata:   string(metadata),
+		ReplacedAt: version.ReplacedAt,
+	}, nil
+}
+
+func (store *FileVersionStore) fromRow(row *dal.FileVersion) (*core.FileVersion, error) {
+	kind, err := core.ParseKind(row.Kind)
+	if err != nil {
+		return nil, err
+	}
+
+	var metadata core.Metadata
+
+	if err := json.Unmarshal([]byte(row.Metadata), &metadata); err != nil {
+		return nil, errors.Wrap(err, "unmarshal metadata")
+	}
+
+	return &core.FileVersion{
+		ID:         core.FileVersionID(row.ID),
+		FileID:     core.FileID(row.FileID),
+		Version:    row.Version,
+		TelegramID: row.TelegramID.String,
+		Kind:       kind,
+		MIMEType:   row.MimeType,
+		Name:       row.Name,
+		Size:       row.Size,
+		Metadata:   metadata,
+		ReplacedAt: row.ReplacedAt,
+	}, nil
+}
+
+func (store *FileVersionStore) Add(ctx context.Context, version *core.FileVersion) error {
+	row, err := store.toRow(version)
+	if err != nil {
+		return errors.Wrap(err, "to row")
+	}
+
+	if err := store.insertOne(ctx, row); err != nil {
+		return errors.Wrap(err, "insert query")
+	}
+
+	result, err := store.fromRow(row)
+	if err != nil {
+		return errors.Wrap(err, "from row")
+	}
+
+	*version = *result
+
+	return nil
+}
+
+func (store *FileVersionStore) Query() core.FileVersionStoreQuery {
+	return &fileVersionStoreQuery{store: store}
+}
+
+type fileVersionStoreQuery struct {
+	mods []qm.QueryMod
+
+	// mods applied only to All
+	listMods []qm.QueryMod
+
+	store *FileVersionStore
+}
+
+func (fvsq *fileVersionStoreQuery) ID(id core.FileVersionID) core.FileVersionStoreQuery {
+	fvsq.mods = append(fvsq.mods, dal.FileVersionWhere.ID.EQ(int(id)))
+	return fvsq
+}
+
+func (fvsq *fileVersionStoreQuery) FileID(id core.FileID) core.FileVersionStoreQuery {
+	fvsq.mods = append(fvsq.mods, dal.FileVersionWhere.FileID.EQ(int(id)))
+	return fvsq
+}
+
+func (fvsq *fileVersionStoreQuery) Limit(n int) core.FileVersionStoreQuery {
+	fvsq.listMods = append(fvsq.listMods, qm.Limit(n))
+	return fvsq
+}
+
+func (fvsq *fileVersionStoreQuery) getOrderMods() []qm.QueryMod {
+	mods := make([]qm.QueryMod, 0, len(fvsq.mods)+1)
+	mods = append(mods, fvsq.mods...)
+	return append(mods, qm.OrderBy(dal.FileVersionColumns.ID+" desc"))
+}
+
+func (fvsq *fileVersionStoreQuery) One(ctx context.Context) (*core.FileVersion, error) {
+	row, err := dal.FileVersions(fvsq.getOrderMods()...).One(ctx, fvsq.store.getExecutor(ctx))
+	if err == sql.ErrNoRows {
+		return nil, core.ErrFileVersionNotFound
+	} else if err != nil {
+		return nil, errors.Wrap(err, "select query")
+	}
+
+	return fvsq.store.fromRow(row)
+}
+
+func (fvsq *fileVersionStoreQuery) All(ctx context.Context) ([]*core.FileVersion, error) {
+	mods := append(fvsq.getOrderMods(), fvsq.listMods...)
+
+	rows, err := dal.FileVersions(mods...).All(ctx, fvsq.store.getExecutor(ctx))
+	if err != nil {
+		return nil, errors.Wrap(err, "select query")
+	}
+
+	result := make([]*core.FileVersion, len(rows))
+
+	for i, row := range rows {
+		version, err := fvsq.store.fromRow(row)
+		if err != nil {
+			return nil, errors.Wrapf(err, "from row #%d", i)
+		}
+
+		result[i] = version
+	}
+
+	return result, nil
+}
+
+func (fvsq *fileVersionStoreQuery) Count(ctx context.Context) (int, error) {
+	count, err := dal.FileVersions(fvsq.mods...).Count(ctx, fvsq.store.getExecutor(ctx))
+	if err != nil {
+		return 0, errors.Wrap(err, "count query")
+	}
+
+	return int(count), nil
+}
@@ -0,0 +1,32 @@
+package migrations
+
+func init() {
+	include(14, query(`
+		create table bundle (
+			id serial primary key,
+			public_id varchar(50) not null unique,
+			caption text,
+			restrictions_chat_id integer references chat(id) on delete set null,
+			owner_id integer not null references "user"(id) on delete cascade,
+			created_at timestamptz not null
+		);
+
+		create index bundle_owner_id_idx on bundle(owner_id);
+
+		create table bundle_file (
+			bundle_id integer not null references bundle(id) on delete cascade,
+			file_id integer not null references file(id) on delete cascade,
+			position integer not null,
+
+			primary key (bundle_id, file_id)
+		);
+
+		alter table download
+			add column
+				bundle_id integer references bundle(id) on delete set null;
+    `), query(`
+		alter table download drop column bundle_id;
+		drop table bundle_file;
+		drop table bundle;
+    `))
+}
@@ -0,0 +1,17 @@
+package migrations
+
+func init() {
+	include(15, query(`
+		alter table "file"
+			add column restrictions_max_downloads integer,
+			add column restrictions_max_downloads_per_user integer,
+			add column restrictions_expires_at timestamptz,
+			add column restrictions_available_from timestamptz;
+    `), query(`
+		alter table "file"
+			drop column restrictions_max_downloads,
+			drop column restrictions_max_downloads_per_user,
+			drop column restrictions_expires_at,
+			drop column restrictions_available_from;
+    `))
+}
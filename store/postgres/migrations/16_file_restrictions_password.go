@@ -0,0 +1,9 @@
+package migrations
+
+func init() {
+	include(16, query(`
+		alter table "file" add column restrictions_password_hash text;
+    `), query(`
+		alter table "file" drop column restrictions_password_hash;
+    `))
+}
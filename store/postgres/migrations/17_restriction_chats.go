@@ -0,0 +1,65 @@
+package migrations
+
+func init() {
+	include(17, query(`
+		create type chat_policy as enum ('All', 'Any');
+
+		create table file_restriction_chat (
+			file_id integer not null references file(id) on delete cascade,
+			chat_id integer not null references chat(id) on delete cascade,
+			position integer not null,
+
+			primary key (file_id, chat_id)
+		);
+
+		create index file_restriction_chat_chat_id_idx on file_restriction_chat(chat_id);
+
+		create table bundle_restriction_chat (
+			bundle_id integer not null references bundle(id) on delete cascade,
+			chat_id integer not null references chat(id) on delete cascade,
+			position integer not null,
+
+			primary key (bundle_id, chat_id)
+		);
+
+		insert into file_restriction_chat (file_id, chat_id, position)
+			select id, restrictions_chat_id, 0 from file where restrictions_chat_id is not null;
+
+		insert into bundle_restriction_chat (bundle_id, chat_id, position)
+			select id, restrictions_chat_id, 0 from bundle where restrictions_chat_id is not null;
+
+		alter table file
+			drop column restrictions_chat_id,
+			add column restrictions_chat_policy chat_policy not null default 'All';
+
+		alter table bundle
+			drop column restrictions_chat_id,
+			add column restrictions_chat_policy chat_policy not null default 'All';
+    `), query(`
+		alter table file
+			drop column restrictions_chat_policy,
+			add column restrictions_chat_id integer references chat(id) on delete set null;
+
+		alter table bundle
+			drop column restrictions_chat_policy,
+			add column restrictions_chat_id integer references chat(id) on delete set null;
+
+		update file set restrictions_chat_id = (
+			select chat_id from file_restriction_chat
+			where file_id = file.id
+			order by position
+			limit 1
+		);
+
+		update bundle set restrictions_chat_id = (
+			select chat_id from bundle_restriction_chat
+			where bundle_id = bundle.id
+			order by position
+			limit 1
+		);
+
+		drop table bundle_restriction_chat;
+		drop table file_restriction_chat;
+		drop type chat_policy;
+    `))
+}
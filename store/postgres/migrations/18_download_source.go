@@ -0,0 +1,13 @@
+package migrations
+
+func init() {
+	include(18, query(`
+		create type download_source as enum ('Link', 'Inline');
+
+		alter table download add column source download_source not null default 'Link';
+    `), query(`
+		alter table download drop column source;
+
+		drop type download_source;
+    `))
+}
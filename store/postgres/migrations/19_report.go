@@ -0,0 +1,36 @@
+package migrations
+
+func init() {
+	include(19, query(`
+		create type report_status as enum (
+			'Pending',
+			'Approved',
+			'Rejected',
+			'Appealed',
+			'AppealApproved',
+			'AppealRejected'
+		);
+
+		create table report (
+			id serial primary key,
+			file_id integer not null references file(id) on delete cascade,
+			reporter_id integer references "user"(id) on delete set null,
+			reason text not null,
+			status report_status not null default 'Pending',
+			created_at timestamp with time zone not null,
+			resolved_by integer references "user"(id) on delete set null,
+			resolved_at timestamp with time zone,
+			appeal text,
+			appealed_at timestamp with time zone,
+			appeal_resolved_by integer references "user"(id) on delete set null,
+			appeal_resolved_at timestamp with time zone
+		);
+
+		create index report_file_id_idx on report(file_id);
+		create index report_status_idx on report(status);
+    `), query(`
+		drop table report;
+
+		drop type report_status;
+    `))
+}
@@ -0,0 +1,36 @@
+package migrations
+
+func init() {
+	include(20, query(`
+		alter table "user" add column is_banned boolean not null default false;
+
+		alter table "file" add column is_blocked boolean not null default false;
+
+		create type audit_action as enum (
+			'FileBlock',
+			'FileUnblock',
+			'UserBan',
+			'UserUnban'
+		);
+
+		create table audit_log (
+			id serial primary key,
+			admin_id integer not null references "user"(id),
+			action audit_action not null,
+			user_id integer,
+			file_id integer,
+			created_at timestamp with time zone not null
+		);
+
+		create index audit_log_user_id_idx on audit_log(user_id);
+		create index audit_log_file_id_idx on audit_log(file_id);
+    `), query(`
+		drop table audit_log;
+
+		drop type audit_action;
+
+		alter table "file" drop column is_blocked;
+
+		alter table "user" drop column is_banned;
+    `))
+}
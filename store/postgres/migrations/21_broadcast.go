@@ -0,0 +1,61 @@
+package migrations
+
+func init() {
+	include(21, query(`
+		alter table "user" add column bot_blocked_at timestamp with time zone;
+
+		create type broadcast_status as enum (
+			'Draft',
+			'Running',
+			'Done',
+			'Canceled'
+		);
+
+		create table broadcast (
+			id serial primary key,
+			author_id integer not null references "user"(id),
+			from_chat_id bigint not null,
+			message_id integer not null,
+			buttons jsonb not null default '[]',
+			segment_ref varchar(60),
+			segment_language varchar(10),
+			segment_joined_after timestamp with time zone,
+			status broadcast_status not null,
+			last_user_id integer not null default 0,
+			total integer not null default 0,
+			delivered integer not null default 0,
+			blocked integer not null default 0,
+			failed integer not null default 0,
+			created_at timestamp with time zone not null,
+			started_at timestamp with time zone,
+			finished_at timestamp with time zone
+		);
+
+		create index broadcast_status_idx on broadcast(status);
+
+		create type broadcast_delivery_status as enum (
+			'Delivered',
+			'Blocked',
+			'Failed'
+		);
+
+		create table broadcast_delivery (
+			broadcast_id integer not null references broadcast(id) on delete cascade,
+			user_id integer not null references "user"(id) on delete cascade,
+			status broadcast_delivery_status not null,
+			error text,
+			created_at timestamp with time zone not null,
+			primary key (broadcast_id, user_id)
+		);
+    `), query(`
+		drop table broadcast_delivery;
+
+		drop type broadcast_delivery_status;
+
+		drop table broadcast;
+
+		drop type broadcast_status;
+
+		alter table "user" drop column bot_blocked_at;
+    `))
+}
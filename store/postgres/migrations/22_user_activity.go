@@ -0,0 +1,21 @@
+package migrations
+
+func init() {
+	include(22, query(`
+		alter table "user"
+			add column bot_unblocked_at timestamp with time zone,
+			add column last_seen_at timestamp with time zone;
+
+		create index user_last_seen_at_idx on "user"(last_seen_at);
+
+		create index download_at_idx on download(at);
+    `), query(`
+		drop index download_at_idx;
+
+		drop index user_last_seen_at_idx;
+
+		alter table "user"
+			drop column bot_unblocked_at,
+			drop column last_seen_at;
+    `))
+}
@@ -0,0 +1,19 @@
+package migrations
+
+func init() {
+	include(23, query(`
+		create table campaign (
+			id serial primary key,
+			ref varchar(60) not null unique,
+			name text not null,
+			cost integer not null default 0,
+			created_at timestamp with time zone not null
+		);
+
+		create index user_ref_idx on "user"(ref);
+    `), query(`
+		drop index user_ref_idx;
+
+		drop table campaign;
+    `))
+}
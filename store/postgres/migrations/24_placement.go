@@ -0,0 +1,22 @@
+package migrations
+
+func init() {
+	include(24, query(`
+		create table placement (
+			id serial primary key,
+			file_id integer not null references file(id) on delete cascade,
+			name text not null,
+			created_at timestamp with time zone not null
+		);
+
+		create index placement_file_id_idx on placement(file_id);
+
+		alter table download
+			add column
+				placement_id integer references placement(id) on delete set null;
+    `), query(`
+		alter table download drop column placement_id;
+
+		drop table placement;
+    `))
+}
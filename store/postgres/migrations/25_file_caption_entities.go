@@ -0,0 +1,9 @@
+package migrations
+
+func init() {
+	include(25, query(`
+		alter table file add column caption_entities jsonb not null default('[]');
+    `), query(`
+		alter table file drop column caption_entities;
+    `))
+}
@@ -0,0 +1,36 @@
+package migrations
+
+func init() {
+	include(26, query(`
+		alter table file
+			add column
+				version integer not null default 1;
+
+		create table file_version (
+			id serial primary key,
+			file_id integer not null references file(id) on delete cascade,
+			version integer not null,
+			telegram_id text not null,
+			kind file_kind not null,
+			mime_type text,
+			name text not null,
+			size integer not null,
+			metadata jsonb not null default('{}'),
+			replaced_at timestamp with time zone not null,
+
+			unique (file_id, version)
+		);
+
+		alter table download
+			add column
+				file_version integer;
+
+		update download set file_version = 1 where file_id is not null;
+    `), query(`
+		alter table download drop column file_version;
+
+		drop table file_version;
+
+		alter table file drop column version;
+    `))
+}
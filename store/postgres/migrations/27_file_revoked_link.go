@@ -0,0 +1,16 @@
+package migrations
+
+func init() {
+	include(27, query(`
+		create table file_revoked_link (
+			public_id varchar(50) primary key,
+			file_id integer not null references file(id) on delete cascade,
+			active_until timestamp with time zone not null,
+			revoked_at timestamp with time zone not null
+		);
+
+		create index file_revoked_link_file_id_idx on file_revoked_link(file_id);
+    `), query(`
+		drop table file_revoked_link;
+    `))
+}
@@ -0,0 +1,13 @@
+package migrations
+
+func init() {
+	include(28, query(`
+		create table reserved_public_id (
+			name varchar(50) primary key,
+			admin_id integer not null references "user"(id),
+			created_at timestamp with time zone not null
+		);
+    `), query(`
+		drop table reserved_public_id;
+    `))
+}
@@ -0,0 +1,31 @@
+package migrations
+
+func init() {
+	include(29, query(`
+		alter type file_kind add value 'Contact';
+		alter type file_kind add value 'Location';
+    `), query(`
+		-- contacts and locations can't be represented by old kinds
+		delete from file where kind in ('Contact', 'Location');
+		delete from file_version where kind in ('Contact', 'Location');
+
+		-- postgres can't drop value of enum, so type is recreated without it
+		alter type file_kind rename to file_kind_old;
+
+		create type file_kind as enum(
+			'Document',
+			'Animation',
+			'Audio',
+			'Sticker',
+			'Video',
+			'VideoNote',
+			'Voice',
+			'Photo'
+		);
+
+		alter table file alter column kind type file_kind using kind::text::file_kind;
+		alter table file_version alter column kind type file_kind using kind::text::file_kind;
+
+		drop type file_kind_old;
+    `))
+}
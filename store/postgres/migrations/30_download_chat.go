@@ -0,0 +1,28 @@
+package migrations
+
+func init() {
+	include(30, query(`
+		create table download_chat (
+			download_id integer not null references download(id) on delete cascade,
+			chat_id integer not null references chat(id) on delete cascade,
+
+			primary key (download_id, chat_id)
+		);
+
+		create index download_chat_chat_id_idx on download_chat(chat_id);
+
+		insert into download_chat (download_id, chat_id)
+			select download.id, file_restriction_chat.chat_id
+			from download
+			inner join file_restriction_chat on file_restriction_chat.file_id = download.file_id
+			where download.new_subscription is not null and download.bundle_id is null;
+
+		insert into download_chat (download_id, chat_id)
+			select download.id, bundle_restriction_chat.chat_id
+			from download
+			inner join bundle_restriction_chat on bundle_restriction_chat.bundle_id = download.bundle_id
+			where download.new_subscription is not null;
+    `), query(`
+		drop table download_chat;
+    `))
+}
@@ -0,0 +1,18 @@
+package migrations
+
+func init() {
+	include(31, query(`
+		alter table file alter column file_id drop not null;
+		alter table file_version alter column telegram_id drop not null;
+
+		-- contacts and locations have no file in telegram
+		update file set file_id = null where file_id = '';
+		update file_version set telegram_id = null where telegram_id = '';
+    `), query(`
+		update file set file_id = '' where file_id is null;
+		update file_version set telegram_id = '' where telegram_id is null;
+
+		alter table file alter column file_id set not null;
+		alter table file_version alter column telegram_id set not null;
+    `))
+}
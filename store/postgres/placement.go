@@ -0,0 +1,114 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/store/postgres/dal"
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type PlacementStore struct {
+	BaseStore
+}
+
+func (store *PlacementStore) toRow(placement *core.Placement) *dal.Placement {
+	return &dal.Placement{
+		ID:        int(placement.ID),
+		FileID:    int(placement.FileID),
+		Name:      placement.Name,
+		CreatedAt: placement.CreatedAt,
+	}
+}
+
+func (store *PlacementStore) fromRow(row *dal.Placement) *core.Placement {
+	return &core.Placement{
+		ID:        core.PlacementID(row.ID),
+		FileID:    core.FileID(row.FileID),
+		Name:      row.Name,
+		CreatedAt: row.CreatedAt,
+	}
+}
+
+func (store *PlacementStore) Add(ctx context.Context, placement *core.Placement) error {
+	row := store.toRow(placement)
+
+	if err := store.insertOne(ctx, row); err != nil {
+		return errors.Wrap(err, "insert query")
+	}
+
+	*placement = *store.fromRow(row)
+
+	return nil
+}
+
+func (store *PlacementStore) Query() core.PlacementStoreQuery {
+	return &placementStoreQuery{store: store}
+}
+
+type placementStoreQuery struct {
+	mods  []qm.QueryMod
+	store *PlacementStore
+}
+
+func (psq *placementStoreQuery) ID(id core.PlacementID) core.PlacementStoreQuery {
+	psq.mods = append(psq.mods, dal.PlacementWhere.ID.EQ(int(id)))
+	return psq
+}
+
+func (psq *placementStoreQuery) FileID(id core.FileID) core.PlacementStoreQuery {
+	psq.mods = append(psq.mods, dal.PlacementWhere.FileID.EQ(int(id)))
+	return psq
+}
+
+func (psq *placementStoreQuery) getListMods() []qm.QueryMod {
+	mods := make([]qm.QueryMod, 0, len(psq.mods)+1)
+	mods = append(mods, psq.mods...)
+	return append(mods, qm.OrderBy(dal.PlacementColumns.ID))
+}
+
+func (psq *placementStoreQuery) One(ctx context.Context) (*core.Placement, error) {
+	row, err := dal.Placements(psq.getListMods()...).One(ctx, psq.store.getExecutor(ctx))
+	if err == sql.ErrNoRows {
+		return nil, core.ErrPlacementNotFound
+	} else if err != nil {
+		return nil, errors.Wrap(err, "select query")
+	}
+
+	return psq.store.fromRow(row), nil
+}
+
+func (psq *placementStoreQuery) All(ctx context.Context) ([]*core.Placement, error) {
+	rows, err := dal.Placements(psq.getListMods()...).All(ctx, psq.store.getExecutor(ctx))
+	if err != nil {
+		return nil, errors.Wrap(err, "select query")
+	}
+
+	result := make([]*core.Placement, len(rows))
+
+	for i, row := range rows {
+		result[i] = psq.store.fromRow(row)
+	}
+
+	return result, nil
+}
+
+func (psq *placementStoreQuery) Count(ctx context.Context) (int, error) {
+	count, err := dal.Placements(psq.mods...).Count(ctx, psq.store.getExecutor(ctx))
+	if err != nil {
+		return 0, errors.Wrap(err, "count query")
+	}
+
+	return int(count), nil
+}
+
+func (psq *placementStoreQuery) Delete(ctx context.Context) (int, error) {
+	count, err := dal.Placements(psq.mods...).DeleteAll(ctx, psq.store.getExecutor(ctx))
+	if err != nil {
+		return 0, errors.Wrap(err, "delete query")
+	}
+
+	return int(count), nil
+}
@@ -20,10 +20,20 @@ type Postgres struct {
 	*sql.DB
 	migrator *migrations.Migrator
 
-	user     *UserStore
-	file     *FileStore
-	download *DownloadStore
-	chat     *ChatStore
+	user              *UserStore
+	file              *FileStore
+	download          *DownloadStore
+	chat              *ChatStore
+	bundle            *BundleStore
+	report            *ReportStore
+	audit             *AuditEntryStore
+	broadcast         *BroadcastStore
+	broadcastDelivery *BroadcastDeliveryStore
+	campaign          *CampaignStore
+	placement         *PlacementStore
+	fileVersion       *FileVersionStore
+	revokedLink       *RevokedLinkStore
+	reservedPublicID  *ReservedPublicIDStore
 }
 
 var _ store.Store = &Postgres{}
@@ -44,6 +54,46 @@ func (pg *Postgres) Chat() core.ChatStore {
 	return pg.chat
 }
 
+func (pg *Postgres) Bundle() core.BundleStore {
+	return pg.bundle
+}
+
+func (pg *Postgres) Report() core.ReportStore {
+	return pg.report
+}
+
+func (pg *Postgres) Audit() core.AuditEntryStore {
+	return pg.audit
+}
+
+func (pg *Postgres) Broadcast() core.BroadcastStore {
+	return pg.broadcast
+}
+
+func (pg *Postgres) BroadcastDelivery() core.BroadcastDeliveryStore {
+	return pg.broadcastDelivery
+}
+
+func (pg *Postgres) Campaign() core.CampaignStore {
+	return pg.campaign
+}
+
+func (pg *Postgres) Placement() core.PlacementStore {
+	return pg.placement
+}
+
+func (pg *Postgres) FileVersion() core.FileVersionStore {
+	return pg.fileVersion
+}
+
+func (pg *Postgres) RevokedLink() core.RevokedLinkStore {
+	return pg.revokedLink
+}
+
+func (pg *Postgres) ReservedPublicID() core.ReservedPublicIDStore {
+	return pg.reservedPublicID
+}
+
 // New create postgres based database with all stores.
 func New(db *sql.DB) *Postgres {
 	pg := &Postgres{
@@ -60,6 +110,16 @@ func New(db *sql.DB) *Postgres {
 	pg.user = &UserStore{base}
 	pg.file = &FileStore{base}
 	pg.chat = &ChatStore{base}
+	pg.bundle = &BundleStore{base}
+	pg.report = &ReportStore{base}
+	pg.audit = &AuditEntryStore{base}
+	pg.broadcast = &BroadcastStore{base}
+	pg.broadcastDelivery = &BroadcastDeliveryStore{base}
+	pg.campaign = &CampaignStore{base}
+	pg.placement = &PlacementStore{base}
+	pg.fileVersion = &FileVersionStore{base}
+	pg.revokedLink = &RevokedLinkStore{base}
+	pg.reservedPublicID = &ReservedPublicIDStore{base}
 
 	return pg
 }
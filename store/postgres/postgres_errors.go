@@ -22,3 +22,7 @@ func isFilePublicIDCollisionErr(err error) bool {
 func isChatAlreadyConnectedError(err error) bool {
 	return isConstraintError(err, "chat_owner_id_telegram_id_key")
 }
+
+func isBundlePublicIDCollisionErr(err error) bool {
+	return isConstraintError(err, "bundle_public_id_key")
+}
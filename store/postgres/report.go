@@ -0,0 +1,170 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/store/postgres/dal"
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type ReportStore struct {
+	BaseStore
+}
+
+func (store *ReportStore) toRow(report *core.Report) *dal.Report {
+	return &dal.Report{
+		ID:               int(report.ID),
+		FileID:           int(report.FileID),
+		ReporterID:       null.NewInt(int(report.ReporterID), report.ReporterID != 0),
+		Reason:           report.Reason,
+		Status:           report.Status.String(),
+		CreatedAt:        report.CreatedAt,
+		ResolvedBy:       null.NewInt(int(report.ResolvedBy), report.ResolvedBy != 0),
+		ResolvedAt:       report.ResolvedAt,
+		Appeal:           report.Appeal,
+		AppealedAt:       report.AppealedAt,
+		AppealResolvedBy: null.NewInt(int(report.AppealResolvedBy), report.AppealResolvedBy != 0),
+		AppealResolvedAt: report.AppealResolvedAt,
+	}
+}
+
+func (store *ReportStore) fromRow(row *dal.Report) (*core.Report, error) {
+	status, err := core.ParseReportStatus(row.Status)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse report status")
+	}
+
+	return &core.Report{
+		ID:               core.ReportID(row.ID),
+		FileID:           core.FileID(row.FileID),
+		ReporterID:       core.UserID(row.ReporterID.Int),
+		Reason:           row.Reason,
+		Status:           status,
+		CreatedAt:        row.CreatedAt,
+		ResolvedBy:       core.UserID(row.ResolvedBy.Int),
+		ResolvedAt:       row.ResolvedAt,
+		Appeal:           row.Appeal,
+		AppealedAt:       row.AppealedAt,
+		AppealResolvedBy: core.UserID(row.AppealResolvedBy.Int),
+		AppealResolvedAt: row.AppealResolvedAt,
+	}, nil
+}
+
+func (store *ReportStore) Add(ctx context.Context, report *core.Report) error {
+	row := store.toRow(report)
+
+	if err := store.insertOne(ctx, row); err != nil {
+		return errors.Wrap(err, "insert query")
+	}
+
+	result, err := store.fromRow(row)
+	if err != nil {
+		return errors.Wrap(err, "from row")
+	}
+
+	*report = *result
+
+	return nil
+}
+
+func (store *ReportStore) Update(ctx context.Context, report *core.Report) error {
+	return store.updateOne(ctx, store.toRow(report), core.ErrReportNotFound)
+}
+
+func (store *ReportStore) Query() core.ReportStoreQuery {
+	return &reportStoreQuery{store: store}
+}
+
+type reportStoreQuery struct {
+	mods []qm.QueryMod
+
+	// mods applied only to One and All
+	listMods []qm.QueryMod
+
+	store *ReportStore
+}
+
+func (rsq *reportStoreQuery) ID(id core.ReportID) core.ReportStoreQuery {
+	rsq.mods = append(rsq.mods, dal.ReportWhere.ID.EQ(int(id)))
+	return rsq
+}
+
+func (rsq *reportStoreQuery) FileID(id core.FileID) core.ReportStoreQuery {
+	rsq.mods = append(rsq.mods, dal.ReportWhere.FileID.EQ(int(id)))
+	return rsq
+}
+
+func (rsq *reportStoreQuery) ReporterID(id core.UserID) core.ReportStoreQuery {
+	rsq.mods = append(rsq.mods, dal.ReportWhere.ReporterID.EQ(null.IntFrom(int(id))))
+	return rsq
+}
+
+func (rsq *reportStoreQuery) Status(statuses ...core.ReportStatus) core.ReportStoreQuery {
+	values := make([]string, len(statuses))
+	for i, status := range statuses {
+		values[i] = status.String()
+	}
+
+	rsq.mods = append(rsq.mods, dal.ReportWhere.Status.IN(values))
+	return rsq
+}
+
+func (rsq *reportStoreQuery) Limit(n int) core.ReportStoreQuery {
+	rsq.listMods = append(rsq.listMods, qm.Limit(n))
+	return rsq
+}
+
+func (rsq *reportStoreQuery) getListMods() []qm.QueryMod {
+	mods := make([]qm.QueryMod, 0, len(rsq.mods)+len(rsq.listMods)+1)
+	mods = append(mods, rsq.mods...)
+	mods = append(mods, qm.OrderBy(dal.ReportColumns.ID))
+	return append(mods, rsq.listMods...)
+}
+
+func (rsq *reportStoreQuery) fromRows(rows []*dal.Report) ([]*core.Report, error) {
+	result := make([]*core.Report, len(rows))
+
+	for i, row := range rows {
+		report, err := rsq.store.fromRow(row)
+		if err != nil {
+			return nil, errors.Wrapf(err, "from row #%d", i)
+		}
+
+		result[i] = report
+	}
+
+	return result, nil
+}
+
+func (rsq *reportStoreQuery) One(ctx context.Context) (*core.Report, error) {
+	row, err := dal.Reports(rsq.getListMods()...).One(ctx, rsq.store.getExecutor(ctx))
+	if err == sql.ErrNoRows {
+		return nil, core.ErrReportNotFound
+	} else if err != nil {
+		return nil, errors.Wrap(err, "select query")
+	}
+
+	return rsq.store.fromRow(row)
+}
+
+func (rsq *reportStoreQuery) All(ctx context.Context) ([]*core.Report, error) {
+	rows, err := dal.Reports(rsq.getListMods()...).All(ctx, rsq.store.getExecutor(ctx))
+	if err != nil {
+		return nil, errors.Wrap(err, "select query")
+	}
+
+	return rsq.fromRows(rows)
+}
+
+func (rsq *reportStoreQuery) Count(ctx context.Context) (int, error) {
+	count, err := dal.Reports(rsq.mods...).Count(ctx, rsq.store.getExecutor(ctx))
+	if err != nil {
+		return 0, errors.Wrap(err, "count query")
+	}
+
+	return int(count), nil
+}
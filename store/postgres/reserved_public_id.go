@@ -0,0 +1,99 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/store/postgres/dal"
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type ReservedPublicIDStore struct {
+	BaseStore
+}
+
+func (store *ReservedPublicIDStore) toRow(reserved *core.ReservedPublicID) *dal.ReservedPublicID {
+	return &dal.ReservedPublicID{
+		Name:      reserved.Name,
+		AdminID:   int(reserved.AdminID),
+		CreatedAt: reserved.CreatedAt,
+	}
+}
+
+func (store *ReservedPublicIDStore) fromRow(row *dal.ReservedPublicID) *core.ReservedPublicID {
+	return &core.ReservedPublicID{
+		Name:      row.Name,
+		AdminID:   core.UserID(row.AdminID),
+		CreatedAt: row.CreatedAt,
+	}
+}
+
+func (store *ReservedPublicIDStore) Add(ctx context.Context, reserved *core.ReservedPublicID) error {
+	row := store.toRow(reserved)
+
+	if err := store.insertOne(ctx, row); err != nil {
+		return errors.Wrap(err, "insert query")
+	}
+
+	*reserved = *store.fromRow(row)
+
+	return nil
+}
+
+func (store *ReservedPublicIDStore) Query() core.ReservedPublicIDStoreQuery {
+	return &reservedPublicIDStoreQuery{store: store}
+}
+
+type reservedPublicIDStoreQuery struct {
+	mods  []qm.QueryMod
+	store *ReservedPublicIDStore
+}
+
+func (rsq *reservedPublicIDStoreQuery) Name(name string) core.ReservedPublicIDStoreQuery {
+	rsq.mods = append(rsq.mods, dal.ReservedPublicIDWhere.Name.EQ(strings.ToLower(name)))
+	return rsq
+}
+
+func (rsq *reservedPublicIDStoreQuery) getListMods() []qm.QueryMod {
+	mods := make([]qm.QueryMod, 0, len(rsq.mods)+1)
+	mods = append(mods, rsq.mods...)
+	return append(mods, qm.OrderBy(dal.ReservedPublicIDColumns.Name))
+}
+
+func (rsq *reservedPublicIDStoreQuery) One(ctx context.Context) (*core.ReservedPublicID, error) {
+	row, err := dal.ReservedPublicIds(rsq.getListMods()...).One(ctx, rsq.store.getExecutor(ctx))
+	if err == sql.ErrNoRows {
+		return nil, core.ErrReservedPublicIDNotFound
+	} else if err != nil {
+		return nil, errors.Wrap(err, "select query")
+	}
+
+	return rsq.store.fromRow(row), nil
+}
+
+func (rsq *reservedPublicIDStoreQuery) All(ctx context.Context) ([]*core.ReservedPublicID, error) {
+	rows, err := dal.ReservedPublicIds(rsq.getListMods()...).All(ctx, rsq.store.getExecutor(ctx))
+	if err != nil {
+		return nil, errors.Wrap(err, "select query")
+	}
+
+	result := make([]*core.ReservedPublicID, len(rows))
+
+	for i, row := range rows {
+		result[i] = rsq.store.fromRow(row)
+	}
+
+	return result, nil
+}
+
+func (rsq *reservedPublicIDStoreQuery) Delete(ctx context.Context) (int, error) {
+	count, err := dal.ReservedPublicIds(rsq.mods...).DeleteAll(ctx, rsq.store.getExecutor(ctx))
+	if err != nil {
+		return 0, errors.Wrap(err, "delete query")
+	}
+
+	return int(count), nil
+}
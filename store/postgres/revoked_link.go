@@ -0,0 +1,84 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/store/postgres/dal"
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type RevokedLinkStore struct {
+	BaseStore
+}
+
+func (store *RevokedLinkStore) toRow(link *core.RevokedLink) *dal.FileRevokedLink {
+	return &dal.FileRevokedLink{
+		PublicID:    link.PublicID,
+		FileID:      int(link.FileID),
+		ActiveUntil: link.ActiveUntil,
+		RevokedAt:   link.RevokedAt,
+	}
+}
+
+func (store *RevokedLinkStore) fromRow(row *dal.FileRevokedLink) *core.RevokedLink {
+	return &core.RevokedLink{
+		PublicID:    row.PublicID,
+		FileID:      core.FileID(row.FileID),
+		ActiveUntil: row.ActiveUntil,
+		RevokedAt:   row.RevokedAt,
+	}
+}
+
+func (store *RevokedLinkStore) Add(ctx context.Context, link *core.RevokedLink) error {
+	row := store.toRow(link)
+
+	if err := store.insertOne(ctx, row); err != nil {
+		return errors.Wrap(err, "insert query")
+	}
+
+	*link = *store.fromRow(row)
+
+	return nil
+}
+
+func (store *RevokedLinkStore) Query() core.RevokedLinkStoreQuery {
+	return &revokedLinkStoreQuery{store: store}
+}
+
+type revokedLinkStoreQuery struct {
+	mods  []qm.QueryMod
+	store *RevokedLinkStore
+}
+
+func (rlsq *revokedLinkStoreQuery) PublicID(id string) core.RevokedLinkStoreQuery {
+	rlsq.mods = append(rlsq.mods, dal.FileRevokedLinkWhere.PublicID.EQ(id))
+	return rlsq
+}
+
+func (rlsq *revokedLinkStoreQuery) FileID(id core.FileID) core.RevokedLinkStoreQuery {
+	rlsq.mods = append(rlsq.mods, dal.FileRevokedLinkWhere.FileID.EQ(int(id)))
+	return rlsq
+}
+
+func (rlsq *revokedLinkStoreQuery) One(ctx context.Context) (*core.RevokedLink, error) {
+	row, err := dal.FileRevokedLinks(rlsq.mods...).One(ctx, rlsq.store.getExecutor(ctx))
+	if err == sql.ErrNoRows {
+		return nil, core.ErrRevokedLinkNotFound
+	} else if err != nil {
+		return nil, errors.Wrap(err, "select query")
+	}
+
+	return rlsq.store.fromRow(row), nil
+}
+
+func (rlsq *revokedLinkStoreQuery) Count(ctx context.Context) (int, error) {
+	count, err := dal.FileRevokedLinks(rlsq.mods...).Count(ctx, rlsq.store.getExecutor(ctx))
+	if err != nil {
+		return 0, errors.Wrap(err, "count query")
+	}
+
+	return int(count), nil
+}
@@ -0,0 +1,15 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/bots-house/share-file-bot/store"
+	"github.com/bots-house/share-file-bot/store/storetest"
+)
+
+func TestPostgres_Conformance(t *testing.T) {
+	storetest.Run(t, func(t *testing.T) store.Store {
+		_, pg := newPostgres(t)
+		return pg
+	})
+}
@@ -4,10 +4,12 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"time"
 
 	"github.com/bots-house/share-file-bot/core"
 	"github.com/bots-house/share-file-bot/store/postgres/dal"
 	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
@@ -22,16 +24,20 @@ func (store *UserStore) toRow(user *core.User) (*dal.User, error) {
 	}
 
 	return &dal.User{
-		ID:           int(user.ID),
-		FirstName:    user.FirstName,
-		LastName:     user.LastName,
-		Username:     user.Username,
-		LanguageCode: user.LanguageCode,
-		IsAdmin:      user.IsAdmin,
-		Settings:     string(settings),
-		Ref:          user.Ref,
-		JoinedAt:     user.JoinedAt,
-		UpdatedAt:    user.UpdatedAt,
+		ID:             int(user.ID),
+		FirstName:      user.FirstName,
+		LastName:       user.LastName,
+		Username:       user.Username,
+		LanguageCode:   user.LanguageCode,
+		IsAdmin:        user.IsAdmin,
+		IsBanned:       user.IsBanned,
+		Settings:       string(settings),
+		Ref:            user.Ref,
+		JoinedAt:       user.JoinedAt,
+		UpdatedAt:      user.UpdatedAt,
+		BotBlockedAt:   user.BotBlockedAt,
+		BotUnblockedAt: user.BotUnblockedAt,
+		LastSeenAt:     user.LastSeenAt,
 	}, nil
 }
 
@@ -43,16 +49,20 @@ func (store *UserStore) fromRow(row *dal.User) (*core.User, error) {
 	}
 
 	return &core.User{
-		ID:           core.UserID(row.ID),
-		FirstName:    row.FirstName,
-		LastName:     row.LastName,
-		Username:     row.Username,
-		LanguageCode: row.LanguageCode,
-		IsAdmin:      row.IsAdmin,
-		Settings:     settings,
-		Ref:          row.Ref,
-		JoinedAt:     row.JoinedAt,
-		UpdatedAt:    row.UpdatedAt,
+		ID:             core.UserID(row.ID),
+		FirstName:      row.FirstName,
+		LastName:       row.LastName,
+		Username:       row.Username,
+		LanguageCode:   row.LanguageCode,
+		IsAdmin:        row.IsAdmin,
+		IsBanned:       row.IsBanned,
+		Settings:       settings,
+		Ref:            row.Ref,
+		JoinedAt:       row.JoinedAt,
+		UpdatedAt:      row.UpdatedAt,
+		BotBlockedAt:   row.BotBlockedAt,
+		BotUnblockedAt: row.BotUnblockedAt,
+		LastSeenAt:     row.LastSeenAt,
 	}, nil
 }
 
@@ -108,10 +118,79 @@ func (store *UserStore) Query() core.UserStoreQuery {
 }
 
 type userStoreQuery struct {
-	mods  []qm.QueryMod
+	mods []qm.QueryMod
+
+	// mods applied only to All
+	listMods []qm.QueryMod
+
 	store *UserStore
 }
 
+func (usq *userStoreQuery) Ref(ref string) core.UserStoreQuery {
+	usq.mods = append(usq.mods, dal.UserWhere.Ref.EQ(null.StringFrom(ref)))
+	return usq
+}
+
+func (usq *userStoreQuery) Language(lang string) core.UserStoreQuery {
+	usq.mods = append(usq.mods, qm.Where(
+		`coalesce(nullif("user".settings->>'language', ''), "user".language_code) like ? || '%'`,
+		lang,
+	))
+	return usq
+}
+
+func (usq *userStoreQuery) JoinedAfter(t time.Time) core.UserStoreQuery {
+	usq.mods = append(usq.mods, dal.UserWhere.JoinedAt.GT(t))
+	return usq
+}
+
+func (usq *userStoreQuery) IDGreaterThan(id core.UserID) core.UserStoreQuery {
+	usq.mods = append(usq.mods, dal.UserWhere.ID.GT(int(id)))
+	return usq
+}
+
+func (usq *userStoreQuery) NotBlockedBot() core.UserStoreQuery {
+	usq.mods = append(usq.mods, dal.UserWhere.BotBlockedAt.IsNull())
+	return usq
+}
+
+func (usq *userStoreQuery) BlockedBot() core.UserStoreQuery {
+	usq.mods = append(usq.mods, dal.UserWhere.BotBlockedAt.IsNotNull())
+	return usq
+}
+
+func (usq *userStoreQuery) Limit(n int) core.UserStoreQuery {
+	usq.listMods = append(usq.listMods, qm.Limit(n))
+	return usq
+}
+
+func (usq *userStoreQuery) getListMods() []qm.QueryMod {
+	mods := make([]qm.QueryMod, 0, len(usq.mods)+len(usq.listMods)+1)
+	mods = append(mods, usq.mods...)
+	mods = append(mods, qm.OrderBy(dal.UserColumns.ID))
+	return append(mods, usq.listMods...)
+}
+
+func (usq *userStoreQuery) All(ctx context.Context) ([]*core.User, error) {
+	rows, err := dal.Users(usq.getListMods()...).All(ctx, usq.store.getExecutor(ctx))
+	if err != nil {
+		return nil, errors.Wrap(err, "select query")
+	}
+
+	result := make([]*core.User, len(rows))
+
+	for i, row := range rows {
+		user, err := usq.store.fromRow(row)
+		if err != nil {
+			return nil, errors.Wrapf(err, "from row #%d", i)
+		}
+
+		result[i] = user
+	}
+
+	return result, nil
+}
+
 func (usq *userStoreQuery) Count(ctx context.Context) (int, error) {
 	count, err := dal.
 		Users(usq.mods...).
@@ -123,20 +202,31 @@ func (usq *userStoreQuery) Count(ctx context.Context) (int, error) {
 	return int(count), nil
 }
 
-func (store *UserStore) RefStats(ctx context.Context) (core.UserRefStats, error) {
+func (store *UserStore) RefStats(ctx context.Context, from, to time.Time) (core.UserRefStats, error) {
 	const query = `
 		select 
-			ref, 
-			count(*) as users 
+			"user".ref, 
+			count(distinct "user".id) as users,
+			count(download.id) as downloads
 		from 
 			"user"
+		left join
+			download on download.user_id = "user".id and
+				($1::timestamptz is null or download.at >= $1) and
+				($2::timestamptz is null or download.at < $2)
+		where
+			($1::timestamptz is null or "user".joined_at >= $1) and
+			($2::timestamptz is null or "user".joined_at < $2)
 		group by
-			ref 
+			"user".ref 
 	`
 
 	executor := store.getExecutor(ctx)
 
-	rows, err := executor.QueryContext(ctx, query)
+	rows, err := executor.QueryContext(ctx, query,
+		null.NewTime(from, !from.IsZero()),
+		null.NewTime(to, !to.IsZero()),
+	)
 	if err != nil {
 		return nil, errors.Wrap(err, "query rows")
 	}
@@ -150,6 +240,7 @@ func (store *UserStore) RefStats(ctx context.Context) (core.UserRefStats, error)
 		if err := rows.Scan(
 			&item.Ref,
 			&item.Count,
+			&item.Downloads,
 		); err != nil {
 			return nil, errors.Wrap(err, "scan row")
 		}
@@ -163,3 +254,25 @@ func (store *UserStore) RefStats(ctx context.Context) (core.UserRefStats, error)
 
 	return result, nil
 }
+
+func (store *UserStore) CountActive(ctx context.Context, since time.Time) (int, error) {
+	const query = `
+		select
+			count(*)
+		from
+			"user"
+		where
+			last_seen_at >= $1 or
+			exists (
+				select 1 from download where download.user_id = "user".id and download.at >= $1
+			)
+	`
+
+	var count int
+
+	if err := store.getExecutor(ctx).QueryRowContext(ctx, query, since).Scan(&count); err != nil {
+		return 0, errors.Wrap(err, "query row")
+	}
+
+	return count, nil
+}
@@ -10,6 +10,16 @@ type StoreFactory interface {
 	File() core.FileStore
 	Download() core.DownloadStore
 	Chat() core.ChatStore
+	Bundle() core.BundleStore
+	Report() core.ReportStore
+	Audit() core.AuditEntryStore
+	Broadcast() core.BroadcastStore
+	BroadcastDelivery() core.BroadcastDeliveryStore
+	Campaign() core.CampaignStore
+	Placement() core.PlacementStore
+	FileVersion() core.FileVersionStore
+	RevokedLink() core.RevokedLinkStore
+	ReservedPublicID() core.ReservedPublicIDStore
 }
 
 // Store define generic interface for database with transaction support
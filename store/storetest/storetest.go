@@ -0,0 +1,1171 @@
+// Package storetest contains conformance tests of store.Store implementations,
+// so implementations can't drift from each other.
+package storetest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bots-house/share-file-bot/core"
+	"github.com/bots-house/share-file-bot/service"
+	"github.com/bots-house/share-file-bot/store"
+	"github.com/friendsofgo/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+// Factory creates empty store for one test.
+type Factory func(t *testing.T) store.Store
+
+// Run runs conformance tests against stores created by factory.
+func Run(t *testing.T, newStore Factory) {
+	for _, test := range []struct {
+		Name string
+		Func func(t *testing.T, s store.Store)
+	}{
+		{"User", testUser},
+		{"UserQuery", testUserQuery},
+		{"UserCountActive", testUserCountActive},
+		{"UserRefStats", testUserRefStats},
+		{"Campaign", testCampaign},
+		{"Chat", testChat},
+		{"File", testFile},
+		{"FileQuery", testFileQuery},
+		{"FileKinds", testFileKinds},
+		{"Bundle", testBundle},
+		{"Report", testReport},
+		{"Audit", testAudit},
+		{"Broadcast", testBroadcast},
+		{"Download", testDownload},
+		{"DownloadSeriesAndLog", testDownloadSeriesAndLog},
+		{"Placement", testPlacement},
+		{"FileVersion", testFileVersion},
+		{"RevokedLink", testRevokedLink},
+		{"ReservedPublicID", testReservedPublicID},
+		{"Tx", testTx},
+	} {
+		test := test
+
+		t.Run(test.Name, func(t *testing.T) {
+			test.Func(t, newStore(t))
+		})
+	}
+}
+
+// baseTime is truncated to microseconds, because of database precision.
+var baseTime = time.Date(2020, time.November, 20, 12, 0, 0, 0, time.UTC)
+
+func newUser(t *testing.T, s store.Store, id core.UserID, username string) *core.User {
+	t.Helper()
+
+	user := core.NewUser(id, "Test", "", username, "en")
+	user.JoinedAt = baseTime
+
+	require.NoError(t, s.User().Add(context.Background(), user))
+
+	return user
+}
+
+func newChat(t *testing.T, s store.Store, owner *core.User, telegramID int64) *core.Chat {
+	t.Helper()
+
+	chat := core.NewChat(telegramID, "test", core.ChatTypeChannel, owner.ID)
+	chat.LinkedAt = baseTime
+
+	require.NoError(t, s.Chat().Add(context.Background(), chat))
+
+	return chat
+}
+
+func newFile(t *testing.T, s store.Store, owner *core.User, name string, do func(file *core.File)) *core.File {
+	t.Helper()
+
+	file := core.NewFile(
+		"telegram-"+name,
+		"",
+		core.KindDocument,
+		"text/plain",
+		100,
+		name,
+		owner.ID,
+		false,
+		core.Metadata{},
+	)
+	file.CreatedAt = baseTime
+
+	if do != nil {
+		do(file)
+	}
+
+	require.NoError(t, s.File().Add(context.Background(), file))
+
+	return file
+}
+
+func newDownload(t *testing.T, s store.Store, file *core.File, user *core.User, at time.Time, do func(dwn *core.Download)) {
+	t.Helper()
+
+	dwn := core.NewDownload(file.ID, user.ID)
+	dwn.At = at
+
+	if do != nil {
+		do(dwn)
+	}
+
+	require.NoError(t, s.Download().Add(context.Background(), dwn))
+	require.NotZero(t, dwn.ID)
+}
+
+func testUser(t *testing.T, s store.Store) {
+	ctx := context.Background()
+
+	user := newUser(t, s, 1, "first")
+	user.Ref = null.StringFrom("ads")
+	user.IsBanned = true
+	require.NoError(t, s.User().Update(ctx, user))
+
+	newUser(t, s, 2, "")
+	newUser(t, s, 3, "")
+
+	found, err := s.User().Find(ctx, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, user.Username, found.Username)
+	require.Equal(t, user.Ref, found.Ref)
+	require.True(t, found.IsBanned)
+	require.True(t, user.JoinedAt.Equal(found.JoinedAt))
+
+	_, err = s.User().Find(ctx, 100)
+	require.True(t, errors.Is(err, core.ErrUserNotFound))
+
+	err = s.User().Update(ctx, core.NewUser(100, "Test", "", "", "en"))
+	require.True(t, errors.Is(err, core.ErrUserNotFound))
+
+	count, err := s.User().Query().Count(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 3, count)
+
+	stats, err := s.User().RefStats(ctx, time.Time{}, time.Time{})
+	require.NoError(t, err)
+	require.ElementsMatch(t, core.UserRefStats{
+		{Ref: null.StringFrom("ads"), Count: 1},
+		{Ref: null.String{}, Count: 2},
+	}, stats)
+}
+
+func testUserRefStats(t *testing.T, s store.Store) {
+	ctx := context.Background()
+
+	first := newUser(t, s, 1, "")
+	first.Ref = null.StringFrom("ads")
+	require.NoError(t, s.User().Update(ctx, first))
+
+	second := newUser(t, s, 2, "")
+	second.Ref = null.StringFrom("ads")
+	second.JoinedAt = baseTime.Add(24 * time.Hour)
+	require.NoError(t, s.User().Update(ctx, second))
+
+	third := newUser(t, s, 3, "")
+	third.JoinedAt = baseTime.Add(24 * time.Hour)
+	require.NoError(t, s.User().Update(ctx, third))
+
+	file := newFile(t, s, third, "file", nil)
+	newDownload(t, s, file, first, baseTime.Add(time.Hour), nil)
+	newDownload(t, s, file, second, baseTime.Add(25*time.Hour), nil)
+	newDownload(t, s, file, second, baseTime.Add(26*time.Hour), nil)
+	newDownload(t, s, file, first, baseTime.Add(30*time.Hour), nil)
+
+	stats, err := s.User().RefStats(ctx, time.Time{}, time.Time{})
+	require.NoError(t, err)
+	require.ElementsMatch(t, core.UserRefStats{
+		{Ref: null.StringFrom("ads"), Count: 2, Downloads: 4},
+		{Ref: null.String{}, Count: 1},
+	}, stats)
+
+	stats, err = s.User().RefStats(ctx, baseTime.Add(time.Hour), time.Time{})
+	require.NoError(t, err)
+	require.ElementsMatch(t, core.UserRefStats{
+		{Ref: null.StringFrom("ads"), Count: 1, Downloads: 2},
+		{Ref: null.String{}, Count: 1},
+	}, stats)
+
+	// download after period is not counted
+	stats, err = s.User().RefStats(ctx, time.Time{}, baseTime.Add(24*time.Hour))
+	require.NoError(t, err)
+	require.ElementsMatch(t, core.UserRefStats{
+		{Ref: null.StringFrom("ads"), Count: 1, Downloads: 1},
+	}, stats)
+
+	// user without downloads in period is still counted
+	stats, err = s.User().RefStats(ctx, baseTime, baseTime.Add(time.Hour))
+	require.NoError(t, err)
+	require.ElementsMatch(t, core.UserRefStats{
+		{Ref: null.StringFrom("ads"), Count: 1, Downloads: 0},
+	}, stats)
+}
+
+func testCampaign(t *testing.T, s store.Store) {
+	ctx := context.Background()
+
+	first := core.NewCampaign("ads", "Ads", 10000)
+	first.CreatedAt = baseTime
+	require.NoError(t, s.Campaign().Add(ctx, first))
+	require.NotZero(t, first.ID)
+
+	second := core.NewCampaign("blog", "Blog", 0)
+	second.CreatedAt = baseTime.Add(time.Hour)
+	require.NoError(t, s.Campaign().Add(ctx, second))
+
+	found, err := s.Campaign().Query().Ref("ads").One(ctx)
+	require.NoError(t, err)
+	require.Equal(t, first.ID, found.ID)
+	require.Equal(t, "Ads", found.Name)
+	require.Equal(t, 10000, found.Cost)
+	require.True(t, found.CreatedAt.Equal(baseTime))
+
+	_, err = s.Campaign().Query().Ref("unknown").One(ctx)
+	require.True(t, errors.Is(err, core.ErrCampaignNotFound))
+
+	campaigns, err := s.Campaign().Query().All(ctx)
+	require.NoError(t, err)
+	require.Len(t, campaigns, 2)
+	require.Equal(t, second.ID, campaigns[0].ID)
+	require.Equal(t, first.ID, campaigns[1].ID)
+
+	deleted, err := s.Campaign().Query().ID(first.ID).Delete(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, deleted)
+
+	_, err = s.Campaign().Query().ID(first.ID).One(ctx)
+	require.True(t, errors.Is(err, core.ErrCampaignNotFound))
+}
+
+func testPlacement(t *testing.T, s store.Store) {
+	ctx := context.Background()
+
+	owner := newUser(t, s, 1, "owner")
+	user := newUser(t, s, 2, "")
+	file := newFile(t, s, owner, "placed", nil)
+	other := newFile(t, s, owner, "other", nil)
+
+	reddit := core.NewPlacement(file.ID, "reddit")
+	reddit.CreatedAt = baseTime
+	require.NoError(t, s.Placement().Add(ctx, reddit))
+	require.NotZero(t, reddit.ID)
+
+	channel := core.NewPlacement(file.ID, "channel-A")
+	channel.CreatedAt = baseTime
+	require.NoError(t, s.Placement().Add(ctx, channel))
+
+	require.NoError(t, s.Placement().Add(ctx, core.NewPlacement(other.ID, "reddit")))
+
+	placements, err := s.Placement().Query().FileID(file.ID).All(ctx)
+	require.NoError(t, err)
+	require.Len(t, placements, 2)
+	require.Equal(t, reddit.ID, placements[0].ID)
+	require.Equal(t, "channel-A", placements[1].Name)
+
+	count, err := s.Placement().Query().FileID(other.ID).Count(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	_, err = s.Placement().Query().ID(reddit.ID).FileID(other.ID).One(ctx)
+	require.True(t, errors.Is(err, core.ErrPlacementNotFound))
+
+	withPlacement := func(id core.PlacementID) func(dwn *core.Download) {
+		return func(dwn *core.Download) {
+			dwn.PlacementID = id
+		}
+	}
+
+	newDownload(t, s, file, user, baseTime, nil)
+	newDownload(t, s, file, user, baseTime, withPlacement(reddit.ID))
+	newDownload(t, s, file, user, baseTime, withPlacement(reddit.ID))
+	newDownload(t, s, file, owner, baseTime, withPlacement(reddit.ID))
+	newDownload(t, s, file, user, baseTime, withPlacement(channel.ID))
+
+	stats, err := s.Download().GetFilePlacementStats(ctx, file.ID)
+	require.NoError(t, err)
+	require.Equal(t, []*core.PlacementDownloadStats{
+		{PlacementID: 0, Total: 1, Unique: 1},
+		{PlacementID: reddit.ID, Total: 3, Unique: 2},
+		{PlacementID: channel.ID, Total: 1, Unique: 1},
+	}, stats)
+
+	deleted, err := s.Placement().Query().ID(reddit.ID).Delete(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, deleted)
+
+	// downloads of deleted placement are kept as direct
+	stats, err = s.Download().GetFilePlacementStats(ctx, file.ID)
+	require.NoError(t, err)
+	require.Equal(t, []*core.PlacementDownloadStats{
+		{PlacementID: 0, Total: 4, Unique: 2},
+		{PlacementID: channel.ID, Total: 1, Unique: 1},
+	}, stats)
+
+	require.NoError(t, s.File().Query().ID(file.ID).Delete(ctx))
+
+	count, err = s.Placement().Query().FileID(file.ID).Count(ctx)
+	require.NoError(t, err)
+	require.Zero(t, count)
+}
+
+func testFileVersion(t *testing.T, s store.Store) {
+	ctx := context.Background()
+
+	owner := newUser(t, s, 1, "owner")
+	user := newUser(t, s, 2, "")
+	file := newFile(t, s, owner, "v1.txt", func(file *core.File) {
+		file.Metadata = core.NewMetadataAudio("title", "performer")
+	})
+	require.Equal(t, 1, file.Version)
+
+	newDownload(t, s, file, user, baseTime, func(dwn *core.Download) {
+		dwn.FileVersion = file.Version
+	})
+
+	// replace v1 by v2, then v2 by v3
+	var versions []*core.FileVersion
+
+	for _, name := range []string{"v2.txt", "v3.txt"} {
+		version := core.NewFileVersion(file)
+		version.ReplacedAt = baseTime
+		require.NoError(t, s.FileVersion().Add(ctx, version))
+		require.NotZero(t, version.ID)
+		versions = append(versions, version)
+
+		file.SetContent(&core.FileVersion{
+			TelegramID: "telegram-" + name,
+			Kind:       core.KindPhoto,
+			Name:       name,
+			Size:       200,
+		})
+		require.NoError(t, s.File().Update(ctx, file))
+	}
+
+	found, err := s.File().Query().ID(file.ID).One(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 3, found.Version)
+	require.Equal(t, "v3.txt", found.Name)
+	require.Equal(t, core.KindPhoto, found.Kind)
+
+	all, err := s.FileVersion().Query().FileID(file.ID).All(ctx)
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+	require.Equal(t, versions[1].ID, all[0].ID)
+	require.Equal(t, 2, all[0].Version)
+	require.Equal(t, "v1.txt", all[1].Name)
+	require.Equal(t, "telegram-v1.txt", all[1].TelegramID)
+	require.Equal(t, core.NewMetadataAudio("title", "performer"), all[1].Metadata)
+
+	limited, err := s.FileVersion().Query().FileID(file.ID).Limit(1).All(ctx)
+	require.NoError(t, err)
+	require.Len(t, limited, 1)
+
+	_, err = s.FileVersion().Query().ID(versions[0].ID).FileID(file.ID + 1).One(ctx)
+	require.True(t, errors.Is(err, core.ErrFileVersionNotFound))
+
+	newDownload(t, s, found, user, baseTime, func(dwn *core.Download) {
+		dwn.FileVersion = found.Version
+	})
+	newDownload(t, s, found, owner, baseTime, func(dwn *core.Download) {
+		dwn.FileVersion = found.Version
+	})
+	newDownload(t, s, found, user, baseTime, nil)
+
+	stats, err := s.Download().GetFileVersionStats(ctx, file.ID)
+	require.NoError(t, err)
+	require.Equal(t, []*core.FileVersionDownloadStats{
+		{Version: 0, Total: 1, Unique: 1},
+		{Version: 1, Total: 1, Unique: 1},
+		{Version: 3, Total: 2, Unique: 2},
+	}, stats)
+
+	require.NoError(t, s.File().Query().ID(file.ID).Delete(ctx))
+
+	count, err := s.FileVersion().Query().FileID(file.ID).Count(ctx)
+	require.NoError(t, err)
+	require.Zero(t, count)
+}
+
+func testRevokedLink(t *testing.T, s store.Store) {
+	ctx := context.Background()
+
+	owner := newUser(t, s, 1, "owner")
+	file := newFile(t, s, owner, "leaked.txt", nil)
+
+	link := core.NewRevokedLink(file, time.Hour)
+	link.RevokedAt = baseTime
+	link.ActiveUntil = baseTime.Add(time.Hour)
+	require.NoError(t, s.RevokedLink().Add(ctx, link))
+
+	found, err := s.RevokedLink().Query().PublicID(file.PublicID).One(ctx)
+	require.NoError(t, err)
+	require.Equal(t, file.ID, found.FileID)
+	require.True(t, found.ActiveUntil.Equal(link.ActiveUntil))
+	require.True(t, found.IsActive(baseTime.Add(time.Minute)))
+	require.False(t, found.IsActive(baseTime.Add(time.Hour)))
+
+	_, err = s.RevokedLink().Query().PublicID(file.PublicID + "x").One(ctx)
+	require.True(t, errors.Is(err, core.ErrRevokedLinkNotFound))
+
+	require.NoError(t, s.File().Query().ID(file.ID).Delete(ctx))
+
+	count, err := s.RevokedLink().Query().FileID(file.ID).Count(ctx)
+	require.NoError(t, err)
+	require.Zero(t, count)
+}
+
+func testReservedPublicID(t *testing.T, s store.Store) {
+	ctx := context.Background()
+
+	admin := newUser(t, s, 1, "admin")
+
+	for _, name := range []string{"Support", "admin"} {
+		require.NoError(t, s.ReservedPublicID().Add(ctx, core.NewReservedPublicID(name, admin.ID)))
+	}
+
+	found, err := s.ReservedPublicID().Query().Name("SUPPORT").One(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "support", found.Name)
+	require.Equal(t, admin.ID, found.AdminID)
+
+	all, err := s.ReservedPublicID().Query().All(ctx)
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+	require.Equal(t, "admin", all[0].Name)
+	require.Equal(t, "support", all[1].Name)
+
+	deleted, err := s.ReservedPublicID().Query().Name("Admin").Delete(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, deleted)
+
+	_, err = s.ReservedPublicID().Query().Name("admin").One(ctx)
+	require.True(t, errors.Is(err, core.ErrReservedPublicIDNotFound))
+}
+
+func testUserQuery(t *testing.T, s store.Store) {
+	ctx := context.Background()
+
+	first := newUser(t, s, 1, "")
+	first.Ref = null.StringFrom("ads")
+	first.LanguageCode = "ru-RU"
+	require.NoError(t, s.User().Update(ctx, first))
+
+	second := newUser(t, s, 2, "")
+	second.JoinedAt = baseTime.Add(time.Hour)
+	second.Settings.Language = "ru"
+	second.BotBlockedAt = null.TimeFrom(baseTime.Add(2 * time.Hour))
+	require.NoError(t, s.User().Update(ctx, second))
+
+	third := newUser(t, s, 3, "")
+	third.JoinedAt = baseTime.Add(time.Hour)
+	require.NoError(t, s.User().Update(ctx, third))
+
+	getIDs := func(query core.UserStoreQuery) []core.UserID {
+		t.Helper()
+
+		users, err := query.All(ctx)
+		require.NoError(t, err)
+
+		ids := make([]core.UserID, len(users))
+		for i, user := range users {
+			ids[i] = user.ID
+		}
+
+		return ids
+	}
+
+	require.Equal(t, []core.UserID{1, 2, 3}, getIDs(s.User().Query()))
+	require.Equal(t, []core.UserID{1}, getIDs(s.User().Query().Ref("ads")))
+	require.Equal(t, []core.UserID{1, 2}, getIDs(s.User().Query().Language("ru")))
+	require.Equal(t, []core.UserID{2, 3}, getIDs(s.User().Query().JoinedAfter(baseTime)))
+	require.Equal(t, []core.UserID{1, 3}, getIDs(s.User().Query().NotBlockedBot()))
+	require.Equal(t, []core.UserID{2}, getIDs(s.User().Query().BlockedBot()))
+	require.Equal(t, []core.UserID{2}, getIDs(s.User().Query().IDGreaterThan(1).Limit(1)))
+
+	found, err := s.User().Find(ctx, second.ID)
+	require.NoError(t, err)
+	require.True(t, found.BotBlockedAt.Time.Equal(second.BotBlockedAt.Time))
+
+	count, err := s.User().Query().Language("en").NotBlockedBot().Count(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+}
+
+func testUserCountActive(t *testing.T, s store.Store) {
+	ctx := context.Background()
+
+	// seen recently
+	first := newUser(t, s, 1, "")
+	first.LastSeenAt = null.TimeFrom(baseTime.Add(-time.Hour))
+	require.NoError(t, s.User().Update(ctx, first))
+
+	// seen long ago, but downloaded file recently
+	second := newUser(t, s, 2, "")
+	second.LastSeenAt = null.TimeFrom(baseTime.Add(-10 * 24 * time.Hour))
+	require.NoError(t, s.User().Update(ctx, second))
+
+	// never seen, downloaded file long ago
+	third := newUser(t, s, 3, "")
+
+	file := newFile(t, s, first, "file", nil)
+	newDownload(t, s, file, second, baseTime.Add(-2*time.Hour), nil)
+	newDownload(t, s, file, second, baseTime.Add(-3*time.Hour), nil)
+	newDownload(t, s, file, third, baseTime.Add(-20*24*time.Hour), nil)
+
+	for _, test := range []struct {
+		Since time.Time
+		Count int
+	}{
+		{baseTime.Add(-90 * time.Minute), 1},
+		{baseTime.Add(-24 * time.Hour), 2},
+		{baseTime.Add(-30 * 24 * time.Hour), 3},
+		{baseTime, 0},
+	} {
+		count, err := s.User().CountActive(ctx, test.Since)
+		require.NoError(t, err)
+		require.Equal(t, test.Count, count, "since %s", test.Since)
+	}
+}
+
+func testChat(t *testing.T, s store.Store) {
+	ctx := context.Background()
+
+	owner := newUser(t, s, 1, "")
+	other := newUser(t, s, 2, "")
+
+	first := newChat(t, s, owner, -1001)
+	second := newChat(t, s, owner, -1002)
+	newChat(t, s, other, -1001)
+
+	err := s.Chat().Add(ctx, core.NewChat(-1001, "test", core.ChatTypeChannel, owner.ID))
+	require.True(t, errors.Is(err, service.ErrChatAlreadyConnected))
+
+	first.Title = "updated"
+	require.NoError(t, s.Chat().Update(ctx, first))
+
+	found, err := s.Chat().Query().ID(first.ID).One(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "updated", found.Title)
+
+	chats, err := s.Chat().Query().OwnerID(owner.ID).All(ctx)
+	require.NoError(t, err)
+	require.Len(t, chats, 2)
+
+	count, err := s.Chat().Query().TelegramID(-1001).Count(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+
+	// deleted chat is removed from restrictions
+	file := newFile(t, s, owner, "file.txt", func(file *core.File) {
+		file.Restriction.ToggleChat(first.ID)
+		file.Restriction.ToggleChat(second.ID)
+	})
+
+	deleted, err := s.Chat().Query().ID(first.ID).Delete(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, deleted)
+
+	_, err = s.Chat().Query().ID(first.ID).One(ctx)
+	require.True(t, errors.Is(err, core.ErrChatNotFound))
+
+	file, err = s.File().Query().ID(file.ID).One(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []core.ChatID{second.ID}, file.Restriction.ChatIDs)
+}
+
+func testFile(t *testing.T, s store.Store) {
+	ctx := context.Background()
+
+	owner := newUser(t, s, 1, "")
+	chat := newChat(t, s, owner, -1001)
+
+	file := newFile(t, s, owner, "file.txt", func(file *core.File) {
+		file.Metadata = core.NewMetadataAudio("title", "performer")
+		file.Restriction.ToggleChat(chat.ID)
+		file.Restriction.ChatPolicy = core.ChatPolicyAny
+		file.Restriction.MaxDownloads = 10
+		file.Restriction.ExpiresAt = null.TimeFrom(baseTime.Add(time.Hour))
+		file.IsBlocked = true
+		file.Caption = null.StringFrom("bold link")
+		file.CaptionEntities = []core.TextEntity{
+			{Type: "bold", Offset: 0, Length: 4},
+			{Type: "text_link", Offset: 5, Length: 4, URL: "https://example.com"},
+		}
+	})
+	require.NotZero(t, file.ID)
+
+	found, err := s.File().Query().PublicID(file.PublicID).One(ctx)
+	require.NoError(t, err)
+	require.Equal(t, file.ID, found.ID)
+	require.Equal(t, file.Metadata, found.Metadata)
+	require.Equal(t, []core.ChatID{chat.ID}, found.Restriction.ChatIDs)
+	require.Equal(t, core.ChatPolicyAny, found.Restriction.ChatPolicy)
+	require.Equal(t, 10, found.Restriction.MaxDownloads)
+	require.True(t, found.IsBlocked)
+	require.True(t, file.Restriction.ExpiresAt.Time.Equal(found.Restriction.ExpiresAt.Time))
+	require.Equal(t, file.CaptionEntities, found.CaptionEntities)
+
+	// returned file is a copy
+	found.Name = "changed.txt"
+	found, err = s.File().Query().ID(file.ID).One(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "file.txt", found.Name)
+
+	// collision of public id is resolved
+	other := newFile(t, s, owner, "other.txt", func(other *core.File) {
+		other.PublicID = file.PublicID
+	})
+	require.NotEqual(t, file.PublicID, other.PublicID)
+
+	found.Restriction.ToggleChat(chat.ID)
+	found.Caption = null.StringFrom("caption")
+	require.NoError(t, s.File().Update(ctx, found))
+
+	found, err = s.File().Query().ID(file.ID).One(ctx)
+	require.NoError(t, err)
+	require.Empty(t, found.Restriction.ChatIDs)
+	require.Equal(t, null.StringFrom("caption"), found.Caption)
+
+	missing := *found
+	missing.ID = 1000
+	require.True(t, errors.Is(s.File().Update(ctx, &missing), core.ErrFileNotFound))
+
+	require.NoError(t, s.File().Query().ID(file.ID).Delete(ctx))
+
+	_, err = s.File().Query().ID(file.ID).One(ctx)
+	require.True(t, errors.Is(err, core.ErrFileNotFound))
+
+	err = s.File().Query().ID(file.ID).Delete(ctx)
+	require.True(t, errors.Is(err, core.ErrFileNotFound))
+}
+
+func testFileKinds(t *testing.T, s store.Store) {
+	ctx := context.Background()
+
+	owner := newUser(t, s, 1, "")
+
+	for _, test := range []struct {
+		kind       core.Kind
+		metadata   core.Metadata
+		telegramID string
+	}{
+		{core.KindSticker, core.NewMetadataSticker("set", "😀"), "sticker"},
+		{core.KindVideoNote, core.NewMetadataVideoNote(240, 15), "video_note"},
+		// contact and location has no file in telegram
+		{core.KindContact, core.NewMetadataContact("+10000000000", "John", "Doe"), ""},
+		{core.KindLocation, core.NewMetadataLocation(50.4501, 30.5234), ""},
+	} {
+		file := newFile(t, s, owner, test.kind.String(), func(file *core.File) {
+			file.Kind = test.kind
+			file.Metadata = test.metadata
+			file.TelegramID = test.telegramID
+		})
+
+		found, err := s.File().Query().ID(file.ID).One(ctx)
+		require.NoError(t, err)
+		require.Equal(t, test.kind, found.Kind)
+		require.Equal(t, test.metadata, found.Metadata)
+		require.Equal(t, test.telegramID, found.TelegramID)
+	}
+}
+
+func testFileQuery(t *testing.T, s store.Store) {
+	ctx := context.Background()
+
+	owner := newUser(t, s, 1, "")
+	other := newUser(t, s, 2, "")
+	chat := newChat(t, s, owner, -1001)
+
+	report := newFile(t, s, owner, "Report 100%.pdf", nil)
+	notes := newFile(t, s, owner, "notes.txt", func(file *core.File) {
+		file.CreatedAt = baseTime.Add(time.Hour)
+		file.Restriction.ToggleChat(chat.ID)
+	})
+	photo := newFile(t, s, owner, "photo.jpg", func(file *core.File) {
+		file.Kind = core.KindPhoto
+		file.Caption = null.StringFrom("Summer NOTES")
+		file.CreatedAt = baseTime.Add(2 * time.Hour)
+	})
+	newFile(t, s, other, "notes.txt", nil)
+
+	newDownload(t, s, notes, owner, baseTime, nil)
+	newDownload(t, s, notes, other, baseTime, nil)
+	newDownload(t, s, report, owner, baseTime, nil)
+
+	ids := func(files []*core.File) []core.FileID {
+		result := make([]core.FileID, len(files))
+		for i, file := range files {
+			result[i] = file.ID
+		}
+		return result
+	}
+
+	files, err := s.File().Query().OwnerID(owner.ID).OrderBy(core.FileOrderCreatedAt).All(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []core.FileID{photo.ID, notes.ID, report.ID}, ids(files))
+
+	files, err = s.File().Query().OwnerID(owner.ID).OrderBy(core.FileOrderDownloads).All(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []core.FileID{notes.ID, report.ID, photo.ID}, ids(files))
+
+	files, err = s.File().Query().OwnerID(owner.ID).Search("notes").OrderBy(core.FileOrderCreatedAt).All(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []core.FileID{photo.ID, notes.ID}, ids(files))
+
+	// like special chars are escaped
+	count, err := s.File().Query().OwnerID(owner.ID).Search("0%").Count(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	count, err = s.File().Query().OwnerID(owner.ID).Kind(core.KindPhoto).Count(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	count, err = s.File().Query().RestrictionChatID(chat.ID).Count(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	blocked := newFile(t, s, other, "blocked.txt", func(file *core.File) {
+		file.IsBlocked = true
+	})
+	violates := newFile(t, s, other, "violates.txt", func(file *core.File) {
+		file.IsViolatesCopyright = null.BoolFrom(true)
+	})
+	newFile(t, s, other, "checked.txt", func(file *core.File) {
+		file.IsViolatesCopyright = null.BoolFrom(false)
+	})
+
+	files, err = s.File().Query().OwnerID(other.ID).NotModerated().All(ctx)
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+	require.NotContains(t, ids(files), blocked.ID)
+	require.NotContains(t, ids(files), violates.ID)
+
+	// pagination is not applied to count
+	query := s.File().Query().OwnerID(owner.ID).OrderBy(core.FileOrderCreatedAt).Offset(1).Limit(1)
+
+	files, err = query.All(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []core.FileID{notes.ID}, ids(files))
+
+	count, err = query.Count(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 3, count)
+
+	file, err := s.File().Query().OwnerID(owner.ID).OrderBy(core.FileOrderCreatedAt).Offset(2).One(ctx)
+	require.NoError(t, err)
+	require.Equal(t, report.ID, file.ID)
+
+	files, err = s.File().Query().OwnerID(owner.ID).Offset(10).All(ctx)
+	require.NoError(t, err)
+	require.Empty(t, files)
+}
+
+func testBundle(t *testing.T, s store.Store) {
+	ctx := context.Background()
+
+	owner := newUser(t, s, 1, "")
+	chat := newChat(t, s, owner, -1001)
+
+	first := newFile(t, s, owner, "first.txt", nil)
+	second := newFile(t, s, owner, "second.txt", nil)
+
+	bundle := core.NewBundle("caption", []core.FileID{second.ID, first.ID}, owner.ID, false)
+	bundle.CreatedAt = baseTime
+
+	// public id of bundle can't be used by file
+	bundle.PublicID = first.PublicID
+
+	require.NoError(t, s.Bundle().Add(ctx, bundle))
+	require.NotZero(t, bundle.ID)
+	require.NotEqual(t, first.PublicID, bundle.PublicID)
+
+	found, err := s.Bundle().Query().PublicID(bundle.PublicID).One(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []core.FileID{second.ID, first.ID}, found.FileIDs)
+
+	found.Restriction.ToggleChat(chat.ID)
+	found.FileIDs = []core.FileID{first.ID, second.ID}
+	require.NoError(t, s.Bundle().Update(ctx, found))
+
+	found, err = s.Bundle().Query().ID(bundle.ID).One(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []core.FileID{first.ID, second.ID}, found.FileIDs)
+	require.Equal(t, []core.ChatID{chat.ID}, found.Restriction.ChatIDs)
+
+	// deleted file is removed from bundle
+	require.NoError(t, s.File().Query().ID(first.ID).Delete(ctx))
+
+	found, err = s.Bundle().Query().ID(bundle.ID).One(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []core.FileID{second.ID}, found.FileIDs)
+
+	count, err := s.Bundle().Query().OwnerID(owner.ID).Count(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	require.NoError(t, s.Bundle().Query().ID(bundle.ID).Delete(ctx))
+
+	_, err = s.Bundle().Query().ID(bundle.ID).One(ctx)
+	require.True(t, errors.Is(err, core.ErrBundleNotFound))
+
+	err = s.Bundle().Query().ID(bundle.ID).Delete(ctx)
+	require.True(t, errors.Is(err, core.ErrBundleNotFound))
+}
+
+func testReport(t *testing.T, s store.Store) {
+	ctx := context.Background()
+
+	owner := newUser(t, s, 1, "")
+	reporter := newUser(t, s, 2, "")
+	admin := newUser(t, s, 3, "")
+
+	first := newFile(t, s, owner, "first.txt", nil)
+	second := newFile(t, s, owner, "second.txt", nil)
+
+	report := core.NewReport(first.ID, reporter.ID, "reason")
+	report.CreatedAt = baseTime
+
+	require.NoError(t, s.Report().Add(ctx, report))
+	require.NotZero(t, report.ID)
+
+	other := core.NewReport(second.ID, reporter.ID, "other")
+	other.CreatedAt = baseTime
+	require.NoError(t, s.Report().Add(ctx, other))
+
+	found, err := s.Report().Query().ID(report.ID).One(ctx)
+	require.NoError(t, err)
+	require.Equal(t, report, found)
+
+	require.NoError(t, found.Resolve(admin.ID, true))
+	found.ResolvedAt = null.TimeFrom(baseTime.Add(time.Hour))
+	require.NoError(t, s.Report().Update(ctx, found))
+
+	updated, err := s.Report().Query().ID(report.ID).One(ctx)
+	require.NoError(t, err)
+	require.Equal(t, found, updated)
+
+	pending, err := s.Report().Query().Status(core.ReportStatusPending, core.ReportStatusAppealed).All(ctx)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	require.Equal(t, other.ID, pending[0].ID)
+
+	all, err := s.Report().Query().ReporterID(reporter.ID).Limit(1).All(ctx)
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+	require.Equal(t, report.ID, all[0].ID)
+
+	count, err := s.Report().Query().FileID(first.ID).Count(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	// reports are deleted with file
+	require.NoError(t, s.File().Query().ID(first.ID).Delete(ctx))
+
+	_, err = s.Report().Query().ID(report.ID).One(ctx)
+	require.True(t, errors.Is(err, core.ErrReportNotFound))
+
+	err = s.Report().Update(ctx, report)
+	require.True(t, errors.Is(err, core.ErrReportNotFound))
+}
+
+func testAudit(t *testing.T, s store.Store) {
+	ctx := context.Background()
+
+	admin := newUser(t, s, 1, "")
+	user := newUser(t, s, 2, "")
+	file := newFile(t, s, user, "file.txt", nil)
+
+	block := core.NewFileAuditEntry(admin.ID, core.AuditActionFileBlock, file.ID)
+	block.CreatedAt = baseTime
+	require.NoError(t, s.Audit().Add(ctx, block))
+	require.NotZero(t, block.ID)
+
+	ban := core.NewUserAuditEntry(admin.ID, core.AuditActionUserBan, user.ID)
+	ban.CreatedAt = baseTime.Add(time.Minute)
+	require.NoError(t, s.Audit().Add(ctx, ban))
+
+	all, err := s.Audit().Query().AdminID(admin.ID).All(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []*core.AuditEntry{ban, block}, all)
+
+	last, err := s.Audit().Query().Limit(1).All(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []*core.AuditEntry{ban}, last)
+
+	count, err := s.Audit().Query().FileID(file.ID).Count(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	count, err = s.Audit().Query().UserID(user.ID).Count(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	// entries are kept after file deletion
+	require.NoError(t, s.File().Query().ID(file.ID).Delete(ctx))
+
+	count, err = s.Audit().Query().Count(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+}
+
+func testBroadcast(t *testing.T, s store.Store) {
+	ctx := context.Background()
+
+	admin := newUser(t, s, 1, "")
+	user := newUser(t, s, 2, "")
+
+	first := core.NewBroadcast(admin.ID, int64(admin.ID), 10)
+	first.CreatedAt = baseTime
+	first.Buttons = []core.BroadcastButton{{Text: "Site", URL: "https://example.com"}}
+	first.Segment.Language = null.StringFrom("ru")
+	require.NoError(t, s.Broadcast().Add(ctx, first))
+	require.NotZero(t, first.ID)
+
+	second := core.NewBroadcast(admin.ID, int64(admin.ID), 11)
+	second.CreatedAt = baseTime
+	require.NoError(t, s.Broadcast().Add(ctx, second))
+
+	require.NoError(t, first.Start(1))
+
+	delivery := core.NewBroadcastDelivery(first.ID, user.ID, core.BroadcastDeliveryStatusBlocked, "")
+	delivery.CreatedAt = baseTime
+	require.NoError(t, s.BroadcastDelivery().Add(ctx, delivery))
+	first.Register(delivery)
+
+	require.NoError(t, s.Broadcast().Update(ctx, first))
+
+	found, err := s.Broadcast().Query().ID(first.ID).One(ctx)
+	require.NoError(t, err)
+	require.Equal(t, core.BroadcastStatusRunning, found.Status)
+	require.Equal(t, first.Buttons, found.Buttons)
+	require.Equal(t, first.Segment.Language, found.Segment.Language)
+	require.Equal(t, user.ID, found.LastUserID)
+	require.Equal(t, 1, found.Blocked)
+	require.True(t, found.StartedAt.Valid)
+
+	_, err = s.Broadcast().Query().ID(100).One(ctx)
+	require.True(t, errors.Is(err, core.ErrBroadcastNotFound))
+
+	err = s.Broadcast().Update(ctx, &core.Broadcast{ID: 100})
+	require.True(t, errors.Is(err, core.ErrBroadcastNotFound))
+
+	all, err := s.Broadcast().Query().All(ctx)
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+	require.Equal(t, second.ID, all[0].ID)
+	require.Empty(t, all[0].Buttons)
+
+	count, err := s.Broadcast().Query().Status(core.BroadcastStatusDraft).Count(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	count, err = s.BroadcastDelivery().Query().
+		BroadcastID(first.ID).
+		Status(core.BroadcastDeliveryStatusBlocked).
+		Count(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	// delivery is registered once per user
+	require.Error(t, s.BroadcastDelivery().Add(ctx, delivery))
+}
+
+func testDownload(t *testing.T, s store.Store) {
+	ctx := context.Background()
+
+	owner := newUser(t, s, 1, "")
+	other := newUser(t, s, 2, "")
+	chat := newChat(t, s, owner, -1001)
+
+	file := newFile(t, s, owner, "file.txt", func(file *core.File) {
+		file.Restriction.ToggleChat(chat.ID)
+	})
+	second := newFile(t, s, owner, "second.txt", nil)
+
+	newDownload(t, s, file, owner, baseTime, func(dwn *core.Download) {
+		dwn.SetNewSubscription(true)
+		dwn.ChatIDs = []core.ChatID{chat.ID}
+	})
+	newDownload(t, s, file, owner, baseTime, func(dwn *core.Download) {
+		dwn.SetNewSubscription(false)
+		dwn.ChatIDs = []core.ChatID{chat.ID}
+	})
+	newDownload(t, s, file, other, baseTime, func(dwn *core.Download) {
+		dwn.Source = core.DownloadSourceInline
+	})
+
+	// chat stats keep downloads required subscription before restriction was removed
+	newDownload(t, s, second, other, baseTime, func(dwn *core.Download) {
+		dwn.SetNewSubscription(true)
+		dwn.ChatIDs = []core.ChatID{chat.ID}
+	})
+
+	stats, err := s.Download().GetFileStats(ctx, file.ID)
+	require.NoError(t, err)
+	require.Equal(t, &core.FileDownloadStats{
+		Total:            3,
+		Unique:           2,
+		NewSubscription:  1,
+		WithSubscription: 1,
+	}, stats)
+
+	chatStats, err := s.Download().GetChatStats(ctx, chat.ID)
+	require.NoError(t, err)
+	require.Equal(t, &core.ChatDownloadStats{
+		NewSubscription:  2,
+		WithSubscription: 1,
+	}, chatStats)
+
+	count, err := s.Download().Query().FileID(file.ID).UserID(owner.ID).Count(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+
+	// bundle download is stored as row per file
+	bundle := core.NewBundle("", []core.FileID{file.ID, second.ID}, owner.ID, false)
+	require.NoError(t, s.Bundle().Add(ctx, bundle))
+
+	for _, at := range []time.Time{baseTime, baseTime.Add(time.Minute)} {
+		for _, dwn := range core.NewBundleDownloads(bundle.ID, []*core.File{file, second}, other.ID) {
+			dwn.At = at
+			require.NoError(t, s.Download().Add(ctx, dwn))
+		}
+	}
+
+	stats, err = s.Download().GetBundleStats(ctx, bundle.ID)
+	require.NoError(t, err)
+	require.Equal(t, &core.FileDownloadStats{
+		Total:  2,
+		Unique: 1,
+	}, stats)
+}
+
+func testDownloadSeriesAndLog(t *testing.T, s store.Store) {
+	ctx := context.Background()
+
+	owner := newUser(t, s, 1, "owner")
+	other := newUser(t, s, 2, "")
+	chat := newChat(t, s, owner, -1001)
+
+	file := newFile(t, s, owner, "file.txt", func(file *core.File) {
+		file.Restriction.ToggleChat(chat.ID)
+	})
+
+	withChat := func(dwn *core.Download) {
+		dwn.ChatIDs = []core.ChatID{chat.ID}
+	}
+
+	newDownload(t, s, file, owner, baseTime.Add(time.Hour), func(dwn *core.Download) {
+		dwn.SetNewSubscription(true)
+		withChat(dwn)
+	})
+	newDownload(t, s, file, other, baseTime.Add(2*time.Hour), withChat)
+	newDownload(t, s, file, owner, baseTime.Add(50*time.Hour), withChat)
+
+	query := &core.DownloadSeriesQuery{
+		From: baseTime,
+		To:   baseTime.Add(72 * time.Hour),
+		Step: 24 * time.Hour,
+	}
+
+	for name, get := range map[string]func() ([]*core.DownloadSeriesPoint, error){
+		"file": func() ([]*core.DownloadSeriesPoint, error) {
+			return s.Download().GetFileSeries(ctx, file.ID, query)
+		},
+		"chat": func() ([]*core.DownloadSeriesPoint, error) {
+			return s.Download().GetChatSeries(ctx, chat.ID, query)
+		},
+	} {
+		series, err := get()
+		require.NoError(t, err, name)
+		require.Len(t, series, 3, name)
+
+		require.True(t, baseTime.Equal(series[0].At), name)
+		require.Equal(t, 2, series[0].Total, name)
+		require.Equal(t, 2, series[0].Unique, name)
+		require.Equal(t, 1, series[0].NewSubscription, name)
+		require.Zero(t, series[1].Total, name)
+		require.Equal(t, 1, series[2].Total, name)
+	}
+
+	page, err := s.Download().GetFileLog(ctx, file.ID, 0, 2)
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+	require.Equal(t, owner.ID, page[0].UserID)
+	require.Equal(t, null.StringFrom("owner"), page[0].Username)
+	require.Equal(t, null.BoolFrom(true), page[0].NewSubscription)
+	require.False(t, page[1].Username.Valid)
+
+	page, err = s.Download().GetFileLog(ctx, file.ID, page[1].ID, 2)
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+
+	page, err = s.Download().GetChatLog(ctx, chat.ID, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, page, 3)
+}
+
+var errRollback = errors.New("rollback")
+
+func testTx(t *testing.T, s store.Store) {
+	ctx := context.Background()
+
+	owner := newUser(t, s, 1, "")
+
+	// commit
+	require.NoError(t, s.Tx(ctx, func(ctx context.Context) error {
+		newFile(t, txStore{s, ctx}, owner, "committed.txt", nil)
+		return nil
+	}))
+
+	// rollback, including nested tx
+	err := s.Tx(ctx, func(ctx context.Context) error {
+		file := core.NewFile("telegram", "", core.KindDocument, "", 1, "rolled-back.txt", owner.ID, false, core.Metadata{})
+		if err := s.File().Add(ctx, file); err != nil {
+			return err
+		}
+
+		return s.Tx(ctx, func(ctx context.Context) error {
+			file.Name = "updated.txt"
+			if err := s.File().Update(ctx, file); err != nil {
+				return err
+			}
+
+			count, err := s.File().Query().OwnerID(owner.ID).Count(ctx)
+			if err != nil {
+				return err
+			}
+			require.Equal(t, 2, count)
+
+			return errRollback
+		})
+	})
+	require.True(t, errors.Is(err, errRollback))
+
+	files, err := s.File().Query().OwnerID(owner.ID).All(ctx)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	require.Equal(t, "committed.txt", files[0].Name)
+}
+
+// txStore is store which uses context of tx in helpers.
+type txStore struct {
+	store.Store
+	ctx context.Context
+}
+
+func (s txStore) File() core.FileStore {
+	return txFileStore{s.Store.File(), s.ctx}
+}
+
+type txFileStore struct {
+	core.FileStore
+	ctx context.Context
+}
+
+func (s txFileStore) Add(_ context.Context, file *core.File) error {
+	return s.FileStore.Add(s.ctx, file)
+}